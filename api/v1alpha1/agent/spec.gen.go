@@ -19,74 +19,91 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcWXPbOrL+Kyje+5DUUIu33Imr8mAryUR1jhyXZTsPJykXRLZEjEmAA4BSdFL677ew",
-	"cBNBSnZsz5anyCTQGxofGt3N/PAClqSMApXCO/3hiSCCBOufZwugUv1IOUuBSwL6ccABSwjP9Ks54wmW",
-	"3qkXYgk9SRLwfE+uU/BOPSE5oQtv46spIVBJcHzDYzWtMYKENWpZRkIXISGxzLQUQLPEO/3Do0z2AkYp",
-	"BBLUlBUmktBFb854r2QrPN8Dzhn3fG+BZQSKYI9Qol72CF0ClYyvPd/L0p5kPaWN53uCZTyA3oJR8L61",
-	"ijOmc+ZUKkvDh1pqCVwQRh3kNr7H4R8Z4RAqvbV9rDlqgmxb268sWFWkklepGZv9HQKp5NBrf8nZ93XT",
-	"ASIpU7uOCaG/A13IyDs98D2axTGexeCdSp7Btna+973HcEp6AQthAbQH3yXHPYkXmuoSx0Sb/dRjCZGU",
-	"xH7GY19IzKWgTK6IjN4p1kLbQv96YSm2RKCsMNDzSpDg7+8OhsOht1Fs3Ws11R5wk5qpjj3btQH3Fyl3",
-	"QWUVbQSzRcZ7bN9HMCmIb2p7/+fpGlKbHVv4EZTVUh3qlerYy4+maxygCgP7IUAuSWW5XJt+FGdCAv8I",
-	"WGbcOE7djUIuPlDl2Xq5Q5jjLJbe6RzHAnwvBBFwkkqts/clAoWz6P3VFL16T5Tqs0xCiK7ACIGmQQRh",
-	"FgN/jYhAYAijOeNIRkSgwEhT+tGMsRgwVZKGXExYCDUpvAsF09tiKPY4kyzB6gFKWAiWBVQ45KfJxyyO",
-	"1+jMjNfnySXmyo5bTyeYZlhZ9qLtaIhwzVIuyyynaQQc0Kcz9OoTWUTobIlJjGckJnLdaRPUK3QKtGwc",
-	"DEKh24lAjCKR8SVZErpAERNSIDxXs7D+C80xiTMODsNu2p3iRpKY/ImldegteGF0TkKgATS1fY8lRgFb",
-	"AscLQOVIlAIPgEr19NWwdzAcvvZRgOMgi5WRERZoObq86a2ALCL1IKfh+ZWzlGUKZ30vwd9JotbwYDj0",
-	"FRybv4aFQjRLZsB1MJJmd3i5aEp6ZmUcXd6grFTXIehTiJDg700RJobGC4mQvj1pivD2REY5PxK/hDUS",
-	"SLoXJIGE8fULSNG5Ji8mxV7L8gLSbB01+b4pfad05HIRSxVKk/pVgHAdPAojhGTcEbmERNyb6KIBsXMO",
-	"MMIpDohc/+28MoRQCQtjzwjzcIU5nAUBxMAVskzYEiqDK6eKQsexA7DH+jCdE+CIzfXJoXF0pdFb43KY",
-	"K6BQG0uJ1dHm7YoE1XqzENw3opQzyQIWX+sXP1yXD6Z2x5iNlHEXGS/A+X85zL1T738G5dVuYO91g6l7",
-	"lkJ+JnG8y56yTZol0JDx3fcW/bbJrLGaBUU/d4H2xdwyVm5Vl6d90JfAhpclIIQ6WhpLr8ej/LW/Q7l8",
-	"3LeN730iQrIFx4khmnIIdPBgPWHLy7HE6l8iIRFdlvcw53itPYfQWxxn4B4tJKSuN9sC50TsDN9I4rLc",
-	"JyZcyYA0GzEbK9Ytd6FRRG0YdYIEelDrhqhIHqTZlAX3IHfSFHbYPlSJY1vfUPKPDBApd3cRZ6n97bqe",
-	"G9SdnDeJKfPkoEwompxXsZdQ+eZ4Lznb8cBu99uEKY7TLE0Zl13hpd3paDnRMxQyiXyWihELRdErJfx0",
-	"LSQk/QCnNgLt5xwndY6vnRF56/73veXeIj9a1G0Wr93BbdX1C3hpB4sxnXPs8HkTEYtL4OrgUmcu8Afu",
-	"3iDNPi+Bj1iSEJnYPFvdKMrF1ZigGIOuFFj30agWJWsoRWdxzDTA6KhZoAG61s8vo7VQlwQ0sjuwGRA0",
-	"gpDiNBM1rbqOlfIEdyirVu6SrYBPJZaGKA5DovTE8WXNtq2WK1dF32j2FkzDVotMagXt9cYN0g+BY731",
-	"d63p1dkkB4nHLK2dmq+t/ROba2MM+60uBbli/H5/E16YCS6tzTFu94Pbhg7TqUnlzmkzsBr1KV/r5vtl",
-	"8nTLtx2jlKybzlsxYG2nuAEkTyy3gkjXZuhalIK0MqTetFuXllQdk3m+gOIElDup4JVwVCS8deDqOSS3",
-	"OeM77HDia5KAkDhJVQRMdTxcJ4hWWCBLATGOAhbHeX5+zzR4CaoPMoKdd+c67sfv8+h9OTLU2xnfVXJ3",
-	"dSq35sUWKYRT0kcfGUfwHSdpDOir99f+sH/UH371doaNFan90jE6Heq9DRidTlVN4HWZbzvftym4b2V7",
-	"9iBSnaHirfzo7F4+NWj/5b6162Z2/87RE9G0dKJ2rRHOad90eWwuRo6bqEk0/g1LWOF1Ld9N0uXxU+S7",
-	"SXp8h8OQm4z/iRY/pOLFeJH0LAw5iJfjKLIZBTnB4v5pEvua3F2Cxb1JmTcz5qWONe7+9voayzudRIgM",
-	"xDkHfB+ylSMdisMlERb12y4wtxOBVkRGCEsUAxYSMQrozM5ERPHwnPcjTnTW9+HER3ZmB3HIr8cPo2xu",
-	"ye1kCTXO5ETUXcTH5eQOFivMqXKaB5P/Yia2kt7OguXmL1nW9fPL5c/t6XKi3/HMXPTqrnMP6yfZCLEm",
-	"r6tQW0mCn6e5ZRElcs7GpemELEySSe8bx24RAoTI4+Vm7wDLam8al/rGjDg3bfepa4b5Vf45N5caeRTc",
-	"PBeWYkVkEDllUYGX80WeSMsrT0JiGmIemnxXXixTf+XkfS+jxa3YWXNaxpi25EqXiRi1GdKdodOSuwwx",
-	"1dU7xzrm7SJdh7LpKdn4ZvRtW5SlhyEbhKFX+Q+JF6+RjrdDE3t+vj3TwabC4ZjhcL+ka5X3lzbcyHHB",
-	"5vQQmeeccU24kMznwAWac5agIONcvawN2UekRzTV7NkyQ9xJjDRvXdi5WqbJQZ3VIrrMZjEJfoOdM28t",
-	"foTT6adyknbEykbqpFAMdJYlSfVqtdcdoYCG/W++BqQd997Wbc3oJYeEiFo2o5ImM/03eU5/64JCQ6Lu",
-	"r0L5ts6H1a9WytFt/85W+Xe2Rphap2McJbo6bZ+jTNRK3GqmrgLpCrYLRh7ctuRqTdIWau89qtipHWO6",
-	"e1q0uuN9N8HDvWVbq0qTWM66Q/RsJuBpmufI86nY4cj79/U0vPoZPa24Kj7umOtcrDY/e2LD/pQCbQ65",
-	"47B+WtW6NGiV0SlcawWz0V6yAKHeXUccRMTiev/R0XA7/fU7lkCDNZL5eEQoSkgcEwEBo6FAM1gzqqII",
-	"EkQaZ221A2mMR0SggFFBQuC69UQLoKOLlvL5ifOu1hS82bZUQHmjd2mSNyuVdCoa5b0yJsKobrqcWsfO",
-	"g4e2ceXVpPHgMxoxKjmLnR1KRUbUWSWyLQqf55eA768jzrJFlGayJsbbxmpemlnqDpcCvlc2yCfu0c7Q",
-	"CQB5Sqm5NcK9enBdbn07yVvcOnIEUbU83FnAKAbmSfGOfPlHxs11ywSb+437QmRko13RPeeCyW7yrkS6",
-	"55RtpyBtXN0WFy7USNIYvhO5LroPLbo8Jt8eEnE/JX/CNQE+zZIEGyzc6nWrMMozDrM1SvIbMCplQjEs",
-	"IfYRUE6CSN1niIxswUfxQoL8qXvkzMA+mmYpcAEhCBRW2JyvRwXNvjOTX6nQd6dLm167Mc0XJQel/Ytb",
-	"EAecCa31fa9iQEnUtSsTECobKwhXEGkTRUAXhNo+qGty7qODYe/Q/Doc9k7Mr5PhX67J+ev+V+oynNE8",
-	"232n7bCcaaN55OTcWE9scKeiKnYTP8NIEdjBxOmzDysGN2t8P7kB0avhu5syreKjg3cfsFj76PDdBEKS",
-	"JT46evcJ89BHx+++RETC32K2hNfebhXTbNfiufTbczOMLm/0BkCzTDfBoFfQX/R99NUb9o6/eurHSe+v",
-	"5sfb3sEb8+vg/3pHh+bn0eFfvnp7qDHRNeZn1MQw2K2MS4ej3hv7/s1J7+DQ6ntw+LZ3eGKHH5682U/R",
-	"CxIUu/0p1Zyt0cV4hHRisaKYFdUKafUx/xy3CUyahYfOoH1ruG4asxuhet7vlQrZyuK6ciIVAz4C8Wj1",
-	"lL8CLBh9SumY+FmkaSwHKz4beQxo2tkurEyfrFeG4+TRR9CuWHOvQPPBUaYaNo0wh/A9EfdiVz1HRlii",
-	"CC+hXtQRmoIOGXaXdGpRai1ELWKn3JLFqV4ND+oL1uLJrr3nDGWdidNn/8RMiOjuHkzW6zZprXXoUvAu",
-	"2Clr6Ju2cGM7LGkw0muhRzl6La+3omRC0fV5eQVVR8l+rVDLpECsLicjtEZ4H3eyopcsvnUEXs9iBf3C",
-	"1nKezhQt+00z0y0xyky2xbvbTDlDv6blt06c3b7gtVYGbUCXhy11jT5P8+5SE3WoS9YVhOgTlui30RRh",
-	"LkkQAzo+PDo+eXtQSa4Eivmc6DyQ6R+9KytyvrpxJhklcl17KlIICI7vIkzDWO1O55fE1eZYV9lgwXEI",
-	"V6BYAA1bSunFewjR5ymys7RPTK5vUaV+qF7rtQwwRTPIh4ZIMoRRddjOdH9gl9FVm8wXcWObAJTIMQmA",
-	"Cn1omCSpd5biIAJ02B96vpfx2Ds1n/eeDgar1aqP9es+44uBnSsGv49HHy6mH3qH/WE/kokp0hCpANH7",
-	"nAKdRmQuUREW5I0V6OxyjHq2UgI0TBmh1U+hT72MhjAnFELteSlQnBLv1DvqD/vKE1IsI+18A5ySwfJg",
-	"oEmJwQ8SbgblF6pFTqvWb66zsMiMyhvITNVUs7IRTFgMrXzYq1lznIDpGfzD0dxWpUbUMyVrnhs+NVWZ",
-	"ct3MsWEwe48c/+abmQxCnrNwbbOy0lZ8cZrGJNDiD/4ujGeWpHdWGGt1no1tMBApo7aMdjgcOnbxb2qF",
-	"DocHba+OzawnEdN8NqIlq7M6xyG6MnYxPA+en+cNxZmMGCd/Gi89Hh49P9OPjM9IGAI1HI+fn+MFk2jO",
-	"Mqp1PHmJxRxTCZziGE2BL4GjfKDvmcjpD9vj8E09ygHARM4GAXZsfWG+OtYpCFmt7CqAxsh+Fv2KQxrj",
-	"AAQq7yDIYkuBWa9bIMM2Z+xGCws/Ih//b4MYtYrWVjOSErEFPJ6Qt8t1fkHOfwjkfPwXQxy7QVshxxV0",
-	"7MiRfri8+jA6u/7w/hTdCECXN9fIRRkRKiTgsI+uI1IiD1qROFYhI4eELUFXVjGaZzLjkHc89VEV8Eqc",
-	"Y/MC5vqdADau1Lb/w5FsO/j5hWe/8Oy/Gs90M87gh/l3bIKqEFTE5PjPRfRzoa+sang1puIsKdCmATZm",
-	"Yq1X618BafwOrkZByZA1hpN/brWfx7sa5Bw3TW+sZoUJkciCAISYZ3G8/mdAB+qhMdWJTXRzM36PjI46",
-	"sib6I7TCMr8w5r8GY3z3bWykmzCF8orMxikuAKlcyjqDlX8n/Hhu1Hi2KKnaovlPiZLsKjsc0iKhbWat",
-	"ZKVekrttLP4Vtf1C1GeN2ja+J/Qog3EmWz/wNt82/x8AAP//Vf82P0pWAAA=",
+	"H4sIAAAAAAAC/+w9a3PbOJJ/BcW7qktqKVl27LmNq/LBcR527ShxWbbzYZxKQWRLxJoEOAAoRzPl/36F",
+	"B98gRcWyd/Y2nyITQHeju9HoF5k/vYAlKaNApfCO//REEEGC9c+TJVA5k1hm4joNsQT1MOUsBS4J6CkB",
+	"hxCoJDi+5rF6INcpeMeekJzQped730cMp2QUsBCWQEfwXXI8knipV69wTAxcj8PvGeEQ+hmPvYcH3xMs",
+	"4wGch2regvEES+/YyzISev7jkRTANSa9w50Qb0GVUM/pgu0EcoK/vzmYTDToFXBBGN0hXO9Bwc0fese/",
+	"5Uyp7cNviLukpCKur4V42PyfEEjvwfdmevCCxSSwelPXooSFWWx+4jAkkjCK44valPpGH3wvBBFwkkrN",
+	"CO8SlgwZGhBbIBkBSi06H93BGkI0X6MFiQFRnIDnIJLDiuRsbQI3IzlkHEiyKhHkjxlfYkr+wGqVjybo",
+	"PgKKGI3XenQZszmOy0U4TeN1SQehEpbAvaYcCqr8gkvdHO4/q1gd5yEH6sH3CF0BlYyv1ez/5rDwjr3/",
+	"2istxZ41E3ssBYpT8u28WKAUNBFtLl4AH91MEYeA8bBgWoFojK4iWCMOaYwD0GPt2UbCPrqPSBAhLAQI",
+	"kSh6bmnAAUsI0YKzpDIXBSxdI0IlUw8JR4LiVERMijH6ogWUECkh9KtrIiwQZRX841slACIhEUMZclIQ",
+	"dzP1HgoWY87xuiXlkt1+IaYeMWdzAdJpjBULTmRNxEoZRpIk4JTzc6iDPnGuMzzcxj/4XqbV+ko//tMD",
+	"miWKcziTTJ0NTDMce187123FlVUAVAI3hLVHE3HKMiorg9XT2yu0rrP5RAx+1Ea6FFRj69bOp9nixp38",
+	"NDg/ZnBcgixAKlO0EzuzwU8cbolKZy23AJTJUcAohUCCWnKPiSR0OVowPirRCs/3gHPGPd9bYhmBAjgi",
+	"lKjBUVW9s3Qk2UjrcG6hRktGwfvaSY7by/tB29Pl3LVEGBbe2SY3rRRYlaQS10YduODs+7qtCJGUqZVn",
+	"QuivQJcy8o73fY9mcYznMXjHkmfw4w67OiuUxCooUL41l4IyeU9k9EahNo62/vXMVDRIoKxg0NNSoLz1",
+	"/cnE+uvdMquagvbxjTNRmNK6yTw1Q+j8HcICZQJCRGjdbCK7XDiPuRn71HUfBYwGwKm5D7YxbjfTU7O0",
+	"bdl8L0izzntMj14LvIQL4IE1Z41NX1ybrc7X9hbw1a5TM1/dBEQKpGapMyVjUIxFL/BcqH+1l18x5SED",
+	"Qf9HqnuFcYmIfOn5laPPMqUOxR5olswNmSGW2Nxx7nCHiLuPb907jJiQPTa1/VhcQZLG9pa2w3PGYsCa",
+	"vwkkjK+nHdjMaD9LP2YgZB4nmQX5jermrp2za6YmZMl1PPb+exBnIYTuDXf6T8wde6bsHrgKtgZ4XXXG",
+	"XF+fv8s5sTrV03LPYw4xo0uBJEMvIEnluuQCfNfbdnJhwCVhI97y3NcPapXgmh5azdJsqG26cuQq2lIo",
+	"aU3DXEKoGIK+q8daow+AZcZdeYOQi/dU2VfL6QXOYukdL3AsoJkh+BKBuvXRu8sZevGOKH7NM+W/XYJV",
+	"slkQgYqw+UtEBAIDGC2YkhARud3zfIcChVxMWQg1KrxPjILXJEOhVzFLojmCEhaCRQEVDLlv8yGL4zU6",
+	"MfM14y4wV/d64+nUhD++welyVCJc45SLM6tZGgEHdHaCXpyRZYROVpjEeE5iIte9PEGjYk+Bpo2DuSfR",
+	"zVQgRpHI+IqsCF0ipVEC4YVahfVfaIFJnHFwMPZhs3JcSxLbnIvjpmN0QUKgAbR3/Q5LjAK2Ao6XgMqZ",
+	"uWVST19MRvuTyUsfBTgOsli7+1ig1enF9egeyDJSD3IYTouU4O8kUbLcn0zUSaDmr4nDVgVp9g2vlm1K",
+	"TyyN+p4qt+sgdBckJPh7m4SpgfFMJKSvj9okvD6SUY5PpfGenpQEkn6B2Evr6anolcmzUTFILM9ATeOK",
+	"y89NqTulIpdCLLdQstSvGoi+i0jZCiEZd2Q11JXXkZRYcIBTnOKAyHWn64Z5eI85nAQBxMCVhZmyVYdf",
+	"pqyly6k418HeggDPXQs1U7kPHIydDvMNKCuOpcTqqvM2xScPOucM7ng95UyygMV5Qs4RGjN1Ss7ZqWLy",
+	"MuOFkR7i8M/cq9WNwCSON/FVdlG1Ahoyvjm61qNtZC2pFhD9XBW6hdpgWs7dPs17r1MW7YIJCOV/t1VB",
+	"z0f58CbvMJ/3tYLxjAjJlhwnBnjKIVAbyDWkof1Y4los1yWJMlhLCL3BcQbu2UJCOiAdWQCxK4zb2svJ",
+	"MxskNTwE5cda37LOyU/a2qgDpW6aQE/qPDD1cHPGgjuQG2EKO20IVOKKJSj5PQNEytNf+GXWb28f50pk",
+	"Vwem2JMbb0LR9G3VRhMqfzkcRGe3vbDm4CZhCuMsS1PGZZ87ai0AWk31CmW5RL5K+ZTFRtELRfxsLSQk",
+	"4wCn1mMd5xindYwvnR58p11QyeWhJP8wqatkM42NI1CYnc1G5JwuOO7MA4kL4O9qyYctTnOQZp9XwE9Z",
+	"khCZdKZX1JygmIMulTEfo9OaV61NLTqJY6YNjvayBdpDV/r5RbQWKrhAp/YkDkyp6Ftv+3xTeeM7Nq0k",
+	"eVFEw0PK1m0OllJS0LYnUJuzDtqURG145Dbi25hrbRI2yfjyZJobjx8RtV2ay9r+iU34GcMwaVOQ94zf",
+	"bc/KT2aha/fm+rfnxM1LBwvVovJEdTFazTrLZd8eXyW7E2PTtylRt5W5wsjaCeo3MJXSntvI9B2SrYqB",
+	"irHt/o8pTtW1arHpHg+BihJcmbxWG3I1f9gKyTfsUO4rkoCQOEnLhFwdILrHAlkIiHEUsDjOq1FbFZx/",
+	"mBl2/TeXm9BKOPYQ8K1SfapDuTEDDVAIp2SMPjCO4DtO0hjQrff38WT8ajy59Ta6nxWq/VJRBinaO+t4",
+	"OpWtmjAcws5mnrEsZDSyS1sAq67UDRT2Ch4mXjV5e7W4sfItC+LDSiuiLZlEeDnRvfJIV4cmQHNExiYR",
+	"+hFLuMfrWgGUpKvDXbTxkfTwGw5DbupiR3obIRXPhoukJ2HIQTwfRpHNKcgpFne76VfU4L4lWNyZTsB2",
+	"I2C5xxp2vylfw/leZREiA/GWA74L2b0jXYvDFRH2FukKnFRCWVVfEZYoBiwkYhTQiV2JiMLhOeMyTnR2",
+	"envgp3ZlD3DIw/TtIJtovRssoUapnBZ5E/DzcnEPinvMqVKercF/MQs7QTezdDn7S5T1/fml+HN+9inT",
+	"r3gOcVuF7mC9k4MRa/C62baRrHg8zAZnFMk5mr4dT/Mymj5HjtNTFP07Su+dFfKOMnGcs7g1wiGBkGx1",
+	"LV5WljQ5YBD51R3k9PYxJPfb2zfPSjVoBJGT9s5ar2x0FgqJaYh5aDJ7ebnQ80vwvpfRIs53Vt1WMaaP",
+	"b77Twz19dy4eO0ryJMQ0AOUVcxAsXgHCqKjNmnPsl91sFRmjAEscs2Xu/NlW5s8XJ5V2ZlPrLzzkUpaI",
+	"CMQBh3kTXYLXKCSLBfASWQFG/aEp0T41x0RAqI2PaYhraHxRRz0jrqj0jN1Xd1vCJhTNs/jOR2SBUiYE",
+	"qYWUpYxCFvxKaCOk7FCcMmiExYJxecYynVq5SRxZWiGJLt2iCNNQjBhFZpGbXEa1xbWdE5ECPCwk1mxd",
+	"X27b2N5sVe9Sh7Jjwd2pB+lWnGu9gaDWO9jZ2lffmTA9qR3N8INbK/XkB9ubfdMVI+lpyIZQ6EX+Q+Ll",
+	"S6Sj6NCcj883J1q9lRcUMxwOK8VUcX/purXtQF4BUApuMeMaceYACnMCg4xzNVibMoSkp2w4d6Ys07zN",
+	"brDUTGOeUkcRXWTzmAT/gMEQbuwtHs5mZ+Vibbwrl88gSMUCZ1PD43qj9f25fd7LuFAOA9bdDkUvOCRE",
+	"1HKblSR6vUW/kYagIQmwBIHubba8nkhRB8KsDxvNJPM1wtQqpypu6V4X+xxlotYw8wSvA/R1VHX01Vb4",
+	"1GubOiukrTaWJQg1dhVxEBGL6/1OrybNdNivWAINVDujna8ujoTEMREQMBoKNIc1o6G9kpUkbLUEaS1Q",
+	"d3bAqCAhcN3iogmAsLtMf+SMudqEt9ukCmG3eqWmeXNUCaeyo7wnx9iqqgLk0Hq0ALZtG8urUed7n9Ep",
+	"o5Kz2NkRVWRMnVUm2wrxeXEB+O4q4ixbRmkma2S8bknzouyVTAHfIVkuHNA20ftuSjN11NI9Z2zQPhJ9",
+	"al727m4ds2AJS2sVhza3dkUtrmOcBx4FnloM0r+nvG2wJ58RVUvpg4o6xYK8QNBTO/jAuAkJzRU9bN4X",
+	"IiPrI4j+NZ+Y7AfvKip4Tto2EtKFtV8CjoZQ9VKlumUIo1uW5fqSHzyj2qkCHERonglCQQhUwYVCkDrh",
+	"X0Y0S9MBXaEHYRoic1WXvdDVt1OrAM/foRcwXo7RrZcI8Xt86/no1mMcBzGMwrn5U+B0FGGKb72XY/RZ",
+	"vWhqVFLYDmFFs4MOxnMyDNm1URNntesjTGX3vxO5Llpn7VX1mKKO6piZkT/gigCfZUmC+botiyrCXCLz",
+	"dSVyLWlDMawg9hFQToLIRo622qhwIUH+0A2eZuIYzbIUuIAQBAoraN6uTwuYYyc7Km0jw3LsbXNhm/pL",
+	"TIoL/zKO4oAzoblwN6owVBLgovZ2BJjYVS0FuiTUNvVdkbc+2p+MDsyvg8noyPw6mvztirx92aFXhgMZ",
+	"lTvg5Me3OwCSM++JBOFkgHKYxS4QKkAbkDl1fFtT2SxEP/LAoheTN9dlJs1H+2/eY7H20cGbKYQkS3z0",
+	"6s0Z5qGPDt98iYiEjzFbwUtv8xbTbJMwN10FPYdFdbaoA4Lmme7kMiZbWebJ6NCY6KPR382P16P9X8yv",
+	"/f8dvTowP18d/O3WG7CNqW6IeMKdGASbN+Paw6vRL3b8l6PR/oHd7/7B69HBkZ1+cPTLsI1+IkFhDXa5",
+	"zfkafTo/RTqnXNmYJdUSafdj/jnsIpi0q1iDAvbGsurLSVWHbKsIvlEScIXyFYY+wjLSqlt2CVgw+hTU",
+	"MvFYS9R2F4uXcx9jXC0Ul01Nd9YIxnHy6CtsU9AwKGLYOlxQ02YR5hC+I+JObComyghLFOEV1CuKQkPQ",
+	"LkhHqZKsIDQHydFmaN8oQ2Za7q7kdQn1kBeOdxnl6a8A5Gvyt9IQ5jrjzVa5S+86ANqhbx1i/ZQypFLj",
+	"wK3JIRQ1OOujHlZrGA1D8wNfBPiid7Xp2PXFcbUgrnB6c1WtvPBX+nH1E9FhOlzGrzfYcyZin/x1ayGi",
+	"b3dgeNTO5LZzJarxY+h9UHbO9CZmnDJsVx5q3pVW4lynVWZKsMQGmyYgigDR4kSywOT/Vdxo1ozblbbh",
+	"eZrGqbQj+cGzxPlIB7dFZwXjeT+B863ynZWuByaBNteeXe59Sx/0EdKzHA33V42olFB09bbMI0qi89oD",
+	"inyrpLjh+4wuoTXAQ7I5lvQSxSaOVOOPnXJDD9gy+O5Y0nEPaWRsYdllkG5gV47Qr+2yj12lX9LM9Xfq",
+	"ug2Q8jCgvrPPs/yVA+PFp8DRJYToDEv0j9MZwlySIAZ0ePDq8Oj1fiVjHijkC6KT++algm9lU4PO/CQZ",
+	"JXJdeypSCAiOv6n6dayOlvPjKNU3JlzVoiXHIVyCQgE07GhcKMYhRJ9nyK7SujG9ukGVFgw1rGUaYIrm",
+	"kE8NkWQIo+q0jVWewIrT1d6RC/PBdmgpkmMSABXaMpvqmXeSqpfs0MF44vlexmPv2Hyp5Hhv7/7+foz1",
+	"8Jjx5Z5dK/Z+PT99/2n2fnQwnowjmZjaHJHqPvM+p0BnEVlIVNwFedcbOrk4RyNbIAMapozQ6tddjr2M",
+	"hrAgFMKKBnrH3qvxZKw0IcUy0sq3h1Oyt9rf06DE3p8kfNgrP7hTFCpqLyHpshcyswr3aml6eJRim2sr",
+	"LKZWPiqpUXOcgGkQ/83RuVyFRtQzRWtegDs2BryUm7n1zVU7oMz88NUsBiHfsnBtS23S3nKVrOjeP4XR",
+	"zBJ03x3f/m7mg+36EimjtnqqPubYPsX/UBI6mOx3DR1OJjsjs/6OoaawjvItVl9H0PwxuPefD/c1xZmM",
+	"GCd/GK09nLx6PuQfGJ+TMARqMB8+H+ZPTKIFy6je89FzCvucSuAUx2gGfAUc5RN9zzjIv9mWma/qUW4o",
+	"bL1BW4oNJkJY/0+l/mS18K8MOc4/9PLCfo5NoDK2R9YGFbbtZYdpsb0+m61K7eNu/06WpfaFvUYnqSKx",
+	"w8jsVIMslx0q9NNE/YeYqA9/UQtlD3SnidpLK58gXoLrw1UgtWmo9bY6vu/b/D6kZMrhBNXFrU1WEWeb",
+	"XspbWgArO2S1v2bfFRONb1Pe0paN+wiy8R3lv4yheyKL09huj8X5eer/kx2TjcfeEcNsKGG+v7h8f3py",
+	"9f7dMboWgC6ur5ALMiJUSMChyiKT0kFB9ySO0bzMIROKMFpkMuOQ99eOUdUvKt0htii8oXGvn3Ne+Wbp",
+	"/3OHpxlL/XR7fro9P92eLeyf/vb43p/m33MTq4WgAjHHh/H0c6EzZmp6NVRTpTBcmpG6cTILa5+o/ytY",
+	"Jr8Hq9mgZMgyw4k/59qO/aTDNusN1ywxIRJZEIAQC/URyH+liUEjdE51eQzp75eavap/ENEfPCg49NMW",
+	"/bRF1hb57mTQqX5lQjehZtb/cRkaxrvtTNUJ+neyM09tXZ7M+6r+zxXP7H3VpOxQSDOSvzhUSZ4/J3b7",
+	"GtBPL/Cn5f1LeIEPvif0LGMLTfFxz3v4+vB/AwCx7BBX7W0AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -126,9 +143,7 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 		res[pathToFile] = rawSpec
 	}
 
-	pathPrefix := path.Dir(pathToFile)
-
-	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(pathPrefix, "../openapi.yaml")) {
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(path.Dir(pathToFile), "../openapi.yaml")) {
 		if _, ok := res[rawPath]; ok {
 			// it is not possible to compare functions in golang, so always overwrite the old value
 		}
@@ -25,10 +25,23 @@ type AgentStatusUpdate struct {
 	Version       string             `json:"version" validate:"required,max=20"`
 }
 
+// SourcePolicies defines model for SourcePolicies.
+type SourcePolicies struct {
+	// Modules Rego source of the policies, keyed by file name
+	Modules map[string]string `json:"modules"`
+
+	// Revision Revision of the active policies of the organization, 0 when only the global policies apply
+	Revision int `json:"revision"`
+}
+
 // SourceStatusUpdate defines model for SourceStatusUpdate.
 type SourceStatusUpdate struct {
 	AgentId   openapi_types.UUID     `json:"agentId"`
 	Inventory externalRef0.Inventory `json:"inventory"`
+
+	// Vms Per-VM records of the inventory. They replace the VM records of the source, which assessments
+	// created from the source copy into their snapshots. When omitted, the source has no VM records.
+	Vms *[]externalRef0.AssessmentVM `json:"vms,omitempty"`
 }
 
 // SourceSubset defines model for SourceSubset.
@@ -59,6 +72,10 @@ type SourceSubsetUpdate struct {
 type SourceUpdate struct {
 	Inventory externalRef0.Inventory `json:"inventory"`
 	VcenterId *string                `json:"vcenterId,omitempty"`
+
+	// Vms Per-VM records of the inventory. They replace the VM records of the source, which assessments
+	// created from the source copy into their snapshots. When omitted, the source has no VM records.
+	Vms *[]externalRef0.AssessmentVM `json:"vms,omitempty"`
 }
 
 // UpdateAgentStatusJSONRequestBody defines body for UpdateAgentStatus for application/json ContentType.
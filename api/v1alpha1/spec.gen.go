@@ -18,215 +18,431 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y963LbOLYo/Coo7q9q7G8kWbKdTNq7UnUc59KeiWNXlGR+TFLaEAlJaJMAGwBlq6dc",
-	"td/h7CfcT3IKF5IgCV4kS066Wz+mJxZxXTcsLKzLvz2fRjEliAjunf3b4/4CRVD989wXeInekCVmlESy",
-	"wSWJEyE/xYzGiAmMVENkNZF/Y4Ei8yGJvLN/yeZB4gtMidfzfoVezwvQ0ut5VCwQ83oeoWICOUeco8D7",
-	"1vPEKkbemccFw2TuPWQ/QMbgyut5CcG/JuhSTyNYgnrefZ/CGPd9GqA5In10LxjsCzhX61jCEAdQyCFo",
-	"JFcXi1VPD9IL8BL1KEF09jJfJvgVggAtgVogKCzv4SFfD53+gnwhF3g+R8QBGZ8hKFBwrj7NKIug8M48",
-	"uZS+wBHyHFv1GQoQERiGn1kou1Va4KAwWpLgwDUQF1AkBTQQKvo+JQT5AskudxALTOb9GWX9fFru9TzE",
-	"GJWImUMJANkGEyw/9jFZIiIoU2iI+4L2FWB7HqcJ81F/TglyolAv55LMqHNTSRysC6klYlySVHW4h57H",
-	"0K8JZiiQ+1bwMeAoLKQM7Z6FMHtJ+Vzf6nB/w+j9qkoACyFig8cIk/eIzMXCOxv1PJKEIZyGKKXf4g7W",
-	"o2eCw17Cwh4XkAlOqLjDYvFSTs0VLNS/nngVpSUQmgFotyuI4P3L0XA4rONThuF5ImgEJZvXyLMZgiJh",
-	"yC3LMJkxOIkZXWJJEXqVfkiTQMmIaBpK1uCILbGPJj4UMKSyyTRMUMywFJE9z6dkhueTaB5J4lr491IY",
-	"Mn+BuGBQKNYTiDEoGUFKSy7/KyD5LZncvuDZv2Ecez3v9gWfEBghHkMf8bI4NX8uIdZw1n9jMkk4+o6y",
-	"tgpGUAQiKIEQ5AAEFvjAwr8HNuhABjgQ8AhkQAMZyEARYAXxDgrAAhao6unpOuabEFKMmJJzxEcTSGC4",
-	"EtiX2FsgGIrFhPuUSWzBUI6nqCyk8wkmHM8Xkmqw4NEEE4HmDJqjlclPHP+mm8NE0AmNBY7wb2kLicCJ",
-	"BPkUh1hI/Powhj4Wq0kcQmLIGRIawXA1CZBA6bH9eyAqJ0iBDVCQghNYwARlUAILkKACRlACIqiAEFQA",
-	"+GgiGyM/YWgjOqMh9leTOV0iRiRolPyJ4hArOEWUYEGNtP1dILm8H+DczeMgrvpF29LpOmpsUiY5lSN6",
-	"RxB7ixkXH0yTAHGf4Vjx5pl3Lb//hYOZbALUML2aUd7DtkFC2DBGjFiEuZTYbmJjCCplawGV8ApQiEQH",
-	"YnnQXeSns397/x9DM+/M+4+j/GpyZO4lRzlmxqaD7EtgzBe0dPtoGmZsejhXojTZy45atmr8Sf1sKwm5",
-	"lsyWglKlVeu2Dmi49FWDAWv8onaa7/lbIwG/lSpEhYjzBbYA6jJrWEug3dk63WQPZstTB7GCwCPAXiTk",
-	"sfoG6AyIBQL5VCCAAp59JeD/B/+V7f+/QB9cQZLAEGS/gSQOKQzAEkPw9/H1B90FSjVfNr+gYaiuUGC6",
-	"AtcxIuMFnglwhdPT4zxYYk4ZUD2+Eq/3eIClSlO6QjW0Fl425VSJppk43mMuOvOMJRQdXJN//agJ3k14",
-	"Mxw6UPYWhyiF+kxCrog0r5dTxBQTqPjqsTDV9xKnKJQCsko/28BjlfDdGFRgasbdOBeYJd7m8hMKLE6d",
-	"UhoiSFI5i4JXrYxvhh8n2dS65z+xvLl1FbOVQYpkUxZ86coLk7WAIZlyJC5tefZU5/U2hehDz1v6iAjE",
-	"LgP314hf0EQrJOaj0lgRazw/8kGtIXqFAyoHUDOkP8eamsvcon8H6spVYZqB1yvh44dguco2L8KEC8Te",
-	"Wpp0cdkB42+I1B4DvfgZTELhnc1gyFGvtJl/LpAyHL7+OAYHr7Fc+zSRB8ZHpEUzGPsLFCQhYocAc4D0",
-	"wEr0iQXmwNerybdvMXDA+BUNUGEV3gdKtL5lL0NODzNjB4hogMwUyJoh1VneJmG4AsY4onjwBjKBYflX",
-	"fVp6PT2nS7VbwAKkXJBZjuMFYgj8fA4OfsbzBTjXyrm6UDXCBPSzPflqbQxpixP4csUBJYAnbImX8gaw",
-	"oFxwAGeyF1R/gRnEYcKQA7ANRPFRk5Kyb8t/I31oFndmPoAYrrJjzIehn4RQaOuGXj6zBqvwhml06YDc",
-	"5euUPdKRBM0mQIVh5dzbOiClNIO+yCmusKaZugv3gBYhHEBw0ieSzEy3bK13WCwAoSBAAfYlIYE7ym4R",
-	"4wNgoKusOoLR8CaEBH2gAVKy6uUJgCQofDO8I8njpZx+AC6Jmk9geblTU0lko+DC6qWaOhnKHvvi5nN1",
-	"mxc3n4FP5RJjxNKlAHnZl1fJAIEDw4hn4Pmh1/MieI8jyVQnL057XoSJ/uu4Vxbcm1yII0xeHisz58mL",
-	"U4OifP1XKDLnUXEL+neACXj3qn0Xo+I2Toc/Pbf2cbq1fZyqfcjhKxvJCMBxVCTRFDHJDdVN8DMwApSB",
-	"E2s3J4e5lBv1Tr5tZflaIR+Bk8rKLfKsrv08DOmdon0lJLhuK+UDJa7tWNtQJ82hm4Lj5HqJ2AWNIiw+",
-	"SmkvZ4ZheD3zzv7VrJtcVPs+fOtZR8vo7LRyskiOoEvE+r7qBtTFBxygwXzQA19ll6/e4aYip8q7TZKn",
-	"ADPMDecDdC8QI/KAcImHYq8ZRmHQEdSRYqSNoX3l7F4G+HEF4IZ/G2F+/AiYa2msmK5dAurGikB3I+0y",
-	"3a0q7PKFtoi6g3evDptWu0WhVlhuSabl6/20YAgGvEmeSTAL3ay8dHAgFYrx1adcqaDkcAAuZ4BQAdRD",
-	"SoCCnlSek0i9aqjWB+l4LzUCDwfgKuECTBH4mgyHJ+glKOLeAtHxcDjc4fl1nD3T2ZeXXAVyyrU6DiyT",
-	"sINSvnXV8HhMCXcInQuHCmejQ6qiSViv1o31y0zLZfGi0Ni+Zn6iAoa882XTNH/oefbrxThzC2ga5Lra",
-	"Ix8HBRvuhJnrzwUlPIkMWFssCKrzR0fHh57HodTz260QplkNqY3TBzPX8qrg70hGY0EZCrIHm5KJUn10",
-	"3gkKFwho3dM2vyk43Ux2rNfvRtP2KiJoe/pv69ibqqQt2ucu1cc1tMXNFLzUeDA6G3k9o7lohXF09lz9",
-	"94XbRLBdHW89VW1j1aput64dPkKjqhLIY7WephEfp5c4Bq890BskZ36glB4ll4jBMMzkjXmT50kU6VeA",
-	"klSkZIYDRHwHOb2GAgJfohnOEchbgmF/NByCA0pCJSCyQ26iJzu0Xx8CmuincLMRomDkkhR8fSnhEAxx",
-	"8lng0BzEV/DeTUhJ3gYY7U3iyUdEyL0+dmsziEMJt9ZtpQ3N3RUGgTHgQcu659yo5tW2vRoi3/F2hTzn",
-	"nUyrNACQsy70GeUcSAKtx6Earo5t9YiRxbzdx6xBhx6SZEgxdgTDs38t0t5hi3BoRLclBni7HLDWXJzB",
-	"xTtlorOwUoRog0yxqMnxKrSJpLCJTEmNw15ugg0A5GB5cfO5f4fwfCF/SMdw0l12zRoVbllDl2yJkwlc",
-	"OsTjuVljWQhUF7qNJUROnjQM+DRLiH96Vl3CT8/EIp0Ph08BjQhFzQiJqpJqN6toxMmTraITWp5gNWXV",
-	"w/BNTjs5IedIzLeQg7RnCwinjKFRHKJ7LFavMb8dy/PgDREuEX9NEEDykzyS5K0swPxW+6yp/mDKELwN",
-	"6B2pqDPaB7R65Od9tVPjjNEIjICg4LQH7tTD2kjqyXK2EEEu0un03DNKhfKkVU8rp2nLiOYNB0BtCYzO",
-	"tJnIfzkagk+vQOawi4L/NJMfZ02OZZP055Ps52f2z6fmZ6R+HdjeMeXzbYx/Q59e1R1w1koAF1RxHSZy",
-	"jVLh+HLFART64TD1pO1w9C+j1guePbJfQkT7IZg2SycqbrWZ0K7HH2DUmcpixPrX4z6BEXISW/VhnnK3",
-	"Y+CnBQLXY+USCNA99EW4kkcdFgDGMYKMyymXER9QFVWh703gq/cRBeBnKMAbIhCLGeYIvMckuQc/gYPn",
-	"p/0pFodfvcOBw0HqodeZ9CHneE60L9ZFKP+ara7HAzAEL0FCbgm9Iz0wAi+LfNADp+BlkeBrKLEjRbBE",
-	"O/4qsrgeD9opwUC7VyGJNiJYS9Zcj3cgaYZlSUO08cclcK7HsnGkfOOQkjdDqz0ksoEyIhlkWct9JEq2",
-	"x6ROjGxqRNnUaNLz7vuyY38JmQpekCPIVRD0iV4r74/0r0931PrrLU2Y9ecY31t/vVHBBN/khhIuaISY",
-	"U1UW0M+8hh2mRPX9ZkGJuwGKIHaHsoXUz/Tz7u7QCUes5mMJk1nLzB/K3kxp6elCrWU5MW8A9RoJiMO6",
-	"0I94seLYh+F7M1TuvOVQXx77njLU7iuQzZH4GbLgDmpmjuB9Fmql3nIeE11lpmuOr0qBs5ZjaUZ6DrdS",
-	"eReTxztyeGRhflvjLDdjCF2YqIx3r1wucz1vYQB17vsoRFI8BVd0idxekwvKhdPWrqIHZ1iLHSn+lJuR",
-	"loxK/ATpBqSaBYWA/kLHVjQGvkm9mgbIzTUxo4L6NEzdnx2xlkofuqQXKlAqYbDTU4u7V2YRaYGnqFvN",
-	"EpGAsnZmFdrJvTxZBZvZiL2UBOqRWQJWClUXX79GcUhXKLACntvjnW2nf0omMUMR5speQclEBbSp6wuB",
-	"cxRMTEQbb4143twhxVoDSFcAyvN3CWh+QxaQ+OpJSrJglfK/XJ0DlDdSdA7+97//J/WmEAsogA8Joeqx",
-	"GSaC9n3bc16FzQLKwMcvn0yARBHMsBJ/3uqhXhOx/tDzYCHws3UgR5ioGeQ65l16Z0GBppuO3+rS0470",
-	"kqdr8bjpKksLp9NDzwuq1N02VC1DyLOZ37d1/8Dvs+bLSDLnOJlaFNTc+8tVuUdpsC86Ilsdq7zbaIUu",
-	"+XBcuaJe0Hb0fMmbmu5O1lHB8xWpESHO4dyhZKv2IP3cFhyUtpM62xsusCbRC0oEunfpIZBBfQLDIMCy",
-	"KQxvCi2q9u/yhqycENl53hLP5YRLtlpNnA7Dq/odBQBlTY1Phb54QMAxmYcoM7rS6sN4YJ12JTjrQVEA",
-	"0jaWc3GKbHAQU0yENYMUpOFKPf/dQ3mV8M68k8XpMBpy18U1gveva5eQGuZQdSkHDJI5CtomPomOa+bF",
-	"pGFerXNuPu+LumkZgtwJ7Ps4hERPQWdgQe+UdmQh9g5yy3jeSvdmItfJ/Y7RJHbdW6IYkpX7zrJ+UEhh",
-	"f660HH7dh27RJLeYBIW4XcgEUZcCGESYOB+Z669I66bTaAghUQsz++tlUK3LlVGLoAvVfA00bejK2Iin",
-	"Dcd043bDwdZG9MbxgmZkoMd92GIEZ23gmqESGwkZBaWYriWRtW6NmusdV0b1IQ9W2jq1ZTr3NsmtOGit",
-	"LHks/uxpXGf0z5gLOmcw0hI9Zkh5daWX1NJRay4GZaWgcinMcRNh8gWGCXK35gLFHeLbskFMj55eiYus",
-	"fqbcFcIfJ1KHa/V5Ua/89Xf1oqPGmPq3SLSOyU2zLqNih8Xhs0qIAHBueMiUmIXcrEs1UA9vV46nFAme",
-	"9F0OE3D1yn4mwUQ8P+20znpThbFEfImo8iRN4pgy0RQYZowQYHmlegDMAU97AUryjYIDufjxigsUDXwY",
-	"G++SQTrjVXFGtzd/rWlC3jK6LnnjpZanOHSHpdmkn1k+6u0Y2iQlHJGwcykYtRWr1fpUf0ilF1LlMDtP",
-	"QtisopiOHafdyLSr1uoEhck2VnokaQjZx0STvz63SvaIOSLiHRba5u94+1cGjTlWjx8RFmAB+aKgRfvP",
-	"4Oj589Hp82fw+Nl09DcfITT929+CEfJPhwGaPvtb8CKAp6ddzINqNeZG63630+sxmcvU810PTCHXxCmX",
-	"KeC8sLzhYDQ47Z8O+3Oz0C7rmNcD5N12QFGX+M296y+P228z0eWbLa6ihvgYdJw92jmJ3yD2Ggqow7PX",
-	"PEULHrypKaf69iTb+FkboF6pBuCi4LCkrK3gPFRPHihQDkwcHAH9yH5jXjHAhTkJO7yhZwbv7tlYciO/",
-	"Y7NSgt7QO8TGAgrUxZRRhVyOFRUT3HlhSn2oWZPEoPE0cytL66hFJVdlN04/nl+lh/UmqDVdU9yaP+38",
-	"Th2wS5C4o+y2Owg/6A6uXWtLv+EHNwxrXENyzqkDsGz1c4pr1xvy9tDn8nPUU1eJ1wJggVPcAqQ+p4UF",
-	"tDpm6BQjpGz7D5VoQxgrL2XjdK2em4GgQCwQZlaeHJObpbJyYxmYQAcRf8IR4gJGMbhbIO2kUBxQG4b0",
-	"CIAykD0aFAi0MTFoLlTXAoLpN8GNQTXLCz16/cQTKzOp+3AqDgVgjAfgLWXAnE3gq/diMBycDIZfvdYz",
-	"yVp1LyeMRoJKX3ScRGWnwOgQ3ZU1f8hmLznedhjE7qEi3szR2Yw+2ag7ur8YvGnub38xqMaLyW7p4hrh",
-	"mwfqlYgoI3QlJLgjG0cRJRu5on+54qn7TyUAbCt+6etMIOHY6qLeaUCXmJWjr+UafhkvT/XztsOfQEdg",
-	"v4MC3cFVwXCK4+XpNnLR4Ph0AoOAaTeKZ9qApFPpPclcOD4PAob4083IkylB4gry260kjtPDTSLIb3UM",
-	"cdUQme+xMHuvjF8NeSeRcJ4g/ipzlqtQCtS3xVWbC5pybIPCuMRRgtJ75gpgOYc75odhldpm/cEvTM+G",
-	"wVH6NrneyPqJsn5Y+9q89uCXeeeGKe4gI84Qsbbh/6k71g5d9hlPwZ9PWdxfL0d/Ck8XEf2dTqtrfQX9",
-	"2zmjCQnAL3RqUt2tiG8nvFOqj9P+kLVxeSLlebrA5WutW8kptPejVKV44vuI81mi4yVb36NqSKXwWg3w",
-	"TG9EPdvWp1IrDvF3OgWXr12mRpdJuEu4+t/pNI1Sb8j9XoOmPCK+ukzd0ySNjBEJMJn/F+gD+Q1z8GuC",
-	"EhTor0ZcmQaXZI640LmAA5B/S71dwAyHyAwLGTe9XiU4DBSxZpqCevhORWGgNGTdLcOs7Hheop8SvnUP",
-	"jaV0+fovzTAK12ZYSHwUWu30O635Ubnk5hmsFTy8npfvz1MWP27SoqdLNPFc6h/ZWE5z4Xs41abkIu3f",
-	"oq08EPZCNbwkkmXpGeLxY5YoTy45ncZFeVdI3am3ka8wc7DNmqeerFVzXW4DbpUAG9Rk2Mh+m64pd8Bt",
-	"TkmoIVf3ntwVGBtgWg/00LjPbbylWrDRU9ZDYa0nU0NyDlOM/lL3aLp9kOZ+iylMH9xbfEwE/zoR+073",
-	"ejN/7mFv/aCd7K0flJ/9N7nq9FUhj57YOGtglL1QWGEMuRPNFhMIOsc3mQRzk3lAI4hJ33+xnfyCa6UK",
-	"cMK1LlfPVTPg6lP1ZK1fqcg+h5Ma5rd9jn9DlcgS3lMKpw7AiREzMTMhWqIQHIz6p4dZWF2X6LwsZK4h",
-	"QI/L6z9TUAgkPu2oODWaXOgZGIEDO4zvsAeOs1+OzS8n2S/PzC+n5hcdq3c4AOdhCGY0KWyMA6jKKtzB",
-	"FQcxQxwRoSN3uvn+18VRup49LNxcjx0Pe+M1UTIsoqRrGFOKmO6RTBpyeIl2ArlCTFgr3NzPZjdt4YLg",
-	"ugDHAEvt1hdZZOBM3WqKVpy/8FyRHYA30F+YEXzIGDaATgfQcqQHsOCAJBFi2K+gExwM//e//+/pYU9p",
-	"1bI3cYbh4U0BmUdYOuAoGWqMf0MflWxe8yWqnAAKCuyDkNLbJAZCVaWIYBzLxSMJpyCTMgIjBpSOKUmw",
-	"CToD8Gmh8hUIeQ/A3DiJ+DBU5wpaIgl6I/wlABmahcgXGg+vze4yuWIFgWR4zWeMoX8L56gQpJfLasq3",
-	"ACSbJk34YbaN67FNcXl64CLJ/QOtNJdVCY3bsaxigVYmmrUYzPqfQCnw+SC1lOkORAUHxUDU/il4CTCR",
-	"+i1XWYGzYQ419iIYKwxCTHhJdFVYrshsPcDQHLIgRJynLrcRJKuUMTKmKCGrfAaXD8CK3K0ygo1vp7hp",
-	"PM5zv/BXK/fRXn9EX3P3IX1BoymW2Lge//V1Kd4+SBNyKy9krJMU9KeJf4uEpSJoof2sKLHVkVGW2V0F",
-	"jV5svt0OAvsKCobvm5joEW/hZed7X2kOIFJzngGaSDlxm7LQ9dgcqRoIPYAJsb9rdcO0GKkWFu/4KUJ0",
-	"i4FLaCBXOEMTQKvxD03UbGjFAd6O5LkFLV7em3ejv+dzPJ36bqNsrHDiSHWocSUXyxIyAF/kSIYyzsDX",
-	"9D28rzx1vno98DV1GuzT2UyC86un0pzKK6NQGU7DEBgKUKQlhy2e9q3FhtqiYrQHQNltTLezIiWAGgdJ",
-	"XMjLJ8MB4ubQiRIuJCf5C2C0wVIv86qeJksQDBI+Q2wiFcxJNI25hoWEzWRBE8YnMWKTAK7074IpFw2+",
-	"oFRMIkz052Wkv8aUy18NRUwQmWOCEONfvcMB+MwR6/MkjkOMUkwAAW+RFGg+0qmOVBqvKRULYJ5NuNIY",
-	"srO1HyCGl1n/AfhstN5MHjD0i471UyL250+fbsDpcNjpCOp2DbQZs/0aWLn7SQHmh4kyt8oDQJNUejHM",
-	"FMwMxRwkHAV6/SXzSM7PG/pjaCYpbCgJHTL6TeUCK+GtlQ6zfqUZpFTVhbkOn0oWF8RedfxGXKsnOcdD",
-	"XKFOmyMzQU3BlPRdopqQIDU/NxsvdbOeV6hU4tcmiyhuo7vvVGn7DkGWeldVH7OX/A4Lf7FeZgVRqmPG",
-	"BSQBZIHW+dIyJvKvdPiel5DM69lp0V+GkDy+po0JDid12mQWbbpeccQI+4xyNJcoTEkzSkKBs+BtkRCC",
-	"VHRysCIwwv6E0cS8dPhSOYdhWsc1ilW7X+nvpQhrZffA2jswOwelfQN710DuGfxKH1dF9dqZn7p6Y07U",
-	"xcZKINY3/r12dU4o1H6rz6f69yZP/sI4d5CDrE/uSJxvy05kVvSEsyIM6uIjP6rflaZYmFWLdctyTejE",
-	"mmhiJgrp3cTKUNbzrDTkk7R6t3kBbC84mIOm1xRn6b6/VF8Lnuw4fJuEoX2wZ9Yq56k4AOdTeV/TVgTJ",
-	"EkBf+jg4MKl+wMuXYOg+EOszJNVeNFOTVf/0cFDvIZXaXbqlOlO3LOOLlblNYW52Ag4C5OMIhtpeOxwM",
-	"tSdAwcqaX34xB9CAhNGo6Is52G5+NHV/HmycIM0Ckosyb3SQiXVBK6eS8FHc/JTZGujw6DRIj3nY3TSo",
-	"eLMES2mwTwubGqiPTTLmgrRrBSfTuOpWjqCI37wggUAswgQ+ErPdH60VkK3X2TwqqridDdNNtT18F8FQ",
-	"H1DdQKgblwFrIu5NjQdu6n7Uw3w9wT+yQujOIqYfn5GsSBZreQSUBKfjhuFkvUIZ+9QJKBWyiht+SV32",
-	"m919iqPX+R/kYsURYLq5/CgfPfVeYiVJt1aqicfmhVhDMmUUlaZoUHO7NjTOK6eUXdRnDHLBEl9emoCp",
-	"sKLrfjDMHSbLUgB8KcA4iSDpMwQDpTJbH6WukI5uCmO7Nk8DxMdw6dLaP5RSnHPZzKwUqddwqbSHmLgd",
-	"SvPcwx9RkPju9d/kCYpZ2krqXGnO89aIqTLP5/txr6Co4jpR51aO69Ld2LYiXVLG5NDRvFFB59R2e95E",
-	"ea+k+XG+61nm0NwVo7LXCN4rFbg9o44OdnPkt7HiFqwUNwWr+LPFcW02H0zaFmBS6zxmAaO6BVQTHxRX",
-	"44BQz8Kgk3yKJZmr1aODzUxGnXL7pUlC3JZdXQSk1d3ruuzn5fDiiZPu6Vg7RD1GzVVXNhq1mqs8K27W",
-	"AJ2P7hJaZeOCKTPs561SOmwKN3KCLY80MjpuKRKvFmghjnB7arbitt7rPg0gr0YmrbksWqWv9vVVKjY+",
-	"DnvvM9DUIM7Abk0EZb0eQdJV+HYfdW2gEBjzBRXbKdq+UTV2Xigfj9cwzteXoH+ovXPWlL3vXIx9rBWl",
-	"qn1j3iGfo8oHUU4d0ZY24iD9h4DzQ5VoHwXapnT95VzZSOVJE1IYdMtqa8/9z7qQnjRkx4oyMTPDwuIC",
-	"PJshxrX1yk8Ykx8LTToZdjagtW6qO3angIgZvV91wtaNainJlC9ukmmI/X+g1p5f0mCR8fjnvJOy3lnP",
-	"RY0jZA2d5vrNOE29mXVnLx0I4riQ1moilNyk6XedOZx1OEOaNLl87UkdOe/MW0AxMF0SugmHKJWfn64A",
-	"JIboKAORqo5vfgcJL5TYlz11PuAEhs778Dbz9rnz8xXgVC9jao1L8p8zBauLBcSkMzFelDs+SLhIxrxJ",
-	"2aH04IOEvMOpWszK1SVEkCk1WvGPyTM8AP9UaQRYgiT4MycRu41yR1DOWmypcwqkqCQSwmFoG7stgtkK",
-	"xW7yUqee6JxWqG58rzCoDEp5xo41eD7royOl3Rxj0BMs/LiIHdM3w49pyLXHqzo6dLViKeEzkXSUdgug",
-	"gAapGTKLQ3ZDZ8pzcoEmBBD77gxVvy9xXDEh1jPxWqZAo1s4JK7+UptRcS8RfniJkPqu7SXDH1kyVKWA",
-	"ct8JKUHOOucberM6q1YrJz9MQkwq+XwOCLXj21MqPnTGXu2yJvUAmP1z4Kzr/PJEef3VVGN+KacfgEui",
-	"5hN4GiI9VbWWsWq6mwrY4MD4Y56B54d2abyTF6c7rNV/8uI0ewfcQq1taxej4jZOhz89t/ZxurV9nKp9",
-	"yOErG9m0sPcZGEkZc2Lt5uSwpdr35qU9RuCksvKtFgu3tqGO2MM1qofDMLyeeWf/aom0qvZ9+JYlZvHO",
-	"TBWszUqRb/S6u+U65C7xUOxlDqpOoK4tYN4N2u7o6TLAjysAX6Mg+kYw3zSpVakQ6LBQC3T0CE7L3Aik",
-	"nBhlJa6Gw3y5m+bJstf8bMdrflZac+fUW1IHw78hEy1ShPGOQaxW+4hS+Ts4/gpLLZ5+jy3Dv4tTrrDc",
-	"0iG3rSL/4EDqgOOrT8ByMz1UUTmECqO0q9AczpNIFZhSrQ/S8V5qBB4OwFXCVT0oHVj+EhRxb4HouEh8",
-	"21ZojrMadusllXMegHWiukzaDgr6tr7aXhfrop+Y0uf0/1SXJCsaViclBAcwlDjJKoSa17PDQV0smh62",
-	"YyJH01iB1flQ2f0p0O5YEyFkZnNPVgPZJudyLG8VDImEER3XlN59QlPPLKDkLyJtQbXPuBqcV8FXW+/p",
-	"HCwavWIkVnjm7a4caeW4Oih1nRo85yCC/gITVDvV3WJVmkCFjWvK+Oq9hThMGPrqmfUojlftNXQwN37U",
-	"QtU7xIrxrVxpubP8AJwD4/Luh5DhGdY5E1RYmNms5GMwTSSUdY26LKgOYOHcOG+NFZD7yIGnchjQ2Rn4",
-	"6o21b/xXT2rw1k4H4ErVaiQzegYWQsT87OhojsXg9gUfYCrJNkoIFqsjpdfhaSIo40cBWqLwiON5HzJ/",
-	"gQVS7lNHWjwpDsSU8EEU/AePkd+HJOjz1FW0atF30G1dQceK66dKO0aJFPh8QcPAymzpnZ0My8reeygQ",
-	"8VdK8qv2EvsRDkPMkU9JwMEUrSgJwN0C+wtDm7pEg7JmAeXmTTgOEFPeV2oBKKiv2v7MmfSwuvDcDJAq",
-	"q9nDS1VllaSjeDUbx9qRdWiVHmPS0RpeZLRFsghGpbP3WipYXB5dA3OxUIyix8mrdWTZn526v/ENu57d",
-	"IHj7acFoMl+Y8KZsGT8Ne253NUn5MYK3EgZpxw5V9G2f/AoJavNvQ05q9QR22fVhdP0XxIZH83Rql8hP",
-	"0/52c3SqPqc5x7xKD6mGJKELu5ROY5L5rGGquTfkNH9LmY4KTO/8Xdr9E4uFeVPnzX0+UNE8vEth8pxr",
-	"a11I3axuiPOmRBB2VMumfotpUotPuBDSUEn+lIfPmOvTdOVO06VCXnoAEYb9RRoHrd0Es9RMStPWsTFg",
-	"nMSIcRQgXkhRYSfFcEYm2dWMmlNaV6nWZLXJZ5C7f3IImjuzynljAVDgNOpawrhUPlDHtIODYX80/IRf",
-	"9cBo2D/W/zoe9p/pfz0b/vUTfnVYk6RG7zxp95xpgJyuhrxh5xRYWwa4c6OfVjHij5lIDtAyiZNm180A",
-	"VK7D8EgGBAfDl5/zEOUeGL18A/mqB45fXqEAJ1EPnLz8GbKgB05f/lOqbu9CurQtcrVbjJM25LVlOGpg",
-	"BnUdx4jlMYqp9W3YP9Wh/M/6L/Q/fuqPnut/jf7WPznW/zw5/qs20rVsQ19Ed7gTYxNp3YxrDyf95+b7",
-	"82f90bHZ7+j4p/7xM9P8+Nnzbhv9gP2M27e5zekKfLi8ACr839qYWapZpNmP/r/TugXjaubxRu2o1Fw5",
-	"rBtGsM/7LSUbIBYAN5B4xD7l9WVwm6uj/LGSxpHFLK0gtonQNL1dsjLeWj0jBqONj6A2XbOTorm2limb",
-	"jReQoeA15re8LZZY2V0WcImKWd25GkGpDB1rRXjVXVm6UwrJ7FS31YMiwmoo2cV7blXWXdm8ot6q48uZ",
-	"tWLpz7yet1zq/3L1X1UH09QyL6ef+H4ZJpb+DCyX8n8cyDUCs8JCuoiarBC1RdsdFwGG+HvsI8IxmWcy",
-	"quGKu6nxWD9YoLwi/u4nU2bGDzRAfPdzxYjFSCQw1MDc/ZROvNc6iOl1vEdkLhbqParZt3u9hREc9nzE",
-	"hA4MbXKd2kb93Z6WxxPlxlWYsOAMtPMdc76Y3KJVaQlb2WtetKW81ag2bZEqRdOm9eQ1fNz0o0WMlOs1",
-	"8oKz6E1u1nOUhY3eEJ+tYp3kpGPDGxpiX2MM3mcYU69Yj6eW9MG0hmVcF8HKrtXp15xpJLdLYAI+vcqN",
-	"lVJ57xZX1DFFSJq3xAzc5QA3S8+n+NZw1d0JFNQHE8q4PVDUaDhqsvQByEzaAqZS0pSGhCm5Zls+SWsz",
-	"ppkrdHpRrKTeNt/1PS9GDHxEAfgZCvCPizGATGA/ROD0+OT02U8jywxv3IfVi4GubjzJ84mpWHb93lL4",
-	"lcfIxzCcLCAJQslTLg2H26WbXeEgcwYD9BHJKRAJYF0spfmucvwA00vRxNWnL8DKfqaShEtc+pCAKUqb",
-	"qkh0COxmrWEcvkGjK7OadYtgSOcc7ifMUTMF3ceYIe6swvhGfrNSkqaF6D5/fA8EvUXqobFjyQ/mKFV1",
-	"w1Df5EOWQ8rh0zgxTOb6GUlHOgaY+1SldMYRnKNBK2zkfFVoPJgqVEpx1sqLSkOjzjDvPIb+AoHjwdAz",
-	"C/bSt727u7sBVJ8HlM2PTF9+9P7y4s2H8Zv+8WA4WIhIu3FjoeK1mypIn99c5sWBvTNvOYJhvIAjxXUx",
-	"IjDG3pl3MhgORiqBjFgoZB3BGB8tR0e5o6wu2Y0cyHuPuQB2QzWyuR4HpsF54XuexVK5jZXSaOFQZQTP",
-	"e6jMWRo/qlCT1L28XxOkXlsMTPV3VbaFZ7lfW15+Hr6pt3rlwKD2dzwcpilrTAQjjOMQ62QnR7+YZ+18",
-	"/G4BmSruQZFESUr9Q2LhdDja2pyqGpZrqs8EJmJBGf5N3m963rMtbrR20kuiHRF1PSetHSmt4l92pspv",
-	"ygDh8vzWQV8qns1ObFkkLt3o3G5gfEhe0WC1A2y+pSwqu+pI5fehQkujHczugrMGQaCJ6Qnw+goGwErR",
-	"syfgh55LYB79Qqf86N84eNCkHSLhytinEhIBCH6h0ypxq49/V18aZWaejVsPoySklOa5gMSBVyZZp6is",
-	"K363U2Ept9ggIf8kRH06PNn9pG8pm+IgQETPeLr7GT9Q8ZYmxGzxp91PKK/jIdZa6fcWFJIf5RHnVJ3e",
-	"IaEKVmbOV0X2f4fEnvf3vP9H4f0fgxVrDmu2FJTqYNru2qhO9WHXUNUFdBeMEppwVdnWpa6aHh21VpUF",
-	"O4ZMHElG7QemXP66quNHvcPu+uvxrln83KRoNKVd/b0e+2PxRJvu+lr93nJB040KpN7xOCsM+ohT7bte",
-	"/vdH2/5oe3J7Sq2yqSydMfKVibuJa98hsWfZPcvuWfbJTKCJg2V1lEPLAasb/ajcuktTrMkB1EmZ3QuK",
-	"vaD4PQiKMWJLxMCbjSzOUmE/MtGofTshTsO1Ni+57kqko3J0Nz/ApAPkfOGIE/6jC6WGjEZPLJ6agrRd",
-	"tlIX1q0QRWDKNs2ScC/Yfv+CLWdSFdI8+67akJz2CaAsRSr2EfhM8pJ3W5OsRzoVcB+nvn21dy+TM9gp",
-	"ZlXvqrC1UqM3XM8cHD9Wc2l/wx9F8vbqZ9a+1dZuXR4eeR3WplU85ZWxBfAuUuxAA9lL2V7S/kEkLWVN",
-	"GP/+cngjWZgFNvaLFRbb1ExnbGSxSHhHIZiNmbm9WWGev1t9M6vDUii/btVKf+h1xL8DLN9JJ3WupF4n",
-	"vWohkb1KuldJfyBRiMgCEl/J9Oxxtk0LtProtLTtF+2Czvcm7/9a1+D941voy3t2sQxHTB+r3Nak9sz6",
-	"p2LWOofiMVyiTThP9vudsN72LVtOrns61WFNptdVF3MFIVztVYS91PnuKkJ26dn4sqTiopquSR2uR3kt",
-	"zD/w9ahnVfwfm3X8Ky0U0Z9Ck4FAbV+HYDJI+AyxCYMCTaJpzNNAWdljsqAJ45MYsUkAV97Z84f17192",
-	"ddQt378scBQpq7jhciXVG8pFP79mXSyQb3JfZMkcvWemCGiaz1Nym4oQ/T/g+XAwBBEmHCDoL8ARGA1N",
-	"BizEuEqii8kcvACLowCuTJ1XnWqMzsAImPoZK24lk8xj10rLOFmclhcisTMYDsG7VwAK8Px4CK6mMQcH",
-	"x8dqVUfPhsN3rw4Vp1ZLtnqnixMzYLWcavZR5VXIAHohkXCvQ7czupG8O8kYdJLtX1JPr4GqBFMhunxB",
-	"qexPNHEtI+/seS3NpSTHHbT8SILscg235M7+aWh/yP6ODtmj6crK4fe4I3fK6C0iKnIYTFfAp9EUExVB",
-	"/dfXmN9axqq1zuJCdro/+GXiKY7EjVdiI2JtuagJIjVW7qXkXkr+oFJSpSpr8ur/TFQTV6SLFDwJR+wv",
-	"HMSQCYIYoGwOCf4tvVWUXBP1UKU4lx1xtMmmv/fJ2/vkPbm58Uc5s2vsng5+1umf1+Tn8Z6b99z8B+dm",
-	"6+zcuiftYhVTsUCqWHdeCm+zYpU1Fwy37+3mNjZHfT1TF6+mHpwp4paXVTtVRg+7bNmL7BeVSH+U2tqs",
-	"qluj5676Vs9PO9s6OhUbfeI7R7dKSnsn3R87evYH8VrlutpUUWAlXNBIqSFNybqyZkooRatuh7/sepFN",
-	"sEu3SjNJW96svQKwIwXge5/HhhxraPvo31JllSpzY3D6RxTRJQIwo3Z9he1E6rpvSoc1tL4nyj+kVgp+",
-	"GLU0Z4OWG2ZKqCBlDPf10vq6hv+8xYJzRpO4LRFkGALTznWAvEs/dUkBOV3pocAtJkFNVID5lG8gzeSa",
-	"Qq/nwSDCxJGTtRqRkM8rRwcHPuSojwlHhGOBl0hJDwxDEEHhLw5rlmRgXA/ThnlVuWay2nRq0937XhER",
-	"Cr0/YL7LP98ZCn2dtbc9vabmsZpMRe/Mt108Bqmx9TRPnVBTb2ufS/NPzxyV061z0qMattGfU7bpaBhO",
-	"h/p9+WPXMtGfStD/+fRS+2ipjXMw/CF1GpWzuxLLsGeRPYv8KVikMbNPzSmiP/9YLLIjBfD7JPFpZcy9",
-	"7rcXBk+kbR5FKJq2Gu1NI4BJrdTIDCxXZsA/+Omqt7k3N+yP2GYDh2adJs6xjB2aqP7Ap67e4Pexuxjg",
-	"7g0vfxox8acr+ND1tO/4jGnMTVLSGDHGkE9ZkDvk5vl81SwD8Ar5MOGW4IsS9TBzB1ccTFFIyVwXgVPt",
-	"e7qkXyYPQYxYBCUcwhXQq+L29P/73/+jPJ9/kYPmv/MFjgdf655SfzDJWnmA+WxQkU4dpUvd2jPa/g15",
-	"ryX90IaIdiXJMkr86Vl5V2rZ97GG1Ktle5G0F0lPoSDhABFhIjSdNpCPSCSMaE1E4kw29+WNIQtgYHSG",
-	"QzQAlzMAQUh9GAIzFUD3mAveA8wMYpIwyZ7Kz3oArsUCsTvMUdYGAr4iYoG4pA3A0DwJofawGbieMy7T",
-	"DeyQTbM59sVE2wnKVN+tfaBqqporO7PI7RsocS3H3iWe5fi1OP7e4FaQLcDauFq1eYalbsxOH0y3u9hN",
-	"OvKf0Gfph/TCTVF9ZFSgNqN17luedWjA88e8zc7QXZxqj/cN8d69sG2MiKqyXiKEmmK3RfSs5Xr9XfTF",
-	"vVNyzeXvpohuq3L71oNeXfdaXVsQULmAX5Av7ECAOgrUly8HBW7/tlec5Pvc+kob3d/+9re/7326tB0q",
-	"7xFcoo7harLpTRYE8IMfI3vCe8qDq/ZSWBMLCQIkIA6dFbqbSWzvRbgn2afTtbTL7a40rTqBnd4JsvSI",
-	"33eZtVlQkmmEpR5YuogMwDUJVwULHwc+JCCCt0g/jqYta/xGvoPG+H3cN9o1xr0bx14UPpnayGnCfNRi",
-	"gkobucxO4+zb7nJ+qCn2ZqYKRjVeurjvmZZu2TtOP+5C5urBv4+sNRvby9gfi1qr4qd7xGANIevvGSF3",
-	"dKnIBvt9+WzXk/Xe2LTP67aTysnWSVN78S86UtYw6jsk9ly659I9l+5MEWzwUKzhSf31R2PLXami3+eh",
-	"qF4a6PVkAnMvGfaSYYfnd43ufYQjOFd69wLBoCpAfkYwUFx//eUc6LZlKSKbXJovzSIk+H4ne8NB3IU9",
-	"OpFzO/m1ksu66NUYacFuP2FhoztfAb9giSH4/PF9vQb3mt6RkMJAN2pEue4AcPC70+JihjieExQo6Llk",
-	"2sf3QFBV0URlIs4ZZC/J95J8mz6jbTyepr1Wb0gNWmDe0K0IXlrf/7C6YHmrP6g6aCFrL0724mTHiuEC",
-	"wVAsanUE/Rn4C+TfutS/ULF9N7XLWoKZ9ZtaP1cL1dJG6Svekffw7eH/BQAA///hPa5l1XABAA==",
+	"H4sIAAAAAAAC/+y963IcN7Iw+CqI/nZjpHO6mxdROjYdiliJkm3OmCZXLdE/hgoOWIXuhlkFlAFUUz0O",
+	"RZxf+wC73xOeJ9lIXKpQVahL8yLRdv+YsdiFSyKRSCTy+vso4mnGGWFKjg5/H8loSVKs//kqUnRF3rIV",
+	"FZyl0OCYZbmCT5ngGRGKEt2QeE3gb6pIaj/k6ejwn9A8ziNFORuNR7/h0XgUk9VoPOJqScRoPGJcXWIp",
+	"iZQkHn0cj9Q6I6PDkVSCssXoc/EDFgKvR+NRzuhvOTk20yiRk/Ho04TjjE4iHpMFYRPySQk8UXih4Vjh",
+	"hMZYwRA8BegytR6bQcYxXZExZ4TPX5Zgot8wiskKaQBRBbzPn0t4+NWvJFIA4KsFYQHMRIJgReJX+tOc",
+	"ixSr0eEIQJkompJRYKmRIDFhiuLkg0igW6MFjSuj5TmNQwNJhVVe2QbG1STijJFIEehyg6mibDGZczEp",
+	"p5Wj8YgIwWFjFhgQAG0oo/BxQtmKMMWF3oZsovhEI3Y8kjwXEZksOCOjj63gHLM5Dy4qz+JNMbUiQlLO",
+	"AsN9Ho8E+S2ngsSwbo0fi44KIHVsj70N80Eq5/rYtvdngn9aNwlgqVRm9zGl7CfCFmo5Otwbj1ieJPgq",
+	"IY5+qyvYjJ4ZTca5SMZSYaEk4+qGquVLmFpqXOh/fWEoaiAwXiDoYSFI8aeXe7u7u23nVFD8Klc8xXDM",
+	"W/jZnGCVCxLmZZTNBb7MBF9RoAgDZZTwPNY8Ir1K4GhIIlY0IpcRVjjh0OQqyUkmKFNAgxFnc7q4TBep",
+	"Go1Hy+jTaDziIloSqQRW+ugpIgSGgzAaj2IJ/68w+3d+ef2NLP6Ns2w0Hl1/Iy8ZTonMcERknZ3aP1eY",
+	"Gjybvym7zCX5iry2iUZURSKqoRCVCEQe+tAy+oR81KECcSiWKSqQhgqUoSrCKuwdVZCFPFS109NpJm9D",
+	"SBkRms+xiFxihpO1ohHs3pLgRC0vZcQF7BZOYDxNZQlfXFIm6WKpRuMRVTK9pEyRhcD2ahXwSdJ/m+Y4",
+	"V/ySZ4qm9N+uBWzgJaD8iiZUwf5GOMMRVevLLMHMkjNmPMXJ+jImirhr+49AVEGUIh+hyKETechEdVQi",
+	"D5GogUZUQyJqoBA1EHhnIpuRKBfkVnTGExqtLxd8RQQD1Gj+k2YJ1XhKOaOKW277h9jk+npQcDV3w7ju",
+	"l4ZlOpiNfKJq/R4GOy+lkJjISNBMH5jDkf2A+BypJUFlNyQjA6GC/lJ/xcWE6AZL3YLECC7R0XhEPmHo",
+	"OzocXeU0UZRN9lokx01FqIGiJDDLoNTGbxgR31Mh1c+2SRUHp/D9bxLNoQnSw4xbRvkJ9w2S4I4xMiJS",
+	"KgHh4VMgCIalkZiq0Xgkl1gz15gkRA0g5s+mC3w6/H30fwgyHx2O/tdO+XTase+mnZJyZrYD9GU4k0te",
+	"ex11DTOzPYKQaEn7eOArQDd+r3/2hZhSihcrxbmW+k3bADZC8rTdCG/8qvRcrnncdmQ+dp6877lIm6ev",
+	"hLwHg8dFw1YCHs6P3OrH5TnVEoRGzR32o0roM/3NMYxyKhRjhQ8vGPoP9K9i/f9CE3SCWY4TVPyG8izh",
+	"OEYritHfZ6c/my4Y3ifQ/IgniX77oas1Os0Imy3pXKET6q69V/GKSi6Q7nHBRuO7I8xJew5CPbThuj5J",
+	"Nampmzh+olINPkxlt9BxKr++MychTHhzmgS27HuaEIf1OWCuumlT9I5kBCu9oRkWCj3JM6Q42ttFMKAc",
+	"I7XOaISTZI04I4h8yrhQKCMCrY4IU0Q8heYpEQuCJFkR4e03JRJRprjuWc481TtXUOIVZVgf9LvupSZ2",
+	"NywgYo7zBGYoOUgNObqto2eDJRKbhU/RqyxLYAWK68/wq0aRRBLQh+eKCETV1BCxnQPI+N35e/gnevsp",
+	"IonF2BgB8tG/aeamy4iYKHyFjmbnZkbb0lC/HcOMveCraPKr5AxG57nKcg20/h0lEk0SZD/DFsPgJT3P",
+	"eRITIdETMl1M0b/+tvMfO0su1d+Q+9fOf5h/r1LzXyALqbgg5k9G1A0X13/719Mx4hpxmhb0hhsBwMKB",
+	"r3iuLBx6g4ubrcB+sY7ghcaClytcuU2Ocx8nv8kqw2deH6zu024P5gdNQX+84wmjM3KD3JW4PaBf/oA+",
+	"7OmqUfYAkgbpkLwjv+VEBt4YgiTmxV7ZxhUlN0Q0dvGdbYwWAjNAn90wmet55RS9janiQqIIMwSCMMIs",
+	"RiSmqi5oXOUKMa6Qlo8vGBfISMiwzeh4wcyrZEkYypkViMcIyH5tJnd766ZGVCJBUr4i8bSC1mIpRIMW",
+	"1hjbQZrH+oMElguLWAieZ5pENciNtxRVS015iluIYSloLng6Gg8THvRGzfJiC6viw+feTbZvhpoUK/Ww",
+	"sSeTXnGeEMzcU4PEr9dDQKNs4QFnev4Cz8bBL43GII0VVmR/B3llsh5az68kUce+5H5nM8nAl+t9PhfA",
+	"5BBppn8ch7+m8ojnTHkftVKJiM4nVDmoN8S48kYrEdSN6fcCMzknopWx5JKIsCDwwX5xRxguLP0wH/Vx",
+	"u2LMbtg+ZOa6akysf0daY9sQRKbofYWbU1leU3iBKYOTjeMY4eJ21b8wnwvwOfxQfyV9V9xTZVOJgEPU",
+	"ZtDXxA7O6M5qb8dru/M7jT/v2AvCsLf7fKt+VTmtYx/PTwIHOMllcS6qgB+ZT+j4DcIS5aCMg12r7Krt",
+	"LoNaLfPt57YjGXEWEcGGq1bOT45Ml9BjMMry1iOsv36QeEHOiIisbrC22LMPZolXa73E85MxrDYz7WH/",
+	"qJIIWhGmqEqIps8n+EpLVPpihW7mGYxiTiT7m0KCaKmTqqe+2Bjz3GhlLZwsT68MmCDaGp4SxFhM5fUP",
+	"r8MrhBdLh9G5+bN8T9IssQe7eZWlJOVifdIym/najdIT3WYAVs1gh7pFgsWCCPcl4kzmKYltE/QEVmn/",
+	"uGBXOLrW2tglQQvgmk+1ZAEd9Z8Iaz+IovOwzSIULOdPL9iwLUudDubtpyjJ4zbJoF0RK4M/Z/yGiJmq",
+	"bk/LdVbjyh+O3zh+Y586FvfoiiScLbTQ9UQr6Utc2PdRkHCH6xRLblI9/tWr0qNyS7caDZVFewfao8Xi",
+	"CFToN7QJHnv52MMUnUaqyhgTmtIWZsLnc0lavrm77DgOf1dc4SRwSWh6gm07P5EoxSpaOsqe00TpZxAF",
+	"KR5+zfCCssIu2JhilcpbKNjOT3olSG9tZha3nLHFVoGaIMrzmKq3TIl1c/mvULTEbKHvSRxFRGoaxUgQ",
+	"c0YbFzSOVNB0AzKHGWqMtF7HE0iM1AtvCngLMyIuhRG2pjBlZjxrGicNR4qLsNCFbpYcpTg2jxczbXCI",
+	"uWXoOI6pecqeeasxBraaQhkOgX2ohwYv0XpF5lyQ24xuevYMf3cBnzL14iBIqBb9ISZWsrBXZ8fINkRq",
+	"iVUd4WNE5+ia8RsWgsURUIvc7z6HtfrwqwPDtRx7FDU2j9gARY3aH8UtpMSFHQwEVzjkXKCES8cEzKGA",
+	"yUGvRQ5Db6YQX/btOiW6HVWP3TmqYaKCtxLy7lO9mWK/6BaS5V4nOLrmuTojgvK4yZsr+AtsK2Hxm+CL",
+	"BUyVSD9aSlUW5TEIJXBd0RWpXPrmcgk5xAnlJuhpXWehRdcSyhBarez9veceUEOBkG8ZmOTiiqZpjhPZ",
+	"OO6/LIn2hnzzboaevKEA2lWuSIze2V1Gs2hJ4jwBpSiViJiBrUqOSiflj8YBwSYW8oTHpALF6GfOSEPb",
+	"BdPjwoMLpTwmhdavnMHpmb7PQd9nPb40az7DQlFc/9VY0kZjM2dIG7XEFUyFMLOaZUsiCPrxFXryI10s",
+	"0SvjcaC9RDpxgibFmoyyWRC9x1Jf5JwhmYsVXcE5BklHWp6O9V9ojmmSCxJA7Od2onhn6Em/ZD11QV21",
+	"qD+gDK8LHXqEkygHjaN22TLgC2+wxi3b8TgsGbQbSfFiAlIZFua+Lx05sBIcqZLiKjDNtYPPGBmuJxFG",
+	"zyYMyMx2K2DVigHGUUxiGgEhITDlECHBrqCn028PJXhylmBGfuYx0cLoy2f6ieF/s2cHyOMlTD9Fx0zP",
+	"pyh4rOipYLNJfOT10k2DB8of++jsQ/ipGnEAMSPCgYLAg4kgvdon9iAeohcgvqf4E03hUD375mA8Sikz",
+	"f+03ruTbePmklL3c176bz745sFtUwm+egK1PQ8rQD6/7V7FXXcbB7rcvvHUc3Ns6DvQ6YPjGQgoC6BLd",
+	"m4uQh2gPbvJn3mqePS253N742cd7Ad8Y6/fQswbkHnkG5O4k4Tea9jWTkKatFj9YaDneMvRN8zRMwVl+",
+	"uiLiiKcpVe+A28PMOElO56PDf3YLBkfNvp8/jr2rZe/wYDQOnAi+ImIS6W5IvwWNYXeMLqDLxejpbVlO",
+	"8+x2cZ4Kzqi0Jx+RT4oIbcIKsYdqrzklSTwQ1eZlfGtsnwS71xG+30C4Pb+dON+/A861F+eM/jt0Z8PP",
+	"lYvH3Ki6y8R6flJ7/xp7GhWgD0e5oolzBH0iCblg7aphb7CnRpPtZvNHodoFULuIIqxtrjEcHMWz6QUr",
+	"LhKjfJG1+3KMONPiQsRFbAULMKyen1i9VJMCLliQBgyY7krstBiVLRuqirbrvcRNRU+fS7jo5ghYkCLx",
+	"2CrvFJFeO2rUxtORx673Qi9BqbjAi374TTOzDCf6fB6PzOWteXT/hWkaa372MJdjoZ9v3o0loD0345Mf",
+	"Xj/tgvYe78AKuLUrsIT3/VIQHMuu6w/QrEyzOujoCZD37OR9KYNy9lQTEJwdHUwQAxVhCfpeCbIZtH7i",
+	"xntpNvDpFJ3kUqErgi7y3d1n5CWq7r2Hov3d3d0HFHf2i1AV/31XUYA2r7I2hl0n4QClfBz6IJAZZ5K0",
+	"m3Mqorm3HfByyZP2V4A5dX1H9KjS2LfjvgdVoRxsULPNP49Hvgf/rAiN6xrktNmjHIfEt1yJ04kcaaNE",
+	"oX3oZ7jvAh29S24ALO/KpiVeJIZnZb+bgG1Wstlhc1aYrdErnxmDS6+drmjYcjxmLtAlhNImyQwkfQCY",
+	"xEWgRV3lCR+Dz97KGxl7qojbP4aD5tAHfro+zGMyqLi9pyde79i3fXX1PLAe8oW0wYPodm8Yu7DR3iGE",
+	"khjh3LyJ9g5f6P//JqwFu99nzGavkVu/HtpWG1rhHaTAJoHcVVLrGvFuslRg8FYhpINzlpdALWZHu8Im",
+	"Bb+xLyqZp6nxda3HVLE5jQmLAuT0BiuMIthmvCCobIl2J3u7u+iJfgBRhoqL+dK+uIZZ3uucQm7OJcKO",
+	"IuUL7wR/anEVKdsgK3E6rwZY612XBnphwFvvslxDsyLwp7JPSewpsIMLtR4cPWt1PhwPu1xtRQ4eWi0B",
+	"oPLo4khwKREQaPse6uHajq0ZMfUO7/AxW7bDDMmKTbGqMntm/7NKe097mEPndntsQPbzAQ/m6gyhs1Mn",
+	"Om9Xqhjt4in24f+Gzuc9rmZN3yYXXdEr0L4pWup5Kn5RnQI9SBKuizbG9PX4ERq5HoWPyS9YsCGCdxGd",
+	"dSxlXgLLuDJfQOB4R7Dk7LZD8SIrRy2A9QRFsFh9dZzOrKoJ2ALshzEAybVUJJXgwyCJbW4s6vFQB+tT",
+	"WUFp3YorcLrhppSJT8JmOl/rd4Mtuxs7P/Wxg1/bzslcoZy5X66IuiHW1UndcOTHODoZQ4+mHyV6ODgl",
+	"BT6KkYKSxyqVr+I4pKwEvd+c5yxGjkVqCLBYkFJPNkWnRotm3LE4K8zTBZhoibVSxGoIjdZQDvaE985l",
+	"yM9Hr+CdXfbANVxhSRLKyCNbxXvnXzWY6Fyn8lBu0L1D/VOkrSmgCs7lubSZA1Mc6xDLaWEfjp9V2GgH",
+	"o/au/VCY/C1EOl8a0OLd03FpDo7Bb2V1dPZhckNAk0HiYoyggFDo8PYqKrzdkBCY5Zd4FZBjX1kY69Ja",
+	"E9D7ACENCk9mjC8EQvbt8yYI3z5XSzcfTb4ENlKSdm9I2hQpHwaKzj35YlAM2pYvAE2dU9lzU9JOScjl",
+	"JpZLKFE69hlEkMcUOQN0HGJkHHPDjp+njCACn9y1AlcDLrv5yTeuBMHXMb9h06Y7aNkjQHjecMdvxuia",
+	"rOFmWqVy6vVzgcAXo1TK35KL0dNpSItXsORXWSY4jpZh1xtAMyraImwbH6KIcxFTBkzxMsqVfrs9MQ3B",
+	"1ga6ljgXOhMNct+9Pkb9p+1y3qp0tI98CpIQeETrgJwnebYQ2lWSQ4hNntkITUESgiV5CkLSirCYi0tn",
+	"6YjB3kLsrygFQ4v75IstxRQ6N8jTKXpbdSX3IaP6vgevcyIQrNFGEw6Mcfa3DloUe3RC4dHG5wrN/u+f",
+	"0IyIFRFtW2byMTWf8dW0LmWMTjnnFO2hlwjwpRwhjtEBeolSXv7yHdq91dorHtu9Wk4QwkRuchRp/zMf",
+	"zP6noNfaywBiE1U1aboEqfuAv6HyegajDD3eIOsEz3TjSA/dNYgIRXtA5Qdj2AdB0J41zFd3zsw951zp",
+	"XGTaj+vAtfQ3dIr0ktDeoTEyRi/3dtH716hIeUbi7+zk+0WTfWjifn5W/Pzc//nA/kz0r23EoN/a4OTw",
+	"/nWbqsGDBFmbDiD4/Wv9xgNS0ZHx1KYjGqaEGUiEbuRaVqQBaknXzE1UXWo3oZ3OII5kk0vkdDbRHGPY",
+	"BcJlOIMReH6czgzvIZ9wpJK15tLa34NgIWFKuEmMzF7wp3ckRj9ihd4yRUQmqCToJ8ryT+hb9OTFweSK",
+	"qqfArsK8cCjpYynpgpnoqqME/pqvT2dTtIteopxpn/jxEA52n2zpdDaAG1lsjxsk0UcEG/Ga09kDcJrd",
+	"OqdhxgwXYjinM2hcXO4sRrtee8yggb7P7WZ54N5xS+7vkHbvyPsWoxlaDUyhFgzsWZFuFRAEOPuRv3YS",
+	"LhaYOfEZC+LnYoMGkpSTBmx0fqBLbTkmbtD5qutUbhPKytEG5wfGymUHqM0QpxCuvOTW/hsjI4hpJH6H",
+	"cB8AwUhRYKz6wMj26KBQiFrPMXiyNzl4CjgnOFo2LnRFfet3STNcKy1plCdqfXegqkdbwyUNYFq8NywR",
+	"owhLMqFMEiapjgSV+ZXBkaMZy9un6BeQ4FwemGuytkF49pRCG3PAQZqTSmu3biiT36Gc6YYkRqczYi9c",
+	"tIue2DNd5fE+Pt5TIoYgwdvUqkeDEek1xm+16DFykCf0mqDmDjUWxwgIHBmJKE7QErMYDOEtC1wNzK5o",
+	"aBiMvi5FohGdmP4PuRh1kH2ZXpGLxWS/N/rGwTR2bCZIl43TU+7WAG54pA9wS2RiayZJk+jA52BTt00m",
+	"swFW5soGw2m0xAJHymVS0Q81xpW2M2HK0P85RpfwvLu4+K7o93x3txwwI8JMHMp9MJx1lN6Iff6dg1mK",
+	"TkgT4ilGCfKevh6jvd3JvvnX/u7kufnX893/fE9fP70757ntmu6bIxnVjg/bc6vg8X/be5ycZYpOjfY/",
+	"gjnnFGwzVtdQKCLGgK80Z5B91/vRcpfLKncJoaK28IaIec/neqPwxlrfkK3CprL4BdOVCQ5uKOHhc7e3",
+	"2Q10jpFtW2GIq/QGCzK1VqvLq4RH15dKGL+8aUyliYi7n2S0HZJNPTkNztWSi8oCgt5y5FNGW9MsmY8y",
+	"JKv94jQxZmh4HmdGWbKmbPGd/4kBt0F2rCI3FlFtAl13wvvhmY1+zSUcilJdGbInhra9kHR1aMOSuI0H",
+	"JYYlhTkX3yEvtQNV1Y/ay8EO4dvdepLoBkOLCwp1yQCqC/PJohqI7PY2fOq8Y1HepR2HI8WfXFWC/efP",
+	"x7UqBS2EdSvaSa3ruX1fznOVCzJY/G9suw+481XvgnwQVXADfIU8NFF4G29Iw1AJFwXRF8TQA0eKP9nU",
+	"4Hu7u7s9pOJTSRUDvbu/Icf1eob5rTPaBsLJE4XDzw5484e/KD4gWZjurtuO7SzBVd/WPfS27qAQ+QAd",
+	"JyusmbOEEQAKRt7zU0ZG4+Kv9zfc++t7ngvvzxn95P31Vpc3+AgLyqXiacu1pnCkunJCwfezJWfhBiTF",
+	"NFxcJ+EdHLU1/Y6fVm1gsrQy2423mBroDlAPrODOW0S9IQrTpK0YRbZcS4gu/8kOVeaqC9j77hrdsqsX",
+	"bkw9P2IRgxhR41aVY3+rei92uu6KLw45m/EB2ynEAgrHsQALoPK6xTFtLgg5snUiWpNvWUS9iiKSEG3r",
+	"OeGrlsxa4KoRlOt0PaM5JYV4BC2tplGr8wrnDrjUsVIYnuZDJJOUxyR8ajLBFY944jKgNBpY+8IxP9Kl",
+	"W3KBBwW+hHsVvp49+FRt0JhXRP9h1V+bkzV2sxhx7EigfTNryHJYDZ3rmotig9yw8xYbRNPFaCGiFqXb",
+	"1t0HUxt6T4UcTkfjhiddEEUkS/iaxF6Vuv4idX4lBM4uM0FSKs1Ljl3qKkT6scgwvHlsGSLZW6bu9gH3",
+	"HgzIQYDq8w+pQvfGnpCzwickkPMle74L/ykfeQfLvd10N6gAzr6ptX2+3G9r+u3zatMXy2fhYWvbDfCY",
+	"mcwgoW1+y5aYRTrQCigv5F34CpGykeZx6H/++3+7MHid8ynCjHEte+Nc8Unkl0PQRdxAjrVJQVtMCm9r",
+	"pQ47sxO11E/8PB7hShmy3oECRcvsIKeZHNK7KFFlu5lqQkN6+nWHQLKqihpD79GKZKI1RY1j28tx2k46",
+	"yGXyU1/3n+WnornRakBWYj//U3fO0HqP2mBWHa2Zmhw2WqVLOZzUOYSOeP/2nJdNbfcQT3grBA+I0CmR",
+	"0sbKV0+Sbo/c577D69qBvP5WKmpIFOLDyKeQDIoFTocoFb2ojvqCvAqlGygemngpoDXEGfBS1b+TGJGi",
+	"qY1uNkZcjCRli4QUHqq8Ge4Ze5JODc9mUBIj18bLCuU2Gz3JOGXKm0Fq9+mnFS3ds+VBGwNP8ac3rSA4",
+	"L0bSBOWJMB7vPRM/S/db5qWsY17K7jbvN23TCu3JHED2J4iaMVPwOVryG5OutNxYCAQoPY176d5O9LGT",
+	"sGaaUgN2WubPbOjZpL7wl00VEjnTriFcxERoK40tVIFTog03aknWyJa2qj2Ry5EGC3V1yI+KMUJSXl/6",
+	"vLAvnhnZWg4xRE1LmxKa1PEWEjJg6f8g64AK68xhxdhdASkuX3CFmGwwgZvilrpL93YvRx750A2hCw+7",
+	"DT7ZnwO8nNkpEj2S8r1Uwjjs5MFDUky34N7mmpFIEuXQb3D9XZF/0wCAFL4mKBMkIsYJOIAyFUyoWSJO",
+	"Z7G0KVov3BtzUjgiXox6z7F94dnttKgZsnsbqRPqnUPH6QfB8yxcbRCzdVjNtbmNpe/Q0qjtwzCrxDVl",
+	"caX4pMloql9zKe0uTHT3mtAdOaU1YHZ94wKrbQWfQxSgN6jdltCyTbdMXdW5T7cck0b3ONjGG33r2nF2",
+	"ZGTG/XyP1fxaS1JZKvE3oaAgt9OtJLIRZ9A9WtlBWTLj3qmt0EHcJ7lVB23lJXfdP3+akGjvFM6vbXBh",
+	"MPub529QSYpSiUqsJky4z4RG8jrvvuALGGb/+KCzU1d+BM0tfCnFAVdnvCnHaO0lD6V3hV/dCLBG2cjZ",
+	"wUwi/E0AGCA9NHJsDMuc0WMm601+Na7t2ccO2jnNWsIa77TrEZdqViZcCuyGxMYXI810mnkdduOTwneI",
+	"kQXWfjN2S0xdMxTpvMDpYEd9NiQrjiYKt8WaEPWMbJIJkoayYZgG3rGR7tlCRZlmwhXMDudzx+w6IN5z",
+	"SZXn8GdWDY7pV8SlN4+WBGeVvOnesLfIc3Wd97V21DK7znuO2pmgZWnXOlo3yG9xxm+I+AWrUFk0/Q3F",
+	"At+gJ7887ZisJVzkHY6uPzAaGho+oRy+abmdOdl+wOD1lzNsr0HuuJHJqyRKH5kN8Jq46D/K7Umuj8rU",
+	"0xKyghbXAEADiyoW6Gw6jttN0S8+pesXDbTTw/D5BdPOzcAlKUMqF+y70JlB14Rkfj/9b1dVQqcNrV1I",
+	"F0yDRqUtM1N8n13niItqNkN9AOu8MOSy6Q3SfTXBgjS45qxoz9KCY9ma5W11nAZnAQdcBMf5aomtH0VW",
+	"6m1e5pacZtscy3fLsXyXFMDXuQxzDP9qMHmUxbqa8Nf4ajcFSdgRJahJ+ztUSfeoshEPTiRXF/g96Wls",
+	"nOx89q7jgRUyznab8K7NctHdB1BDmNmAfHZ+FrvbgnWfGX/vKVvvIJGlTMMbvquHCqjFU/jeculyFtgq",
+	"c8y9i3wOEiPI6U42N+H+Q5P1VPEROvA5kznVUSiz63wIRKUTAEgYhbwyCJwPxWR1wb/LVlDsVYm4BtzD",
+	"0tX6swbCJaVOYKBL4iRtIusY5dppH2wvuFu/4YJcao8M60aIGgxs01zoxaX4w+tBvGjj7OVduuVso3cU",
+	"L3PNdjhLwrCCRqTj9ecNFMjC0pUFRgx6nD358LR8oIWgDifON+XxmsHQgi4o8+7ww8oVrSvCPwHGPNE2",
+	"WmRD7F6dHXs+xdBqNB7hjA50IfaofKYB+96M0Pj9FQwJp2+Dm+OB88i7kKDb3yaFV7AdyJ0QR1s+JVTI",
+	"uNjGHs7RbsL4E533qp9xb1DElht4UG+PU59GaXadb2Tl6ZQWKsO2W3z+BIdze8r+rKfsno4XlYovBE4N",
+	"UjJBdGkCF49Q86yzfsB13UDD/788Zyll5zjJSbi1VCQbYGUqBrE9TLLM8Hp4qCS0rkgtSG/idofTlrCM",
+	"arbxGY+uieodU9pmQ0aloargjP6WE0TLGJPCZ9FW4G56AnoV4KuD/VhWYUeUoZPX/gF19X774WyPSrEO",
+	"Qecp1643Ljy8PS2MjTdBqxPucq+VKe84KxeKngDwM538eArWLJMifepmPKnOGNYItkahgFPxUJBvDeoq",
+	"7YexkfjCBrm0h6yUua7vGK0CA91HoErbOF8wRsVEZKl1EyW6VrNRVfYGX7U73DiffA3GIk9wt7uV7Thw",
+	"2ltFNmpYg6gIJho/zQibLelcoSJDOXoVr6jUzo2GJUDLRkjGgjD1A1VGqxbQiMB3tKAKWc33EstlxZE4",
+	"eo73XrzYO3jxHO8/v9r7r4gQcvVf/xXvkehgNyZXz/8r/ibGBwdDouM0NNapP5wGzsDjkloZF1hQDukD",
+	"C2AqvKiAtzvdmx5MDnYnCwvoEDgW7Qj54X5QEXg+JTRavyMmnWAol6n54uSZRcKvcIJOz14h3ZUSicgK",
+	"JzkuuJcOMR9DhWMsl7YfNbY0qMKPjl2eeVmmzSzGwoIgQcDo5bJlej7r8338bXSwd7UbD0rHsOra0fO7",
+	"7WX3gSo3sgpFy8ESuNVbRZ4RAXFTEWGKiArv7JeaKiptF6nTFFmhTVS0QVrHPUVHleTdmmsiMEuamlOQ",
+	"zFuiHWTyUZaPGSv5DPFDrtR7uHvoItyY+ikCBd82zS7W2JWiPMSdrio9yhkR1k8hLBxvIgbXzBHhPX33",
+	"6sQJZ7fZWtvV7a3909aTSYb6KBEFGurhKPzZdGi98C0KZRiHLW4x5clpQzC0+tHtdSjd4v1tX0gOMVM3",
+	"iddDYG9K/8LK085Eug7DIAMSILIZPHCCM53kycxic4sVJXcLs4gO6QwFCVgP7kscIOL3NCVS4TQrL4nq",
+	"gCbux4yAuEBFTOjghCyrkqluhATb75J2WqpXR2b09okvh6bIs0MhnNEp+p4LZO8mdDH6Zro7fTbdHRAv",
+	"4UE9Lgmjk6BcwG6QqL4nWOUDqvUc1ZqXDkW1IhQDBvF7aHOovTq7tw8aDd/uc7tvZcKd7oBQ2cR0aqyC",
+	"MG8nfksrbo2ICkLXTEIWGRDbSlPeqn4WJAKirDbufRbT2mQCwGNvXa1BA4bY7PnJhvWsjrPVgclcEcoW",
+	"pL12fsCK3OB1JcCFZquDUchStmH4BM0OLnEcC5Mh5bleVMzkF5uLZq/iWBD55WaU+RUj6gTL6/sIDxmb",
+	"4S5TLK9NseZmwEi5xsrs4/r+GswHiUTX4npdROwF9Cb6Jbzuy9as/dOwstmjOSPuDb1GFOYIHptIUAUy",
+	"9+aDH9meHYMTF3q+2cgmAr19WF8lsPHgx2XnjiluTKWkzYe3JZZah67rzR36yymr6xuX2+/wGSKiv/Or",
+	"JqyvcXQNGiYWo1/5lcnbJ9cs8t3ftOgT1K0UbUKudK/KEY7fGNkKpjC5S0GUknkUESnnuSny2hs32EIq",
+	"lWQEEPCgF6Kj8ketqROrQ/ydX6HjNyHVcsgEMKQu+N/5lSsHHgo4tIO0bNOspTgdgGl6Hl4w9B/oXxlh",
+	"MWWLf6EJgm9Uot9ykpPYfLXsyjY41umkge4wi1H5zSUz0Z4adlgspO31OqcJTOGJxDqvQVnIBSRk063Y",
+	"Wej4qkY/tf02PcwuOfDNX+bA6L22w2IWkcRrZ8Lw7Y9Gc+P0nQYfo/GoXJ8J2ZXmXwWItgil/kcxVlAV",
+	"+hO+MqaDKu1fk3sJ5BwnenggklXN7HT3MWuUByC7aUKUd0L0m7ophm8eQlzkziuam18CTT39di8H2DjY",
+	"95a6aQdTmVuvxEE75tqcZoYi4xY7bQb63LnO+4h59XBjpmzHwkZOD6ZLSBVjvrS5Otw/Sst8Ww6nn8NL",
+	"vEvZ8U3KjAfd3uz8ZfJM7weTP9P7QafQBN+3wmJSJotuDXR6VyRgWOuAEJAICmcCtvAqjXlJyMu0Em0R",
+	"sINDetxcJDw+wFNRmcc8xZRNom/u4TjpgxRVE2qfD9SctCfcV1z/YoJDxkgSgn54+x7t4IzurPZ2/Ez8",
+	"cud3LhbH8eedcriJGaYZdOBsRLVgBbgd/XIkLhahpQDQY4mF2KiwfJCgSzf42mHsplibrqmZzK1s/dov",
+	"LxhwIhpSSBCVRYMyCMbLJWVEVoqroZgok2uuqnr4m/Skryf1YoJPx0gai/6VK3KgQNY22fFrBfPKcQTJ",
+	"uFDaA8iv76apZMPE843aiyEDkYdMKOUWyKRF5fVEBzc2qhuMEa8gz6wyISuSmMIHRR21IeXYihppHRXZ",
+	"JIq4EJqkdHy1XwZNjwaAHqI99MSv2/Z0jPbRE79M29Mxelb88tz+coCeeMXZnk7BIgJljysLs7U4khu8",
+	"ligTRIJF8za7Uyuc17M3p7OA6X224ZbsVrdkaN2q74qSNkNLVxnM6foQD4C509kmeAsbf8/66sPV+EJM",
+	"paIsUkUpIFMOu50hTNFbiB82I0RYCGoR7QYwvH6MKBz2PCWCRo3tRE92/+e//z8oIOLyh7Fg3TV6W0SW",
+	"JfU68XhfN+7SEoREN8TGHeeqjOMppQdXCGivq7LVOy353KmKFLzoaYQSzq/zzICJUpxlAHRR3MpwP10K",
+	"Rb/g4Gh07ZoJ+7beDsDMjMsd6NtAajNRk060go0VZA6GK4OgN5UKJSjysycX9FbOmOHoGi9Ia42pe0CS",
+	"f1ZsHbxiGacz/yRQGT4KkFdNn/7mAZB+UUWdEs+UVaxWVfwO6edxOUjriQlXRERPqhURJ1AAkTJ4PYIG",
+	"whvmqdm9FGeumJJEvJsVVJnAGAmywCJOQIqw+QpTzNbuwBaHtbt6TeNibtwHzYPg73eQDY5bhafW094p",
+	"5pW50V6vwyJfu+h2KsPyxhFPryjT9cb+802tDBSgXtCr3GR9pKaC9uQqB8dYT3Q098/z6uWjb7/69TOU",
+	"Zxpgy+UO4JknWAn6qevc3cE5pZ7sNDIuWqme8xDxvMilCAfmdFaUo9rV5agoY/53IzlVClZ5xy1yG2Ja",
+	"TIN2/Ie4Ks5P7D1hNtjeE9PhFwUJJbUdluvPdeg6lpaCA5t+19N0D1oARVPyMO//co4/9vPfUhiuExjc",
+	"HDvFIiflIneu1hNfyH2Qhz/pzUBrftf6C5GzKXrtiiKaM3uILpzr0EQ7NV6Mxl6CTT6fA+VcjL5DJf+x",
+	"aT4lSvEagkuc0EHipl4kiBnbv4oT8ABwAwOWAdqqmNqbEqIv36lxwKp7JNtFeWlWi7S7WvcnaEyklUp0",
+	"RSdT6NM+Y2q9rFOTK+usBGZyTsSlwIpcpleZNPgFfF8ueS7kZUbEZYzX5ncltIecXHKuLlPKzOdVar5m",
+	"XKrLAqOXhC0oI0TYMVepaW2cZS9vKIv5jflU+cnMaz4IkpKYmuFafvZmeToNdwEijYkwNcMET21Wo6Id",
+	"IvM5F0X+uZIlaNuprPEPQ0QFn9NW86k32hRBjbgJxEAklMTu8qklmdVbh664WpaZajGLSzlz4iA2/afo",
+	"g32ZFhedIL8aRY4+iD++f3+GDnZ3W2RnSVMbqdWfUcW1dIz7cWUzgbtgSMKJ97ZdsYrbKf/8e6xf+dfQ",
+	"+I1t5kLg1SDeGejsRxnKpF2y05o1ohj6tu6PhtdWFpQnAQnsbX0RsqyaKcus2QUXGcKgnwYlrfuQaeq0",
+	"fSvMeDQfxskJTIeOsEh4J1Km6MxI46U61GWbXupEFyWwQYz41H2blRSk6Mi/uZQjnBAWY4EywWFa2Odb",
+	"LcXBOu19/1VEt+amdx5AzWADzkgFr2kpvJZXvjTCCRs9EmeCb3zxmHsf+t95TetIMBOMfcgdnP0IuO9y",
+	"fxsjYVB9QLfEYXUCqysc7ltf7Re061ZHDuPN1pINXGi6k3tWI8rMPe2uKudthWI6nxMBTfB8bq7i8xMU",
+	"2ayRt1hKucmBNTFy0wsqZ8naaXBMybsC6ttBFA58lDxZkXgjaIo8WfcNT40CAUseiONilzsJ8L3HdfuZ",
+	"pZV2vLvexva7AhrZEkuifXvJJxIZBY+unNG81LFIKJHqLYvfBMusG0WUHgEekmVK0LS1dImsRwwEX2ab",
+	"T4g/3WVCg5PBZ9ztyBl0C1GhVFgot4Se2Ws0UnYt8TBubEUBcifl/IJXnapBrfju1Avu1MvDV4wlSC51",
+	"MtOrdfHGL2pNr1qcXSuC4u0EQvIpIiSWR5xJJTBloYD+9yInRjQo6g+dn/jQIZwIguM1sqO5Ir7FkKFY",
+	"dBtz1Zt2WU9gmUuWYDZGelu166FCe+2u+aDmCqUWgd8hUao3/rh8LuIV0QH5hV0W2lElK/Y2rXgZFlC2",
+	"So/aJJQ6RzN9xg2iKgeprmxcE7eaW9lL0ZCydCO1HewAc9mvywc0IFETLG6N9dhUdQczPaxybqjSSr/U",
+	"ACTbgRgjG6x5it64R73izRfStK1AFmzg1RkRjquEq2TFllKd9kYYZw5szsQTc+Mho00x5aoAf3RFTtzD",
+	"2miaNsxBk+JP56nsha5q1LVWd1PUPxeCMJWsS2h7X/sp/gTTuRJeP4JGZ6P6YXxup4KXONIaoftDyQNq",
+	"9HRNKkhG0qpQv7NK6I/tlVXyqTa1zAzwxBSFkK5NWNL9aCVuz0bc9dNWR0/HkbUXtZvlaaHKdBzDNq4I",
+	"D7LCRvd3l62l+yjbYErKhk65t986pWm88YNQc6a+J0KgvJuDrbHSAL5DROmivZtv85W8oSpablbE3fxQ",
+	"ZliRCsMbJDZWcmM01o+bYniTxtXl6AlFGKwSzFoqgq9SOVQY8auUBRHhips2MDH34muLTXULTGkkuCQL",
+	"YDMF4vNE0aIIssoZI7oYbrxmOKXRpeC5jbyICFMCJ5fpIlXQMdPtfuONSsn2Ty/0H/6m7DKXJIi0Ch0B",
+	"jiHp1LGB3vD2zT2/zSDjmK6IrSvVWD3y1o7sylFt3chfNYI1o994tTYzqqwWeWsN+53DzZRaJ+G26CDz",
+	"O5zpvAxenth8I15/hJVebzOcy/zelUmqMg4YHIs+ZWKTclkeHLXIfO9V0VaO853+XYuwlVmNitfzpGf8",
+	"0pvo0k6U8JtLbV50hQO9jHiXJpJsPLIRSaPxSNDFUl3qbM8BcqsdtRJR464in6fyvlWD7WxpiApQ9x6q",
+	"AQx7ojSW8eVMH9/nSRKsX9miIX91pTVdQD/6dNs3oAQPar0v6OVLtBs2fshe1UDDZcipBiYH/pChFy54",
+	"Ur1/3RaD3vA0tmHuRUQ6lXYlUFIioilOjBPx7nTXPPkrrr+lGxOVCFuUuJdz6TnX8w7uim/FqqxHbHGh",
+	"PaGm/ZGtsv2xbJEUoswzHF2T+DwNZk0cUEPh/GSYHqBFD2/TV14NyoA6dK5hEWp+rUpYqwdMGFECpz4N",
+	"hPKf8iub7K/q9KalcP32mSIlKGY6ZxwIxPBwZGOdQF7bSFP86TuUMwqrLL6XXxgsPrEfCDZfpIpjstL/",
+	"1JrotamRkmlF0oo4w3rA1JriTwOrz8JkQ5vSwS1t3ZMBTc0aBzaui5UlyrUkBCg0Qg6M1X9B6a8tJKEY",
+	"EZ4CqSYCRBHJuiMse5OhaXfWSP3cdlfZ72dLzsh9lawt4gFvW5NWp6SiG9WiLNMk9l1xFuuzPE2xWFeF",
+	"nl50WsPubFDYeXV/bR+gLyJSyvAdd3Z4LK1GctF87CWirC6nZGce0dRIpAzCLTapLx63iob2ZPYdhHpr",
+	"98Eu4r7loC3Ufad44XaCvyWQD15wdzMS6SeLjQKVq11DVq/g0Tv8PZCbwDFZfRp+dZnEurMQVEdvC4su",
+	"2Ypq5jm+Pf9oGunaklfUON1GlcrvWlb8FjJUUeFbzx1ckM5a+jpncbB4HhK1rKWVdKUgRDHkB/g239wR",
+	"CDztD+5GytIyBapL1QVCOPzXnwdRne0jbMe7xf1qu7wO71xfwfiUx3kyKBahZczRO7LgyJQucZh2WBmX",
+	"7z9da8bubWMnWy9x4aWk7atL6poWJGT3r1yjj6vea8ojrrZL6gvhdrW3AXpdRCv8W/8LvGwX3IY0FeSa",
+	"2hIClLnoMUT59JqzFVlzMZ1DzUk6V9NVqotPwWsAx7GLeos4i4hgEMuUkO/gmanjdJ/veueBxWgPnVBt",
+	"GTbgT9Er339YTn/VSiSAHMexyxnpkgt7LYtyrRdWtezj8LmpdOD/tNdBYhsVlglfdm5b+8hms0vM6xi8",
+	"wrzv74lUrc8Em66wFhFR5szTOwS2xCzXGpgUqzHSLqm/X+gVXowOwe18sncxGl8Y3zR5MTr858Uoi+jF",
+	"6ONn3wepy4DXQE6KP1ktsEO9+7PH9rBK+7FtkKL1U4G7V+o0ArfZjPOTUuvVCaSbpA/Q85M2MN2xGgzn",
+	"+cmR6RKimFXaXQX4/GSMuNDn2eUAM3nFEeM1Tt2WTDQ1wp4BOrTud1Uf0Fo285zGIFjBwbeuZwjX4wjG",
+	"4dgDyxG6EpIb9l964XomWSqRIDiGipM0WuqIF+OVWE5WDAN/aEi0Tl1gKl0QWkAFgnPFjQ70RxpSz/3I",
+	"b/zVlmODt2SeXOs6/RmXkl4lAfyPRzGPfqKsllG5N4TGRGpoL4AzIs4DhPG2cAFYYhbLSRneEQSXM+25",
+	"d35ScQ8YoEzZNNO83US7v5VNCZHDFUk4xBMrXk7uy9kk2whzDeEa+gfQ2VhX8CyAGcMU3n2nE3wEVg9N",
+	"JqaML5L5QmeF40xW5EkjuQYcDBrUWHVVCKTLK2cIXxk3Sy6JzvKOdBo/rUONMAPJQZA4j0g8RgkWMIr5",
+	"wQQCblIi1EPLrICnNQH4JsO5eqf1XSyxUgxaxUXP7nlgtqVFbjEZR1n+QeIFOSMisi74A86MdQCymX2b",
+	"22i/n3jlvwaMWhNea3wqTzHTPFIbC0vkFNErmij2XqD/+X/+X3QwRpBt/sUBuFfBD/vwLyhK3ZrjsUV1",
+	"fwvstL4dLNAkbkVc0WIj1HU9Wv1KwuWu1WBp7lgIlOoO9ZBkWw5pm8/fdyiBc31F5txJ9XNFBBIe42n6",
+	"GlborzqBqQCBi7IBVoa3qZaHkGq7naZl1CH6fb2Q11hSGXq+a7MYqTBYHQFsreQQPetZrS9G1l3XzxN7",
+	"foIEibiIpRagKhzygnm9IawLF7vgxmIwRsztO8mk0Tg/QdeEZGbAssu0SAIKTRd8FSHySeeHumAGBL0Y",
+	"b0rYae1TeX7ynf6mK9uY6RhXKMKZygVBGREalyCC6fALIuQYSe7dNBfM9HXWSKfpMKWXTZwzFR60KGc6",
+	"6YX53cNShIVYF3kvnOLNg3o09vAU1LPVD/RQSgzT+EB2sBl5hqca5Ngs3XEmcZ9Zd8C1HFzgKpW/ULWs",
+	"Zdxvm0kX+zO7XORKrk5Z3b0+g7J3JoOg1JFwdxYaYpozvHK5cOrp9ucCSyXySB8Oadpp0zkWVAb0g/03",
+	"6KS4Qb2PWiFiR7cVf0MaUx4TOcOrbmrQrWA0EltIDTl6JcubZJCZ+xUvyDsnsgWTY9lGnmBHmZlxCE3X",
+	"dSflesIQ9N93Ld4obW8ZPxBX58524RbS+S/WSvL7Kdxv4y1TzvuGKG3LCWVR8vyXy+yGIVVJj/Ooc942",
+	"hXsCLtxeDYZyzqor6fPl/u29V60P+J0A2GsDoFm0s9fDdOztYJB8ICRolpu/G4J7sFqmNZI60QmMpogL",
+	"VLp562TEo3G/Lyp0dcmLB/oIaFG5bSH6GdK5lOGWckGSli1+Z7+ghcDMu+mkmRo1Ep10oWLQkq0g3bby",
+	"RpKFJlV6Eecpj8lhPeiQmgxcOh2XoikBkQfI0YXhmOAEpEBKXOpwrIr3zdhma7OVOrEs6d1yNZqE3WOy",
+	"usvPrQsyNZ2Hmumg/HlKM4GGAf7szWXSnrPEmBLW4dQcamk9hNwJkXB+YELKLGohrWKydgEv5jdk7K5B",
+	"XFMlUaKrS1/pLHPadCGIzIACfd95O6PxjAoHb4icddbYhe/VKKS93d3dqV9bGX6oVFcOBuBIErq4Z4TE",
+	"DkyBWcxTKwF8V+LKJQuApcMoLl2Z9sPym8HdUYV1d+pfzK5MQlfx7c89hyx8wb4JULzxSvNPn5+L7cxr",
+	"iP2sj5LAdimSrI03KDxbvBsSkOB0X/qese8TLgsCK31NY66fN2CnyjPHqnSv0HG883XvsOCtLXjjFwsv",
+	"TqG3wDaLqCPUdsoaUA5jUI3c4DLqpo1cRyvrqd2wvdetlvdPrFOezdYzOhxdQZ4lPU5ANV6N1S3yYwqC",
+	"VC6YDthTHEHQCMRQlnpReI+Kwwv2H+hfdnwoSKGKslfa5dGWMBQEyQwEc3h3AycyOYV0N2mfWHqkDHS7",
+	"epwlqY/C5+WrHUbMtOOr0XxO5lShmBSJJTmztAhwE2FE6MozuMSJnnNgwvkSw6+L/uVvRjP9sdiJ3sT4",
+	"p/WM+IG0221OvKFs+oP8QMMFxTpy9G/67vB9cTvo9B0xjBjiffM0axOHTCMUla2clNtVmC2ItrImm+X4",
+	"JB6yvPEooSlVA3Lz+Mv6yfTpQHmzhtuGYPEmffXDVyfKO+7eTwVqWjbO4m7DDSp63YGkm/gdPurGSHEG",
+	"ofsoF9Nd39IZU6bITRowUAGDpGmam9zKXOsbDSDTlgJhXi3TQUU5jfruShJ1XGoqB5vRy3pIs8oY69Hn",
+	"Vs/bsIVQGwNK8PucjBzOWjLtVIrdVlh1JRmxbedn3iFUe6i53fHLELjWuqbWsGThpkcF2IB9Dkznsx6L",
+	"o+LdLWrorA1Z699TwNSH9/zkrs/kVqtP19QbeQC5TiHczoyesLEGrZ3vJW/dqFbhvq+6/RP3D4UXT5Eu",
+	"PBwb28Xp+SvtCgGSnynRvml1/V/aqvPZD37BODszrgBnnDWk8dawmuBqkyEg3ZoZ9rq70nA190zwT+tB",
+	"u3WmWwJTk8uz/Cqh0T9Ib89zV/dtNvux7KT1/l6kdecIRcPgy/B2fNl4kA0+BqamW+AMtOqvODsTJKWy",
+	"4lHp+dSaymTvrRqqrvV31SxuPK/eYqGa0E3/GDnnnggnyRpYKdxymui4AH1SXvyOrLrPyffQU+sQoE3Q",
+	"trVx9bQuK3RB1/64FTwFmZYRgNsCMuCfc42royWmbDAxHtU76jRacDDP3HGoK0m009EcJ1K7hkUJwdrE",
+	"iPT5QXMdkDZFv2jHLpETQH+RUMNvY15mcCuKlSkP7rbShG0lfnClRzD3QrG3CXLX0e3ByI1h517voA7C",
+	"KIvvb3Dmiz6m6HH4xNjtiZdRVt0d27fYH9tQmvIa+urQAbQ6qWvBknZctxgrbDe12MzqkMO20505ANBW",
+	"86RR8Mz9wdhxwxO5/RBvJnfoLu1SR1tAy5YjPH6O4LImbTnDn5kzNLmAznyTcEbs2+2doSB44spbF5Zw",
+	"L0fhDWbLGTCdpLQUm2DL0BPGfS2Ao+KnwWp+OFKlrrgioc31kR5blYFEGD2bMB4bIwKOVAGXBoVxFBMj",
+	"08VW4SqnyK5fR64owRPIgEV+5rHJHfvymVbt+t/A1yDO9fvhJUw/RcdMz6fAM9tMteTaHcXrpZuG47q8",
+	"VkH3pTIVgn7Sm+Y6bR/RCmP0xKrQD9GLp7496tk3B56NZ7+hT7kN10kpe7mvS+Y/++Zg9LkG/0m31pYy",
+	"cAPtXcVedRkHu9++8NZxcG/rONDrgOEbCykIoMsk2FyEhOKCXKBn3mqePS0ZzN742cd7Ad/kOdpDzxqQ",
+	"e+QZdvq8KYwk2t0lzhNjiQgtx1uGvmKfhik4ywMmBJwkp/PR4T97NEjNvp8/jj2jEFTZHQ+xKxi7NRio",
+	"9w4PjIfxrSKim2e3i/NUcEalPfmIfFJEMH29BNhDtZe9qAahOm2rZDwM2+FCyHWE7zcQ3mZ18XG+fwec",
+	"a3NckPmVqnbDAj3PIePzu4n1fXPoNJ/Y03zCjF+CezJYnd8O8/MHhvl5DWaYvgXgapZRxU1OXlO4qYrj",
+	"B0axhtZcz5oL91+JnvH0Ya6/CqjV268EtOfu05TQAe093nIVcGuXXAnv+6UgOO70eQE0K9OsDjp6AjLg",
+	"7OQ98jK0PdUZTBlXVmjXZZ6kzFPtXq5bP3HjvTQb+HSKTmzAsYlJfomqe++haL9KfPct0Owb4qt7n7lz",
+	"43GpKgcIXoBtrLpO2gEK+ri52N6asNXEi1lv0u/0I8krvWliQ9ATl1acVoqSPJ02xXFr79HDDjUOmca2",
+	"zkHAkj7cVu13bElyO3Oe9aHJWjDblZeRwqtCEJULW0HNvX0S6wgYc/Y35Vpwk25RDy6b6LPGi1BGimWn",
+	"U7i2pxaJInXiNhi3XvXGd9wMp2d8hVIcLSkjrVPdLNe1CQAHljIuRt9jmuSCXIwsPPrE6/YGO1TavH2A",
+	"Cf0n44gyo7emfp5JiPm32SKjBAs6N7kBTJJlu1g4x+gq9yJUXDpniBcJB3L2pdmEdZTI04Wc+RwCe2Ym",
+	"reTFCHHhr3SKTjgshc35IVoqlcnDnZ0FVdPrb+SUciDbNGdUrXe0XAceilzInRjS6e1IuphgES2pIjp6",
+	"YMewJ30CdaaDNP5fMiPRBLN4Il16paZGP0C3uiDUa/AuYgFD/HtbrcQ0Q1emXbUkm6mvAvkKpfGQ4vH8",
+	"HdFlX5+Bd9NpRthsSecKvYFX+/fgZGlDl4CNEyRMY1k6PV0lPLp2Y70VWOaCHHFQ3/QMSExbveUxyjhP",
+	"YFCtLTAv8FgrGpY5uzY+WE7EnmEGQ7s/0ezVz0hr1Sp+VN7KRuNRHTZoWA431MmqsgOnlQka3+rTVRu8",
+	"9ScvN/eYH/n1nYNpAWycGtzmcsmTuOJS92y3Lsn/hBVh0Rop1x6OdkqThEoScRZDBOCas9jGvxvGY0hI",
+	"IxXpnJFM0lhHAFkASOzf03uVa/p5MNqvCXjTH7CwqjXfIzy2jLgYx1uRJ5HULG1utA5zm1E3V9GoH2Tj",
+	"llw/dq/Q8c4psq9GzQXNONYPkUpNyoDKsBbI+suezs8Ivn6/FDxfLG3a5wKMb3dbfEh1TBbB10iVHVv3",
+	"Y6ivr1lWedXX+alZdYQzHFG1LnR4iFerJVX5T9PXtuRfnXJAldt9Ho8AnaE4vCMHkBa4jduoYXHGLZ27",
+	"8kl6pLENy1NLysokrrrmCYsRI9r/kyTWAbXYQpTre3yQ21fR6YMkcT/EuSxxWHSt+70OnJnKa9DHd0YT",
+	"bBoPbsq6RBWY/ey3ZcTjGAzeAAOyERyB+vMxjCbPjPwbiNcC+R2dzt64HeQu15C5bRx1wYtkjE7ffO/2",
+	"VeoMpeEYtxLY1qo1Q5Z3qy0R+CY06Tt8U5tTcfeG8gteGum8qMuOuPDvTWgB8tGS4IGemRZ/P+vIveZD",
+	"EH7WGi0Y+XT2Rg7FsX4endrd7d9WAFqrR/zrRisgh06YS+C2IdR+0F86sAuR1Q63diJ75KmSjo1V41k3",
+	"dcusMD+P+JrMwT+0BY/zluco6GMfx2415bTIhU7ho5YerqoUXjJSoxW6J0ZOfMnI/r+W8ip3335DB+wL",
+	"hOhJWiRvbgqSY1SX9zQheRrX/arBoS+EpwIySLIBgA8afBNEWQfu9Z3APaiAu/eiUylS8lkInnZHxEGp",
+	"tU4NpQGppMM2m47j2Avz8w8pwrKNO/hA735bM0/t/9eLb3zQn78I8pKlTuFW3MxejIJdxF4jNx400UJR",
+	"lXNny7W0PlpliH7JGUoDFLxvaHRdkQieBg++J2QFqaaHI4TOsTmhVntygrPMCmDV8+aM/e1ZvCzrKt9c",
+	"5U3VfDYbXYlLUhIKgn9T3Hgu1MG0Nu8Bbu3WJgzHm76ctD210vB0b9aPrQFuLx5LOfY+NVo1Tdpg50fb",
+	"vDv09j6VZeEdvmWyq7ZNGA9SwjWxFty8SrnKxrYFynxuUqGzt234dB1Vo/NcRbFaGHEZ/BbWT922yqZD",
+	"+6Bim104bZUNitqsroKWrdFaX6EOWwOYwbMKfG10mjdtIojxGkU8JWW6vAut4b7UTXSkcIzXFyM/Frcm",
+	"SCQ4uua5OiOC8hAnsh+0KZXnCkG4tleOjIvrMZJ5tITdWWq+tDYZcmwdv7kg5N9avhrksfW6Ak/IGQ6W",
+	"kiQkmSlBcCjH5plt4IEpTduxiWu1v7OFljl1hVI/KVNRMnZBV9qPzQRmI4EVsTHT/qVcfPcWbYoXukK6",
+	"JtIxocrZJi0436GMSzUpwYyWJLo27Z2RoNJPEwZbUEa0M00lPNpsbk8wtH8cGvKSfq2Xiigfe1ioQVVy",
+	"gRy0kLaWoSnWZTU1Qq7L6W40VeMsI/Wo7xPOgMYVR98LIK2pT0dF+S3dCODJiTT/uiExc/9Wy1zYf871",
+	"IHCsscqF/Weue/fWz2ovwxs8/TzjCV+se94HVuBou+sbEVyKtwoacore6me6aXDB7O+ISpsmqqwGu1gI",
+	"srAChHMVswVhayCMy+MA0ucFi3wdqVfe1z0oq4JIMAHDZm5mYSczufUya3t/fH0Xsa/s3tWwHZSHEfIO",
+	"HdVAG5Wn9cdX9Y9SWwi2Ll8hl6+t+9bd3LceUxHb8Uj5D7gNKt2H3s/dr8wv43D0Rd2F/uy+Pg0/nSqx",
+	"fAmnnLpEVXrhVKWL+6DjUudwu+THtWH6kKfaX+YfWJbgiMBt85eo5tfuTPPLcl0YGJ1/ypzq3Ge4dm5v",
+	"XSGws1TpByZzqlMn/IhFDOU4Ztd5u6qwY2GDNA9dkOigmmM/CjgQjn48NEb6NsHEweoWkFbRSzVrKaKs",
+	"oGnylYFJDUfEbWattXl/uIoA5c0nL5iNpy3fMUUWmGxdaEJpmW1B1oOVvD62vEI5v3mobJim4vyk95no",
+	"J6Fw2xLa0/MjwhQRzb0MHsomSQfHLMpSNCmkWEOQVuFBtbA0MTRzuI7NG5ixwbT15hn7EIXX4lStr/2E",
+	"YdVVLalUfCFw2reFPxYN/fRcLabV77kwJdqdzD+kHWTVtWkUZHefn7nqHj7kIzsKwtYLSNusYYzLAN1k",
+	"hT18SD0lb7ldiZRFzgA+o567yiVlRErkzYViokhUOfwLk53Pg0c/602EqKfP84oz+QMevylSL6ZS/paY",
+	"bItc4Cghk/jK/ClxNllihnWyRa35MyQobeJJgDkABxcODAN25WtLRsQoWJH4tjnxYlcemFZKarbnpnQ7",
+	"ceVrd+vliseIMEHhTWu1QkBLxulEm7MzIkzDKZrlGRGSwBPXzyT5el1WXg5WlY6y/IgLMqDiTpMdWCec",
+	"cgZY/RfHoNXVQv+Jh0BFiTBPMcBxTcVvlLpQGXtv9z19PUZ7u5N986/93clz86/nu//5nr5+2kI/ZuU5",
+	"U3fA3A+v79DZIeueER5caK+fVd9EMEDPJEGa3ZTlZYJo3aTLfXPHA4ie7L4EMTQzWW/HaO/lWyzXY7T/",
+	"8oTENE/H6NlLkE7H6ODlL0uqyA8JX/nqkdYlZnnf5vWx9I7DoJ+7lIiyvrxThexODgyrfT75xvzj28ne",
+	"C/Ovvf+aPNs3/3y2/58XowHLMA+SB1yJmaB/MaE1PJu8sN9fPJ/s7dv17u1/O9l/bpvvP38xbKE/06g4",
+	"7fe5zKs1+vn4yJSh8BZmQbVA2vWY/xy0AawrVMmKtNb5vKg11zokexB8QWqQiH7ieupRg1mMPATeguMx",
+	"X3wygRX3CR2Xd+U0AffPYzbnt2WatneIV+r6FBDxQTYEujGSwOmtr6A+IX6QBL+x+A7NdD77NufLqpzr",
+	"JTP3i9hYKxpcTEF/yxtMVyQ2BySg7nFFQE0zJ164snvwo5BNVbIpRGr7FIVEsdAF3fjKidohQjcJeOuH",
+	"U//KOILKb0RYFkIZqmF2jDpQrceoMY4NXua/6NX0Ha+u91TlMVUIo440CzHJl7eqJ6CFNYSYWfjRdWOq",
+	"IxQ7fMxsZEL1IablgQrHcebwVTQfjUerlfl/qf+fZPAfmS2JIAbES0MJLaW0a/lyckZ/y4nV5xv+snns",
+	"pxnEZNIxGRVW0RytVvA/iQBGZCFEFfg+f/7ciiibyFBvhGzBlFaF/kQjwqT2ebVMvyNE47aRrSaamrAV",
+	"FZzBGXv4yXQMpDZUPvxcGREZUTlODDIffsrgvrdmrzr8vVZEuDvx5GaAMZqMIyKUycneldfp8Pc7TWQw",
+	"YC64S60KrkxYyVT04CuWcnl5TdY1EO5lrUXYfGOpfu6lmio0Wx30ipHZ6sDE8YUjrc7TMxxdB6OsTnOl",
+	"nenAldm0qdT6LDzzuct973LINzxcbCnO85CS/CfzzWWq1xoqU/llRVAzQb3WrAy9/870mOdpSKQsYRrk",
+	"j+GvDzFCrDu6yJk1M5tV6KdRwtsqXFl4egUji4wQZpuj5r5NrKViqo0Wb7USFdU/XHJ1jWdT6AQLghIy",
+	"V4jnyrUrasgN2oeq1a5PACmx1Fibv22j8B4GpQhzj4Lw0nIpSpG+LWMvm74Tq/Qti8Rao3RwQ1Ndu1nd",
+	"fXd8DyzRpSxpuReCol8oIt9TrmiZ14nAYHWXcPz1iSy93go65ZHJcBwRJ2o3cw5sYFapOwObL0UUkAHO",
+	"VoQ/ElSnu0VcICs+hkx5kTvUzTPzMDabqJUCQ9q8pqkeJG7d6nVbopxSuUwZev+6DPBVdGgU4CrtZXZF",
+	"Wf5y4CFGGAt6OcXHDn3lg2DBD/i8P1S0PFP1ZC4jRjXKtA1NbsJxZZUfO9UTdem9laatHtRp+2qX+QzZ",
+	"70ZZB7aJdyRGP2KF/nE0Q1goGiUEHew/O3j+7Z4Xum7zqeoo+xVhMReXhcZV07xNQFH5VWYkoji5hKrt",
+	"4O/XUjjVdWjJj70QOCbvCExBbK6GUHpI+53E6HSGbC9NEyfvz1Fe6ofhs95LW5PUNtUXOUZ+s16nichu",
+	"Y7mE0CZmgki6YCSe5CJp7iX5lFFB5CUOFYuEb4YxK5qSoqLQh3c/IcWvCZuOxoMSco9Hdu6ab4IgEwOb",
+	"HhKGd4nznaBnXQNiKiOuPalpihdk2osbmK+Jjc8m/7wm6cQ8mEr3kNGrDEdLgvanuyML8MglO7m5uZli",
+	"/XnKxWLH9pU7Px0fvf159nayP92dLlVq8tpSlcBwpTd4cQOiV/GKSi7Qq7NjTcm23MBotYeTbIn39KnL",
+	"CMMZHR2Onk13p3AKMqyWerN2cEZ3Vns75ZWmf16QwOZBnmPfV2OkR7Y3cWwbvKp81xEpxLho/bM+3vc0",
+	"0fWmyh6g1bL7YwpmQLPfcqKvIYtT810XpDCC2AD/F3DnFNaXTK9vf3fXsB2m7C3uGW93frUePOX4wxxF",
+	"YP2GJGpc6h+wCwe7e/c251shuAhN9YHhXC250DV9P49Hz3d3H37SY2bzxBDbYjwyQt4/K/4eWoscjJHS",
+	"Lj/VIIgGcZlGr/wGVqx/zeP1A+zm91yk9dxl8OD+3KClvQeYPYRng4LYENMX2NfXOEYuqGVLwKOP8HuA",
+	"Ye78yq/kzu80/mxIOyEqGOjIIpIgjH7lV03i1h//zq/6eGbpLm6G0RwSuHnJIDUDrJJskFW2VTJ8UGYJ",
+	"S+zgkH8Roj7Yffbwk37PxRWNY8LMjAcPP+PPXOn0Y2bCbx9+QlABJjRSj4FRwHmEKy4oOv1AFBxYVGSj",
+	"qx7/H4janv3t2f+znP3HcRRbLmuxUpyb4I7h0qixkmOG3p2/h96golvwVYT+Pjv9GZFPWgOB5ZpFS8EZ",
+	"z2WybhxyM64dYKAcm+aJohkWageO7iTGCt9GmHxn1jxcot1/6EP/SlcgJzGaoL/zK1egcivZPpZT0ifN",
+	"vtG/9zzZTKMKqQ+84CqD3uGe+6rqgO1lt73svriGpVX81LpP0F+D0rvr1P5A1PbIbo/s9sh+MaVoHjiy",
+	"Jvqz54I1jR7raX1I5axZ+TBhdssotozij8AoZlBPUaC3t9JBg8C+Y33XJn7NwI6Hrs08QcK1BsF42mOS",
+	"cQOU5yJQSuXPzpQ6ij5+YfbUVccmpD0N7bqXjgRJU71jnidbxvbHZ2zlITX+kl9VGoJpvwCWgaXSiKAP",
+	"rKiRc3+cdUcqLkg8oc75svXtZRqG2azu3WS2XpLfjudZ4MTP9FzGIfSxcN5x+8wmwsNbbcjnI3IJcTuh",
+	"+JJPxh7Eh0hxAA0UtrMtp/2TcFouunb86/PhW/HCIl59UmY3GCJmBkPeyyE2YILFmIUjnBe9/4eVN8kn",
+	"DIvwcqjrxcY8xZRNom9Gn/3pB8Uel2j5SjJpEJJ2mfSkh0S2IulWJH1ErJCwJWaR5umFcbZPCvT6mFp8",
+	"/Q/tisz3tuwP9U/+Ehr6+ppDR0YSYa5V6UtS28P6lzqsbS7GM4hzucXJg35/kKN3/5qt4Kn7cqLDhode",
+	"YgjwKwWEZL0VEbZc56uLCEubRnbCsyKfYguPgtA/LwC9kRZch6uaKo06unn2jw+ulZsFRVjhhJtamwIz",
+	"qF9KLtjsHx+kSxlj8vlFXBZhz34s9hTN8MokaRGmHkMOblp4gSmTylb0k7rmzQXzOh4i7MNjwRgjG+BV",
+	"j3WvRWab7C+91gWXkvfUovLP8NJz2NRphkfixfPdybP9aPJ8b39RVn+qvAP3wpm4XZL/loT4Oov94Adk",
+	"DdNf6fHYgKL94eiaInvMNPHbPEkFwW8vhD/ThTAuWaXQvGdr1tj0cio0crfW5Okw3i4d3gDd3dty7j+v",
+	"7m48KrE0s3D8c8RMFpwJ3AI62lovXzNSV3LsUmBFLtOrTLo0G83ib6PDF583Vw6WeL93/u6ho0pZ1QXD",
+	"/eenjjyDUmmlDvBIl0rThFjU6x893013ZZk6H37Y1QkN/i/0Yne6i1LKpMkxvYP2dstSasjWLUPfoOUO",
+	"1BvTpGpvBz5He7oBVNuTXunfMtS6Bsaz5UEdENid6e4uVFvCCr3Y30UnV5lET/b3NVQ7z3d3f3j9VJ/U",
+	"FH/SSR/elAMeLJ/ZAVPK2j5C3xKhUJKHfNKbUNINnN3L4oBeFus39VTbqUoJnVFCLjmH/swQ1yodHb5o",
+	"pTlHcjJAy3ckyCE6Yo/vbP0Wti/AR/oCDF2yO1drL2/43a7cKwGZM3SiCxB3I55eUaYTfvynqbzvm8aG",
+	"38WVjNh/ck3Xl7gSbw2JvxEb80VDELb3lktuueRj5ZKCLpZqIoui4UEz2ixf6ISEMoXivwKtIOe8zlNs",
+	"MrbbEvRaAeByC7nMjixUYW9clBq5YLWxtAh5fmKqOdwsCfPTB91giSKeJLpciUmybLvp5lSW+ROdJk9X",
+	"VZV5Cto0FvvVTUwOZ9PfViDRJYqXXJKicpKpRYJyRRP6b3OCoQSrNBiBFR9eMBeeaiJSvSrHBaJWLrc9",
+	"vpImEaKOY210OD9Bv+VQr18qrKRZoa5sdMGKwlwRzlQudEUQzbeZLtOUM1NSVvIK+qFvJdfPNSGZreVU",
+	"bhvKWUKkvGDmg1c5KsJCrFGU5R8kXpAzIiI9YLFf/s8hdWLFhvoOiK0oqf7oneQctVbLJgcKQU7bkiaV",
+	"FSh9kLpqRTbhKYpi2y3VGeKGO+59HUc9b6ff6RO0jdzYRm48pmuvLx3Bh0wXGe7LPQD2GZ3xL44h659X",
+	"QNbr6V2A1WQFhr9DhhJzzZjrK1ibljNb1c8vPovjmMQhrmugDyU9+AO8J+43+4JBxTYHw5YTbpOxVFig",
+	"LozSlWziA9NNQilZgEUAJ4hB7hamsshC8DyDsqBULXkOIrJO1inHdVZG5QXLma3LUgwHB55po9ICMydq",
+	"O7N2LhVPiYDKpUQtiUDU8D+0ouRGdwIoSUwVF0F+aKb7gmkMdeEaT5nxkMqLmUlvtZWwtnzli7v9PRZx",
+	"osW3J8DAyuzzQQYGtd8tW9Eyn2EqIb6mX8tVxuaqTRVzDOFq5YRj+w43eotgX5wIguO1rrGMtSYRhE0a",
+	"fHvPtjxvy/O2PO/LvSqL4ug9ybyTpKyjXiibAkmCxoiRGyKhyoqQqifx96yY/K8QmuBW25f8e8sFtlzg",
+	"a3GBnZjO562sAKyLIJ2oGz6MGxR+d1dr989m7j86nz9mltChbXceuQUyWpTb8GLshGEz9XpT3a8tt1qM",
+	"C7lft0Cl+O1h+hJ8Eghjyye3fPJR8snfS0PZ585YToygzGjindUOftlti5yVXOYPY4gMz1uxMj5iFrRl",
+	"P1v284jYj+IZT/hi7Tm/9DkDutCpIp8InyMJkWE4QQqcThQqi0VZEU2OjUtLxJnkuvQiZYsL5hn0OSOg",
+	"aEq5KPxcXN9AZfFhIVvv7eIembPFnfz7bxd7NR6ZnbGpg8zy7Wp4lE0I1i9rg/gj5zgBzSqRX+7f+96/",
+	"n4EKtDLYDekd7MAb4Ln37xejj4AeE7GnK5CefRgdPtv3fzJORKPD/ecvBnt5VynhK7lX1oFo96Z0LW1R",
+	"2K275J84RVOV2W3jyDa/wmxUSRGJ8ngtMe8tqBoIfsOIkEuaNT1EteeMLryvLTPWL7PopKttumUjqr4z",
+	"N2kmyIryXNpG4OMoreXGWklC96aDqaTKUwfYg9tK3NxfiSFvUypv64I9WF0w4/HByI09jhWbZdgWK3Fq",
+	"DLJ/qCfEKu2x9PQ6wk/RzFf9Nj0Grbc4n7vb0vt0wYyvunHyNi6EJEYZEZPSg3tsXbjtr+BN953vqnhj",
+	"zdred/REF0anbEWY4mJ9wbxJnyJBVC6YRAe7ByGuWrVKnZ/Irav3UF20Lc1b6ve1L2iZy1LP1eny3enw",
+	"3TGfCYk4nXVNweVtR884OGlJBW9oQ1v6FxKfsqctk+kGYOQnm01ahHEsdT4Vv96/4zZUtpc5tk2P47vN",
+	"Wint7qYvfPfLGv9BEMrPJQSufvqRoIpGOBmNR79gwczb/pgZygdYPo6HbAxJdJ1yyYVCV22AwNcKELE5",
+	"GqPDkaUSB5X9s6RBTSqVLYyy3FWrN6/1E6jtD1Xvf4B/WBzVK/23r2EGoHMRt8ZAuG8h8LGMPOjNXzD8",
+	"oJlP8Cc40ohVy/pzyxdbwEloSluwuQdh4KkZ1UWFb8Y3fq6DIq9p1gIIn88laYHEn3j3C2uJ/Stja9Tf",
+	"aosfmah3g+mKiAHiXnHZmA4N0W8MsSMgv8DdIBFlUZLHLpjRxiS6vtp5EfBBYieOnJ8gvFgIssCKBNTD",
+	"pSdBm2h2ZOD7xa7nIZO1+zM9wiL92+O19RauQKApFeFKwnRzmHXOmiRpf89xodtInroce+lYa54yMPcg",
+	"qiQSVF5PkS1dYCK9QEMFD6+USgnTcTbE2qMDhiqH64G0VZU5zLTD9FR7DwNCUOewDZ/aMq6vLxfs/G7+",
+	"cdxdv/cdWfFrnW20IiVszBZaav42mULlWB60FRTeJu3e3sGPRhV346g3MKs7ZHe7/7vP84p0JQvOElpV",
+	"6xbKOcrg4Q1XA1MUJ54UoccENxBJjZZtJ64miDL+/VP0FhxFoDWEEl0BAdlUqpC0hK6IFkikEpgyZWKW",
+	"bMoh/ZrQUga/YSGh4SzBrEhU9Ite418r52MtTZ5WuECarh+uzogAhIwO93d3rSbmPJXFr8/NT/CHSwz4",
+	"I88BZd9snmkPRoGt+NpZrko4huS10hSZJZhtpaxtxqovLnHlMVX9ahfdDEEKdse1THBktMRsQSR64qIp",
+	"La+RYxPviVKSXhmb/7iiR1lioTX5LDZWTGjx1Fg2Uy4VmNegneXcr+KUgqCWrE2gKDJc72UkV7oLYUpQ",
+	"YjQ6JneIdlVGR7PzsX5Z2kejCwDV6mIdUEpUq5ENlvzWDNzHzbWBwgHB5wVaUhwTc8NQqR0uWrTGOFJc",
+	"3MIm4k2ppwAFF2fWCOQJtCZu9mn77CZL8V2nF8RaUaE7euJr5Qw1cOFCdi8tobTB5IZ6D4DcETJSAjbA",
+	"RuXaHsd3nZdKF82so55hhEMa290Bajj8NebaOZWLxeFFvrv7LNJ4Oo71H6QNOXbUu+Pl1dmxO7HDUKOb",
+	"3gkzxm4Ox1OrdfBcaa8lKnUG0LYFUxZVyaAQe2KsyMR2vSUkV2TOBekFImeKJvcARNPC5SAqrFxVO/ne",
+	"7q5Wf0FqpOlgA1jF5HUHm5cH3APZvZoWVI1X7/AaKSo8s92E4MwjfbGWtkj7ZyRXo48tovJD2d/cbbK2",
+	"qvrxCJJc7wAolUHqQG2tdF9aTvzqoloUGTu9L6fdexH85Trjakm0u4POEa8TsulLgDIdKFo4KFmvJcZ9",
+	"Cc49q562x0uEy+Z/hQgFrnCi/f0Pdnftn87X/5viF3ClMs4CtSCBvRehIIEXB4PfpzOFWYwTzsjjqarf",
+	"A9O2vv6mfOqv7FFvQ7yqDMum3+mx6RfNNFNK18GUPmFLezHBQxrZ7SRbl5m/6GVsybGFtnd+h2ccCKI9",
+	"dqmUa9O362jy8g0iddPX0WELrW+J8k9p5EKPxspVHoMeTZgjVOQORtio4X1tN210WZZMNrn+RFC2XegC",
+	"+cF96lyQ58QN7dE1ZXHLS9R+aroVO+yNRxgUmQO9iN28MDp6EmFJJpRJwiTVXmwwqDaEYRUt2zRFFse3",
+	"cisH0sFsfdupbfevliNdb+/WL+5RPWjbXMOMmxHC5oy1+GT9YL89hC+WHvvr+GCZZW19r7banvrtpl0m",
+	"uuRK417UemzMZ3dsBrojuKH+WPkKWw/R1gH6Ty2X+ldLR1orI7pdrY1pq5G2antEtkfkL3FEsjxYiyXu",
+	"Er7M58d1RB5IADRL/dKq+N6DuZX9tszgC0mbO9Zfq1uxYhshylq5RqFgObED/slvV7PMrbphe8V2KzjM",
+	"0ek6OZ6ywxDVn/jWNQv8OnoXi9yt4uUvwya+aJamP9JtP9CMadVNakkcGzMulGWVsTJPtJ5lil6TCOfS",
+	"Y3xprg0zN3gt0RVJOOSQ4Y4Xjo0PZsEPdZQeBjwka2Sgkv70//Pf/1t72f+aS+X9Dr7k04s2U+oj46wN",
+	"A8wHuxVu6tSBem9mtK0NeSslPWpFRL+Q5Ckl/vJH+aHEsq+jDWkXy7YsacuSvoSAtMQivsGCTOR1PiAj",
+	"EeMxQbN/fCiCalx/FGGFE74Yoytu83D6zexXNKcJ0XFwuiqhbpFihhcEfhE8XyxdqE5bpNqPdsIZwPuA",
+	"R9Ob5xEqOr42Lblt79ACvNJlzB3BuJxTdXpBT3AR7vi0RT3gbcUDeUR4M3yd97m/xO0j/atdCV/gyfxK",
+	"n4ZmsuIiszH5RKWSj+yQt90YO78P9wcuWEELxy9f1psxCfNSrzKJTvH4Z09Knf3jQ1hEva+X5pdgD5XM",
+	"O1v2sJUYH+SW73zF9h7uziNshnkcR/hBpYuv88zsYR/bt+aWc3wR0YHGhCmq1q3vzHe2HIDJwqKW0DzS",
+	"KalyScTfJMoEhyfkFB1DuqyEQzyvfddawWlc1BSQigvbUwf1TtGpWhJxQyUp2mAk10wtiQTiQIIs8gTb",
+	"ajEh37ljt4AHPKzFHNsXZ6/2grI57yzyWZbQK1NQvYpXVHLQuJap7kN7DWM/5D7D+K17/LXRrTFbwXWR",
+	"g25SZkRzU/Qrjso+yPZBaomVrsF0RVxeFhI75RAqr3/4k4oi7JoLieClFNQOva0la7uliqgIxP/n7yNv",
+	"Xr/e30xxgRekpCsnssA0nz19uW42KbA38sr8nXGpJiVhHi1JdC3D42TQtNyCyDQFiaX2FKDy2qSGingG",
+	"tkm+soWybNK6MZrzJOE3JhtgdVgUOQgsgPVEdxqwf5C1CZPjUl0WfS8JW1BGjPeTzjFwCZkJLxdX8Let",
+	"UHUpsCKX6ZWORFOC51cJkUvOYRwmLzMiLlfpaDxapZeRrfAA818ueS7M5xivTXHDgaRfo4etKm9wsjQ/",
+	"UFfu/M7F4jj+vFMmmpwoCI0fcPQhpy2MUeiCiyGQjLjOjGaGQkXAvSndVokVPjQq46ucJmpCWdGFxcFJ",
+	"/K5jv/iOSbLWmtDegfbeLG6woa0W1hx4jWgEPhqNQm2lW1++R3ASy4PRoVaHe0c/uMmNI/r+g2VyzPs0",
+	"qg8OtCI61SsjN5XKXDY3jqo8201diaI+YnHk4La5JllnhvkKtT2CY/UQGe4ra/xaOe6riN7aEv7UtgRb",
+	"3dTxgRssUWS2FzIOFvYFnazvsTG4zUWNHVPSpsvYMItMckNTMtJnaAEWWBhhGkLFAlNWZX0X7H2PlNHJ",
+	"Bt8RSdTj44JfRrgI1uZuon2MGL+xZYu2UsfXkjpaVSndEsbAIwdnBHoSk30vpHd5pQlge1a2Qvgf8o76",
+	"3d4Rnzv1kvgusnvo1Dyq89LwDT2vLla5x3VgNouX7fnc2rIeiS1rM46Q8YRG68lVzuJB2jF4TVdkytOz",
+	"V0gPQoOHv6nMCmqyzjQYry0Uf9rb01/mVof1CA6LIf8O/dWHTOdeNgosR/wDaT+kjXKt01yqC3ZFIJAi",
+	"w9E12GYon15ztiJrLqZzyPpM52q6SrVrGei/YM3gkFw8BBcJv8JJMajWOa8RjuMLZqusybH5LcKMcWVr",
+	"X/h9OSPSgFYsjkpEmS3HqvPqm0cOPNPbVWY+Zf8p9WX+Ar+OsqyC4selKRsjXTdD+RQecx3/Z4l2q0t7",
+	"GF1acWofuzKt4LQbiiQDtGhvVzjJsSKa0YYYo85fXS1gW33mNxVowBIvWEPaGaxBe0Mc03xknLGvLObP",
+	"HB1ZGtmKIV9BDOlUaFUEDmLJPm4VCHrovl2d9ZhpdveLXajbh/J9z3jaxqCBlRb0/Ie+rn53d0afRm3D",
+	"x0TosD6eYzoOlHuurM6tLDyhw8WQOcvaVFvesFWiPd7jv+MEwPZSUIXg2sMNBt3v9aN9wShbEKmkCVQD",
+	"Y2VYJWEdZJK1NWZWNAEx6Xz8v3p0Qu5flhN9gQNTURMxjiBFEBHuid9Op1tWuWWVnaxSEakGsMmg5Mji",
+	"APvs0soC05TaYR74ZoOlvSdyK1g9uAoVsPyVyus1wZB5sq0gtuWWX4tb2hJEfRWTimiDUG2ycBmlMzfy",
+	"X7CWz6OsTmd/lDuWv/bsuVdzsejQsc/vyjYPxz0rU233/Zb73ls/5giziCQIo4ywGPyraoQQKO0LHarb",
+	"s1FJwq9y42yL9bWIlWfV7fYK/9938uhgpoxXUUQyhTgA8CuJlF8gs40CTbaIAAU+gChZmeTrpKmoLXQr",
+	"P27lx699u/RdKj8RvCIDyzhD07OiOOYjv0a2hPclL65Wq1ZLjXAUE4VpIoM2rE4S21bX2pLsl5O1TCm6",
+	"h5K02hi2exPAII8AzNZI7vwqpSAH1h4iU6St+n4yIqnzpKT4mpiiAa5lm+/ol5cYv5IHZ6/EuI123rLC",
+	"LyY2Sp6LqC/owzUKqZ1mxbcHu7vNFFs1U2NHzb4MKWtlW4Z578x9fAieawb/OrzWLmzLYx8XtTbZz/BK",
+	"2i2EbL4XhDzQWFsM9seqZdhO1ltl059EavhKQsOMCMi997brpul0Ti8LjLUc1B+I2p7S7SndntIHEwQ7",
+	"cp63nEnz9bEdy4cSRb+OoaidGxh4Coa55QxbzvCA93eL7L1DU7zQcveS4LjJQH4k2CQtPT1/hUzbOheB",
+	"Jsf2SzcLib/ezd5xEQ85HoPIuZ/8esll0+01O9Kzu5NcJJ3xSJX9RSuK0Yd3P7VLcG/4DYPECKZR55ab",
+	"DojGX3Kv7+XMZYJIumAk1tgL8bR3PyHFUWyR4R2QLSffcvL7TG/fd8bZijDFhZaXuqTAsmFYEDz2vv9p",
+	"ZcH6Uh+pOOht1padbNnJAwuGS4ITtWyVEcxnU3EhJP4l+tgPE7s8EOysHzX8UgNquI2WV0Y7o88fP///",
+	"AwBBoG92A8ECAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
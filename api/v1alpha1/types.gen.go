@@ -76,6 +76,7 @@ const (
 // Defines values for AssessmentPermissions.
 const (
 	Delete AssessmentPermissions = "delete"
+	Edit   AssessmentPermissions = "edit"
 	Read   AssessmentPermissions = "read"
 	Share  AssessmentPermissions = "share"
 )
@@ -87,6 +88,24 @@ const (
 	AssessmentSourceTypeSource    AssessmentSourceType = "source"
 )
 
+// Defines values for AssessmentRvtoolsFormFormat.
+const (
+	AssessmentRvtoolsFormFormatGovcJson AssessmentRvtoolsFormFormat = "govc-json"
+	AssessmentRvtoolsFormFormatRvtools  AssessmentRvtoolsFormFormat = "rvtools"
+)
+
+// Defines values for AssessmentRvtoolsUploadFormFormat.
+const (
+	GovcJson AssessmentRvtoolsUploadFormFormat = "govc-json"
+	Rvtools  AssessmentRvtoolsUploadFormFormat = "rvtools"
+)
+
+// Defines values for AssessmentShareRequestRelation.
+const (
+	Editor AssessmentShareRequestRelation = "editor"
+	Viewer AssessmentShareRequestRelation = "viewer"
+)
+
 // Defines values for ClusterFeaturesDrsMode.
 const (
 	ClusterFeaturesDrsModeFullyAutomated     ClusterFeaturesDrsMode = "Fully Automated"
@@ -123,6 +142,14 @@ const (
 	ClusterRequirementsStoredInputMemoryOverCommitRatioN14 ClusterRequirementsStoredInputMemoryOverCommitRatio = "1:4"
 )
 
+// Defines values for ClusterSnapshotDiffStatus.
+const (
+	Added     ClusterSnapshotDiffStatus = "added"
+	Changed   ClusterSnapshotDiffStatus = "changed"
+	Removed   ClusterSnapshotDiffStatus = "removed"
+	Unchanged ClusterSnapshotDiffStatus = "unchanged"
+)
+
 // Defines values for CpuOverCommitRatio.
 const (
 	CpuOneToEight CpuOverCommitRatio = "1:8"
@@ -152,6 +179,12 @@ const (
 	GroupCreateKindPartner GroupCreateKind = "partner"
 )
 
+// Defines values for HardwareSkuSource.
+const (
+	HardwareSkuSourceApi  HardwareSkuSource = "api"
+	HardwareSkuSourceFile HardwareSkuSource = "file"
+)
+
 // Defines values for IdentityKind.
 const (
 	IdentityKindAdmin    IdentityKind = "admin"
@@ -204,9 +237,17 @@ const (
 	CalculationError  OptimizationStatusReason = "calculation_error"
 	LowConfidence     OptimizationStatusReason = "low_confidence"
 	NoUtilizationData OptimizationStatusReason = "no_utilization_data"
+	RightSized        OptimizationStatusReason = "right_sized"
 	Success           OptimizationStatusReason = "success"
 )
 
+// Defines values for ParamDistributionType.
+const (
+	Normal     ParamDistributionType = "normal"
+	Triangular ParamDistributionType = "triangular"
+	Uniform    ParamDistributionType = "uniform"
+)
+
 // Defines values for PartnerRequestStatus.
 const (
 	PartnerRequestStatusAccepted  PartnerRequestStatus = "accepted"
@@ -215,6 +256,24 @@ const (
 	PartnerRequestStatusRejected  PartnerRequestStatus = "rejected"
 )
 
+// Defines values for RightSizingTotalsSizingBasis.
+const (
+	Allocation  RightSizingTotalsSizingBasis = "allocation"
+	Utilization RightSizingTotalsSizingBasis = "utilization"
+)
+
+// Defines values for ShareSubjectType.
+const (
+	ShareSubjectTypeGroup ShareSubjectType = "group"
+	ShareSubjectTypeUser  ShareSubjectType = "user"
+)
+
+// Defines values for SizingMode.
+const (
+	SizingModeBatched SizingMode = "batched"
+	SizingModePerVm   SizingMode = "perVm"
+)
+
 // Defines values for SourceUpdateType.
 const (
 	SourceUpdateTypeAuto   SourceUpdateType = "auto"
@@ -239,12 +298,36 @@ const (
 	N3 StandaloneClusterRequirementsRequestControlPlaneNodeCount = 3
 )
 
+// Defines values for StorageBackend.
+const (
+	StorageBackendExternalSan      StorageBackend = "externalSan"
+	StorageBackendOdfErasureCoding StorageBackend = "odfErasureCoding"
+	StorageBackendOdfReplica3      StorageBackend = "odfReplica3"
+)
+
 // Defines values for StorageIoConfigurationCongestionThresholdMode.
 const (
 	Automatic StorageIoConfigurationCongestionThresholdMode = "automatic"
 	Manual    StorageIoConfigurationCongestionThresholdMode = "manual"
 )
 
+// Defines values for TimelineRequestWorkingDays.
+const (
+	Friday    TimelineRequestWorkingDays = "friday"
+	Monday    TimelineRequestWorkingDays = "monday"
+	Saturday  TimelineRequestWorkingDays = "saturday"
+	Sunday    TimelineRequestWorkingDays = "sunday"
+	Thursday  TimelineRequestWorkingDays = "thursday"
+	Tuesday   TimelineRequestWorkingDays = "tuesday"
+	Wednesday TimelineRequestWorkingDays = "wednesday"
+)
+
+// Defines values for TopologySizingRequestControlPlaneNodeCount.
+const (
+	TopologyHAControlPlaneNodes    TopologySizingRequestControlPlaneNodeCount = 3
+	TopologySingleControlPlaneNode TopologySizingRequestControlPlaneNodeCount = 1
+)
+
 // Defines values for VMwareSubscriptionInputLevel.
 const (
 	NotAssessed VMwareSubscriptionInputLevel = "not_assessed"
@@ -263,6 +346,37 @@ const (
 	VendorSupported    OsInfoSupportTier = "vendor_supported"
 )
 
+// Defines values for ListAssessmentVMsParamsCategory.
+const (
+	Critical    ListAssessmentVMsParamsCategory = "Critical"
+	Information ListAssessmentVMsParamsCategory = "Information"
+	Warning     ListAssessmentVMsParamsCategory = "Warning"
+)
+
+// Defines values for ListAssessmentVMsParamsSort.
+const (
+	Cluster      ListAssessmentVMsParamsSort = "cluster"
+	ConcernCount ListAssessmentVMsParamsSort = "concernCount"
+	CpuCount     ListAssessmentVMsParamsSort = "cpuCount"
+	DiskGB       ListAssessmentVMsParamsSort = "diskGB"
+	MemoryMB     ListAssessmentVMsParamsSort = "memoryMB"
+	Name         ListAssessmentVMsParamsSort = "name"
+	Os           ListAssessmentVMsParamsSort = "os"
+	PowerState   ListAssessmentVMsParamsSort = "powerState"
+)
+
+// Defines values for ListAssessmentVMsParamsOrder.
+const (
+	Asc  ListAssessmentVMsParamsOrder = "asc"
+	Desc ListAssessmentVMsParamsOrder = "desc"
+)
+
+// Defines values for ListAuditEntriesParamsFormat.
+const (
+	Csv  ListAuditEntriesParamsFormat = "csv"
+	Json ListAuditEntriesParamsFormat = "json"
+)
+
 // Defines values for ListGroupsParamsKind.
 const (
 	ListGroupsParamsKindAdmin   ListGroupsParamsKind = "admin"
@@ -324,9 +438,11 @@ type AriaSecureInputFeatures string
 
 // Assessment defines model for Assessment.
 type Assessment struct {
-	CreatedAt time.Time          `json:"createdAt"`
-	Id        openapi_types.UUID `json:"id"`
-	Name      string             `json:"name"`
+	// ComplexityTableVersion Version of the complexity scoring tables the assessment was scored with
+	ComplexityTableVersion string             `json:"complexityTableVersion"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	Id                     openapi_types.UUID `json:"id"`
+	Name                   string             `json:"name"`
 
 	// OwnerFirstName Owner's first name
 	OwnerFirstName *string `json:"ownerFirstName,omitempty"`
@@ -363,13 +479,53 @@ type AssessmentList = []Assessment
 
 // AssessmentRvtoolsForm defines model for AssessmentRvtoolsForm.
 type AssessmentRvtoolsForm struct {
-	// File File upload for assessment data
+	// File File upload for assessment data. Repeat the part (up to 10 files, typically one export per vCenter) to merge several inventories into one assessment.
 	File openapi_types.File `json:"file" validate:"required"`
 
+	// Format Format of the uploaded files. Applies to the file parts sent after it.
+	//  * `rvtools` - RVTools Excel export, or a zip of the per-tab CSV files exported by RVTools
+	//  * `govc-json` - output of `govc ls -l -json` for the inventory folders (e.g. `'/*/host' '/*/host/*' '/*/vm' '/*/datastore' '/*/network'`), optionally merged with `govc about -json`
+	Format *AssessmentRvtoolsFormFormat `json:"format,omitempty"`
+
 	// Name Name of the assessment
 	Name string `json:"name" validate:"required,assessment_name"`
 }
 
+// AssessmentRvtoolsFormFormat Format of the uploaded files. Applies to the file parts sent after it.
+//   - `rvtools` - RVTools Excel export, or a zip of the per-tab CSV files exported by RVTools
+//   - `govc-json` - output of `govc ls -l -json` for the inventory folders (e.g. `'/*/host' '/*/host/*' '/*/vm' '/*/datastore' '/*/network'`), optionally merged with `govc about -json`
+type AssessmentRvtoolsFormFormat string
+
+// AssessmentRvtoolsUploadForm defines model for AssessmentRvtoolsUploadForm.
+type AssessmentRvtoolsUploadForm struct {
+	// File File upload for assessment data. Repeat the part (up to 10 files, typically one export per vCenter) to merge several inventories into the new snapshot.
+	File openapi_types.File `json:"file" validate:"required"`
+
+	// Format Format of the uploaded files. Applies to the file parts sent after it.
+	//  * `rvtools` - RVTools Excel export, or a zip of the per-tab CSV files exported by RVTools
+	//  * `govc-json` - output of `govc ls -l -json` for the inventory folders, optionally merged with `govc about -json`
+	Format *AssessmentRvtoolsUploadFormFormat `json:"format,omitempty"`
+}
+
+// AssessmentRvtoolsUploadFormFormat Format of the uploaded files. Applies to the file parts sent after it.
+//   - `rvtools` - RVTools Excel export, or a zip of the per-tab CSV files exported by RVTools
+//   - `govc-json` - output of `govc ls -l -json` for the inventory folders, optionally merged with `govc about -json`
+type AssessmentRvtoolsUploadFormFormat string
+
+// AssessmentShareRequest defines model for AssessmentShareRequest.
+type AssessmentShareRequest struct {
+	// Relation Relation granted to the subjects. Editors can read and edit the assessment but not share
+	// or delete it. Ignored when unsharing, every grant of the subjects is removed.
+	Relation *AssessmentShareRequestRelation `json:"relation,omitempty"`
+
+	// Subjects Users and groups to share the assessment with, or to unshare it from
+	Subjects *[]ShareSubject `json:"subjects,omitempty"`
+}
+
+// AssessmentShareRequestRelation Relation granted to the subjects. Editors can read and edit the assessment but not share
+// or delete it. Ignored when unsharing, every grant of the subjects is removed.
+type AssessmentShareRequestRelation string
+
 // AssessmentSharing defines model for AssessmentSharing.
 type AssessmentSharing struct {
 	IsShared   bool             `json:"isShared"`
@@ -387,12 +543,99 @@ type AssessmentSubsetInventory struct {
 	VmsCount  int                `json:"vmsCount"`
 }
 
-// AssessmentUpdate Update form of the assessment.
+// AssessmentTransferRequest defines model for AssessmentTransferRequest.
+type AssessmentTransferRequest struct {
+	// Username Username of the new owner
+	Username string `json:"username"`
+}
+
+// AssessmentUpdate Update form of the assessment. The inventory is uploaded again to add a snapshot to an assessment of an inventory upload; RVTools assessments are uploaded again with /api/v1/assessments/{id}/rvtools.
 type AssessmentUpdate struct {
+	Inventory *Inventory `json:"inventory,omitempty"`
+
 	// Name Name of the assessment
 	Name *string `json:"name,omitempty" validate:"required,assessment_name"`
 }
 
+// AssessmentVM defines model for AssessmentVM.
+type AssessmentVM struct {
+	// ClusterId Cluster ID as used in the inventory clusters
+	ClusterId   string      `json:"clusterId"`
+	ClusterName string      `json:"clusterName"`
+	Concerns    []VMConcern `json:"concerns"`
+	CpuCount    int         `json:"cpuCount"`
+
+	// CpuUsagePercent CPU used by the VM, in percent of its CPU entitlement (absent when the source doesn't report it)
+	CpuUsagePercent *float64 `json:"cpuUsagePercent,omitempty"`
+	Datacenter      string   `json:"datacenter"`
+	DiskGB          int      `json:"diskGB"`
+	Host            string   `json:"host"`
+	Id              string   `json:"id"`
+	IsTemplate      bool     `json:"isTemplate"`
+	MemoryMB        int      `json:"memoryMB"`
+
+	// MemoryUsagePercent Memory used by the VM, in percent of its memory: the larger of its consumed memory (host memory
+	// backing the guest) and its guest active memory (absent when the source doesn't report either)
+	MemoryUsagePercent *float64 `json:"memoryUsagePercent,omitempty"`
+	MigrationExcluded  bool     `json:"migrationExcluded"`
+	Name               string   `json:"name"`
+	Os                 string   `json:"os"`
+	PowerState         string   `json:"powerState"`
+
+	// VcenterId UUID of the vCenter the VM belongs to (empty when the export doesn't report it)
+	VcenterId string `json:"vcenterId"`
+}
+
+// AssessmentVMList defines model for AssessmentVMList.
+type AssessmentVMList struct {
+	Limit      int `json:"limit"`
+	Offset     int `json:"offset"`
+	SnapshotId int `json:"snapshotId"`
+
+	// Total Number of VMs matching the filters, ignoring pagination
+	Total int            `json:"total"`
+	Vms   []AssessmentVM `json:"vms"`
+}
+
+// AuditEntry A change of access to a resource
+type AuditEntry struct {
+	// Action The change, e.g. assessment.shared or partner_request.accepted
+	Action string `json:"action"`
+
+	// Actor User who made the change
+	Actor string `json:"actor"`
+
+	// After State after the change
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before State before the change
+	Before    *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Id        int64                   `json:"id"`
+
+	// RequestId ID of the API request that made the change, if known
+	RequestId  string `json:"requestId"`
+	ResourceId string `json:"resourceId"`
+
+	// ResourceType Type of the resource, assessment, group or partner_request
+	ResourceType string `json:"resourceType"`
+
+	// Subject User or group gaining or losing the access, as type:id
+	Subject string `json:"subject"`
+}
+
+// AuditEntryList defines model for AuditEntryList.
+type AuditEntryList = []AuditEntry
+
+// BlackoutPeriod defines model for BlackoutPeriod.
+type BlackoutPeriod struct {
+	Description *string `json:"description,omitempty"`
+
+	// EndDate Last date of the period, inclusive
+	EndDate   openapi_types.Date `json:"endDate"`
+	StartDate openapi_types.Date `json:"startDate"`
+}
+
 // ClusterFeatures defines model for ClusterFeatures.
 type ClusterFeatures struct {
 	// DrsEnabled Whether DRS (Distributed Resource Scheduler) is enabled for this cluster
@@ -437,6 +680,22 @@ type ClusterRequirementsRequest struct {
 	// MemoryOverCommitRatio Memory over-commit ratio (e.g., "1:2")
 	MemoryOverCommitRatio MemoryOverCommitRatio `json:"memoryOverCommitRatio" validate:"required"`
 
+	// RightSized Size the cluster after right-sizing its VMs to their own utilization (see
+	// /api/v1/assessments/{id}/right-sizing). The cluster utilization is then not applied on top.
+	// Requires the VMs of the cluster, only recorded for per-VM sources (default: false)
+	RightSized *bool `json:"rightSized,omitempty"`
+
+	// SizingMode How the VMs of the cluster are turned into workloads for the sizer:
+	// * `batched` - total CPU and memory are spread evenly over batches of VMs
+	// * `perVm` - the CPU and memory of every VM are packed first-fit decreasing onto the worker nodes
+	SizingMode *SizingMode `json:"sizingMode,omitempty"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId *int `json:"snapshotId,omitempty"`
+
+	// Storage Target storage backend to size the capacity of the cluster VM disks for
+	Storage *StorageSizingRequest `json:"storage,omitempty"`
+
 	// WorkerNodeCPU CPU cores per worker node
 	WorkerNodeCPU int `json:"workerNodeCPU" validate:"required,min=2,max=384"`
 
@@ -467,8 +726,17 @@ type ClusterRequirementsResponse struct {
 	// ResourceConsumption Resource consumption across the cluster
 	ResourceConsumption SizingResourceConsumption `json:"resourceConsumption"`
 
+	// RightSizing Allocations of the VMs before and after right-sizing
+	RightSizing *RightSizingTotals `json:"rightSizing,omitempty"`
+
 	// Savings Infrastructure savings comparison
 	Savings *Savings `json:"savings,omitempty"`
+
+	// StorageSizing Storage capacity required on the target storage backend
+	StorageSizing *StorageSizing `json:"storageSizing,omitempty"`
+
+	// VmPacking Outcome of packing the VMs of a cluster onto worker nodes
+	VmPacking *VmPacking `json:"vmPacking,omitempty"`
 }
 
 // ClusterRequirementsStoredInput Stored cluster requirements payload for a cluster
@@ -549,6 +817,34 @@ type ClusterSizing struct {
 	WorkerNodes int `json:"workerNodes"`
 }
 
+// ClusterSnapshotDiff defines model for ClusterSnapshotDiff.
+type ClusterSnapshotDiff struct {
+	ClusterId            string              `json:"clusterId"`
+	Datastores           DatastoresDiff      `json:"datastores"`
+	DiskGB               CountDiff           `json:"diskGB"`
+	Hosts                HostsDiff           `json:"hosts"`
+	MigrationWarnings    MigrationIssuesDiff `json:"migrationWarnings"`
+	NotMigratableReasons MigrationIssuesDiff `json:"notMigratableReasons"`
+
+	// OsInfo VM count per OS, only for operating systems whose count changed
+	OsInfo []OsCountDiff `json:"osInfo"`
+	RamGB  CountDiff     `json:"ramGB"`
+
+	// Status Whether the cluster was added, removed, changed or left unchanged between the two snapshots
+	Status ClusterSnapshotDiffStatus `json:"status"`
+
+	// VmsAdded VMs found only in the target snapshot. Omitted when one of the snapshots has no per-VM records
+	VmsAdded *[]SnapshotDiffVM `json:"vmsAdded,omitempty"`
+
+	// VmsRemoved VMs found only in the baseline snapshot. Omitted when one of the snapshots has no per-VM records
+	VmsRemoved         *[]SnapshotDiffVM `json:"vmsRemoved,omitempty"`
+	VmsTotal           CountDiff         `json:"vmsTotal"`
+	VmsTotalMigratable CountDiff         `json:"vmsTotalMigratable"`
+}
+
+// ClusterSnapshotDiffStatus Whether the cluster was added, removed, changed or left unchanged between the two snapshots
+type ClusterSnapshotDiffStatus string
+
 // ClusterUtilization defines model for ClusterUtilization.
 type ClusterUtilization struct {
 	// Confidence Data coverage confidence percentage (0-100), calculated as vCPU-weighted coverage
@@ -573,6 +869,24 @@ type ClusterUtilization struct {
 	MemP95 float64 `json:"mem_p95"`
 }
 
+// ComplexityApplicationEntry One entry in the per-application complexity breakdown.
+type ComplexityApplicationEntry struct {
+	// Application Application ID, key of vms.applications (e.g. "mssql").
+	Application string `json:"application"`
+
+	// MigrationApproach Required migration approach: coordinated_cutover (migrate as is during a cutover coordinated with the application owners), replatform (upgrade to a supported release) or vendor_validated (the vendor must validate the target platform first). Empty when the application is no longer rated.
+	MigrationApproach string `json:"migrationApproach"`
+
+	// Name Application name (e.g. "Microsoft SQL Server").
+	Name string `json:"name"`
+
+	// Score Complexity score of the application. 1 = least complex, 4 = most complex; 0 when the application is no longer rated.
+	Score int `json:"score"`
+
+	// VmCount Number of VMs running this application.
+	VmCount int `json:"vmCount"`
+}
+
 // ComplexityDiskScoreEntry One entry in the disk complexity breakdown
 type ComplexityDiskScoreEntry struct {
 	// Score Complexity score from 1 to 4, where 1 is the least complex disk footprint and 4 is the most complex. Score 1: <=10 TB provisioned; score 2: <=20 TB; score 3: <=50 TB; score 4: >50 TB.
@@ -606,6 +920,86 @@ type ComplexityOSScoreEntry struct {
 	VmCount int `json:"vmCount"`
 }
 
+// ComplexityTable A version of the complexity scoring tables
+type ComplexityTable struct {
+	// Active Whether the new assessments of the organization are scored with these tables
+	Active bool `json:"active"`
+
+	// CreatedAt Absent for the built-in tables
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy Admin who created the version; absent for the built-in tables
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DiskSizeScores Complexity score (1-4) of each disk complexity tier
+	DiskSizeScores map[string]int `json:"diskSizeScores"`
+
+	// OsDifficultyScores OS complexity scores (1-4), keyed by a case-insensitive substring of the OS name. When several keys match an OS name the longest one wins; unmatched OSes score 0 (unknown).
+	OsDifficultyScores map[string]int `json:"osDifficultyScores"`
+
+	// OsTiers Support tier keyed by a case-insensitive substring of the OS name, matched like osDifficultyScores; unmatched OSes need special handling.
+	OsTiers map[string]string `json:"osTiers"`
+
+	// Version Version of the tables, "builtin-<n>" for the built-in tables
+	Version string `json:"version"`
+}
+
+// ComplexityTableCreate The complexity scoring tables of an organization. OS names are at most 100 characters and must not contain %, _ or \; at most 500 OS names per table.
+type ComplexityTableCreate struct {
+	// DiskSizeScores Complexity score (1-4) of every disk complexity tier (0-10TiB, 10-20TiB, 20-50TiB, 50+TiB)
+	DiskSizeScores map[string]int `json:"diskSizeScores"`
+
+	// OsDifficultyScores OS complexity scores (1-4), keyed by a case-insensitive substring of the OS name
+	OsDifficultyScores map[string]int `json:"osDifficultyScores"`
+
+	// OsTiers Support tier keyed by a case-insensitive substring of the OS name. One of certified, vendor_supported, community_supported, special_handling.
+	OsTiers map[string]string `json:"osTiers"`
+}
+
+// ComplexityTableList defines model for ComplexityTableList.
+type ComplexityTableList = []ComplexityTable
+
+// ConcernWaiver defines model for ConcernWaiver.
+type ConcernWaiver struct {
+	// ConcernId ID of the waived concern
+	ConcernId string    `json:"concernId"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Username of the author of the waiver
+	CreatedBy string `json:"createdBy"`
+	Expired   bool   `json:"expired"`
+
+	// ExpiresAt When the waiver stops applying; the waiver never expires when unset
+	ExpiresAt     *time.Time         `json:"expiresAt"`
+	Id            openapi_types.UUID `json:"id"`
+	Justification string             `json:"justification"`
+
+	// Vms IDs of the VMs the concern is waived for; empty when it is waived for all the VMs
+	Vms []string `json:"vms"`
+}
+
+// ConcernWaiverCreate defines model for ConcernWaiverCreate.
+type ConcernWaiverCreate struct {
+	ConcernId string `json:"concernId"`
+
+	// ExpiresAt When the waiver stops applying; must be in the future
+	ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+	Justification string     `json:"justification"`
+
+	// Vms IDs of the VMs to waive the concern for; all the VMs when empty or unset
+	Vms *[]string `json:"vms,omitempty"`
+}
+
+// ConcernWaiverList defines model for ConcernWaiverList.
+type ConcernWaiverList = []ConcernWaiver
+
+// CountDiff defines model for CountDiff.
+type CountDiff struct {
+	Delta int `json:"delta"`
+	From  int `json:"from"`
+	To    int `json:"to"`
+}
+
 // CpuOverCommitRatio CPU over-commit ratio
 type CpuOverCommitRatio string
 
@@ -644,6 +1038,13 @@ type Datastore struct {
 	Vendor                 string                  `json:"vendor"`
 }
 
+// DatastoresDiff defines model for DatastoresDiff.
+type DatastoresDiff struct {
+	Added   []Datastore `json:"added"`
+	Removed []Datastore `json:"removed"`
+	Total   CountDiff   `json:"total"`
+}
+
 // DeployedEnvironmentInput defines model for DeployedEnvironmentInput.
 type DeployedEnvironmentInput struct {
 	Environment *DeployedEnvironmentInputEnvironment `json:"environment,omitempty" validate:"omitempty,oneof=on_premises on_cloud managed_services not_assessed"`
@@ -652,6 +1053,13 @@ type DeployedEnvironmentInput struct {
 // DeployedEnvironmentInputEnvironment defines model for DeployedEnvironmentInput.Environment.
 type DeployedEnvironmentInputEnvironment string
 
+// DurationPercentiles defines model for DurationPercentiles.
+type DurationPercentiles struct {
+	P50 string `json:"p50"`
+	P80 string `json:"p80"`
+	P95 string `json:"p95"`
+}
+
 // EnhancementData VMA enhancement data — fields that cannot be auto-collected by Agent or RVTools
 type EnhancementData struct {
 	ActiveEnvironments  *ActiveEnvironmentsInput  `json:"activeEnvironments,omitempty"`
@@ -693,6 +1101,33 @@ type EstimationDetail struct {
 	Reason string `json:"reason"`
 }
 
+// EstimationSchema An estimation schema, the calculators it runs in order and the parameters they read
+type EstimationSchema struct {
+	Calculators []EstimationSchemaCalculator `json:"calculators"`
+	Description *string                      `json:"description,omitempty"`
+
+	// Name Schema name, as passed in estimationSchema
+	Name string `json:"name"`
+
+	// ParamKeys Parameter keys read by the calculators of the schema
+	ParamKeys []string `json:"paramKeys"`
+}
+
+// EstimationSchemaCalculator defines model for EstimationSchemaCalculator.
+type EstimationSchemaCalculator struct {
+	// Name Name of the calculator in the estimation breakdown
+	Name string `json:"name"`
+
+	// Params Parameter defaults set by the schema; request params take precedence
+	Params map[string]float64 `json:"params"`
+
+	// Type Calculator type, e.g. "storage-migration"
+	Type string `json:"type"`
+}
+
+// EstimationSchemaList defines model for EstimationSchemaList.
+type EstimationSchemaList = []EstimationSchema
+
 // Group defines model for Group.
 type Group struct {
 	Company     string             `json:"company"`
@@ -731,6 +1166,188 @@ type GroupUpdate struct {
 	Name        *string `json:"name,omitempty" validate:"omitempty"`
 }
 
+// HardwareBaseline Sizing of the cluster with the baseline worker node
+type HardwareBaseline struct {
+	// ClusterSizing Overall cluster sizing summary
+	ClusterSizing ClusterSizing `json:"clusterSizing"`
+
+	// Sku Name of the baseline SKU, if the baseline is a SKU of the catalog
+	Sku *string `json:"sku,omitempty"`
+
+	// TotalCost Cost of the nodes, control plane included, if the baseline is a SKU of the catalog
+	TotalCost        *float64 `json:"totalCost,omitempty"`
+	WorkerNodeCPU    int      `json:"workerNodeCPU"`
+	WorkerNodeMemory int      `json:"workerNodeMemory"`
+}
+
+// HardwareOption defines model for HardwareOption.
+type HardwareOption struct {
+	// ClusterSizing Overall cluster sizing summary
+	ClusterSizing ClusterSizing `json:"clusterSizing"`
+
+	// CostSavings Cost saved compared to a baseline SKU; negative if the option costs more
+	CostSavings *float64 `json:"costSavings,omitempty"`
+
+	// NodeCount Number of nodes of the SKU, the on-prem control plane nodes, the worker nodes and their failover capacity
+	NodeCount int `json:"nodeCount"`
+
+	// Rank Position of the option, 1 being the cheapest
+	Rank int `json:"rank"`
+
+	// Savings Infrastructure savings comparison
+	Savings *Savings `json:"savings,omitempty"`
+
+	// Sku A server model of the hardware catalog, usable as a worker node
+	Sku HardwareSku `json:"sku"`
+
+	// TotalCost Price of the nodes of the SKU
+	TotalCost float64 `json:"totalCost"`
+
+	// TotalPowerWatts Power draw (W) of the nodes of the SKU
+	TotalPowerWatts int `json:"totalPowerWatts"`
+
+	// TotalRackUnits Rack units taken by the nodes of the SKU
+	TotalRackUnits int `json:"totalRackUnits"`
+}
+
+// HardwareOptionsRequest Cluster to size with the node SKUs of the hardware catalog. Worker nodes take the size of
+// each SKU in turn; control plane nodes keep the size of the request. The baseline worker
+// node is either baselineSku or workerNodeCPU and workerNodeMemory.
+type HardwareOptionsRequest struct {
+	// BaselineSku Name of the SKU the savings are compared with
+	BaselineSku *string `json:"baselineSku,omitempty"`
+
+	// ClusterId ID of the cluster to size
+	ClusterId string `json:"clusterId"`
+
+	// ControlPlaneCPU CPU cores per control plane node (default: 6)
+	ControlPlaneCPU *int `json:"controlPlaneCPU,omitempty"`
+
+	// ControlPlaneMemory Memory in GB per control plane node (default: 16)
+	ControlPlaneMemory *int `json:"controlPlaneMemory,omitempty"`
+
+	// ControlPlaneSchedulable Allow workload scheduling on control plane nodes (default: false)
+	ControlPlaneSchedulable *bool `json:"controlPlaneSchedulable,omitempty"`
+
+	// CpuOverCommitRatio CPU over-commit ratio (e.g., "1:4")
+	CpuOverCommitRatio CpuOverCommitRatio `json:"cpuOverCommitRatio"`
+
+	// HostedControlPlane If true, control plane is hosted externally. Incompatible with control plane fields (default: false)
+	HostedControlPlane *bool `json:"hostedControlPlane,omitempty"`
+
+	// MemoryOverCommitRatio Memory over-commit ratio (e.g., "1:2")
+	MemoryOverCommitRatio MemoryOverCommitRatio `json:"memoryOverCommitRatio"`
+
+	// SizingMode How the VMs of the cluster are turned into workloads for the sizer:
+	// * `batched` - total CPU and memory are spread evenly over batches of VMs
+	// * `perVm` - the CPU and memory of every VM are packed first-fit decreasing onto the worker nodes
+	SizingMode *SizingMode `json:"sizingMode,omitempty"`
+
+	// Skus Names of the SKUs to try. If omitted, every SKU of the catalog is tried.
+	Skus *[]string `json:"skus,omitempty"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId *int `json:"snapshotId,omitempty"`
+
+	// WorkerNodeCPU CPU cores of the baseline worker node, when baselineSku is not set
+	WorkerNodeCPU *int `json:"workerNodeCPU,omitempty"`
+
+	// WorkerNodeMemory Memory (GB) of the baseline worker node, when baselineSku is not set
+	WorkerNodeMemory *int `json:"workerNodeMemory,omitempty"`
+
+	// WorkerNodeThreads CPU threads of the baseline worker node, when baselineSku is not set. Must be >= workerNodeCPU
+	WorkerNodeThreads *int `json:"workerNodeThreads,omitempty"`
+}
+
+// HardwareOptionsResponse defines model for HardwareOptionsResponse.
+type HardwareOptionsResponse struct {
+	// Baseline Sizing of the cluster with the baseline worker node
+	Baseline HardwareBaseline `json:"baseline"`
+
+	// InventoryTotals Inventory totals for the cluster
+	InventoryTotals InventoryTotals `json:"inventoryTotals"`
+
+	// Options SKUs the cluster fits on, cheapest first
+	Options []HardwareOption `json:"options"`
+
+	// UnsuitableSkus SKUs the cluster cannot be sized with
+	UnsuitableSkus []UnsuitableHardwareSku `json:"unsuitableSkus"`
+}
+
+// HardwareSku A server model of the hardware catalog, usable as a worker node
+type HardwareSku struct {
+	// Cores Physical CPU cores of the node
+	Cores int `json:"cores"`
+
+	// MemoryGB Memory (GB) of the node
+	MemoryGB int    `json:"memoryGB"`
+	Name     string `json:"name"`
+
+	// PowerWatts Power draw (W) of one node
+	PowerWatts int `json:"powerWatts"`
+
+	// Price Price of one node
+	Price float64 `json:"price"`
+
+	// RackUnits Rack units (U) taken by one node
+	RackUnits int `json:"rackUnits"`
+
+	// Source Origin of the SKU: the catalog file (read-only) or the API
+	Source HardwareSkuSource `json:"source"`
+
+	// Threads CPU threads of the node (for SMT calculation). If not provided, assumes no SMT (threads = cores). Must be >= cores
+	Threads *int `json:"threads,omitempty"`
+}
+
+// HardwareSkuSource Origin of the SKU: the catalog file (read-only) or the API
+type HardwareSkuSource string
+
+// HardwareSkuCreate defines model for HardwareSkuCreate.
+type HardwareSkuCreate struct {
+	// Cores Physical CPU cores of the node
+	Cores int `json:"cores"`
+
+	// MemoryGB Memory (GB) of the node
+	MemoryGB int    `json:"memoryGB"`
+	Name     string `json:"name"`
+
+	// PowerWatts Power draw (W) of one node
+	PowerWatts int `json:"powerWatts"`
+
+	// Price Price of one node
+	Price float64 `json:"price"`
+
+	// RackUnits Rack units (U) taken by one node
+	RackUnits int `json:"rackUnits"`
+
+	// Threads CPU threads of the node (for SMT calculation). If not provided, assumes no SMT (threads = cores). Must be >= cores
+	Threads *int `json:"threads,omitempty"`
+}
+
+// HardwareSkuList defines model for HardwareSkuList.
+type HardwareSkuList = []HardwareSku
+
+// HardwareSkuUpdate defines model for HardwareSkuUpdate.
+type HardwareSkuUpdate struct {
+	// Cores Physical CPU cores of the node
+	Cores int `json:"cores"`
+
+	// MemoryGB Memory (GB) of the node
+	MemoryGB int `json:"memoryGB"`
+
+	// PowerWatts Power draw (W) of one node
+	PowerWatts int `json:"powerWatts"`
+
+	// Price Price of one node
+	Price float64 `json:"price"`
+
+	// RackUnits Rack units (U) taken by one node
+	RackUnits int `json:"rackUnits"`
+
+	// Threads CPU threads of the node (for SMT calculation). If not provided, assumes no SMT (threads = cores). Must be >= cores
+	Threads *int `json:"threads,omitempty"`
+}
+
 // Histogram defines model for Histogram.
 type Histogram struct {
 	Data     []int `json:"data"`
@@ -761,6 +1378,13 @@ type Host struct {
 	VmotionSupported *bool `json:"vmotionSupported,omitempty"`
 }
 
+// HostsDiff defines model for HostsDiff.
+type HostsDiff struct {
+	Added   []Host    `json:"added"`
+	Removed []Host    `json:"removed"`
+	Total   CountDiff `json:"total"`
+}
+
 // Identity defines model for Identity.
 type Identity struct {
 	GroupId   *string      `json:"groupId"`
@@ -783,6 +1407,9 @@ type Info struct {
 	// GitCommit Git commit hash
 	GitCommit string `json:"gitCommit"`
 
+	// PolicyRevision Revision of the global OPA policies evaluated on the VMs, a hash of their content. It changes when the policies are reloaded.
+	PolicyRevision *string `json:"policyRevision"`
+
 	// VersionName Version name, based on git tag
 	VersionName string `json:"versionName"`
 }
@@ -939,10 +1566,19 @@ type MemoryOverCommitRatio string
 type MigrationComplexityRequest struct {
 	// ClusterId ID of the cluster to calculate complexity estimation for
 	ClusterId string `json:"clusterId" validate:"required"`
+
+	// ComplexityTableVersion Version of the complexity scoring tables to score with, see GET /api/v1/organizations/{orgId}/complexity-tables. If omitted, the version the assessment was scored with is used.
+	ComplexityTableVersion *string `json:"complexityTableVersion,omitempty"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId *int `json:"snapshotId,omitempty"`
 }
 
 // MigrationComplexityResponse Migration complexity estimation results
 type MigrationComplexityResponse struct {
+	// ComplexityByApplication Per-application complexity breakdown. One entry per business application detected in the cluster's inventory (vms.applications), sorted by score then name. Empty when the inventory reports no application.
+	ComplexityByApplication []ComplexityApplicationEntry `json:"complexityByApplication"`
+
 	// ComplexityByDisk Disk-size complexity scores, one entry per score level (1-4). Score 1 is the least complex disk footprint; score 4 is the most complex. Scores correspond to provisioned disk size: 1 (<=10 TB), 2 (<=20 TB), 3 (<=50 TB), 4 (>50 TB). All four score levels are always present.
 	ComplexityByDisk []ComplexityDiskScoreEntry `json:"complexityByDisk"`
 
@@ -952,6 +1588,9 @@ type MigrationComplexityResponse struct {
 	// ComplexityByOSName Per-OS-name complexity breakdown. One entry per distinct OS name found in the cluster's inventory. Each entry carries the OS name string, its numeric complexity score (0–4), and the number of VMs running it.
 	ComplexityByOSName []ComplexityOSNameEntry `json:"complexityByOSName"`
 
+	// ComplexityTableVersion Version of the complexity scoring tables the scores were computed with
+	ComplexityTableVersion string `json:"complexityTableVersion"`
+
 	// DiskSizeRatings Static lookup table mapping each disk-size tier label to its numeric complexity score. The content is identical for every cluster and reflects the DiskSizeScores configuration in the complexity package.
 	DiskSizeRatings map[string]int `json:"diskSizeRatings"`
 
@@ -965,8 +1604,11 @@ type MigrationEstimationByComplexityResponse struct {
 	ComplexityByOsDisk []OsDiskEstimationEntry `json:"complexityByOsDisk"`
 
 	// ComplexityMatrix Decision matrix: outer keys are OS scores (0-4), inner keys are disk scores (1-4), values are combined scores.
-	ComplexityMatrix  map[string]map[string]int `json:"complexityMatrix"`
-	EstimationContext *EstimationContext        `json:"estimationContext,omitempty"`
+	ComplexityMatrix map[string]map[string]int `json:"complexityMatrix"`
+
+	// ComplexityTableVersion Version of the complexity scoring tables the VMs were bucketed with.
+	ComplexityTableVersion string             `json:"complexityTableVersion"`
+	EstimationContext      *EstimationContext `json:"estimationContext,omitempty"`
 }
 
 // MigrationEstimationRequest Request payload for calculating migration time estimation
@@ -974,11 +1616,23 @@ type MigrationEstimationRequest struct {
 	// ClusterId ID of the cluster to calculate migration estimation for
 	ClusterId string `json:"clusterId" validate:"required"`
 
-	// EstimationSchema Schemas to run. Valid values: "network-based", "storage-offload". If omitted, all schemas are run.
+	// ComplexityTableVersion Version of the complexity scoring tables the VMs are bucketed with by /migration-estimation/by-complexity. If omitted, the version the assessment was scored with is used.
+	ComplexityTableVersion *string `json:"complexityTableVersion,omitempty"`
+
+	// EstimationSchema Schemas to run. Built-in values: "network-based", "storage-offload"; additional schemas may be configured, see GET /api/v1/migration-estimation/schemas. If omitted, all schemas are run.
 	EstimationSchema *[]string `json:"estimationSchema,omitempty"`
 
-	// Params Optional calculator parameter overrides. Keys must match known calculator param names (e.g. "transfer_rate_mbps", "work_hours_per_day", "troubleshoot_mins_per_vm", "post_migration_engineers"). User-supplied values take precedence over both defaults and inventory-derived values. Unknown keys are rejected with HTTP 400.
+	// Params Optional calculator parameter overrides. Keys must match known calculator param names (e.g. "transfer_rate_mbps", "work_hours_per_day", "troubleshoot_mins_per_vm", "post_migration_engineers", "vms_per_change_window", "change_window_hours", "remediation_hours", "remediation_engineers"). "remediation_hours" is derived from the remediation effort of the migration issues of the cluster, see MigrationIssue.remediation. User-supplied values take precedence over both defaults and inventory-derived values. Unknown keys are rejected with HTTP 400.
 	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// Simulation Monte Carlo mode: the estimation is run many times, sampling the params that have a distribution, and reported as duration percentiles.
+	Simulation *SimulationRequest `json:"simulation,omitempty"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId *int `json:"snapshotId,omitempty"`
+
+	// Timeline Calendar used to project the estimation onto dates. Work hours per day come from the "work_hours_per_day" param.
+	Timeline *TimelineRequest `json:"timeline,omitempty"`
 }
 
 // MigrationEstimationResponse Migration estimation result, including per-schema results and the parameters used.
@@ -986,6 +1640,12 @@ type MigrationEstimationResponse struct {
 	// Estimation Estimation results keyed by schema name (e.g. "network-based", "storage-offload").
 	Estimation        map[string]SchemaEstimationResult `json:"estimation"`
 	EstimationContext EstimationContext                 `json:"estimationContext"`
+
+	// Simulation Monte Carlo results keyed by schema name. Present when the request has a simulation.
+	Simulation *map[string]SimulationResult `json:"simulation,omitempty"`
+
+	// Timeline Calendar projection keyed by schema name. Present when the request has a timeline.
+	Timeline *map[string]MigrationTimeline `json:"timeline,omitempty"`
 }
 
 // MigrationIssue defines model for MigrationIssue.
@@ -994,11 +1654,104 @@ type MigrationIssue struct {
 	Count      int     `json:"count"`
 	Id         *string `json:"id,omitempty"`
 	Label      string  `json:"label"`
+
+	// Remediation Guidance to resolve a migration issue, from the remediation catalog of the global OPA policies active when the assessment is read, which may differ from the policies the issue was raised with.
+	Remediation *Remediation `json:"remediation,omitempty"`
+}
+
+// MigrationIssueCountDiff defines model for MigrationIssueCountDiff.
+type MigrationIssueCountDiff struct {
+	Delta int     `json:"delta"`
+	From  int     `json:"from"`
+	Id    *string `json:"id,omitempty"`
+	Label string  `json:"label"`
+	To    int     `json:"to"`
 }
 
 // MigrationIssues defines model for MigrationIssues.
 type MigrationIssues = []MigrationIssue
 
+// MigrationIssuesDiff defines model for MigrationIssuesDiff.
+type MigrationIssuesDiff struct {
+	// Changed Issues present in both snapshots with a different affected VM count
+	Changed []MigrationIssueCountDiff `json:"changed"`
+
+	// New Issues present only in the target snapshot
+	New []MigrationIssue `json:"new"`
+
+	// Resolved Issues present only in the baseline snapshot
+	Resolved []MigrationIssue `json:"resolved"`
+}
+
+// MigrationTimeline Calendar projection of the estimation of one schema, phases in execution order
+type MigrationTimeline struct {
+	// EarliestEndDate Completion date with the minimum estimated durations
+	EarliestEndDate openapi_types.Date `json:"earliestEndDate"`
+
+	// EndDate Completion date with the maximum estimated durations
+	EndDate   openapi_types.Date `json:"endDate"`
+	Phases    []TimelinePhase    `json:"phases"`
+	StartDate openapi_types.Date `json:"startDate"`
+}
+
+// MigrationWave defines model for MigrationWave.
+type MigrationWave struct {
+	// ComplexityScore Combined OS/disk complexity score (0–4) shared by the VMs of the wave
+	ComplexityScore int `json:"complexityScore"`
+
+	// Estimation Estimation results for a single schema
+	Estimation SchemaEstimationResult `json:"estimation"`
+
+	// ExceedsConstraints True when a single VM of the wave already exceeds the constraints
+	ExceedsConstraints bool `json:"exceedsConstraints"`
+
+	// Number Position of the wave in the plan, starting at 1
+	Number int `json:"number"`
+
+	// TotalDiskGB Disk GB of the wave, from the average disk size of its complexity bucket
+	TotalDiskGB float64 `json:"totalDiskGB"`
+	VmCount     int     `json:"vmCount"`
+}
+
+// MigrationWavePlanRequest Request payload for planning the migration waves of a cluster
+type MigrationWavePlanRequest struct {
+	// ClusterId ID of the cluster to plan
+	ClusterId string `json:"clusterId" validate:"required"`
+
+	// EstimationSchema Schema used to estimate each wave. Defaults to "network-based".
+	EstimationSchema *string `json:"estimationSchema,omitempty"`
+
+	// MaxDiskGbPerWave Maximum disk GB transferred in a wave (change window)
+	MaxDiskGbPerWave *float64 `json:"maxDiskGbPerWave,omitempty"`
+
+	// MaxVmsPerWave Maximum number of VMs migrated concurrently in a wave
+	MaxVmsPerWave *int `json:"maxVmsPerWave,omitempty"`
+
+	// MaxWaveDurationHours Maximum estimated duration of a wave, in hours
+	MaxWaveDurationHours *float64 `json:"maxWaveDurationHours,omitempty"`
+
+	// Params Optional calculator parameter overrides, as in MigrationEstimationRequest. Unknown keys are rejected with HTTP 400.
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId *int `json:"snapshotId,omitempty"`
+}
+
+// MigrationWavePlanResponse Sequential migration waves of a cluster
+type MigrationWavePlanResponse struct {
+	EstimationContext *EstimationContext `json:"estimationContext,omitempty"`
+
+	// EstimationSchema Schema used to estimate the waves
+	EstimationSchema string `json:"estimationSchema"`
+
+	// MaxTotalDuration Sum of the maximum durations of the waves
+	MaxTotalDuration string `json:"maxTotalDuration"`
+
+	// MinTotalDuration Sum of the minimum durations of the waves
+	MinTotalDuration string          `json:"minTotalDuration"`
+	Waves            []MigrationWave `json:"waves"`
+}
+
 // Network defines model for Network.
 type Network struct {
 	Dvswitch *string     `json:"dvswitch,omitempty"`
@@ -1031,6 +1784,14 @@ type OptimizationStatus struct {
 // OptimizationStatusReason Reason for optimization result
 type OptimizationStatusReason string
 
+// OsCountDiff defines model for OsCountDiff.
+type OsCountDiff struct {
+	Delta int    `json:"delta"`
+	From  int    `json:"from"`
+	Name  string `json:"name"`
+	To    int    `json:"to"`
+}
+
 // OsDiskEstimationEntry defines model for OsDiskEstimationEntry.
 type OsDiskEstimationEntry struct {
 	// Estimation Full estimation breakdown keyed by schema name. Absent for empty buckets (vmCount == 0).
@@ -1046,6 +1807,30 @@ type OsDiskEstimationEntry struct {
 	VmCount int `json:"vmCount"`
 }
 
+// PackedVm defines model for PackedVm.
+type PackedVm struct {
+	// Cpu CPU cores of the VM
+	Cpu float64 `json:"cpu"`
+	Id  string  `json:"id"`
+
+	// MemoryGb Memory (GB) of the VM
+	MemoryGb float64 `json:"memoryGb"`
+	Name     string  `json:"name"`
+}
+
+// ParamDistribution Probability distribution of a param. triangular uses min, mode and max; uniform uses min and max; normal uses mean and stddev and only yields positive values.
+type ParamDistribution struct {
+	Max    *float64              `json:"max,omitempty"`
+	Mean   *float64              `json:"mean,omitempty"`
+	Min    *float64              `json:"min,omitempty"`
+	Mode   *float64              `json:"mode,omitempty"`
+	Stddev *float64              `json:"stddev,omitempty"`
+	Type   ParamDistributionType `json:"type"`
+}
+
+// ParamDistributionType defines model for ParamDistribution.Type.
+type ParamDistributionType string
+
 // PartnerRequest defines model for PartnerRequest.
 type PartnerRequest struct {
 	AcceptedAt    *time.Time           `json:"acceptedAt"`
@@ -1092,6 +1877,125 @@ type PartnerSummary struct {
 	Name    string             `json:"name"`
 }
 
+// PolicyBundle A revision of the OPA policies of an organization
+type PolicyBundle struct {
+	// Active Whether the policies are evaluated for the VMs the organization ingests
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CreatedBy   string    `json:"createdBy"`
+	Description *string   `json:"description,omitempty"`
+
+	// Modules Rego source of the policies, keyed by file name
+	Modules  map[string]string `json:"modules"`
+	Name     string            `json:"name"`
+	Revision int               `json:"revision"`
+}
+
+// PolicyBundleCreate defines model for PolicyBundleCreate.
+type PolicyBundleCreate struct {
+	Description *string `json:"description,omitempty"`
+
+	// Modules Rego v1 source of the policies, keyed by file name (<name>.rego). The policies must be in package io.konveyor.forklift.vmware and add to its concerns rule; at most 50 policies and 1 MiB of source. A remediations.json file adds to the global remediation catalog.
+	Modules map[string]string `json:"modules"`
+	Name    string            `json:"name"`
+}
+
+// PolicyBundleList defines model for PolicyBundleList.
+type PolicyBundleList = []PolicyBundle
+
+// PolicyBundleTestRequest defines model for PolicyBundleTestRequest.
+type PolicyBundleTestRequest struct {
+	// Vms VMs in the policy input format, e.g. {"name":"vm-1","labels":["pci"]}
+	Vms []map[string]interface{} `json:"vms"`
+}
+
+// PolicyBundleTestResult defines model for PolicyBundleTestResult.
+type PolicyBundleTestResult struct {
+	Results []PolicyBundleVMResult `json:"results"`
+}
+
+// PolicyBundleVMResult defines model for PolicyBundleVMResult.
+type PolicyBundleVMResult struct {
+	Concerns []VMConcern `json:"concerns"`
+
+	// Vm Name of the VM, or its ID when it has no name
+	Vm string `json:"vm"`
+}
+
+// Remediation Guidance to resolve a migration issue, from the remediation catalog of the global OPA policies active when the assessment is read, which may differ from the policies the issue was raised with.
+type Remediation struct {
+	// AutomationHint How to resolve the issue in bulk, if possible
+	AutomationHint *string   `json:"automationHint,omitempty"`
+	DocLinks       *[]string `json:"docLinks,omitempty"`
+
+	// EffortHoursPerVm Estimated hands-on effort to resolve the issue on one VM, in hours
+	EffortHoursPerVm float64 `json:"effortHoursPerVm"`
+
+	// PolicyRevision Revision of the active global policies the remediation catalog belongs to
+	PolicyRevision string   `json:"policyRevision"`
+	Steps          []string `json:"steps"`
+}
+
+// RightSizingReport Right-sizing suggestions for the VMs of an assessment snapshot
+type RightSizingReport struct {
+	SnapshotId int `json:"snapshotId"`
+
+	// Suggestions VMs whose vCPU or memory can be reduced, largest reduction first
+	Suggestions []RightSizingSuggestion `json:"suggestions"`
+
+	// Totals Allocations of the VMs before and after right-sizing
+	Totals RightSizingTotals `json:"totals"`
+}
+
+// RightSizingSuggestion defines model for RightSizingSuggestion.
+type RightSizingSuggestion struct {
+	ClusterId       string   `json:"clusterId"`
+	CpuUsagePercent *float64 `json:"cpuUsagePercent,omitempty"`
+	CurrentCPU      int      `json:"currentCPU"`
+	CurrentMemoryGB float64  `json:"currentMemoryGB"`
+
+	// Description Human readable suggestion (e.g. "vCPU 16 → 4, RAM 64 GB → 24 GB")
+	Description        string   `json:"description"`
+	Id                 string   `json:"id"`
+	MemoryUsagePercent *float64 `json:"memoryUsagePercent,omitempty"`
+	Name               string   `json:"name"`
+	SuggestedCPU       int      `json:"suggestedCPU"`
+	SuggestedMemoryGB  float64  `json:"suggestedMemoryGB"`
+}
+
+// RightSizingTotals Allocations of the VMs before and after right-sizing
+type RightSizingTotals struct {
+	// CurrentCPU vCPUs allocated to the VMs
+	CurrentCPU int `json:"currentCPU"`
+
+	// CurrentMemoryGB Memory (GB) allocated to the VMs
+	CurrentMemoryGB float64 `json:"currentMemoryGB"`
+
+	// SizingBasis What the suggestions are based on: "utilization" when at least one VM records its CPU or memory
+	// utilization, "allocation" when none does and every VM keeps its allocation. RVTools and govc exports
+	// record the utilization of each VM; the agent does not capture performance counters, so the VMs of
+	// agent inventories are sized on their allocation unless their VM records carry it.
+	SizingBasis RightSizingTotalsSizingBasis `json:"sizingBasis"`
+
+	// SuggestedCPU vCPUs allocated to the VMs after right-sizing
+	SuggestedCPU int `json:"suggestedCPU"`
+
+	// SuggestedMemoryGB Memory (GB) allocated to the VMs after right-sizing
+	SuggestedMemoryGB float64 `json:"suggestedMemoryGB"`
+
+	// VmsRightSized Number of VMs whose vCPU or memory can be reduced
+	VmsRightSized int `json:"vmsRightSized"`
+
+	// VmsWithUtilization Number of powered on VMs with CPU or memory utilization
+	VmsWithUtilization int `json:"vmsWithUtilization"`
+}
+
+// RightSizingTotalsSizingBasis What the suggestions are based on: "utilization" when at least one VM records its CPU or memory
+// utilization, "allocation" when none does and every VM keeps its allocation. RVTools and govc exports
+// record the utilization of each VM; the agent does not capture performance counters, so the VMs of
+// agent inventories are sized on their allocation unless their VM records carry it.
+type RightSizingTotalsSizingBasis string
+
 // Savings Infrastructure savings comparison
 type Savings struct {
 	// Description Human-readable description of savings source
@@ -1116,13 +2020,52 @@ type SchemaEstimationResult struct {
 	MinTotalDuration string `json:"minTotalDuration"`
 }
 
+// ShareSubject defines model for ShareSubject.
+type ShareSubject struct {
+	// Id Username of the user or ID of the group
+	Id   string           `json:"id"`
+	Type ShareSubjectType `json:"type"`
+}
+
+// ShareSubjectType defines model for ShareSubject.Type.
+type ShareSubjectType string
+
 // SharingSubject defines model for SharingSubject.
 type SharingSubject struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
-	Type string `json:"type"`
+
+	// Relation Relation granted to the subject on the assessment
+	Relation *string `json:"relation,omitempty"`
+	Type     string  `json:"type"`
 }
 
+// SimulationRequest Monte Carlo mode: the estimation is run many times, sampling the params that have a distribution, and reported as duration percentiles.
+type SimulationRequest struct {
+	// ParamDistributions Distributions keyed by param key (e.g. "transfer_rate_mbps", "troubleshoot_mins_per_vm"). They take precedence over the value of the same key in params. Only number params accept a distribution, and its lower bound must respect the minimum of the param.
+	ParamDistributions *map[string]ParamDistribution `json:"paramDistributions,omitempty"`
+
+	// Runs Number of runs. Defaults to 1000.
+	Runs *int `json:"runs,omitempty"`
+
+	// Seed Seed of the random source; the same request and seed always yield the same result. Defaults to 0.
+	Seed *int64 `json:"seed,omitempty"`
+}
+
+// SimulationResult Duration percentiles of a Monte Carlo estimation. Percentiles are computed separately for each calculator and for the total, so those of the breakdown do not add up to the total.
+type SimulationResult struct {
+	// Breakdown Percentiles keyed by calculator name
+	Breakdown map[string]DurationPercentiles `json:"breakdown"`
+	Runs      int                            `json:"runs"`
+	Seed      int64                          `json:"seed"`
+	Total     DurationPercentiles            `json:"total"`
+}
+
+// SizingMode How the VMs of the cluster are turned into workloads for the sizer:
+// * `batched` - total CPU and memory are spread evenly over batches of VMs
+// * `perVm` - the CPU and memory of every VM are packed first-fit decreasing onto the worker nodes
+type SizingMode string
+
 // SizingOverCommitRatio Over-commit ratios
 type SizingOverCommitRatio struct {
 	// Cpu CPU over-commit ratio
@@ -1158,11 +2101,31 @@ type SizingResourceLimits struct {
 
 // Snapshot defines model for Snapshot.
 type Snapshot struct {
-	CreatedAt         time.Time                    `json:"createdAt"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id ID of the snapshot. Snapshots of an assessment are immutable once created.
+	Id                int                          `json:"id"`
 	Inventory         Inventory                    `json:"inventory"`
 	SubsetInventories *[]AssessmentSubsetInventory `json:"subsetInventories"`
 }
 
+// SnapshotDiff defines model for SnapshotDiff.
+type SnapshotDiff struct {
+	// Clusters One entry per cluster present in either snapshot, sorted by cluster ID
+	Clusters       []ClusterSnapshotDiff `json:"clusters"`
+	FromSnapshotId int                   `json:"fromSnapshotId"`
+	ToSnapshotId   int                   `json:"toSnapshotId"`
+}
+
+// SnapshotDiffVM defines model for SnapshotDiffVM.
+type SnapshotDiffVM struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SnapshotList defines model for SnapshotList.
+type SnapshotList = []Snapshot
+
 // Source defines model for Source.
 type Source struct {
 	Agent *Agent `json:"agent,omitempty"`
@@ -1304,6 +2267,12 @@ type Status struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// StorageBackend Target storage backend of the migrated VM disks:
+// * `odfReplica3` - OpenShift Data Foundation, three replicas of every block
+// * `odfErasureCoding` - OpenShift Data Foundation, erasure coded pool of data and coding chunks
+// * `externalSan` - external SAN array
+type StorageBackend string
+
 // StorageIoConfiguration defines model for StorageIoConfiguration.
 type StorageIoConfiguration struct {
 	// CongestionThreshold Latency threshold in milliseconds beyond which the storage array is considered congested
@@ -1322,10 +2291,195 @@ type StorageIoConfiguration struct {
 // StorageIoConfigurationCongestionThresholdMode Mode for congestion threshold calculation
 type StorageIoConfigurationCongestionThresholdMode string
 
+// StorageSizing Storage capacity required on the target storage backend
+type StorageSizing struct {
+	// Backend Target storage backend of the migrated VM disks:
+	// * `odfReplica3` - OpenShift Data Foundation, three replicas of every block
+	// * `odfErasureCoding` - OpenShift Data Foundation, erasure coded pool of data and coding chunks
+	// * `externalSan` - external SAN array
+	Backend StorageBackend `json:"backend"`
+
+	// DataGB Capacity (GB) the VM disks take on the backend, after thin provisioning and never below the datastore usage
+	DataGB float64 `json:"dataGB"`
+
+	// DatastoreUsedGB Capacity (GB) used on the datastores of the cluster
+	DatastoreUsedGB float64 `json:"datastoreUsedGB"`
+
+	// DiskTypes Disk capacity (GB) provisioned to the VMs, by disk type
+	DiskTypes map[string]float64 `json:"diskTypes"`
+
+	// OsdDisksPerNode Extra OSD disks to add to every storage node, ODF backends only
+	OsdDisksPerNode *int `json:"osdDisksPerNode,omitempty"`
+
+	// ProvisionedGB Disk capacity (GB) provisioned to the VMs of the cluster
+	ProvisionedGB float64 `json:"provisionedGB"`
+
+	// RawGB Raw capacity (GB) to provide, including the replication or erasure coding overhead
+	RawGB float64 `json:"rawGB"`
+
+	// StorageNodes Nodes hosting OSDs, ODF backends only
+	StorageNodes *int `json:"storageNodes,omitempty"`
+
+	// TotalOsdDisks Extra OSD disks over all the storage nodes, ODF backends only
+	TotalOsdDisks *int `json:"totalOsdDisks,omitempty"`
+
+	// UsableGB Usable capacity (GB) to provide, keeping the backend below its target utilization
+	UsableGB float64 `json:"usableGB"`
+}
+
+// StorageSizingRequest Target storage backend to size the capacity of the cluster VM disks for
+type StorageSizingRequest struct {
+	// Backend Target storage backend of the migrated VM disks:
+	// * `odfReplica3` - OpenShift Data Foundation, three replicas of every block
+	// * `odfErasureCoding` - OpenShift Data Foundation, erasure coded pool of data and coding chunks
+	// * `externalSan` - external SAN array
+	Backend StorageBackend `json:"backend"`
+
+	// ErasureCodingCodingChunks Coding chunks (m) of the erasure coded pool, odfErasureCoding only (default: 2)
+	ErasureCodingCodingChunks *int `json:"erasureCodingCodingChunks,omitempty"`
+
+	// ErasureCodingDataChunks Data chunks (k) of the erasure coded pool, odfErasureCoding only (default: 4)
+	ErasureCodingDataChunks *int `json:"erasureCodingDataChunks,omitempty"`
+
+	// OsdDiskSizeGB Size (GB) of the disks added to the storage nodes as OSDs, ODF backends only (default: 4096)
+	OsdDiskSizeGB *int `json:"osdDiskSizeGB,omitempty"`
+
+	// ThinProvisioningRatio Ratio of provisioned to physically allocated capacity (default: 1, thick provisioning)
+	ThinProvisioningRatio *float64 `json:"thinProvisioningRatio,omitempty"`
+}
+
+// TargetClusterMapping defines model for TargetClusterMapping.
+type TargetClusterMapping struct {
+	// Name Name of the target OpenShift cluster
+	Name string `json:"name"`
+
+	// SourceClusterIds IDs of the source clusters consolidated into the target cluster
+	SourceClusterIds []string `json:"sourceClusterIds"`
+}
+
+// TargetClusterSizing defines model for TargetClusterSizing.
+type TargetClusterSizing struct {
+	// ClusterSizing Overall cluster sizing summary
+	ClusterSizing ClusterSizing `json:"clusterSizing"`
+
+	// InventoryTotals Inventory totals for the cluster
+	InventoryTotals InventoryTotals `json:"inventoryTotals"`
+	Name            string          `json:"name"`
+
+	// ResourceConsumption Resource consumption across the cluster
+	ResourceConsumption SizingResourceConsumption `json:"resourceConsumption"`
+	SourceClusterIds    []string                  `json:"sourceClusterIds"`
+}
+
+// TimelinePhase defines model for TimelinePhase.
+type TimelinePhase struct {
+	EarliestEndDate openapi_types.Date `json:"earliestEndDate"`
+	EndDate         openapi_types.Date `json:"endDate"`
+
+	// Name Calculator name, as in the estimation breakdown
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"startDate"`
+}
+
+// TimelineRequest Calendar used to project the estimation onto dates. Work hours per day come from the "work_hours_per_day" param.
+type TimelineRequest struct {
+	// BlackoutPeriods Periods without any migration work, such as holidays and change freezes
+	BlackoutPeriods *[]BlackoutPeriod `json:"blackoutPeriods,omitempty"`
+
+	// ParallelStreams Parallel migration streams, each migrating its share of the VMs with the given transfer rate. Only the storage transfer and change window phases are split across streams; post-migration checks are already split across the engineers. Defaults to 1.
+	ParallelStreams *int `json:"parallelStreams,omitempty"`
+
+	// StartDate Date on which the migration starts
+	StartDate openapi_types.Date `json:"startDate"`
+
+	// WorkingDays Days of the week on which work happens. Defaults to Monday to Friday.
+	WorkingDays *[]TimelineRequestWorkingDays `json:"workingDays,omitempty"`
+}
+
+// TimelineRequestWorkingDays defines model for TimelineRequest.WorkingDays.
+type TimelineRequestWorkingDays string
+
+// TopologySizingRequest Mapping of the source clusters of an assessment to target OpenShift clusters. Every target
+// cluster is sized from the aggregated inventory of its source clusters, with the node
+// configuration shared by all the target clusters.
+type TopologySizingRequest struct {
+	// CompactMode If true, creates 3-node compact clusters with no dedicated workers. Requires controlPlaneNodeCount=3 and controlPlaneSchedulable=true. Incompatible with hostedControlPlane=true
+	CompactMode *bool `json:"compactMode,omitempty"`
+
+	// ControlPlaneCPU CPU cores per control plane node (default: 6)
+	ControlPlaneCPU *int `json:"controlPlaneCPU,omitempty"`
+
+	// ControlPlaneMemory Memory in GB per control plane node (default: 16)
+	ControlPlaneMemory *int `json:"controlPlaneMemory,omitempty"`
+
+	// ControlPlaneNodeCount Number of control plane nodes: 1 or 3 (default: 3)
+	ControlPlaneNodeCount *TopologySizingRequestControlPlaneNodeCount `json:"controlPlaneNodeCount,omitempty"`
+
+	// ControlPlaneSchedulable Allow workload scheduling on control plane nodes (default: false)
+	ControlPlaneSchedulable *bool `json:"controlPlaneSchedulable,omitempty"`
+
+	// CpuOverCommitRatio CPU over-commit ratio (e.g., "1:4")
+	CpuOverCommitRatio CpuOverCommitRatio `json:"cpuOverCommitRatio"`
+
+	// HostedControlPlane If true, control plane is hosted externally. Incompatible with control plane fields (default: false)
+	HostedControlPlane *bool `json:"hostedControlPlane,omitempty"`
+
+	// MemoryOverCommitRatio Memory over-commit ratio (e.g., "1:2")
+	MemoryOverCommitRatio MemoryOverCommitRatio `json:"memoryOverCommitRatio"`
+
+	// SnapshotId ID of the assessment snapshot to use. If omitted, the latest snapshot is used.
+	SnapshotId     *int                   `json:"snapshotId,omitempty"`
+	TargetClusters []TargetClusterMapping `json:"targetClusters"`
+
+	// WorkerNodeCPU CPU cores per worker node
+	WorkerNodeCPU int `json:"workerNodeCPU"`
+
+	// WorkerNodeMemory Memory (GB) per worker node
+	WorkerNodeMemory int `json:"workerNodeMemory"`
+
+	// WorkerNodeThreads Number of CPU threads per worker node (for SMT calculation). If not provided, assumes no SMT (threads = cores). Must be >= workerNodeCPU
+	WorkerNodeThreads *int `json:"workerNodeThreads,omitempty"`
+}
+
+// TopologySizingRequestControlPlaneNodeCount Number of control plane nodes: 1 or 3 (default: 3)
+type TopologySizingRequestControlPlaneNodeCount int
+
+// TopologySizingResponse defines model for TopologySizingResponse.
+type TopologySizingResponse struct {
+	TargetClusters []TargetClusterSizing `json:"targetClusters"`
+
+	// Totals Overall cluster sizing summary
+	Totals ClusterSizing `json:"totals"`
+}
+
+// UnplaceableVm defines model for UnplaceableVm.
+type UnplaceableVm struct {
+	// Cpu CPU cores of the VM
+	Cpu float64 `json:"cpu"`
+	Id  string  `json:"id"`
+
+	// MemoryGb Memory (GB) of the VM
+	MemoryGb float64 `json:"memoryGb"`
+	Name     string  `json:"name"`
+
+	// Reason Why the VM does not fit on a worker node
+	Reason string `json:"reason"`
+}
+
+// UnsuitableHardwareSku defines model for UnsuitableHardwareSku.
+type UnsuitableHardwareSku struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
 // UpdateInventory defines model for UpdateInventory.
 type UpdateInventory struct {
 	AgentId   openapi_types.UUID `json:"agentId"`
 	Inventory Inventory          `json:"inventory"`
+
+	// Vms Per-VM records of the inventory. They replace the VM records of the source, which assessments
+	// created from the source copy into their snapshots. When omitted, the source has no VM records.
+	Vms *[]AssessmentVM `json:"vms,omitempty"`
 }
 
 // VCenter defines model for VCenter.
@@ -1333,6 +2487,14 @@ type VCenter struct {
 	Id string `json:"id"`
 }
 
+// VMConcern defines model for VMConcern.
+type VMConcern struct {
+	Assessment string `json:"assessment"`
+	Category   string `json:"category"`
+	Id         string `json:"id"`
+	Label      string `json:"label"`
+}
+
 // VMResourceBreakdown defines model for VMResourceBreakdown.
 type VMResourceBreakdown struct {
 	// Deprecated:
@@ -1345,6 +2507,9 @@ type VMResourceBreakdown struct {
 
 // VMs defines model for VMs.
 type VMs struct {
+	// Applications Number of VMs running each business application detected from the guest applications and labels of the VMs, keyed by application ID (e.g. "mssql", "oracle-db", "sap-hana"). Only sources reporting guest applications or labels detect applications.
+	Applications *map[string]int `json:"applications,omitempty"`
+
 	// ComplexityDistribution Distribution of VMs by migration complexity level, enriched with total disk size per level. Supersedes distributionByComplexity.
 	ComplexityDistribution *map[string]DiskSizeTierSummary `json:"complexityDistribution,omitempty"`
 	CpuCores               VMResourceBreakdown             `json:"cpuCores"`
@@ -1383,6 +2548,9 @@ type VMs struct {
 
 	// TotalWithSharedDisks Number of VMs that have at least one shared disk
 	TotalWithSharedDisks *int `json:"totalWithSharedDisks,omitempty"`
+
+	// WaivedIssues Concerns waived by the active waivers of the assessment. The waived concerns are removed from notMigratableReasons and migrationWarnings and no longer count in totalMigratable, totalMigratableWithWarnings and issuesBreakdown.
+	WaivedIssues *[]WaivedMigrationIssue `json:"waivedIssues,omitempty"`
 }
 
 // VMwareSubscriptionInput defines model for VMwareSubscriptionInput.
@@ -1418,6 +2586,21 @@ type VmNetwork struct {
 	Ipv4 *Ipv4Config `json:"ipv4,omitempty"`
 }
 
+// VmPacking Outcome of packing the VMs of a cluster onto worker nodes
+type VmPacking struct {
+	// LargestVms Largest packed VMs, relative to the worker node size
+	LargestVms []PackedVm `json:"largestVms"`
+
+	// LargestVmsNodeCount Number of worker nodes needed to run the largest VMs alone
+	LargestVmsNodeCount int `json:"largestVmsNodeCount"`
+
+	// PackedVms Number of VMs packed onto worker nodes
+	PackedVms int `json:"packedVms"`
+
+	// UnplaceableVms VMs that do not fit on a worker node of the requested size, and are left out of the sizing
+	UnplaceableVms []UnplaceableVm `json:"unplaceableVms"`
+}
+
 // VsphereCoreInput defines model for VsphereCoreInput.
 type VsphereCoreInput struct {
 	SrmEnabled          *bool   `json:"srmEnabled,omitempty"`
@@ -1425,6 +2608,17 @@ type VsphereCoreInput struct {
 	VmEncryptionPolicy  *string `json:"vmEncryptionPolicy,omitempty" validate:"omitempty,max=1000"`
 }
 
+// WaivedMigrationIssue A migration concern waived for some VMs, with the number of occurrences waived.
+type WaivedMigrationIssue struct {
+	Assessment *string `json:"assessment,omitempty"`
+
+	// Category Category of the concern, e.g. Critical or Warning
+	Category string `json:"category"`
+	Count    int    `json:"count"`
+	Id       string `json:"id"`
+	Label    string `json:"label"`
+}
+
 // DiskSizeTierSummary defines model for diskSizeTierSummary.
 type DiskSizeTierSummary struct {
 	// TotalSizeTB Total disk size in TB for this tier
@@ -1479,6 +2673,105 @@ type GetAssessmentClusterRequirementsStoredInputParams struct {
 	ClusterId string `form:"clusterId" json:"clusterId"`
 }
 
+// GetAssessmentRightSizingParams defines parameters for GetAssessmentRightSizing.
+type GetAssessmentRightSizingParams struct {
+	// SnapshotId ID of the snapshot. Defaults to the latest snapshot.
+	SnapshotId *int `form:"snapshotId,omitempty" json:"snapshotId,omitempty"`
+
+	// ClusterId Only the VMs of this cluster
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+}
+
+// DiffAssessmentSnapshotsParams defines parameters for DiffAssessmentSnapshots.
+type DiffAssessmentSnapshotsParams struct {
+	// From ID of the baseline snapshot
+	From int `form:"from" json:"from"`
+
+	// To ID of the snapshot compared against the baseline
+	To int `form:"to" json:"to"`
+}
+
+// ListAssessmentVMsParams defines parameters for ListAssessmentVMs.
+type ListAssessmentVMsParams struct {
+	// SnapshotId ID of the snapshot. Defaults to the latest snapshot.
+	SnapshotId *int `form:"snapshotId,omitempty" json:"snapshotId,omitempty"`
+
+	// Cluster Filter by cluster ID or cluster name
+	Cluster *string `form:"cluster,omitempty" json:"cluster,omitempty"`
+
+	// Os Filter by guest OS name
+	Os *string `form:"os,omitempty" json:"os,omitempty"`
+
+	// PowerState Filter by power state (e.g. poweredOn)
+	PowerState *string `form:"powerState,omitempty" json:"powerState,omitempty"`
+
+	// ConcernId Only VMs having the concern with this ID
+	ConcernId *string `form:"concernId,omitempty" json:"concernId,omitempty"`
+
+	// Category Only VMs having at least one concern of this category
+	Category *ListAssessmentVMsParamsCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Sort Field to sort by
+	Sort *ListAssessmentVMsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort order
+	Order *ListAssessmentVMsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of VMs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of VMs to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListAssessmentVMsParamsCategory defines parameters for ListAssessmentVMs.
+type ListAssessmentVMsParamsCategory string
+
+// ListAssessmentVMsParamsSort defines parameters for ListAssessmentVMs.
+type ListAssessmentVMsParamsSort string
+
+// ListAssessmentVMsParamsOrder defines parameters for ListAssessmentVMs.
+type ListAssessmentVMsParamsOrder string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// Actor Only entries of changes made by this user
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action Only entries of this action (e.g. assessment.shared)
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ResourceType Only entries of this resource type (assessment, group or partner_request)
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceId Only entries of the resource with this ID
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Subject Only entries of this subject, as type:id (e.g. user:jdoe or org:<groupId>)
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// RequestId Only entries of the API request with this ID
+	RequestId *string `form:"requestId,omitempty" json:"requestId,omitempty"`
+
+	// Since Only entries recorded at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only entries recorded before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of entries to return. Defaults to 100 for JSON.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of entries to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Format Format of the response
+	Format *ListAuditEntriesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListAuditEntriesParamsFormat defines parameters for ListAuditEntries.
+type ListAuditEntriesParamsFormat string
+
 // ListGroupsParams defines parameters for ListGroups.
 type ListGroupsParams struct {
 	// Kind Filter by group kind
@@ -1512,12 +2805,36 @@ type CalculateMigrationComplexityJSONRequestBody = MigrationComplexityRequest
 // SaveAssessmentEnhancementDataJSONRequestBody defines body for SaveAssessmentEnhancementData for application/json ContentType.
 type SaveAssessmentEnhancementDataJSONRequestBody = EnhancementData
 
+// CalculateAssessmentHardwareOptionsJSONRequestBody defines body for CalculateAssessmentHardwareOptions for application/json ContentType.
+type CalculateAssessmentHardwareOptionsJSONRequestBody = HardwareOptionsRequest
+
 // CalculateMigrationEstimationJSONRequestBody defines body for CalculateMigrationEstimation for application/json ContentType.
 type CalculateMigrationEstimationJSONRequestBody = MigrationEstimationRequest
 
 // CalculateMigrationEstimationByComplexityJSONRequestBody defines body for CalculateMigrationEstimationByComplexity for application/json ContentType.
 type CalculateMigrationEstimationByComplexityJSONRequestBody = MigrationEstimationRequest
 
+// UploadRVToolsAssessmentMultipartRequestBody defines body for UploadRVToolsAssessment for multipart/form-data ContentType.
+type UploadRVToolsAssessmentMultipartRequestBody = AssessmentRvtoolsUploadForm
+
+// UnshareAssessmentJSONRequestBody defines body for UnshareAssessment for application/json ContentType.
+type UnshareAssessmentJSONRequestBody = AssessmentShareRequest
+
+// ShareAssessmentJSONRequestBody defines body for ShareAssessment for application/json ContentType.
+type ShareAssessmentJSONRequestBody = AssessmentShareRequest
+
+// CalculateAssessmentTopologySizingJSONRequestBody defines body for CalculateAssessmentTopologySizing for application/json ContentType.
+type CalculateAssessmentTopologySizingJSONRequestBody = TopologySizingRequest
+
+// TransferAssessmentOwnershipJSONRequestBody defines body for TransferAssessmentOwnership for application/json ContentType.
+type TransferAssessmentOwnershipJSONRequestBody = AssessmentTransferRequest
+
+// CreateConcernWaiverJSONRequestBody defines body for CreateConcernWaiver for application/json ContentType.
+type CreateConcernWaiverJSONRequestBody = ConcernWaiverCreate
+
+// PlanMigrationWavesJSONRequestBody defines body for PlanMigrationWaves for application/json ContentType.
+type PlanMigrationWavesJSONRequestBody = MigrationWavePlanRequest
+
 // CalculateClusterRequirementsJSONRequestBody defines body for CalculateClusterRequirements for application/json ContentType.
 type CalculateClusterRequirementsJSONRequestBody = StandaloneClusterRequirementsRequest
 
@@ -1533,6 +2850,21 @@ type CreateGroupMemberJSONRequestBody = MemberCreate
 // UpdateGroupMemberJSONRequestBody defines body for UpdateGroupMember for application/json ContentType.
 type UpdateGroupMemberJSONRequestBody = MemberUpdate
 
+// CreateHardwareSkuJSONRequestBody defines body for CreateHardwareSku for application/json ContentType.
+type CreateHardwareSkuJSONRequestBody = HardwareSkuCreate
+
+// UpdateHardwareSkuJSONRequestBody defines body for UpdateHardwareSku for application/json ContentType.
+type UpdateHardwareSkuJSONRequestBody = HardwareSkuUpdate
+
+// CreateComplexityTableJSONRequestBody defines body for CreateComplexityTable for application/json ContentType.
+type CreateComplexityTableJSONRequestBody = ComplexityTableCreate
+
+// CreatePolicyBundleJSONRequestBody defines body for CreatePolicyBundle for application/json ContentType.
+type CreatePolicyBundleJSONRequestBody = PolicyBundleCreate
+
+// TestPolicyBundleJSONRequestBody defines body for TestPolicyBundle for application/json ContentType.
+type TestPolicyBundleJSONRequestBody = PolicyBundleTestRequest
+
 // UpdatePartnerRequestJSONRequestBody defines body for UpdatePartnerRequest for application/json ContentType.
 type UpdatePartnerRequestJSONRequestBody = PartnerRequestUpdate
 
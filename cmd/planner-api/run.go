@@ -98,6 +98,15 @@ var runCmd = &cobra.Command{
 		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 		var wg sync.WaitGroup // Responsible for keeping the main thread waiting for all goroutines to shut down gracefully
 
+		// Start OPA policy watcher
+		if watcher := createPolicyWatcher(ctx, cfg, opaValidator); watcher != nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				watcher.Run(ctx)
+			}()
+		}
+
 		// Create Kafka producer and event writer
 		var writer kafka.Writer = kafka.NewNoOpWriter()
 
@@ -147,6 +156,7 @@ var runCmd = &cobra.Command{
 
 		// register metrics
 		metrics.RegisterMetrics(store)
+		metrics.RegisterPolicyMetrics(opaValidator)
 
 		runServer(ctx, &wg, cancel, cfg.Service.Address, "api_server", func(l net.Listener) Server {
 			return apiserver.New(cfg, store, l, opaValidator, jobsClient)
@@ -180,6 +190,37 @@ func newListener(address string) (net.Listener, error) {
 	return net.Listen("tcp", address)
 }
 
+// createPolicyWatcher returns the watcher reloading the OPA policies, or nil if reloads are disabled.
+// With a bundle, the policies of the bundle replace the ones read from the policy folder at startup;
+// until the bundle can be fetched, the policies of the folder are used.
+func createPolicyWatcher(ctx context.Context, cfg *config.Config, validator *opa.Validator) *opa.Watcher {
+	interval, err := time.ParseDuration(cfg.Service.OpaPolicies.ReloadInterval)
+	if err != nil {
+		zap.S().Fatalw("parsing OPA policies reload interval", "error", err)
+	}
+	if interval <= 0 {
+		zap.S().Info("OPA policies reload disabled")
+		return nil
+	}
+
+	location := cfg.Service.OpaPoliciesFolder
+	if cfg.Service.OpaPolicies.Bundle != "" {
+		location = cfg.Service.OpaPolicies.Bundle
+	}
+	source, err := opa.NewPolicySource(location)
+	if err != nil {
+		zap.S().Fatalw("creating OPA policy source", "error", err)
+	}
+
+	watcher := opa.NewWatcher(validator, source, interval)
+	if cfg.Service.OpaPolicies.Bundle != "" {
+		if _, err := watcher.Check(ctx); err != nil {
+			zap.S().Warnw("failed to load OPA policy bundle, using the policies of the folder", "bundle", source.String(), "error", err)
+		}
+	}
+	return watcher
+}
+
 func createEventWriter(ctx context.Context, cfg *config.Config) (kafka.Writer, func(), error) {
 	brokers := strings.Split(cfg.Kafka.Brokers, ",")
 	var kafkaOpts []kgo.Opt
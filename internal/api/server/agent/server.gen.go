@@ -26,6 +26,9 @@ type ServerInterface interface {
 	// (PUT /api/v1/sources/{id})
 	UpdateSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/sources/{id}/policies)
+	GetSourcePolicies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (PUT /api/v1/sources/{id}/status)
 	UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
@@ -50,6 +53,11 @@ func (_ Unimplemented) UpdateSource(w http.ResponseWriter, r *http.Request, id o
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/sources/{id}/policies)
+func (_ Unimplemented) GetSourcePolicies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (PUT /api/v1/sources/{id}/status)
 func (_ Unimplemented) UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -126,6 +134,32 @@ func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetSourcePolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetSourcePolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourcePolicies(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // UpdateSourceInventory operation middleware
 func (siw *ServerInterfaceWrapper) UpdateSourceInventory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -341,6 +375,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/sources/{id}", wrapper.UpdateSource)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/sources/{id}/policies", wrapper.GetSourcePolicies)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/sources/{id}/status", wrapper.UpdateSourceInventory)
 	})
@@ -487,6 +524,59 @@ func (response UpdateSource500JSONResponse) VisitUpdateSourceResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSourcePoliciesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetSourcePoliciesResponseObject interface {
+	VisitGetSourcePoliciesResponse(w http.ResponseWriter) error
+}
+
+type GetSourcePolicies200JSONResponse SourcePolicies
+
+func (response GetSourcePolicies200JSONResponse) VisitGetSourcePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourcePolicies401JSONResponse externalRef0.Error
+
+func (response GetSourcePolicies401JSONResponse) VisitGetSourcePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourcePolicies403JSONResponse externalRef0.Error
+
+func (response GetSourcePolicies403JSONResponse) VisitGetSourcePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourcePolicies404JSONResponse externalRef0.Error
+
+func (response GetSourcePolicies404JSONResponse) VisitGetSourcePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourcePolicies500JSONResponse externalRef0.Error
+
+func (response GetSourcePolicies500JSONResponse) VisitGetSourcePoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type UpdateSourceInventoryRequestObject struct {
 	Id   openapi_types.UUID `json:"id"`
 	Body *UpdateSourceInventoryJSONRequestBody
@@ -694,6 +784,9 @@ type StrictServerInterface interface {
 	// (PUT /api/v1/sources/{id})
 	UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error)
 
+	// (GET /api/v1/sources/{id}/policies)
+	GetSourcePolicies(ctx context.Context, request GetSourcePoliciesRequestObject) (GetSourcePoliciesResponseObject, error)
+
 	// (PUT /api/v1/sources/{id}/status)
 	UpdateSourceInventory(ctx context.Context, request UpdateSourceInventoryRequestObject) (UpdateSourceInventoryResponseObject, error)
 
@@ -799,6 +892,32 @@ func (sh *strictHandler) UpdateSource(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// GetSourcePolicies operation middleware
+func (sh *strictHandler) GetSourcePolicies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetSourcePoliciesRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSourcePolicies(ctx, request.(GetSourcePoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSourcePolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSourcePoliciesResponseObject); ok {
+		if err := validResponse.VisitGetSourcePoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UpdateSourceInventory operation middleware
 func (sh *strictHandler) UpdateSourceInventory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	var request UpdateSourceInventoryRequestObject
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 
@@ -59,18 +60,60 @@ type ServerInterface interface {
 	// (POST /api/v1/assessments/{id}/enhancement-data)
 	SaveAssessmentEnhancementData(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (POST /api/v1/assessments/{id}/hardware-options)
+	CalculateAssessmentHardwareOptions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (POST /api/v1/assessments/{id}/migration-estimation)
 	CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
 	// (POST /api/v1/assessments/{id}/migration-estimation/by-complexity)
 	CalculateMigrationEstimationByComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/assessments/{id}/right-sizing)
+	GetAssessmentRightSizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetAssessmentRightSizingParams)
+
+	// (POST /api/v1/assessments/{id}/rvtools)
+	UploadRVToolsAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
 	// (DELETE /api/v1/assessments/{id}/share)
 	UnshareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
 	// (POST /api/v1/assessments/{id}/share)
 	ShareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 
+	// (GET /api/v1/assessments/{id}/snapshots)
+	ListAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/assessments/{id}/snapshots/diff)
+	DiffAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DiffAssessmentSnapshotsParams)
+
+	// (GET /api/v1/assessments/{id}/snapshots/{snapshotId})
+	GetAssessmentSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, snapshotId int)
+
+	// (POST /api/v1/assessments/{id}/topology-sizing)
+	CalculateAssessmentTopologySizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (POST /api/v1/assessments/{id}/transfer)
+	TransferAssessmentOwnership(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/assessments/{id}/vms)
+	ListAssessmentVMs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListAssessmentVMsParams)
+
+	// (GET /api/v1/assessments/{id}/waivers)
+	ListConcernWaivers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (POST /api/v1/assessments/{id}/waivers)
+	CreateConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (DELETE /api/v1/assessments/{id}/waivers/{waiverId})
+	DeleteConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, waiverId openapi_types.UUID)
+
+	// (POST /api/v1/assessments/{id}/waves)
+	PlanMigrationWaves(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+
+	// (GET /api/v1/audit)
+	ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams)
+
 	// (POST /api/v1/cluster-requirements)
 	CalculateClusterRequirements(w http.ResponseWriter, r *http.Request)
 
@@ -107,12 +150,63 @@ type ServerInterface interface {
 	// (PUT /api/v1/groups/{id}/members/{username})
 	UpdateGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, username string)
 
+	// (GET /api/v1/hardware-skus)
+	ListHardwareSkus(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/hardware-skus)
+	CreateHardwareSku(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/hardware-skus/{name})
+	DeleteHardwareSku(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /api/v1/hardware-skus/{name})
+	UpdateHardwareSku(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /api/v1/identity)
 	GetIdentity(w http.ResponseWriter, r *http.Request)
 
 	// (GET /api/v1/info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
 
+	// (GET /api/v1/migration-estimation/schemas)
+	ListEstimationSchemas(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/organizations/{orgId}/complexity-tables)
+	ListComplexityTables(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (POST /api/v1/organizations/{orgId}/complexity-tables)
+	CreateComplexityTable(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (DELETE /api/v1/organizations/{orgId}/complexity-tables/active)
+	ResetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (GET /api/v1/organizations/{orgId}/complexity-tables/active)
+	GetActiveComplexityTable(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (GET /api/v1/organizations/{orgId}/complexity-tables/{version})
+	GetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string, version string)
+
+	// (GET /api/v1/organizations/{orgId}/policy-bundles)
+	ListPolicyBundles(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (POST /api/v1/organizations/{orgId}/policy-bundles)
+	CreatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (DELETE /api/v1/organizations/{orgId}/policy-bundles/active)
+	DeactivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (GET /api/v1/organizations/{orgId}/policy-bundles/active)
+	GetActivePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string)
+
+	// (GET /api/v1/organizations/{orgId}/policy-bundles/{revision})
+	GetPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int)
+
+	// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/activate)
+	ActivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int)
+
+	// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/test)
+	TestPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int)
+
 	// (GET /api/v1/partners)
 	ListPartners(w http.ResponseWriter, r *http.Request)
 
@@ -231,6 +325,11 @@ func (_ Unimplemented) SaveAssessmentEnhancementData(w http.ResponseWriter, r *h
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/assessments/{id}/hardware-options)
+func (_ Unimplemented) CalculateAssessmentHardwareOptions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /api/v1/assessments/{id}/migration-estimation)
 func (_ Unimplemented) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -241,6 +340,16 @@ func (_ Unimplemented) CalculateMigrationEstimationByComplexity(w http.ResponseW
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/assessments/{id}/right-sizing)
+func (_ Unimplemented) GetAssessmentRightSizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetAssessmentRightSizingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/rvtools)
+func (_ Unimplemented) UploadRVToolsAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (DELETE /api/v1/assessments/{id}/share)
 func (_ Unimplemented) UnshareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -251,6 +360,61 @@ func (_ Unimplemented) ShareAssessment(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/assessments/{id}/snapshots)
+func (_ Unimplemented) ListAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/assessments/{id}/snapshots/diff)
+func (_ Unimplemented) DiffAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DiffAssessmentSnapshotsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/assessments/{id}/snapshots/{snapshotId})
+func (_ Unimplemented) GetAssessmentSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, snapshotId int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/topology-sizing)
+func (_ Unimplemented) CalculateAssessmentTopologySizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/transfer)
+func (_ Unimplemented) TransferAssessmentOwnership(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/assessments/{id}/vms)
+func (_ Unimplemented) ListAssessmentVMs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListAssessmentVMsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/assessments/{id}/waivers)
+func (_ Unimplemented) ListConcernWaivers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/waivers)
+func (_ Unimplemented) CreateConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/assessments/{id}/waivers/{waiverId})
+func (_ Unimplemented) DeleteConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, waiverId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/assessments/{id}/waves)
+func (_ Unimplemented) PlanMigrationWaves(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/audit)
+func (_ Unimplemented) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /api/v1/cluster-requirements)
 func (_ Unimplemented) CalculateClusterRequirements(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -311,6 +475,26 @@ func (_ Unimplemented) UpdateGroupMember(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/hardware-skus)
+func (_ Unimplemented) ListHardwareSkus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/hardware-skus)
+func (_ Unimplemented) CreateHardwareSku(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/hardware-skus/{name})
+func (_ Unimplemented) DeleteHardwareSku(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/hardware-skus/{name})
+func (_ Unimplemented) UpdateHardwareSku(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/identity)
 func (_ Unimplemented) GetIdentity(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -321,6 +505,71 @@ func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/migration-estimation/schemas)
+func (_ Unimplemented) ListEstimationSchemas(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables)
+func (_ Unimplemented) ListComplexityTables(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/organizations/{orgId}/complexity-tables)
+func (_ Unimplemented) CreateComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/organizations/{orgId}/complexity-tables/active)
+func (_ Unimplemented) ResetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables/active)
+func (_ Unimplemented) GetActiveComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables/{version})
+func (_ Unimplemented) GetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string, version string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles)
+func (_ Unimplemented) ListPolicyBundles(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles)
+func (_ Unimplemented) CreatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/organizations/{orgId}/policy-bundles/active)
+func (_ Unimplemented) DeactivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles/active)
+func (_ Unimplemented) GetActivePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles/{revision})
+func (_ Unimplemented) GetPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/activate)
+func (_ Unimplemented) ActivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/test)
+func (_ Unimplemented) TestPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/partners)
 func (_ Unimplemented) ListPartners(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -746,8 +995,8 @@ func (siw *ServerInterfaceWrapper) SaveAssessmentEnhancementData(w http.Response
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CalculateMigrationEstimation operation middleware
-func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request) {
+// CalculateAssessmentHardwareOptions operation middleware
+func (siw *ServerInterfaceWrapper) CalculateAssessmentHardwareOptions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -762,7 +1011,7 @@ func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseW
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateMigrationEstimation(w, r, id)
+		siw.Handler.CalculateAssessmentHardwareOptions(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -772,8 +1021,8 @@ func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CalculateMigrationEstimationByComplexity operation middleware
-func (siw *ServerInterfaceWrapper) CalculateMigrationEstimationByComplexity(w http.ResponseWriter, r *http.Request) {
+// CalculateMigrationEstimation operation middleware
+func (siw *ServerInterfaceWrapper) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -788,7 +1037,7 @@ func (siw *ServerInterfaceWrapper) CalculateMigrationEstimationByComplexity(w ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateMigrationEstimationByComplexity(w, r, id)
+		siw.Handler.CalculateMigrationEstimation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -798,8 +1047,8 @@ func (siw *ServerInterfaceWrapper) CalculateMigrationEstimationByComplexity(w ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UnshareAssessment operation middleware
-func (siw *ServerInterfaceWrapper) UnshareAssessment(w http.ResponseWriter, r *http.Request) {
+// CalculateMigrationEstimationByComplexity operation middleware
+func (siw *ServerInterfaceWrapper) CalculateMigrationEstimationByComplexity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -814,7 +1063,7 @@ func (siw *ServerInterfaceWrapper) UnshareAssessment(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UnshareAssessment(w, r, id)
+		siw.Handler.CalculateMigrationEstimationByComplexity(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -824,8 +1073,8 @@ func (siw *ServerInterfaceWrapper) UnshareAssessment(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ShareAssessment operation middleware
-func (siw *ServerInterfaceWrapper) ShareAssessment(w http.ResponseWriter, r *http.Request) {
+// GetAssessmentRightSizing operation middleware
+func (siw *ServerInterfaceWrapper) GetAssessmentRightSizing(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -839,64 +1088,27 @@ func (siw *ServerInterfaceWrapper) ShareAssessment(w http.ResponseWriter, r *htt
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ShareAssessment(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CalculateClusterRequirements operation middleware
-func (siw *ServerInterfaceWrapper) CalculateClusterRequirements(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CalculateClusterRequirements(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// ListCustomers operation middleware
-func (siw *ServerInterfaceWrapper) ListCustomers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssessmentRightSizingParams
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCustomers(w, r)
-	}))
+	// ------------- Optional query parameter "snapshotId" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "snapshotId", r.URL.Query(), &params.SnapshotId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// RemoveCustomer operation middleware
-func (siw *ServerInterfaceWrapper) RemoveCustomer(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "username" -------------
-	var username string
+	// ------------- Optional query parameter "clusterId" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "clusterId", r.URL.Query(), &params.ClusterId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveCustomer(w, r, username)
+		siw.Handler.GetAssessmentRightSizing(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -906,56 +1118,23 @@ func (siw *ServerInterfaceWrapper) RemoveCustomer(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
+// UploadRVToolsAssessment operation middleware
+func (siw *ServerInterfaceWrapper) UploadRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListGroupsParams
-
-	// ------------- Optional query parameter "kind" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "name" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "company" -------------
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "company", r.URL.Query(), &params.Company)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "company", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListGroups(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CreateGroup operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroup(w, r)
+		siw.Handler.UploadRVToolsAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -965,8 +1144,8 @@ func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+// UnshareAssessment operation middleware
+func (siw *ServerInterfaceWrapper) UnshareAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -981,7 +1160,7 @@ func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGroup(w, r, id)
+		siw.Handler.UnshareAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -991,8 +1170,8 @@ func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetGroup operation middleware
-func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Request) {
+// ShareAssessment operation middleware
+func (siw *ServerInterfaceWrapper) ShareAssessment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1007,7 +1186,7 @@ func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Reque
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroup(w, r, id)
+		siw.Handler.ShareAssessment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1017,8 +1196,8 @@ func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+// ListAssessmentSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListAssessmentSnapshots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1033,7 +1212,7 @@ func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroup(w, r, id)
+		siw.Handler.ListAssessmentSnapshots(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1043,8 +1222,8 @@ func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListGroupMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListGroupMembers(w http.ResponseWriter, r *http.Request) {
+// DiffAssessmentSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) DiffAssessmentSnapshots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1058,8 +1237,41 @@ func (siw *ServerInterfaceWrapper) ListGroupMembers(w http.ResponseWriter, r *ht
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListGroupMembers(w, r, id)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffAssessmentSnapshotsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffAssessmentSnapshots(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1069,8 +1281,8 @@ func (siw *ServerInterfaceWrapper) ListGroupMembers(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateGroupMember operation middleware
-func (siw *ServerInterfaceWrapper) CreateGroupMember(w http.ResponseWriter, r *http.Request) {
+// GetAssessmentSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) GetAssessmentSnapshot(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1084,8 +1296,17 @@ func (siw *ServerInterfaceWrapper) CreateGroupMember(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGroupMember(w, r, id)
+		siw.Handler.GetAssessmentSnapshot(w, r, id, snapshotId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1095,8 +1316,8 @@ func (siw *ServerInterfaceWrapper) CreateGroupMember(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RemoveGroupMember operation middleware
-func (siw *ServerInterfaceWrapper) RemoveGroupMember(w http.ResponseWriter, r *http.Request) {
+// CalculateAssessmentTopologySizing operation middleware
+func (siw *ServerInterfaceWrapper) CalculateAssessmentTopologySizing(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1110,17 +1331,34 @@ func (siw *ServerInterfaceWrapper) RemoveGroupMember(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CalculateAssessmentTopologySizing(w, r, id)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// TransferAssessmentOwnership operation middleware
+func (siw *ServerInterfaceWrapper) TransferAssessmentOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveGroupMember(w, r, id, username)
+		siw.Handler.TransferAssessmentOwnership(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1130,8 +1368,8 @@ func (siw *ServerInterfaceWrapper) RemoveGroupMember(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateGroupMember operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroupMember(w http.ResponseWriter, r *http.Request) {
+// ListAssessmentVMs operation middleware
+func (siw *ServerInterfaceWrapper) ListAssessmentVMs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1145,77 +1383,91 @@ func (siw *ServerInterfaceWrapper) UpdateGroupMember(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "username" -------------
-	var username string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssessmentVMsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "snapshotId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshotId", r.URL.Query(), &params.SnapshotId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshotId", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroupMember(w, r, id, username)
-	}))
+	// ------------- Optional query parameter "cluster" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "cluster", r.URL.Query(), &params.Cluster)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "os" -------------
 
-// GetIdentity operation middleware
-func (siw *ServerInterfaceWrapper) GetIdentity(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "os", r.URL.Query(), &params.Os)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "os", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIdentity(w, r)
-	}))
+	// ------------- Optional query parameter "powerState" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "powerState", r.URL.Query(), &params.PowerState)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "powerState", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "concernId" -------------
 
-// GetInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "concernId", r.URL.Query(), &params.ConcernId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "concernId", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInfo(w, r)
-	}))
+	// ------------- Optional query parameter "category" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "sort" -------------
 
-// ListPartners operation middleware
-func (siw *ServerInterfaceWrapper) ListPartners(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPartners(w, r)
-	}))
+	// ------------- Optional query parameter "order" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// ListPartnerRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListPartnerRequests(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPartnerRequests(w, r)
+		siw.Handler.ListAssessmentVMs(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1225,8 +1477,8 @@ func (siw *ServerInterfaceWrapper) ListPartnerRequests(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CancelPartnerRequest operation middleware
-func (siw *ServerInterfaceWrapper) CancelPartnerRequest(w http.ResponseWriter, r *http.Request) {
+// ListConcernWaivers operation middleware
+func (siw *ServerInterfaceWrapper) ListConcernWaivers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1241,7 +1493,7 @@ func (siw *ServerInterfaceWrapper) CancelPartnerRequest(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelPartnerRequest(w, r, id)
+		siw.Handler.ListConcernWaivers(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1251,8 +1503,8 @@ func (siw *ServerInterfaceWrapper) CancelPartnerRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdatePartnerRequest operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePartnerRequest(w http.ResponseWriter, r *http.Request) {
+// CreateConcernWaiver operation middleware
+func (siw *ServerInterfaceWrapper) CreateConcernWaiver(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1267,7 +1519,7 @@ func (siw *ServerInterfaceWrapper) UpdatePartnerRequest(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePartnerRequest(w, r, id)
+		siw.Handler.CreateConcernWaiver(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1277,8 +1529,8 @@ func (siw *ServerInterfaceWrapper) UpdatePartnerRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// LeavePartner operation middleware
-func (siw *ServerInterfaceWrapper) LeavePartner(w http.ResponseWriter, r *http.Request) {
+// DeleteConcernWaiver operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConcernWaiver(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1292,8 +1544,17 @@ func (siw *ServerInterfaceWrapper) LeavePartner(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "waiverId" -------------
+	var waiverId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "waiverId", chi.URLParam(r, "waiverId"), &waiverId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "waiverId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LeavePartner(w, r, id)
+		siw.Handler.DeleteConcernWaiver(w, r, id, waiverId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1303,8 +1564,8 @@ func (siw *ServerInterfaceWrapper) LeavePartner(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetPartner operation middleware
-func (siw *ServerInterfaceWrapper) GetPartner(w http.ResponseWriter, r *http.Request) {
+// PlanMigrationWaves operation middleware
+func (siw *ServerInterfaceWrapper) PlanMigrationWaves(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1319,7 +1580,7 @@ func (siw *ServerInterfaceWrapper) GetPartner(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPartner(w, r, id)
+		siw.Handler.PlanMigrationWaves(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1329,23 +1590,105 @@ func (siw *ServerInterfaceWrapper) GetPartner(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreatePartnerRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreatePartnerRequest(w http.ResponseWriter, r *http.Request) {
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceType", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", r.URL.Query(), &params.ResourceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "requestId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requestId", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePartnerRequest(w, r, id)
+		siw.Handler.ListAuditEntries(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1355,12 +1698,12 @@ func (siw *ServerInterfaceWrapper) CreatePartnerRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListSources operation middleware
-func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+// CalculateClusterRequirements operation middleware
+func (siw *ServerInterfaceWrapper) CalculateClusterRequirements(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSources(w, r)
+		siw.Handler.CalculateClusterRequirements(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1370,12 +1713,12 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateSource operation middleware
-func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+// ListCustomers operation middleware
+func (siw *ServerInterfaceWrapper) ListCustomers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSource(w, r)
+		siw.Handler.ListCustomers(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1385,23 +1728,23 @@ func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteSource operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+// RemoveCustomer operation middleware
+func (siw *ServerInterfaceWrapper) RemoveCustomer(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "username" -------------
+	var username string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSource(w, r, id)
+		siw.Handler.RemoveCustomer(w, r, username)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1411,34 +1754,67 @@ func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSource operation middleware
-func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+// ListGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListGroups(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGroupsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSource(w, r, id)
-	}))
+	// ------------- Optional query parameter "name" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "company" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "company", r.URL.Query(), &params.Company)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "company", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateSource operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.Request) {
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGroup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1453,7 +1829,7 @@ func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSource(w, r, id)
+		siw.Handler.DeleteGroup(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1463,8 +1839,8 @@ func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HeadImage operation middleware
-func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Request) {
+// GetGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1479,7 +1855,7 @@ func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HeadImage(w, r, id)
+		siw.Handler.GetGroup(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1489,8 +1865,8 @@ func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSourceDownloadURL operation middleware
-func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request) {
+// UpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1505,7 +1881,7 @@ func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSourceDownloadURL(w, r, id)
+		siw.Handler.UpdateGroup(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1515,8 +1891,8 @@ func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateInventory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+// ListGroupMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListGroupMembers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1531,7 +1907,7 @@ func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateInventory(w, r, id)
+		siw.Handler.ListGroupMembers(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1541,12 +1917,23 @@ func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// CreateGroupMember operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroupMember(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.CreateGroupMember(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1556,124 +1943,1030 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// RemoveGroupMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveGroupMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveGroupMember(w, r, id, username)
+	}))
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+// UpdateGroupMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroupMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "username" -------------
+	var username string
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGroupMember(w, r, id, username)
+	}))
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// ListHardwareSkus operation middleware
+func (siw *ServerInterfaceWrapper) ListHardwareSkus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHardwareSkus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// CreateHardwareSku operation middleware
+func (siw *ServerInterfaceWrapper) CreateHardwareSku(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHardwareSku(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// DeleteHardwareSku operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHardwareSku(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if r == nil {
-		r = chi.NewRouter()
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHardwareSku(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateHardwareSku operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHardwareSku(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/assessments", wrapper.ListAssessments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/assessments", wrapper.CreateAssessment)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHardwareSku(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetIdentity operation middleware
+func (siw *ServerInterfaceWrapper) GetIdentity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIdentity(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEstimationSchemas operation middleware
+func (siw *ServerInterfaceWrapper) ListEstimationSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEstimationSchemas(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComplexityTables operation middleware
+func (siw *ServerInterfaceWrapper) ListComplexityTables(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComplexityTables(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateComplexityTable operation middleware
+func (siw *ServerInterfaceWrapper) CreateComplexityTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateComplexityTable(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResetComplexityTable operation middleware
+func (siw *ServerInterfaceWrapper) ResetComplexityTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetComplexityTable(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetActiveComplexityTable operation middleware
+func (siw *ServerInterfaceWrapper) GetActiveComplexityTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActiveComplexityTable(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComplexityTable operation middleware
+func (siw *ServerInterfaceWrapper) GetComplexityTable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComplexityTable(w, r, orgId, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPolicyBundles operation middleware
+func (siw *ServerInterfaceWrapper) ListPolicyBundles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPolicyBundles(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) CreatePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePolicyBundle(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeactivatePolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) DeactivatePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeactivatePolicyBundle(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetActivePolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetActivePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActivePolicyBundle(w, r, orgId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyBundle(w, r, orgId, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ActivatePolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) ActivatePolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ActivatePolicyBundle(w, r, orgId, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// TestPolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) TestPolicyBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orgId" -------------
+	var orgId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orgId", chi.URLParam(r, "orgId"), &orgId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orgId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestPolicyBundle(w, r, orgId, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPartners operation middleware
+func (siw *ServerInterfaceWrapper) ListPartners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPartners(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPartnerRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPartnerRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPartnerRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CancelPartnerRequest operation middleware
+func (siw *ServerInterfaceWrapper) CancelPartnerRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelPartnerRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdatePartnerRequest operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePartnerRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePartnerRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// LeavePartner operation middleware
+func (siw *ServerInterfaceWrapper) LeavePartner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LeavePartner(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPartner operation middleware
+func (siw *ServerInterfaceWrapper) GetPartner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPartner(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreatePartnerRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePartnerRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePartnerRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListSources operation middleware
+func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSources(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateSource operation middleware
+func (siw *ServerInterfaceWrapper) CreateSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSource(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteSource operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSource operation middleware
+func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSource(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HeadImage operation middleware
+func (siw *ServerInterfaceWrapper) HeadImage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeadImage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSourceDownloadURL operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceDownloadURL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceDownloadURL(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateInventory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateInventory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments", wrapper.ListAssessments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments", wrapper.CreateAssessment)
 	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/assessments/jobs/{id}", wrapper.CancelJob)
@@ -1708,18 +3001,60 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/assessments/{id}/enhancement-data", wrapper.SaveAssessmentEnhancementData)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/hardware-options", wrapper.CalculateAssessmentHardwareOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/assessments/{id}/migration-estimation", wrapper.CalculateMigrationEstimation)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/assessments/{id}/migration-estimation/by-complexity", wrapper.CalculateMigrationEstimationByComplexity)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/right-sizing", wrapper.GetAssessmentRightSizing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/rvtools", wrapper.UploadRVToolsAssessment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/assessments/{id}/share", wrapper.UnshareAssessment)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/assessments/{id}/share", wrapper.ShareAssessment)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/snapshots", wrapper.ListAssessmentSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/snapshots/diff", wrapper.DiffAssessmentSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/snapshots/{snapshotId}", wrapper.GetAssessmentSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/topology-sizing", wrapper.CalculateAssessmentTopologySizing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/transfer", wrapper.TransferAssessmentOwnership)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/vms", wrapper.ListAssessmentVMs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/assessments/{id}/waivers", wrapper.ListConcernWaivers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/waivers", wrapper.CreateConcernWaiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/assessments/{id}/waivers/{waiverId}", wrapper.DeleteConcernWaiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/assessments/{id}/waves", wrapper.PlanMigrationWaves)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/audit", wrapper.ListAuditEntries)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/cluster-requirements", wrapper.CalculateClusterRequirements)
 	})
@@ -1756,12 +3091,63 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/groups/{id}/members/{username}", wrapper.UpdateGroupMember)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/hardware-skus", wrapper.ListHardwareSkus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/hardware-skus", wrapper.CreateHardwareSku)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/hardware-skus/{name}", wrapper.DeleteHardwareSku)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/hardware-skus/{name}", wrapper.UpdateHardwareSku)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/identity", wrapper.GetIdentity)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/info", wrapper.GetInfo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/migration-estimation/schemas", wrapper.ListEstimationSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/complexity-tables", wrapper.ListComplexityTables)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/organizations/{orgId}/complexity-tables", wrapper.CreateComplexityTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/organizations/{orgId}/complexity-tables/active", wrapper.ResetComplexityTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/complexity-tables/active", wrapper.GetActiveComplexityTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/complexity-tables/{version}", wrapper.GetComplexityTable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles", wrapper.ListPolicyBundles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles", wrapper.CreatePolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles/active", wrapper.DeactivatePolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles/active", wrapper.GetActivePolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles/{revision}", wrapper.GetPolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles/{revision}/activate", wrapper.ActivatePolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/organizations/{orgId}/policy-bundles/{revision}/test", wrapper.TestPolicyBundle)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/partners", wrapper.ListPartners)
 	})
@@ -1811,2754 +3197,5528 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		r.Get(options.BaseURL+"/health", wrapper.Health)
 	})
 
-	return r
+	return r
+}
+
+type ListAssessmentsRequestObject struct {
+	Params ListAssessmentsParams
+}
+
+type ListAssessmentsResponseObject interface {
+	VisitListAssessmentsResponse(w http.ResponseWriter) error
+}
+
+type ListAssessments200JSONResponse AssessmentList
+
+func (response ListAssessments200JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessments401JSONResponse Error
+
+func (response ListAssessments401JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessments500JSONResponse Error
+
+func (response ListAssessments500JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessmentRequestObject struct {
+	Body *CreateAssessmentJSONRequestBody
+}
+
+type CreateAssessmentResponseObject interface {
+	VisitCreateAssessmentResponse(w http.ResponseWriter) error
+}
+
+type CreateAssessment201JSONResponse Assessment
+
+func (response CreateAssessment201JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment400JSONResponse Error
+
+func (response CreateAssessment400JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment401JSONResponse Error
+
+func (response CreateAssessment401JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAssessment500JSONResponse Error
+
+func (response CreateAssessment500JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJobRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type CancelJobResponseObject interface {
+	VisitCancelJobResponse(w http.ResponseWriter) error
+}
+
+type CancelJob200JSONResponse Job
+
+func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob400JSONResponse Error
+
+func (response CancelJob400JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob401JSONResponse Error
+
+func (response CancelJob401JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob403JSONResponse Error
+
+func (response CancelJob403JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob404JSONResponse Error
+
+func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob409JSONResponse Error
+
+func (response CancelJob409JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob500JSONResponse Error
+
+func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob400JSONResponse Error
+
+func (response GetJob400JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob401JSONResponse Error
+
+func (response GetJob401JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob403JSONResponse Error
+
+func (response GetJob403JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404JSONResponse Error
+
+func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob500JSONResponse Error
+
+func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessmentRequestObject struct {
+	Body *multipart.Reader
+}
+
+type CreateRVToolsAssessmentResponseObject interface {
+	VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error
+}
+
+type CreateRVToolsAssessment202JSONResponse Job
+
+func (response CreateRVToolsAssessment202JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment400JSONResponse Error
+
+func (response CreateRVToolsAssessment400JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment401JSONResponse Error
+
+func (response CreateRVToolsAssessment401JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRVToolsAssessment500JSONResponse Error
+
+func (response CreateRVToolsAssessment500JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessmentRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAssessmentResponseObject interface {
+	VisitDeleteAssessmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteAssessment200JSONResponse Assessment
+
+func (response DeleteAssessment200JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment400JSONResponse Error
+
+func (response DeleteAssessment400JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment401JSONResponse Error
+
+func (response DeleteAssessment401JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment403JSONResponse Error
+
+func (response DeleteAssessment403JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment404JSONResponse Error
+
+func (response DeleteAssessment404JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAssessment500JSONResponse Error
+
+func (response DeleteAssessment500JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetAssessmentResponseObject interface {
+	VisitGetAssessmentResponse(w http.ResponseWriter) error
+}
+
+type GetAssessment200JSONResponse Assessment
+
+func (response GetAssessment200JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment400JSONResponse Error
+
+func (response GetAssessment400JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment401JSONResponse Error
+
+func (response GetAssessment401JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment403JSONResponse Error
+
+func (response GetAssessment403JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment404JSONResponse Error
+
+func (response GetAssessment404JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessment500JSONResponse Error
+
+func (response GetAssessment500JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateAssessmentJSONRequestBody
+}
+
+type UpdateAssessmentResponseObject interface {
+	VisitUpdateAssessmentResponse(w http.ResponseWriter) error
+}
+
+type UpdateAssessment200JSONResponse Assessment
+
+func (response UpdateAssessment200JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment400JSONResponse Error
+
+func (response UpdateAssessment400JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment401JSONResponse Error
+
+func (response UpdateAssessment401JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment403JSONResponse Error
+
+func (response UpdateAssessment403JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment404JSONResponse Error
+
+func (response UpdateAssessment404JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAssessment500JSONResponse Error
+
+func (response UpdateAssessment500JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirementsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateAssessmentClusterRequirementsJSONRequestBody
+}
+
+type CalculateAssessmentClusterRequirementsResponseObject interface {
+	VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error
+}
+
+type CalculateAssessmentClusterRequirements200JSONResponse ClusterRequirementsResponse
+
+func (response CalculateAssessmentClusterRequirements200JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements400JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements400JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements401JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements401JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements403JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements403JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements404JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements404JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements500JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements500JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentClusterRequirements503JSONResponse Error
+
+func (response CalculateAssessmentClusterRequirements503JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInputRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetAssessmentClusterRequirementsStoredInputParams
+}
+
+type GetAssessmentClusterRequirementsStoredInputResponseObject interface {
+	VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error
+}
+
+type GetAssessmentClusterRequirementsStoredInput200JSONResponse ClusterRequirementsStoredInput
+
+func (response GetAssessmentClusterRequirementsStoredInput200JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInput400JSONResponse Error
+
+func (response GetAssessmentClusterRequirementsStoredInput400JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInput401JSONResponse Error
+
+func (response GetAssessmentClusterRequirementsStoredInput401JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInput403JSONResponse Error
+
+func (response GetAssessmentClusterRequirementsStoredInput403JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInput404JSONResponse Error
+
+func (response GetAssessmentClusterRequirementsStoredInput404JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentClusterRequirementsStoredInput500JSONResponse Error
+
+func (response GetAssessmentClusterRequirementsStoredInput500JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexityRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateMigrationComplexityJSONRequestBody
+}
+
+type CalculateMigrationComplexityResponseObject interface {
+	VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error
+}
+
+type CalculateMigrationComplexity200JSONResponse MigrationComplexityResponse
+
+func (response CalculateMigrationComplexity200JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity400JSONResponse Error
+
+func (response CalculateMigrationComplexity400JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity401JSONResponse Error
+
+func (response CalculateMigrationComplexity401JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity403JSONResponse Error
+
+func (response CalculateMigrationComplexity403JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity404JSONResponse Error
+
+func (response CalculateMigrationComplexity404JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationComplexity500JSONResponse Error
+
+func (response CalculateMigrationComplexity500JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentEnhancementDataRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetAssessmentEnhancementDataResponseObject interface {
+	VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error
+}
+
+type GetAssessmentEnhancementData200JSONResponse EnhancementData
+
+func (response GetAssessmentEnhancementData200JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentEnhancementData401JSONResponse Error
+
+func (response GetAssessmentEnhancementData401JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentEnhancementData403JSONResponse Error
+
+func (response GetAssessmentEnhancementData403JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentEnhancementData404JSONResponse Error
+
+func (response GetAssessmentEnhancementData404JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentEnhancementData500JSONResponse Error
+
+func (response GetAssessmentEnhancementData500JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementDataRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SaveAssessmentEnhancementDataJSONRequestBody
+}
+
+type SaveAssessmentEnhancementDataResponseObject interface {
+	VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error
+}
+
+type SaveAssessmentEnhancementData200JSONResponse EnhancementData
+
+func (response SaveAssessmentEnhancementData200JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementData400JSONResponse Error
+
+func (response SaveAssessmentEnhancementData400JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementData401JSONResponse Error
+
+func (response SaveAssessmentEnhancementData401JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementData403JSONResponse Error
+
+func (response SaveAssessmentEnhancementData403JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementData404JSONResponse Error
+
+func (response SaveAssessmentEnhancementData404JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SaveAssessmentEnhancementData500JSONResponse Error
+
+func (response SaveAssessmentEnhancementData500JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptionsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateAssessmentHardwareOptionsJSONRequestBody
+}
+
+type CalculateAssessmentHardwareOptionsResponseObject interface {
+	VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error
+}
+
+type CalculateAssessmentHardwareOptions200JSONResponse HardwareOptionsResponse
+
+func (response CalculateAssessmentHardwareOptions200JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions400JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions400JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions401JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions401JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions403JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions403JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions404JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions404JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions500JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions500JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentHardwareOptions503JSONResponse Error
+
+func (response CalculateAssessmentHardwareOptions503JSONResponse) VisitCalculateAssessmentHardwareOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateMigrationEstimationJSONRequestBody
+}
+
+type CalculateMigrationEstimationResponseObject interface {
+	VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error
+}
+
+type CalculateMigrationEstimation200JSONResponse MigrationEstimationResponse
+
+func (response CalculateMigrationEstimation200JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation400JSONResponse Error
+
+func (response CalculateMigrationEstimation400JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation401JSONResponse Error
+
+func (response CalculateMigrationEstimation401JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation403JSONResponse Error
+
+func (response CalculateMigrationEstimation403JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation404JSONResponse Error
+
+func (response CalculateMigrationEstimation404JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimation500JSONResponse Error
+
+func (response CalculateMigrationEstimation500JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexityRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateMigrationEstimationByComplexityJSONRequestBody
+}
+
+type CalculateMigrationEstimationByComplexityResponseObject interface {
+	VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error
+}
+
+type CalculateMigrationEstimationByComplexity200JSONResponse MigrationEstimationByComplexityResponse
+
+func (response CalculateMigrationEstimationByComplexity200JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexity400JSONResponse Error
+
+func (response CalculateMigrationEstimationByComplexity400JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexity401JSONResponse Error
+
+func (response CalculateMigrationEstimationByComplexity401JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexity403JSONResponse Error
+
+func (response CalculateMigrationEstimationByComplexity403JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexity404JSONResponse Error
+
+func (response CalculateMigrationEstimationByComplexity404JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateMigrationEstimationByComplexity500JSONResponse Error
+
+func (response CalculateMigrationEstimationByComplexity500JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizingRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetAssessmentRightSizingParams
+}
+
+type GetAssessmentRightSizingResponseObject interface {
+	VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error
+}
+
+type GetAssessmentRightSizing200JSONResponse RightSizingReport
+
+func (response GetAssessmentRightSizing200JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizing400JSONResponse Error
+
+func (response GetAssessmentRightSizing400JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizing401JSONResponse Error
+
+func (response GetAssessmentRightSizing401JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizing403JSONResponse Error
+
+func (response GetAssessmentRightSizing403JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizing404JSONResponse Error
+
+func (response GetAssessmentRightSizing404JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentRightSizing500JSONResponse Error
+
+func (response GetAssessmentRightSizing500JSONResponse) VisitGetAssessmentRightSizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *multipart.Reader
+}
+
+type UploadRVToolsAssessmentResponseObject interface {
+	VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error
+}
+
+type UploadRVToolsAssessment202JSONResponse Job
+
+func (response UploadRVToolsAssessment202JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessment400JSONResponse Error
+
+func (response UploadRVToolsAssessment400JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessment401JSONResponse Error
+
+func (response UploadRVToolsAssessment401JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessment403JSONResponse Error
+
+func (response UploadRVToolsAssessment403JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessment404JSONResponse Error
+
+func (response UploadRVToolsAssessment404JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadRVToolsAssessment500JSONResponse Error
+
+func (response UploadRVToolsAssessment500JSONResponse) VisitUploadRVToolsAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UnshareAssessmentJSONRequestBody
+}
+
+type UnshareAssessmentResponseObject interface {
+	VisitUnshareAssessmentResponse(w http.ResponseWriter) error
+}
+
+type UnshareAssessment200JSONResponse Status
+
+func (response UnshareAssessment200JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessment400JSONResponse Error
+
+func (response UnshareAssessment400JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessment401JSONResponse Error
+
+func (response UnshareAssessment401JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessment403JSONResponse Error
+
+func (response UnshareAssessment403JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessment404JSONResponse Error
+
+func (response UnshareAssessment404JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnshareAssessment500JSONResponse Error
+
+func (response UnshareAssessment500JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ShareAssessmentJSONRequestBody
+}
+
+type ShareAssessmentResponseObject interface {
+	VisitShareAssessmentResponse(w http.ResponseWriter) error
+}
+
+type ShareAssessment200JSONResponse Status
+
+func (response ShareAssessment200JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessment400JSONResponse Error
+
+func (response ShareAssessment400JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessment401JSONResponse Error
+
+func (response ShareAssessment401JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessment403JSONResponse Error
+
+func (response ShareAssessment403JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessment404JSONResponse Error
+
+func (response ShareAssessment404JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ShareAssessment500JSONResponse Error
+
+func (response ShareAssessment500JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshotsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListAssessmentSnapshotsResponseObject interface {
+	VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type ListAssessmentSnapshots200JSONResponse SnapshotList
+
+func (response ListAssessmentSnapshots200JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshots400JSONResponse Error
+
+func (response ListAssessmentSnapshots400JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshots401JSONResponse Error
+
+func (response ListAssessmentSnapshots401JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshots403JSONResponse Error
+
+func (response ListAssessmentSnapshots403JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshots404JSONResponse Error
+
+func (response ListAssessmentSnapshots404JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentSnapshots500JSONResponse Error
+
+func (response ListAssessmentSnapshots500JSONResponse) VisitListAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshotsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DiffAssessmentSnapshotsParams
+}
+
+type DiffAssessmentSnapshotsResponseObject interface {
+	VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type DiffAssessmentSnapshots200JSONResponse SnapshotDiff
+
+func (response DiffAssessmentSnapshots200JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshots400JSONResponse Error
+
+func (response DiffAssessmentSnapshots400JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshots401JSONResponse Error
+
+func (response DiffAssessmentSnapshots401JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshots403JSONResponse Error
+
+func (response DiffAssessmentSnapshots403JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshots404JSONResponse Error
+
+func (response DiffAssessmentSnapshots404JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAssessmentSnapshots500JSONResponse Error
+
+func (response DiffAssessmentSnapshots500JSONResponse) VisitDiffAssessmentSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshotRequestObject struct {
+	Id         openapi_types.UUID `json:"id"`
+	SnapshotId int                `json:"snapshotId"`
+}
+
+type GetAssessmentSnapshotResponseObject interface {
+	VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error
+}
+
+type GetAssessmentSnapshot200JSONResponse Snapshot
+
+func (response GetAssessmentSnapshot200JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshot400JSONResponse Error
+
+func (response GetAssessmentSnapshot400JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshot401JSONResponse Error
+
+func (response GetAssessmentSnapshot401JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshot403JSONResponse Error
+
+func (response GetAssessmentSnapshot403JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshot404JSONResponse Error
+
+func (response GetAssessmentSnapshot404JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAssessmentSnapshot500JSONResponse Error
+
+func (response GetAssessmentSnapshot500JSONResponse) VisitGetAssessmentSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizingRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CalculateAssessmentTopologySizingJSONRequestBody
+}
+
+type CalculateAssessmentTopologySizingResponseObject interface {
+	VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error
+}
+
+type CalculateAssessmentTopologySizing200JSONResponse TopologySizingResponse
+
+func (response CalculateAssessmentTopologySizing200JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing400JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing400JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing401JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing401JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing403JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing403JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing404JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing404JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing500JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing500JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateAssessmentTopologySizing503JSONResponse Error
+
+func (response CalculateAssessmentTopologySizing503JSONResponse) VisitCalculateAssessmentTopologySizingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnershipRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *TransferAssessmentOwnershipJSONRequestBody
+}
+
+type TransferAssessmentOwnershipResponseObject interface {
+	VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error
+}
+
+type TransferAssessmentOwnership200JSONResponse Assessment
+
+func (response TransferAssessmentOwnership200JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership400JSONResponse Error
+
+func (response TransferAssessmentOwnership400JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership401JSONResponse Error
+
+func (response TransferAssessmentOwnership401JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership403JSONResponse Error
+
+func (response TransferAssessmentOwnership403JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership404JSONResponse Error
+
+func (response TransferAssessmentOwnership404JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership409JSONResponse Error
+
+func (response TransferAssessmentOwnership409JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferAssessmentOwnership500JSONResponse Error
+
+func (response TransferAssessmentOwnership500JSONResponse) VisitTransferAssessmentOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ListAssessmentVMsParams
+}
+
+type ListAssessmentVMsResponseObject interface {
+	VisitListAssessmentVMsResponse(w http.ResponseWriter) error
+}
+
+type ListAssessmentVMs200JSONResponse AssessmentVMList
+
+func (response ListAssessmentVMs200JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMs400JSONResponse Error
+
+func (response ListAssessmentVMs400JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMs401JSONResponse Error
+
+func (response ListAssessmentVMs401JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMs403JSONResponse Error
+
+func (response ListAssessmentVMs403JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMs404JSONResponse Error
+
+func (response ListAssessmentVMs404JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAssessmentVMs500JSONResponse Error
+
+func (response ListAssessmentVMs500JSONResponse) VisitListAssessmentVMsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConcernWaiversRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListConcernWaiversResponseObject interface {
+	VisitListConcernWaiversResponse(w http.ResponseWriter) error
+}
+
+type ListConcernWaivers200JSONResponse ConcernWaiverList
+
+func (response ListConcernWaivers200JSONResponse) VisitListConcernWaiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConcernWaivers401JSONResponse Error
+
+func (response ListConcernWaivers401JSONResponse) VisitListConcernWaiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConcernWaivers403JSONResponse Error
+
+func (response ListConcernWaivers403JSONResponse) VisitListConcernWaiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConcernWaivers404JSONResponse Error
+
+func (response ListConcernWaivers404JSONResponse) VisitListConcernWaiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConcernWaivers500JSONResponse Error
+
+func (response ListConcernWaivers500JSONResponse) VisitListConcernWaiversResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiverRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreateConcernWaiverJSONRequestBody
+}
+
+type CreateConcernWaiverResponseObject interface {
+	VisitCreateConcernWaiverResponse(w http.ResponseWriter) error
+}
+
+type CreateConcernWaiver201JSONResponse ConcernWaiver
+
+func (response CreateConcernWaiver201JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiver400JSONResponse Error
+
+func (response CreateConcernWaiver400JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiver401JSONResponse Error
+
+func (response CreateConcernWaiver401JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiver403JSONResponse Error
+
+func (response CreateConcernWaiver403JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiver404JSONResponse Error
+
+func (response CreateConcernWaiver404JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConcernWaiver500JSONResponse Error
+
+func (response CreateConcernWaiver500JSONResponse) VisitCreateConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConcernWaiverRequestObject struct {
+	Id       openapi_types.UUID `json:"id"`
+	WaiverId openapi_types.UUID `json:"waiverId"`
+}
+
+type DeleteConcernWaiverResponseObject interface {
+	VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error
+}
+
+type DeleteConcernWaiver204Response struct {
+}
+
+func (response DeleteConcernWaiver204Response) VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteConcernWaiver401JSONResponse Error
+
+func (response DeleteConcernWaiver401JSONResponse) VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConcernWaiver403JSONResponse Error
+
+func (response DeleteConcernWaiver403JSONResponse) VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConcernWaiver404JSONResponse Error
+
+func (response DeleteConcernWaiver404JSONResponse) VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConcernWaiver500JSONResponse Error
+
+func (response DeleteConcernWaiver500JSONResponse) VisitDeleteConcernWaiverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWavesRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *PlanMigrationWavesJSONRequestBody
+}
+
+type PlanMigrationWavesResponseObject interface {
+	VisitPlanMigrationWavesResponse(w http.ResponseWriter) error
+}
+
+type PlanMigrationWaves200JSONResponse MigrationWavePlanResponse
+
+func (response PlanMigrationWaves200JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWaves400JSONResponse Error
+
+func (response PlanMigrationWaves400JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWaves401JSONResponse Error
+
+func (response PlanMigrationWaves401JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWaves403JSONResponse Error
+
+func (response PlanMigrationWaves403JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWaves404JSONResponse Error
+
+func (response PlanMigrationWaves404JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlanMigrationWaves500JSONResponse Error
+
+func (response PlanMigrationWaves500JSONResponse) VisitPlanMigrationWavesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntriesRequestObject struct {
+	Params ListAuditEntriesParams
+}
+
+type ListAuditEntriesResponseObject interface {
+	VisitListAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEntries200JSONResponse AuditEntryList
+
+func (response ListAuditEntries200JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ListAuditEntries200TextcsvResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ListAuditEntries400JSONResponse Error
+
+func (response ListAuditEntries400JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries401JSONResponse Error
+
+func (response ListAuditEntries401JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries403JSONResponse Error
+
+func (response ListAuditEntries403JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries500JSONResponse Error
+
+func (response ListAuditEntries500JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateClusterRequirementsRequestObject struct {
+	Body *CalculateClusterRequirementsJSONRequestBody
+}
+
+type CalculateClusterRequirementsResponseObject interface {
+	VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error
+}
+
+type CalculateClusterRequirements200JSONResponse StandaloneClusterRequirementsResponse
+
+func (response CalculateClusterRequirements200JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateClusterRequirements400JSONResponse Error
+
+func (response CalculateClusterRequirements400JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateClusterRequirements401JSONResponse Error
+
+func (response CalculateClusterRequirements401JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateClusterRequirements500JSONResponse Error
+
+func (response CalculateClusterRequirements500JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CalculateClusterRequirements503JSONResponse Error
+
+func (response CalculateClusterRequirements503JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomersRequestObject struct {
+}
+
+type ListCustomersResponseObject interface {
+	VisitListCustomersResponse(w http.ResponseWriter) error
+}
+
+type ListCustomers200JSONResponse CustomerList
+
+func (response ListCustomers200JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomers400JSONResponse Error
+
+func (response ListCustomers400JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomers401JSONResponse Error
+
+func (response ListCustomers401JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomers403JSONResponse Error
+
+func (response ListCustomers403JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCustomers500JSONResponse Error
+
+func (response ListCustomers500JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCustomerRequestObject struct {
+	Username string `json:"username"`
+}
+
+type RemoveCustomerResponseObject interface {
+	VisitRemoveCustomerResponse(w http.ResponseWriter) error
+}
+
+type RemoveCustomer200Response struct {
+}
+
+func (response RemoveCustomer200Response) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RemoveCustomer400JSONResponse Error
+
+func (response RemoveCustomer400JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCustomer401JSONResponse Error
+
+func (response RemoveCustomer401JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCustomer403JSONResponse Error
+
+func (response RemoveCustomer403JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCustomer404JSONResponse Error
+
+func (response RemoveCustomer404JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCustomer500JSONResponse Error
+
+func (response RemoveCustomer500JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupsRequestObject struct {
+	Params ListGroupsParams
+}
+
+type ListGroupsResponseObject interface {
+	VisitListGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListGroups200JSONResponse GroupList
+
+func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroups401JSONResponse Error
+
+func (response ListGroups401JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroups403JSONResponse Error
+
+func (response ListGroups403JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroups500JSONResponse Error
+
+func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAssessmentsRequestObject struct {
-	Params ListAssessmentsParams
+type CreateGroupRequestObject struct {
+	Body *CreateGroupJSONRequestBody
 }
 
-type ListAssessmentsResponseObject interface {
-	VisitListAssessmentsResponse(w http.ResponseWriter) error
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
 }
 
-type ListAssessments200JSONResponse AssessmentList
+type CreateGroup201JSONResponse Group
 
-func (response ListAssessments200JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup400JSONResponse Error
+
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup401JSONResponse Error
+
+func (response CreateGroup401JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup403JSONResponse Error
+
+func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup500JSONResponse Error
+
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroupRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteGroupResponseObject interface {
+	VisitDeleteGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteGroup200JSONResponse Group
+
+func (response DeleteGroup200JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAssessments401JSONResponse Error
+type DeleteGroup401JSONResponse Error
+
+func (response DeleteGroup401JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup403JSONResponse Error
+
+func (response DeleteGroup403JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup404JSONResponse Error
+
+func (response DeleteGroup404JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup500JSONResponse Error
+
+func (response DeleteGroup500JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetGroupResponseObject interface {
+	VisitGetGroupResponse(w http.ResponseWriter) error
+}
+
+type GetGroup200JSONResponse Group
+
+func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup401JSONResponse Error
+
+func (response GetGroup401JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup403JSONResponse Error
+
+func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup404JSONResponse Error
+
+func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroup500JSONResponse Error
+
+func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroupRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateGroupJSONRequestBody
+}
+
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateGroup200JSONResponse Group
+
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup400JSONResponse Error
+
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup401JSONResponse Error
+
+func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup403JSONResponse Error
+
+func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup404JSONResponse Error
+
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup500JSONResponse Error
+
+func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembersRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListGroupMembersResponseObject interface {
+	VisitListGroupMembersResponse(w http.ResponseWriter) error
+}
+
+type ListGroupMembers200JSONResponse MemberList
+
+func (response ListGroupMembers200JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers401JSONResponse Error
+
+func (response ListGroupMembers401JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers403JSONResponse Error
+
+func (response ListGroupMembers403JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGroupMembers404JSONResponse Error
 
-func (response ListAssessments401JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+func (response ListGroupMembers404JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListAssessments500JSONResponse Error
+type ListGroupMembers500JSONResponse Error
 
-func (response ListAssessments500JSONResponse) VisitListAssessmentsResponse(w http.ResponseWriter) error {
+func (response ListGroupMembers500JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAssessmentRequestObject struct {
-	Body *CreateAssessmentJSONRequestBody
+type CreateGroupMemberRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreateGroupMemberJSONRequestBody
 }
 
-type CreateAssessmentResponseObject interface {
-	VisitCreateAssessmentResponse(w http.ResponseWriter) error
+type CreateGroupMemberResponseObject interface {
+	VisitCreateGroupMemberResponse(w http.ResponseWriter) error
 }
 
-type CreateAssessment201JSONResponse Assessment
+type CreateGroupMember201JSONResponse Member
 
-func (response CreateAssessment201JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember201JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAssessment400JSONResponse Error
+type CreateGroupMember400JSONResponse Error
 
-func (response CreateAssessment400JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember400JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAssessment401JSONResponse Error
+type CreateGroupMember401JSONResponse Error
 
-func (response CreateAssessment401JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember401JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateAssessment500JSONResponse Error
+type CreateGroupMember403JSONResponse Error
 
-func (response CreateAssessment500JSONResponse) VisitCreateAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember403JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJobRequestObject struct {
-	Id int64 `json:"id"`
-}
+type CreateGroupMember404JSONResponse Error
 
-type CancelJobResponseObject interface {
-	VisitCancelJobResponse(w http.ResponseWriter) error
+func (response CreateGroupMember404JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob200JSONResponse Job
+type CreateGroupMember409JSONResponse Error
 
-func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember409JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob400JSONResponse Error
+type CreateGroupMember500JSONResponse Error
 
-func (response CancelJob400JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response CreateGroupMember500JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob401JSONResponse Error
+type RemoveGroupMemberRequestObject struct {
+	Id       openapi_types.UUID `json:"id"`
+	Username string             `json:"username"`
+}
 
-func (response CancelJob401JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+type RemoveGroupMemberResponseObject interface {
+	VisitRemoveGroupMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveGroupMember200Response struct {
+}
+
+func (response RemoveGroupMember200Response) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RemoveGroupMember400JSONResponse Error
+
+func (response RemoveGroupMember400JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob403JSONResponse Error
+type RemoveGroupMember401JSONResponse Error
 
-func (response CancelJob403JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response RemoveGroupMember401JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob404JSONResponse Error
+type RemoveGroupMember403JSONResponse Error
 
-func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response RemoveGroupMember403JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob409JSONResponse Error
+type RemoveGroupMember404JSONResponse Error
 
-func (response CancelJob409JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response RemoveGroupMember404JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelJob500JSONResponse Error
+type RemoveGroupMember500JSONResponse Error
 
-func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+func (response RemoveGroupMember500JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJobRequestObject struct {
-	Id int64 `json:"id"`
+type UpdateGroupMemberRequestObject struct {
+	Id       openapi_types.UUID `json:"id"`
+	Username string             `json:"username"`
+	Body     *UpdateGroupMemberJSONRequestBody
 }
 
-type GetJobResponseObject interface {
-	VisitGetJobResponse(w http.ResponseWriter) error
+type UpdateGroupMemberResponseObject interface {
+	VisitUpdateGroupMemberResponse(w http.ResponseWriter) error
 }
 
-type GetJob200JSONResponse Job
+type UpdateGroupMember200JSONResponse Member
 
-func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember200JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJob400JSONResponse Error
+type UpdateGroupMember400JSONResponse Error
 
-func (response GetJob400JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember400JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJob401JSONResponse Error
+type UpdateGroupMember401JSONResponse Error
 
-func (response GetJob401JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember401JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJob403JSONResponse Error
+type UpdateGroupMember403JSONResponse Error
 
-func (response GetJob403JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember403JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJob404JSONResponse Error
+type UpdateGroupMember404JSONResponse Error
 
-func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember404JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetJob500JSONResponse Error
+type UpdateGroupMember500JSONResponse Error
 
-func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+func (response UpdateGroupMember500JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRVToolsAssessmentRequestObject struct {
-	Body *multipart.Reader
+type ListHardwareSkusRequestObject struct {
 }
 
-type CreateRVToolsAssessmentResponseObject interface {
-	VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error
+type ListHardwareSkusResponseObject interface {
+	VisitListHardwareSkusResponse(w http.ResponseWriter) error
 }
 
-type CreateRVToolsAssessment202JSONResponse Job
+type ListHardwareSkus200JSONResponse HardwareSkuList
 
-func (response CreateRVToolsAssessment202JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+func (response ListHardwareSkus200JSONResponse) VisitListHardwareSkusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRVToolsAssessment400JSONResponse Error
+type ListHardwareSkus401JSONResponse Error
 
-func (response CreateRVToolsAssessment400JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+func (response ListHardwareSkus401JSONResponse) VisitListHardwareSkusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHardwareSkus500JSONResponse Error
+
+func (response ListHardwareSkus500JSONResponse) VisitListHardwareSkusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHardwareSkuRequestObject struct {
+	Body *CreateHardwareSkuJSONRequestBody
+}
+
+type CreateHardwareSkuResponseObject interface {
+	VisitCreateHardwareSkuResponse(w http.ResponseWriter) error
+}
+
+type CreateHardwareSku201JSONResponse HardwareSku
+
+func (response CreateHardwareSku201JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHardwareSku400JSONResponse Error
+
+func (response CreateHardwareSku400JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRVToolsAssessment401JSONResponse Error
+type CreateHardwareSku401JSONResponse Error
 
-func (response CreateRVToolsAssessment401JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateHardwareSku401JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRVToolsAssessment500JSONResponse Error
+type CreateHardwareSku403JSONResponse Error
 
-func (response CreateRVToolsAssessment500JSONResponse) VisitCreateRVToolsAssessmentResponse(w http.ResponseWriter) error {
+func (response CreateHardwareSku403JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHardwareSku409JSONResponse Error
+
+func (response CreateHardwareSku409JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHardwareSku500JSONResponse Error
+
+func (response CreateHardwareSku500JSONResponse) VisitCreateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type DeleteHardwareSkuRequestObject struct {
+	Name string `json:"name"`
 }
 
-type DeleteAssessmentResponseObject interface {
-	VisitDeleteAssessmentResponse(w http.ResponseWriter) error
+type DeleteHardwareSkuResponseObject interface {
+	VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error
 }
 
-type DeleteAssessment200JSONResponse Assessment
+type DeleteHardwareSku200JSONResponse HardwareSku
 
-func (response DeleteAssessment200JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku200JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessment400JSONResponse Error
+type DeleteHardwareSku400JSONResponse Error
 
-func (response DeleteAssessment400JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku400JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessment401JSONResponse Error
+type DeleteHardwareSku401JSONResponse Error
 
-func (response DeleteAssessment401JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku401JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessment403JSONResponse Error
+type DeleteHardwareSku403JSONResponse Error
 
-func (response DeleteAssessment403JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku403JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessment404JSONResponse Error
+type DeleteHardwareSku404JSONResponse Error
 
-func (response DeleteAssessment404JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku404JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteAssessment500JSONResponse Error
+type DeleteHardwareSku500JSONResponse Error
 
-func (response DeleteAssessment500JSONResponse) VisitDeleteAssessmentResponse(w http.ResponseWriter) error {
+func (response DeleteHardwareSku500JSONResponse) VisitDeleteHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type UpdateHardwareSkuRequestObject struct {
+	Name string `json:"name"`
+	Body *UpdateHardwareSkuJSONRequestBody
 }
 
-type GetAssessmentResponseObject interface {
-	VisitGetAssessmentResponse(w http.ResponseWriter) error
+type UpdateHardwareSkuResponseObject interface {
+	VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error
 }
 
-type GetAssessment200JSONResponse Assessment
+type UpdateHardwareSku200JSONResponse HardwareSku
 
-func (response GetAssessment200JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku200JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessment400JSONResponse Error
+type UpdateHardwareSku400JSONResponse Error
 
-func (response GetAssessment400JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku400JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessment401JSONResponse Error
+type UpdateHardwareSku401JSONResponse Error
 
-func (response GetAssessment401JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku401JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessment403JSONResponse Error
+type UpdateHardwareSku403JSONResponse Error
 
-func (response GetAssessment403JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku403JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessment404JSONResponse Error
+type UpdateHardwareSku404JSONResponse Error
 
-func (response GetAssessment404JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku404JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessment500JSONResponse Error
+type UpdateHardwareSku500JSONResponse Error
 
-func (response GetAssessment500JSONResponse) VisitGetAssessmentResponse(w http.ResponseWriter) error {
+func (response UpdateHardwareSku500JSONResponse) VisitUpdateHardwareSkuResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAssessmentRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateAssessmentJSONRequestBody
+type GetIdentityRequestObject struct {
 }
 
-type UpdateAssessmentResponseObject interface {
-	VisitUpdateAssessmentResponse(w http.ResponseWriter) error
+type GetIdentityResponseObject interface {
+	VisitGetIdentityResponse(w http.ResponseWriter) error
 }
 
-type UpdateAssessment200JSONResponse Assessment
+type GetIdentity200JSONResponse Identity
 
-func (response UpdateAssessment200JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+func (response GetIdentity200JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAssessment400JSONResponse Error
+type GetIdentity401JSONResponse Error
 
-func (response UpdateAssessment400JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+func (response GetIdentity401JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAssessment401JSONResponse Error
+type GetIdentity500JSONResponse Error
 
-func (response UpdateAssessment401JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+func (response GetIdentity500JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAssessment403JSONResponse Error
-
-func (response UpdateAssessment403JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+type GetInfoRequestObject struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+type GetInfoResponseObject interface {
+	VisitGetInfoResponse(w http.ResponseWriter) error
 }
 
-type UpdateAssessment404JSONResponse Error
+type GetInfo200JSONResponse Info
 
-func (response UpdateAssessment404JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateAssessment500JSONResponse Error
+type GetInfo500JSONResponse Error
 
-func (response UpdateAssessment500JSONResponse) VisitUpdateAssessmentResponse(w http.ResponseWriter) error {
+func (response GetInfo500JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirementsRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateAssessmentClusterRequirementsJSONRequestBody
+type ListEstimationSchemasRequestObject struct {
 }
 
-type CalculateAssessmentClusterRequirementsResponseObject interface {
-	VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error
+type ListEstimationSchemasResponseObject interface {
+	VisitListEstimationSchemasResponse(w http.ResponseWriter) error
 }
 
-type CalculateAssessmentClusterRequirements200JSONResponse ClusterRequirementsResponse
+type ListEstimationSchemas200JSONResponse EstimationSchemaList
 
-func (response CalculateAssessmentClusterRequirements200JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListEstimationSchemas200JSONResponse) VisitListEstimationSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements400JSONResponse Error
+type ListEstimationSchemas401JSONResponse Error
 
-func (response CalculateAssessmentClusterRequirements400JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListEstimationSchemas401JSONResponse) VisitListEstimationSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements401JSONResponse Error
+type ListEstimationSchemas500JSONResponse Error
 
-func (response CalculateAssessmentClusterRequirements401JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListEstimationSchemas500JSONResponse) VisitListEstimationSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements403JSONResponse Error
+type ListComplexityTablesRequestObject struct {
+	OrgId string `json:"orgId"`
+}
 
-func (response CalculateAssessmentClusterRequirements403JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+type ListComplexityTablesResponseObject interface {
+	VisitListComplexityTablesResponse(w http.ResponseWriter) error
+}
+
+type ListComplexityTables200JSONResponse ComplexityTableList
+
+func (response ListComplexityTables200JSONResponse) VisitListComplexityTablesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements404JSONResponse Error
+type ListComplexityTables401JSONResponse Error
 
-func (response CalculateAssessmentClusterRequirements404JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListComplexityTables401JSONResponse) VisitListComplexityTablesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements500JSONResponse Error
+type ListComplexityTables403JSONResponse Error
 
-func (response CalculateAssessmentClusterRequirements500JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListComplexityTables403JSONResponse) VisitListComplexityTablesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateAssessmentClusterRequirements503JSONResponse Error
+type ListComplexityTables500JSONResponse Error
 
-func (response CalculateAssessmentClusterRequirements503JSONResponse) VisitCalculateAssessmentClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response ListComplexityTables500JSONResponse) VisitListComplexityTablesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInputRequestObject struct {
-	Id     openapi_types.UUID `json:"id"`
-	Params GetAssessmentClusterRequirementsStoredInputParams
+type CreateComplexityTableRequestObject struct {
+	OrgId string `json:"orgId"`
+	Body  *CreateComplexityTableJSONRequestBody
 }
 
-type GetAssessmentClusterRequirementsStoredInputResponseObject interface {
-	VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error
+type CreateComplexityTableResponseObject interface {
+	VisitCreateComplexityTableResponse(w http.ResponseWriter) error
 }
 
-type GetAssessmentClusterRequirementsStoredInput200JSONResponse ClusterRequirementsStoredInput
+type CreateComplexityTable201JSONResponse ComplexityTable
 
-func (response GetAssessmentClusterRequirementsStoredInput200JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable201JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInput400JSONResponse Error
+type CreateComplexityTable400JSONResponse Error
 
-func (response GetAssessmentClusterRequirementsStoredInput400JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable400JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInput401JSONResponse Error
+type CreateComplexityTable401JSONResponse Error
 
-func (response GetAssessmentClusterRequirementsStoredInput401JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable401JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInput403JSONResponse Error
+type CreateComplexityTable403JSONResponse Error
 
-func (response GetAssessmentClusterRequirementsStoredInput403JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable403JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInput404JSONResponse Error
+type CreateComplexityTable409JSONResponse Error
 
-func (response GetAssessmentClusterRequirementsStoredInput404JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable409JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentClusterRequirementsStoredInput500JSONResponse Error
+type CreateComplexityTable500JSONResponse Error
 
-func (response GetAssessmentClusterRequirementsStoredInput500JSONResponse) VisitGetAssessmentClusterRequirementsStoredInputResponse(w http.ResponseWriter) error {
+func (response CreateComplexityTable500JSONResponse) VisitCreateComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationComplexityRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateMigrationComplexityJSONRequestBody
+type ResetComplexityTableRequestObject struct {
+	OrgId string `json:"orgId"`
 }
 
-type CalculateMigrationComplexityResponseObject interface {
-	VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error
+type ResetComplexityTableResponseObject interface {
+	VisitResetComplexityTableResponse(w http.ResponseWriter) error
 }
 
-type CalculateMigrationComplexity200JSONResponse MigrationComplexityResponse
+type ResetComplexityTable200JSONResponse ComplexityTable
 
-func (response CalculateMigrationComplexity200JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+func (response ResetComplexityTable200JSONResponse) VisitResetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationComplexity400JSONResponse Error
-
-func (response CalculateMigrationComplexity400JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CalculateMigrationComplexity401JSONResponse Error
+type ResetComplexityTable401JSONResponse Error
 
-func (response CalculateMigrationComplexity401JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+func (response ResetComplexityTable401JSONResponse) VisitResetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationComplexity403JSONResponse Error
+type ResetComplexityTable403JSONResponse Error
 
-func (response CalculateMigrationComplexity403JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+func (response ResetComplexityTable403JSONResponse) VisitResetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationComplexity404JSONResponse Error
-
-func (response CalculateMigrationComplexity404JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CalculateMigrationComplexity500JSONResponse Error
+type ResetComplexityTable500JSONResponse Error
 
-func (response CalculateMigrationComplexity500JSONResponse) VisitCalculateMigrationComplexityResponse(w http.ResponseWriter) error {
+func (response ResetComplexityTable500JSONResponse) VisitResetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentEnhancementDataRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type GetActiveComplexityTableRequestObject struct {
+	OrgId string `json:"orgId"`
 }
 
-type GetAssessmentEnhancementDataResponseObject interface {
-	VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error
+type GetActiveComplexityTableResponseObject interface {
+	VisitGetActiveComplexityTableResponse(w http.ResponseWriter) error
 }
 
-type GetAssessmentEnhancementData200JSONResponse EnhancementData
+type GetActiveComplexityTable200JSONResponse ComplexityTable
 
-func (response GetAssessmentEnhancementData200JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetActiveComplexityTable200JSONResponse) VisitGetActiveComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentEnhancementData401JSONResponse Error
+type GetActiveComplexityTable401JSONResponse Error
 
-func (response GetAssessmentEnhancementData401JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetActiveComplexityTable401JSONResponse) VisitGetActiveComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentEnhancementData403JSONResponse Error
+type GetActiveComplexityTable403JSONResponse Error
 
-func (response GetAssessmentEnhancementData403JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetActiveComplexityTable403JSONResponse) VisitGetActiveComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAssessmentEnhancementData404JSONResponse Error
-
-func (response GetAssessmentEnhancementData404JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetAssessmentEnhancementData500JSONResponse Error
+type GetActiveComplexityTable500JSONResponse Error
 
-func (response GetAssessmentEnhancementData500JSONResponse) VisitGetAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetActiveComplexityTable500JSONResponse) VisitGetActiveComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SaveAssessmentEnhancementDataRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *SaveAssessmentEnhancementDataJSONRequestBody
+type GetComplexityTableRequestObject struct {
+	OrgId   string `json:"orgId"`
+	Version string `json:"version"`
 }
 
-type SaveAssessmentEnhancementDataResponseObject interface {
-	VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error
+type GetComplexityTableResponseObject interface {
+	VisitGetComplexityTableResponse(w http.ResponseWriter) error
 }
 
-type SaveAssessmentEnhancementData200JSONResponse EnhancementData
+type GetComplexityTable200JSONResponse ComplexityTable
 
-func (response SaveAssessmentEnhancementData200JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetComplexityTable200JSONResponse) VisitGetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SaveAssessmentEnhancementData400JSONResponse Error
-
-func (response SaveAssessmentEnhancementData400JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type SaveAssessmentEnhancementData401JSONResponse Error
+type GetComplexityTable401JSONResponse Error
 
-func (response SaveAssessmentEnhancementData401JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetComplexityTable401JSONResponse) VisitGetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SaveAssessmentEnhancementData403JSONResponse Error
+type GetComplexityTable403JSONResponse Error
 
-func (response SaveAssessmentEnhancementData403JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetComplexityTable403JSONResponse) VisitGetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SaveAssessmentEnhancementData404JSONResponse Error
+type GetComplexityTable404JSONResponse Error
 
-func (response SaveAssessmentEnhancementData404JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetComplexityTable404JSONResponse) VisitGetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SaveAssessmentEnhancementData500JSONResponse Error
+type GetComplexityTable500JSONResponse Error
 
-func (response SaveAssessmentEnhancementData500JSONResponse) VisitSaveAssessmentEnhancementDataResponse(w http.ResponseWriter) error {
+func (response GetComplexityTable500JSONResponse) VisitGetComplexityTableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateMigrationEstimationJSONRequestBody
+type ListPolicyBundlesRequestObject struct {
+	OrgId string `json:"orgId"`
 }
 
-type CalculateMigrationEstimationResponseObject interface {
-	VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error
+type ListPolicyBundlesResponseObject interface {
+	VisitListPolicyBundlesResponse(w http.ResponseWriter) error
 }
 
-type CalculateMigrationEstimation200JSONResponse MigrationEstimationResponse
+type ListPolicyBundles200JSONResponse PolicyBundleList
 
-func (response CalculateMigrationEstimation200JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+func (response ListPolicyBundles200JSONResponse) VisitListPolicyBundlesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimation400JSONResponse Error
-
-func (response CalculateMigrationEstimation400JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CalculateMigrationEstimation401JSONResponse Error
+type ListPolicyBundles401JSONResponse Error
 
-func (response CalculateMigrationEstimation401JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+func (response ListPolicyBundles401JSONResponse) VisitListPolicyBundlesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimation403JSONResponse Error
+type ListPolicyBundles403JSONResponse Error
 
-func (response CalculateMigrationEstimation403JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+func (response ListPolicyBundles403JSONResponse) VisitListPolicyBundlesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimation404JSONResponse Error
-
-func (response CalculateMigrationEstimation404JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CalculateMigrationEstimation500JSONResponse Error
+type ListPolicyBundles500JSONResponse Error
 
-func (response CalculateMigrationEstimation500JSONResponse) VisitCalculateMigrationEstimationResponse(w http.ResponseWriter) error {
+func (response ListPolicyBundles500JSONResponse) VisitListPolicyBundlesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexityRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CalculateMigrationEstimationByComplexityJSONRequestBody
+type CreatePolicyBundleRequestObject struct {
+	OrgId string `json:"orgId"`
+	Body  *CreatePolicyBundleJSONRequestBody
 }
 
-type CalculateMigrationEstimationByComplexityResponseObject interface {
-	VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error
+type CreatePolicyBundleResponseObject interface {
+	VisitCreatePolicyBundleResponse(w http.ResponseWriter) error
 }
 
-type CalculateMigrationEstimationByComplexity200JSONResponse MigrationEstimationByComplexityResponse
+type CreatePolicyBundle201JSONResponse PolicyBundle
 
-func (response CalculateMigrationEstimationByComplexity200JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle201JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexity400JSONResponse Error
+type CreatePolicyBundle400JSONResponse Error
 
-func (response CalculateMigrationEstimationByComplexity400JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle400JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexity401JSONResponse Error
+type CreatePolicyBundle401JSONResponse Error
 
-func (response CalculateMigrationEstimationByComplexity401JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle401JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexity403JSONResponse Error
+type CreatePolicyBundle403JSONResponse Error
 
-func (response CalculateMigrationEstimationByComplexity403JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle403JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexity404JSONResponse Error
+type CreatePolicyBundle409JSONResponse Error
 
-func (response CalculateMigrationEstimationByComplexity404JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle409JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateMigrationEstimationByComplexity500JSONResponse Error
+type CreatePolicyBundle500JSONResponse Error
 
-func (response CalculateMigrationEstimationByComplexity500JSONResponse) VisitCalculateMigrationEstimationByComplexityResponse(w http.ResponseWriter) error {
+func (response CreatePolicyBundle500JSONResponse) VisitCreatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnshareAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type DeactivatePolicyBundleRequestObject struct {
+	OrgId string `json:"orgId"`
 }
 
-type UnshareAssessmentResponseObject interface {
-	VisitUnshareAssessmentResponse(w http.ResponseWriter) error
+type DeactivatePolicyBundleResponseObject interface {
+	VisitDeactivatePolicyBundleResponse(w http.ResponseWriter) error
 }
 
-type UnshareAssessment200JSONResponse Status
-
-func (response UnshareAssessment200JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type DeactivatePolicyBundle204Response struct {
 }
 
-type UnshareAssessment400JSONResponse Error
-
-func (response UnshareAssessment400JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (response DeactivatePolicyBundle204Response) VisitDeactivatePolicyBundleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type UnshareAssessment401JSONResponse Error
+type DeactivatePolicyBundle401JSONResponse Error
 
-func (response UnshareAssessment401JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+func (response DeactivatePolicyBundle401JSONResponse) VisitDeactivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnshareAssessment403JSONResponse Error
+type DeactivatePolicyBundle403JSONResponse Error
 
-func (response UnshareAssessment403JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+func (response DeactivatePolicyBundle403JSONResponse) VisitDeactivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UnshareAssessment404JSONResponse Error
-
-func (response UnshareAssessment404JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UnshareAssessment500JSONResponse Error
+type DeactivatePolicyBundle500JSONResponse Error
 
-func (response UnshareAssessment500JSONResponse) VisitUnshareAssessmentResponse(w http.ResponseWriter) error {
+func (response DeactivatePolicyBundle500JSONResponse) VisitDeactivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ShareAssessmentRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type GetActivePolicyBundleRequestObject struct {
+	OrgId string `json:"orgId"`
 }
 
-type ShareAssessmentResponseObject interface {
-	VisitShareAssessmentResponse(w http.ResponseWriter) error
+type GetActivePolicyBundleResponseObject interface {
+	VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error
 }
 
-type ShareAssessment200JSONResponse Status
+type GetActivePolicyBundle200JSONResponse PolicyBundle
 
-func (response ShareAssessment200JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+func (response GetActivePolicyBundle200JSONResponse) VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ShareAssessment400JSONResponse Error
-
-func (response ShareAssessment400JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ShareAssessment401JSONResponse Error
+type GetActivePolicyBundle401JSONResponse Error
 
-func (response ShareAssessment401JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+func (response GetActivePolicyBundle401JSONResponse) VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ShareAssessment403JSONResponse Error
+type GetActivePolicyBundle403JSONResponse Error
 
-func (response ShareAssessment403JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+func (response GetActivePolicyBundle403JSONResponse) VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ShareAssessment404JSONResponse Error
+type GetActivePolicyBundle404JSONResponse Error
 
-func (response ShareAssessment404JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+func (response GetActivePolicyBundle404JSONResponse) VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ShareAssessment500JSONResponse Error
+type GetActivePolicyBundle500JSONResponse Error
 
-func (response ShareAssessment500JSONResponse) VisitShareAssessmentResponse(w http.ResponseWriter) error {
+func (response GetActivePolicyBundle500JSONResponse) VisitGetActivePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateClusterRequirementsRequestObject struct {
-	Body *CalculateClusterRequirementsJSONRequestBody
+type GetPolicyBundleRequestObject struct {
+	OrgId    string `json:"orgId"`
+	Revision int    `json:"revision"`
 }
-
-type CalculateClusterRequirementsResponseObject interface {
-	VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error
+
+type GetPolicyBundleResponseObject interface {
+	VisitGetPolicyBundleResponse(w http.ResponseWriter) error
 }
 
-type CalculateClusterRequirements200JSONResponse StandaloneClusterRequirementsResponse
+type GetPolicyBundle200JSONResponse PolicyBundle
 
-func (response CalculateClusterRequirements200JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response GetPolicyBundle200JSONResponse) VisitGetPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateClusterRequirements400JSONResponse Error
+type GetPolicyBundle401JSONResponse Error
 
-func (response CalculateClusterRequirements400JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response GetPolicyBundle401JSONResponse) VisitGetPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateClusterRequirements401JSONResponse Error
+type GetPolicyBundle403JSONResponse Error
 
-func (response CalculateClusterRequirements401JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response GetPolicyBundle403JSONResponse) VisitGetPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateClusterRequirements500JSONResponse Error
+type GetPolicyBundle404JSONResponse Error
 
-func (response CalculateClusterRequirements500JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response GetPolicyBundle404JSONResponse) VisitGetPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CalculateClusterRequirements503JSONResponse Error
+type GetPolicyBundle500JSONResponse Error
 
-func (response CalculateClusterRequirements503JSONResponse) VisitCalculateClusterRequirementsResponse(w http.ResponseWriter) error {
+func (response GetPolicyBundle500JSONResponse) VisitGetPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCustomersRequestObject struct {
+type ActivatePolicyBundleRequestObject struct {
+	OrgId    string `json:"orgId"`
+	Revision int    `json:"revision"`
 }
 
-type ListCustomersResponseObject interface {
-	VisitListCustomersResponse(w http.ResponseWriter) error
+type ActivatePolicyBundleResponseObject interface {
+	VisitActivatePolicyBundleResponse(w http.ResponseWriter) error
 }
 
-type ListCustomers200JSONResponse CustomerList
+type ActivatePolicyBundle200JSONResponse PolicyBundle
 
-func (response ListCustomers200JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+func (response ActivatePolicyBundle200JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCustomers400JSONResponse Error
+type ActivatePolicyBundle400JSONResponse Error
 
-func (response ListCustomers400JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+func (response ActivatePolicyBundle400JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCustomers401JSONResponse Error
+type ActivatePolicyBundle401JSONResponse Error
 
-func (response ListCustomers401JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+func (response ActivatePolicyBundle401JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCustomers403JSONResponse Error
+type ActivatePolicyBundle403JSONResponse Error
 
-func (response ListCustomers403JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+func (response ActivatePolicyBundle403JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCustomers500JSONResponse Error
+type ActivatePolicyBundle404JSONResponse Error
 
-func (response ListCustomers500JSONResponse) VisitListCustomersResponse(w http.ResponseWriter) error {
+func (response ActivatePolicyBundle404JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomerRequestObject struct {
-	Username string `json:"username"`
+type ActivatePolicyBundle500JSONResponse Error
+
+func (response ActivatePolicyBundle500JSONResponse) VisitActivatePolicyBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomerResponseObject interface {
-	VisitRemoveCustomerResponse(w http.ResponseWriter) error
+type TestPolicyBundleRequestObject struct {
+	OrgId    string `json:"orgId"`
+	Revision int    `json:"revision"`
+	Body     *TestPolicyBundleJSONRequestBody
 }
 
-type RemoveCustomer200Response struct {
+type TestPolicyBundleResponseObject interface {
+	VisitTestPolicyBundleResponse(w http.ResponseWriter) error
 }
 
-func (response RemoveCustomer200Response) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+type TestPolicyBundle200JSONResponse PolicyBundleTestResult
+
+func (response TestPolicyBundle200JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomer400JSONResponse Error
+type TestPolicyBundle400JSONResponse Error
 
-func (response RemoveCustomer400JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+func (response TestPolicyBundle400JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomer401JSONResponse Error
+type TestPolicyBundle401JSONResponse Error
 
-func (response RemoveCustomer401JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+func (response TestPolicyBundle401JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomer403JSONResponse Error
+type TestPolicyBundle403JSONResponse Error
 
-func (response RemoveCustomer403JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+func (response TestPolicyBundle403JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomer404JSONResponse Error
+type TestPolicyBundle404JSONResponse Error
 
-func (response RemoveCustomer404JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+func (response TestPolicyBundle404JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveCustomer500JSONResponse Error
+type TestPolicyBundle500JSONResponse Error
 
-func (response RemoveCustomer500JSONResponse) VisitRemoveCustomerResponse(w http.ResponseWriter) error {
+func (response TestPolicyBundle500JSONResponse) VisitTestPolicyBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupsRequestObject struct {
-	Params ListGroupsParams
+type ListPartnersRequestObject struct {
 }
 
-type ListGroupsResponseObject interface {
-	VisitListGroupsResponse(w http.ResponseWriter) error
+type ListPartnersResponseObject interface {
+	VisitListPartnersResponse(w http.ResponseWriter) error
 }
 
-type ListGroups200JSONResponse GroupList
+type ListPartners200JSONResponse GroupList
 
-func (response ListGroups200JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+func (response ListPartners200JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroups401JSONResponse Error
+type ListPartners401JSONResponse Error
 
-func (response ListGroups401JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+func (response ListPartners401JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroups403JSONResponse Error
+type ListPartners500JSONResponse Error
 
-func (response ListGroups403JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+func (response ListPartners500JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroups500JSONResponse Error
+type ListPartnerRequestsRequestObject struct {
+}
 
-func (response ListGroups500JSONResponse) VisitListGroupsResponse(w http.ResponseWriter) error {
+type ListPartnerRequestsResponseObject interface {
+	VisitListPartnerRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListPartnerRequests200JSONResponse PartnerRequestList
+
+func (response ListPartnerRequests200JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupRequestObject struct {
-	Body *CreateGroupJSONRequestBody
-}
+type ListPartnerRequests401JSONResponse Error
 
-type CreateGroupResponseObject interface {
-	VisitCreateGroupResponse(w http.ResponseWriter) error
+func (response ListPartnerRequests401JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup201JSONResponse Group
+type ListPartnerRequests500JSONResponse Error
 
-func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response ListPartnerRequests500JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup400JSONResponse Error
+type CancelPartnerRequestRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
 
-func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type CancelPartnerRequestResponseObject interface {
+	VisitCancelPartnerRequestResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type CancelPartnerRequest200Response struct {
 }
 
-type CreateGroup401JSONResponse Error
+func (response CancelPartnerRequest200Response) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-func (response CreateGroup401JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+type CancelPartnerRequest401JSONResponse Error
+
+func (response CancelPartnerRequest401JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup403JSONResponse Error
+type CancelPartnerRequest404JSONResponse Error
 
-func (response CreateGroup403JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response CancelPartnerRequest404JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroup500JSONResponse Error
+type CancelPartnerRequest500JSONResponse Error
 
-func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+func (response CancelPartnerRequest500JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroupRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type UpdatePartnerRequestRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdatePartnerRequestJSONRequestBody
 }
 
-type DeleteGroupResponseObject interface {
-	VisitDeleteGroupResponse(w http.ResponseWriter) error
+type UpdatePartnerRequestResponseObject interface {
+	VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error
 }
 
-type DeleteGroup200JSONResponse Group
+type UpdatePartnerRequest200JSONResponse PartnerRequest
 
-func (response DeleteGroup200JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response UpdatePartnerRequest200JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup401JSONResponse Error
+type UpdatePartnerRequest400JSONResponse Error
 
-func (response DeleteGroup401JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response UpdatePartnerRequest400JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePartnerRequest401JSONResponse Error
+
+func (response UpdatePartnerRequest401JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup403JSONResponse Error
+type UpdatePartnerRequest403JSONResponse Error
 
-func (response DeleteGroup403JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response UpdatePartnerRequest403JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup404JSONResponse Error
+type UpdatePartnerRequest404JSONResponse Error
 
-func (response DeleteGroup404JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response UpdatePartnerRequest404JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteGroup500JSONResponse Error
+type UpdatePartnerRequest500JSONResponse Error
 
-func (response DeleteGroup500JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+func (response UpdatePartnerRequest500JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroupRequestObject struct {
+type LeavePartnerRequestObject struct {
 	Id openapi_types.UUID `json:"id"`
 }
 
-type GetGroupResponseObject interface {
-	VisitGetGroupResponse(w http.ResponseWriter) error
+type LeavePartnerResponseObject interface {
+	VisitLeavePartnerResponse(w http.ResponseWriter) error
 }
 
-type GetGroup200JSONResponse Group
+type LeavePartner200Response struct {
+}
 
-func (response GetGroup200JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response LeavePartner200Response) VisitLeavePartnerResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetGroup401JSONResponse Error
+type LeavePartner401JSONResponse Error
 
-func (response GetGroup401JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response LeavePartner401JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup403JSONResponse Error
+type LeavePartner403JSONResponse Error
 
-func (response GetGroup403JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response LeavePartner403JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup404JSONResponse Error
+type LeavePartner404JSONResponse Error
 
-func (response GetGroup404JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response LeavePartner404JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetGroup500JSONResponse Error
+type LeavePartner500JSONResponse Error
 
-func (response GetGroup500JSONResponse) VisitGetGroupResponse(w http.ResponseWriter) error {
+func (response LeavePartner500JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateGroupJSONRequestBody
+type GetPartnerRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type UpdateGroupResponseObject interface {
-	VisitUpdateGroupResponse(w http.ResponseWriter) error
+type GetPartnerResponseObject interface {
+	VisitGetPartnerResponse(w http.ResponseWriter) error
 }
 
-type UpdateGroup200JSONResponse Group
+type GetPartner200JSONResponse Group
 
-func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response GetPartner200JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup400JSONResponse Error
+type GetPartner401JSONResponse Error
 
-func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response GetPartner401JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup401JSONResponse Error
+type GetPartner403JSONResponse Error
 
-func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response GetPartner403JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup403JSONResponse Error
+type GetPartner404JSONResponse Error
 
-func (response UpdateGroup403JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response GetPartner404JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup404JSONResponse Error
+type GetPartner500JSONResponse Error
 
-func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response GetPartner500JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroup500JSONResponse Error
+type CreatePartnerRequestRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreatePartnerRequestJSONRequestBody
+}
+
+type CreatePartnerRequestResponseObject interface {
+	VisitCreatePartnerRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePartnerRequest201JSONResponse PartnerRequest
 
-func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest201JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembersRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
-
-type ListGroupMembersResponseObject interface {
-	VisitListGroupMembersResponse(w http.ResponseWriter) error
-}
-
-type ListGroupMembers200JSONResponse MemberList
+type CreatePartnerRequest400JSONResponse Error
 
-func (response ListGroupMembers200JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest400JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers401JSONResponse Error
+type CreatePartnerRequest401JSONResponse Error
 
-func (response ListGroupMembers401JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest401JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers403JSONResponse Error
+type CreatePartnerRequest403JSONResponse Error
 
-func (response ListGroupMembers403JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest403JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers404JSONResponse Error
+type CreatePartnerRequest404JSONResponse Error
 
-func (response ListGroupMembers404JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest404JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListGroupMembers500JSONResponse Error
+type CreatePartnerRequest500JSONResponse Error
 
-func (response ListGroupMembers500JSONResponse) VisitListGroupMembersResponse(w http.ResponseWriter) error {
+func (response CreatePartnerRequest500JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMemberRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CreateGroupMemberJSONRequestBody
+type ListSourcesRequestObject struct {
 }
 
-type CreateGroupMemberResponseObject interface {
-	VisitCreateGroupMemberResponse(w http.ResponseWriter) error
+type ListSourcesResponseObject interface {
+	VisitListSourcesResponse(w http.ResponseWriter) error
 }
 
-type CreateGroupMember201JSONResponse Member
+type ListSources200JSONResponse SourceList
 
-func (response CreateGroupMember201JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember400JSONResponse Error
+type ListSources401JSONResponse Error
 
-func (response CreateGroupMember400JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember401JSONResponse Error
+type ListSources500JSONResponse Error
 
-func (response CreateGroupMember401JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember403JSONResponse Error
+type CreateSourceRequestObject struct {
+	Body *CreateSourceJSONRequestBody
+}
 
-func (response CreateGroupMember403JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+type CreateSourceResponseObject interface {
+	VisitCreateSourceResponse(w http.ResponseWriter) error
+}
+
+type CreateSource201JSONResponse Source
+
+func (response CreateSource201JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember404JSONResponse Error
+type CreateSource400JSONResponse Error
 
-func (response CreateGroupMember404JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response CreateSource400JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember409JSONResponse Error
+type CreateSource401JSONResponse Error
 
-func (response CreateGroupMember409JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response CreateSource401JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateGroupMember500JSONResponse Error
+type CreateSource500JSONResponse Error
 
-func (response CreateGroupMember500JSONResponse) VisitCreateGroupMemberResponse(w http.ResponseWriter) error {
+func (response CreateSource500JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMemberRequestObject struct {
-	Id       openapi_types.UUID `json:"id"`
-	Username string             `json:"username"`
+type DeleteSourceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type RemoveGroupMemberResponseObject interface {
-	VisitRemoveGroupMemberResponse(w http.ResponseWriter) error
+type DeleteSourceResponseObject interface {
+	VisitDeleteSourceResponse(w http.ResponseWriter) error
 }
 
-type RemoveGroupMember200Response struct {
-}
+type DeleteSource200JSONResponse Source
 
-func (response RemoveGroupMember200Response) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource200JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember400JSONResponse Error
+type DeleteSource400JSONResponse Error
 
-func (response RemoveGroupMember400JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource400JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember401JSONResponse Error
+type DeleteSource401JSONResponse Error
 
-func (response RemoveGroupMember401JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource401JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember403JSONResponse Error
+type DeleteSource403JSONResponse Error
 
-func (response RemoveGroupMember403JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource403JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember404JSONResponse Error
+type DeleteSource404JSONResponse Error
 
-func (response RemoveGroupMember404JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource404JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveGroupMember500JSONResponse Error
+type DeleteSource500JSONResponse Error
 
-func (response RemoveGroupMember500JSONResponse) VisitRemoveGroupMemberResponse(w http.ResponseWriter) error {
+func (response DeleteSource500JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMemberRequestObject struct {
-	Id       openapi_types.UUID `json:"id"`
-	Username string             `json:"username"`
-	Body     *UpdateGroupMemberJSONRequestBody
+type GetSourceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type UpdateGroupMemberResponseObject interface {
-	VisitUpdateGroupMemberResponse(w http.ResponseWriter) error
+type GetSourceResponseObject interface {
+	VisitGetSourceResponse(w http.ResponseWriter) error
 }
 
-type UpdateGroupMember200JSONResponse Member
+type GetSource200JSONResponse Source
 
-func (response UpdateGroupMember200JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMember400JSONResponse Error
+type GetSource400JSONResponse Error
 
-func (response UpdateGroupMember400JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource400JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMember401JSONResponse Error
+type GetSource401JSONResponse Error
 
-func (response UpdateGroupMember401JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMember403JSONResponse Error
+type GetSource403JSONResponse Error
 
-func (response UpdateGroupMember403JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMember404JSONResponse Error
+type GetSource404JSONResponse Error
 
-func (response UpdateGroupMember404JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateGroupMember500JSONResponse Error
+type GetSource500JSONResponse Error
 
-func (response UpdateGroupMember500JSONResponse) VisitUpdateGroupMemberResponse(w http.ResponseWriter) error {
+func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIdentityRequestObject struct {
+type UpdateSourceRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateSourceJSONRequestBody
 }
 
-type GetIdentityResponseObject interface {
-	VisitGetIdentityResponse(w http.ResponseWriter) error
+type UpdateSourceResponseObject interface {
+	VisitUpdateSourceResponse(w http.ResponseWriter) error
 }
 
-type GetIdentity200JSONResponse Identity
+type UpdateSource200JSONResponse Source
 
-func (response GetIdentity200JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
+func (response UpdateSource200JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIdentity401JSONResponse Error
+type UpdateSource400JSONResponse Error
 
-func (response GetIdentity401JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
+func (response UpdateSource400JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetIdentity500JSONResponse Error
+type UpdateSource401JSONResponse Error
 
-func (response GetIdentity500JSONResponse) VisitGetIdentityResponse(w http.ResponseWriter) error {
+func (response UpdateSource401JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInfoRequestObject struct {
-}
+type UpdateSource403JSONResponse Error
 
-type GetInfoResponseObject interface {
-	VisitGetInfoResponse(w http.ResponseWriter) error
+func (response UpdateSource403JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInfo200JSONResponse Info
+type UpdateSource404JSONResponse Error
 
-func (response GetInfo200JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
+func (response UpdateSource404JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetInfo500JSONResponse Error
+type UpdateSource500JSONResponse Error
 
-func (response GetInfo500JSONResponse) VisitGetInfoResponse(w http.ResponseWriter) error {
+func (response UpdateSource500JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPartnersRequestObject struct {
+type HeadImageRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type ListPartnersResponseObject interface {
-	VisitListPartnersResponse(w http.ResponseWriter) error
+type HeadImageResponseObject interface {
+	VisitHeadImageResponse(w http.ResponseWriter) error
 }
 
-type ListPartners200JSONResponse GroupList
+type HeadImage200Response struct {
+}
 
-func (response ListPartners200JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response HeadImage200Response) VisitHeadImageResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type HeadImage400Response struct {
 }
 
-type ListPartners401JSONResponse Error
+func (response HeadImage400Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
 
-func (response ListPartners401JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+type HeadImage401Response struct {
+}
+
+func (response HeadImage401Response) VisitHeadImageResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type HeadImage403Response struct {
 }
 
-type ListPartners500JSONResponse Error
+func (response HeadImage403Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type HeadImage404Response struct {
+}
+
+func (response HeadImage404Response) VisitHeadImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type HeadImage500Response struct {
+}
 
-func (response ListPartners500JSONResponse) VisitListPartnersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response HeadImage500Response) VisitHeadImageResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type ListPartnerRequestsRequestObject struct {
+type GetSourceDownloadURLRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
 }
 
-type ListPartnerRequestsResponseObject interface {
-	VisitListPartnerRequestsResponse(w http.ResponseWriter) error
+type GetSourceDownloadURLResponseObject interface {
+	VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error
 }
 
-type ListPartnerRequests200JSONResponse PartnerRequestList
+type GetSourceDownloadURL200JSONResponse PresignedUrl
 
-func (response ListPartnerRequests200JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL200JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPartnerRequests401JSONResponse Error
+type GetSourceDownloadURL400JSONResponse Error
 
-func (response ListPartnerRequests401JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL400JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPartnerRequests500JSONResponse Error
+type GetSourceDownloadURL401JSONResponse Error
 
-func (response ListPartnerRequests500JSONResponse) VisitListPartnerRequestsResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL401JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelPartnerRequestRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
-
-type CancelPartnerRequestResponseObject interface {
-	VisitCancelPartnerRequestResponse(w http.ResponseWriter) error
-}
-
-type CancelPartnerRequest200Response struct {
-}
-
-func (response CancelPartnerRequest200Response) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type CancelPartnerRequest401JSONResponse Error
+type GetSourceDownloadURL403JSONResponse Error
 
-func (response CancelPartnerRequest401JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL403JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelPartnerRequest404JSONResponse Error
+type GetSourceDownloadURL404JSONResponse Error
 
-func (response CancelPartnerRequest404JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL404JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelPartnerRequest500JSONResponse Error
+type GetSourceDownloadURL500JSONResponse Error
 
-func (response CancelPartnerRequest500JSONResponse) VisitCancelPartnerRequestResponse(w http.ResponseWriter) error {
+func (response GetSourceDownloadURL500JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequestRequestObject struct {
+type UpdateInventoryRequestObject struct {
 	Id   openapi_types.UUID `json:"id"`
-	Body *UpdatePartnerRequestJSONRequestBody
+	Body *UpdateInventoryJSONRequestBody
 }
 
-type UpdatePartnerRequestResponseObject interface {
-	VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error
+type UpdateInventoryResponseObject interface {
+	VisitUpdateInventoryResponse(w http.ResponseWriter) error
 }
 
-type UpdatePartnerRequest200JSONResponse PartnerRequest
+type UpdateInventory200JSONResponse Source
 
-func (response UpdatePartnerRequest200JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory200JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequest400JSONResponse Error
+type UpdateInventory400JSONResponse Error
 
-func (response UpdatePartnerRequest400JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory400JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequest401JSONResponse Error
+type UpdateInventory401JSONResponse Error
 
-func (response UpdatePartnerRequest401JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory401JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequest403JSONResponse Error
+type UpdateInventory403JSONResponse Error
 
-func (response UpdatePartnerRequest403JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory403JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequest404JSONResponse Error
+type UpdateInventory404JSONResponse Error
 
-func (response UpdatePartnerRequest404JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory404JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdatePartnerRequest500JSONResponse Error
+type UpdateInventory500JSONResponse Error
 
-func (response UpdatePartnerRequest500JSONResponse) VisitUpdatePartnerRequestResponse(w http.ResponseWriter) error {
+func (response UpdateInventory500JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LeavePartnerRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+type HealthRequestObject struct {
 }
 
-type LeavePartnerResponseObject interface {
-	VisitLeavePartnerResponse(w http.ResponseWriter) error
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
 }
 
-type LeavePartner200Response struct {
+type Health200Response struct {
 }
 
-func (response LeavePartner200Response) VisitLeavePartnerResponse(w http.ResponseWriter) error {
+func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type LeavePartner401JSONResponse Error
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 
-func (response LeavePartner401JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (GET /api/v1/assessments)
+	ListAssessments(ctx context.Context, request ListAssessmentsRequestObject) (ListAssessmentsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments)
+	CreateAssessment(ctx context.Context, request CreateAssessmentRequestObject) (CreateAssessmentResponseObject, error)
 
-type LeavePartner403JSONResponse Error
+	// (DELETE /api/v1/assessments/jobs/{id})
+	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
 
-func (response LeavePartner403JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// (GET /api/v1/assessments/jobs/{id})
+	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments/rvtools)
+	CreateRVToolsAssessment(ctx context.Context, request CreateRVToolsAssessmentRequestObject) (CreateRVToolsAssessmentResponseObject, error)
 
-type LeavePartner404JSONResponse Error
+	// (DELETE /api/v1/assessments/{id})
+	DeleteAssessment(ctx context.Context, request DeleteAssessmentRequestObject) (DeleteAssessmentResponseObject, error)
 
-func (response LeavePartner404JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (GET /api/v1/assessments/{id})
+	GetAssessment(ctx context.Context, request GetAssessmentRequestObject) (GetAssessmentResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (PUT /api/v1/assessments/{id})
+	UpdateAssessment(ctx context.Context, request UpdateAssessmentRequestObject) (UpdateAssessmentResponseObject, error)
 
-type LeavePartner500JSONResponse Error
+	// (POST /api/v1/assessments/{id}/cluster-requirements)
+	CalculateAssessmentClusterRequirements(ctx context.Context, request CalculateAssessmentClusterRequirementsRequestObject) (CalculateAssessmentClusterRequirementsResponseObject, error)
 
-func (response LeavePartner500JSONResponse) VisitLeavePartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// (GET /api/v1/assessments/{id}/cluster-requirements/stored-input)
+	GetAssessmentClusterRequirementsStoredInput(ctx context.Context, request GetAssessmentClusterRequirementsStoredInputRequestObject) (GetAssessmentClusterRequirementsStoredInputResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments/{id}/complexity-estimation)
+	CalculateMigrationComplexity(ctx context.Context, request CalculateMigrationComplexityRequestObject) (CalculateMigrationComplexityResponseObject, error)
 
-type GetPartnerRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	// (GET /api/v1/assessments/{id}/enhancement-data)
+	GetAssessmentEnhancementData(ctx context.Context, request GetAssessmentEnhancementDataRequestObject) (GetAssessmentEnhancementDataResponseObject, error)
 
-type GetPartnerResponseObject interface {
-	VisitGetPartnerResponse(w http.ResponseWriter) error
-}
+	// (POST /api/v1/assessments/{id}/enhancement-data)
+	SaveAssessmentEnhancementData(ctx context.Context, request SaveAssessmentEnhancementDataRequestObject) (SaveAssessmentEnhancementDataResponseObject, error)
 
-type GetPartner200JSONResponse Group
+	// (POST /api/v1/assessments/{id}/hardware-options)
+	CalculateAssessmentHardwareOptions(ctx context.Context, request CalculateAssessmentHardwareOptionsRequestObject) (CalculateAssessmentHardwareOptionsResponseObject, error)
 
-func (response GetPartner200JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /api/v1/assessments/{id}/migration-estimation)
+	CalculateMigrationEstimation(ctx context.Context, request CalculateMigrationEstimationRequestObject) (CalculateMigrationEstimationResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments/{id}/migration-estimation/by-complexity)
+	CalculateMigrationEstimationByComplexity(ctx context.Context, request CalculateMigrationEstimationByComplexityRequestObject) (CalculateMigrationEstimationByComplexityResponseObject, error)
 
-type GetPartner401JSONResponse Error
+	// (GET /api/v1/assessments/{id}/right-sizing)
+	GetAssessmentRightSizing(ctx context.Context, request GetAssessmentRightSizingRequestObject) (GetAssessmentRightSizingResponseObject, error)
 
-func (response GetPartner401JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (POST /api/v1/assessments/{id}/rvtools)
+	UploadRVToolsAssessment(ctx context.Context, request UploadRVToolsAssessmentRequestObject) (UploadRVToolsAssessmentResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/assessments/{id}/share)
+	UnshareAssessment(ctx context.Context, request UnshareAssessmentRequestObject) (UnshareAssessmentResponseObject, error)
 
-type GetPartner403JSONResponse Error
+	// (POST /api/v1/assessments/{id}/share)
+	ShareAssessment(ctx context.Context, request ShareAssessmentRequestObject) (ShareAssessmentResponseObject, error)
 
-func (response GetPartner403JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// (GET /api/v1/assessments/{id}/snapshots)
+	ListAssessmentSnapshots(ctx context.Context, request ListAssessmentSnapshotsRequestObject) (ListAssessmentSnapshotsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/assessments/{id}/snapshots/diff)
+	DiffAssessmentSnapshots(ctx context.Context, request DiffAssessmentSnapshotsRequestObject) (DiffAssessmentSnapshotsResponseObject, error)
 
-type GetPartner404JSONResponse Error
+	// (GET /api/v1/assessments/{id}/snapshots/{snapshotId})
+	GetAssessmentSnapshot(ctx context.Context, request GetAssessmentSnapshotRequestObject) (GetAssessmentSnapshotResponseObject, error)
 
-func (response GetPartner404JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (POST /api/v1/assessments/{id}/topology-sizing)
+	CalculateAssessmentTopologySizing(ctx context.Context, request CalculateAssessmentTopologySizingRequestObject) (CalculateAssessmentTopologySizingResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments/{id}/transfer)
+	TransferAssessmentOwnership(ctx context.Context, request TransferAssessmentOwnershipRequestObject) (TransferAssessmentOwnershipResponseObject, error)
 
-type GetPartner500JSONResponse Error
+	// (GET /api/v1/assessments/{id}/vms)
+	ListAssessmentVMs(ctx context.Context, request ListAssessmentVMsRequestObject) (ListAssessmentVMsResponseObject, error)
 
-func (response GetPartner500JSONResponse) VisitGetPartnerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// (GET /api/v1/assessments/{id}/waivers)
+	ListConcernWaivers(ctx context.Context, request ListConcernWaiversRequestObject) (ListConcernWaiversResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/assessments/{id}/waivers)
+	CreateConcernWaiver(ctx context.Context, request CreateConcernWaiverRequestObject) (CreateConcernWaiverResponseObject, error)
 
-type CreatePartnerRequestRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *CreatePartnerRequestJSONRequestBody
-}
+	// (DELETE /api/v1/assessments/{id}/waivers/{waiverId})
+	DeleteConcernWaiver(ctx context.Context, request DeleteConcernWaiverRequestObject) (DeleteConcernWaiverResponseObject, error)
 
-type CreatePartnerRequestResponseObject interface {
-	VisitCreatePartnerRequestResponse(w http.ResponseWriter) error
-}
+	// (POST /api/v1/assessments/{id}/waves)
+	PlanMigrationWaves(ctx context.Context, request PlanMigrationWavesRequestObject) (PlanMigrationWavesResponseObject, error)
 
-type CreatePartnerRequest201JSONResponse PartnerRequest
+	// (GET /api/v1/audit)
+	ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error)
 
-func (response CreatePartnerRequest201JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// (POST /api/v1/cluster-requirements)
+	CalculateClusterRequirements(ctx context.Context, request CalculateClusterRequirementsRequestObject) (CalculateClusterRequirementsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/customers)
+	ListCustomers(ctx context.Context, request ListCustomersRequestObject) (ListCustomersResponseObject, error)
 
-type CreatePartnerRequest400JSONResponse Error
+	// (DELETE /api/v1/customers/{username})
+	RemoveCustomer(ctx context.Context, request RemoveCustomerRequestObject) (RemoveCustomerResponseObject, error)
 
-func (response CreatePartnerRequest400JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (GET /api/v1/groups)
+	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
 
-type CreatePartnerRequest401JSONResponse Error
+	// (DELETE /api/v1/groups/{id})
+	DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error)
 
-func (response CreatePartnerRequest401JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (GET /api/v1/groups/{id})
+	GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (PUT /api/v1/groups/{id})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+
+	// (GET /api/v1/groups/{id}/members)
+	ListGroupMembers(ctx context.Context, request ListGroupMembersRequestObject) (ListGroupMembersResponseObject, error)
+
+	// (POST /api/v1/groups/{id}/members)
+	CreateGroupMember(ctx context.Context, request CreateGroupMemberRequestObject) (CreateGroupMemberResponseObject, error)
+
+	// (DELETE /api/v1/groups/{id}/members/{username})
+	RemoveGroupMember(ctx context.Context, request RemoveGroupMemberRequestObject) (RemoveGroupMemberResponseObject, error)
+
+	// (PUT /api/v1/groups/{id}/members/{username})
+	UpdateGroupMember(ctx context.Context, request UpdateGroupMemberRequestObject) (UpdateGroupMemberResponseObject, error)
+
+	// (GET /api/v1/hardware-skus)
+	ListHardwareSkus(ctx context.Context, request ListHardwareSkusRequestObject) (ListHardwareSkusResponseObject, error)
+
+	// (POST /api/v1/hardware-skus)
+	CreateHardwareSku(ctx context.Context, request CreateHardwareSkuRequestObject) (CreateHardwareSkuResponseObject, error)
+
+	// (DELETE /api/v1/hardware-skus/{name})
+	DeleteHardwareSku(ctx context.Context, request DeleteHardwareSkuRequestObject) (DeleteHardwareSkuResponseObject, error)
+
+	// (PUT /api/v1/hardware-skus/{name})
+	UpdateHardwareSku(ctx context.Context, request UpdateHardwareSkuRequestObject) (UpdateHardwareSkuResponseObject, error)
 
-type CreatePartnerRequest403JSONResponse Error
+	// (GET /api/v1/identity)
+	GetIdentity(ctx context.Context, request GetIdentityRequestObject) (GetIdentityResponseObject, error)
 
-func (response CreatePartnerRequest403JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// (GET /api/v1/info)
+	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/migration-estimation/schemas)
+	ListEstimationSchemas(ctx context.Context, request ListEstimationSchemasRequestObject) (ListEstimationSchemasResponseObject, error)
 
-type CreatePartnerRequest404JSONResponse Error
+	// (GET /api/v1/organizations/{orgId}/complexity-tables)
+	ListComplexityTables(ctx context.Context, request ListComplexityTablesRequestObject) (ListComplexityTablesResponseObject, error)
 
-func (response CreatePartnerRequest404JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (POST /api/v1/organizations/{orgId}/complexity-tables)
+	CreateComplexityTable(ctx context.Context, request CreateComplexityTableRequestObject) (CreateComplexityTableResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/organizations/{orgId}/complexity-tables/active)
+	ResetComplexityTable(ctx context.Context, request ResetComplexityTableRequestObject) (ResetComplexityTableResponseObject, error)
 
-type CreatePartnerRequest500JSONResponse Error
+	// (GET /api/v1/organizations/{orgId}/complexity-tables/active)
+	GetActiveComplexityTable(ctx context.Context, request GetActiveComplexityTableRequestObject) (GetActiveComplexityTableResponseObject, error)
 
-func (response CreatePartnerRequest500JSONResponse) VisitCreatePartnerRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// (GET /api/v1/organizations/{orgId}/complexity-tables/{version})
+	GetComplexityTable(ctx context.Context, request GetComplexityTableRequestObject) (GetComplexityTableResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/organizations/{orgId}/policy-bundles)
+	ListPolicyBundles(ctx context.Context, request ListPolicyBundlesRequestObject) (ListPolicyBundlesResponseObject, error)
 
-type ListSourcesRequestObject struct {
-}
+	// (POST /api/v1/organizations/{orgId}/policy-bundles)
+	CreatePolicyBundle(ctx context.Context, request CreatePolicyBundleRequestObject) (CreatePolicyBundleResponseObject, error)
 
-type ListSourcesResponseObject interface {
-	VisitListSourcesResponse(w http.ResponseWriter) error
-}
+	// (DELETE /api/v1/organizations/{orgId}/policy-bundles/active)
+	DeactivatePolicyBundle(ctx context.Context, request DeactivatePolicyBundleRequestObject) (DeactivatePolicyBundleResponseObject, error)
 
-type ListSources200JSONResponse SourceList
+	// (GET /api/v1/organizations/{orgId}/policy-bundles/active)
+	GetActivePolicyBundle(ctx context.Context, request GetActivePolicyBundleRequestObject) (GetActivePolicyBundleResponseObject, error)
 
-func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (GET /api/v1/organizations/{orgId}/policy-bundles/{revision})
+	GetPolicyBundle(ctx context.Context, request GetPolicyBundleRequestObject) (GetPolicyBundleResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/activate)
+	ActivatePolicyBundle(ctx context.Context, request ActivatePolicyBundleRequestObject) (ActivatePolicyBundleResponseObject, error)
 
-type ListSources401JSONResponse Error
+	// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/test)
+	TestPolicyBundle(ctx context.Context, request TestPolicyBundleRequestObject) (TestPolicyBundleResponseObject, error)
 
-func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (GET /api/v1/partners)
+	ListPartners(ctx context.Context, request ListPartnersRequestObject) (ListPartnersResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/partners/requests)
+	ListPartnerRequests(ctx context.Context, request ListPartnerRequestsRequestObject) (ListPartnerRequestsResponseObject, error)
 
-type ListSources500JSONResponse Error
+	// (DELETE /api/v1/partners/requests/{id})
+	CancelPartnerRequest(ctx context.Context, request CancelPartnerRequestRequestObject) (CancelPartnerRequestResponseObject, error)
 
-func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// (PUT /api/v1/partners/requests/{id})
+	UpdatePartnerRequest(ctx context.Context, request UpdatePartnerRequestRequestObject) (UpdatePartnerRequestResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/partners/{id})
+	LeavePartner(ctx context.Context, request LeavePartnerRequestObject) (LeavePartnerResponseObject, error)
 
-type CreateSourceRequestObject struct {
-	Body *CreateSourceJSONRequestBody
-}
+	// (GET /api/v1/partners/{id})
+	GetPartner(ctx context.Context, request GetPartnerRequestObject) (GetPartnerResponseObject, error)
 
-type CreateSourceResponseObject interface {
-	VisitCreateSourceResponse(w http.ResponseWriter) error
-}
+	// (POST /api/v1/partners/{id}/request)
+	CreatePartnerRequest(ctx context.Context, request CreatePartnerRequestRequestObject) (CreatePartnerRequestResponseObject, error)
 
-type CreateSource201JSONResponse Source
+	// (GET /api/v1/sources)
+	ListSources(ctx context.Context, request ListSourcesRequestObject) (ListSourcesResponseObject, error)
 
-func (response CreateSource201JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// (POST /api/v1/sources)
+	CreateSource(ctx context.Context, request CreateSourceRequestObject) (CreateSourceResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/sources/{id})
+	DeleteSource(ctx context.Context, request DeleteSourceRequestObject) (DeleteSourceResponseObject, error)
 
-type CreateSource400JSONResponse Error
+	// (GET /api/v1/sources/{id})
+	GetSource(ctx context.Context, request GetSourceRequestObject) (GetSourceResponseObject, error)
 
-func (response CreateSource400JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (PUT /api/v1/sources/{id})
+	UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (HEAD /api/v1/sources/{id}/image)
+	HeadImage(ctx context.Context, request HeadImageRequestObject) (HeadImageResponseObject, error)
 
-type CreateSource401JSONResponse Error
+	// (GET /api/v1/sources/{id}/image-url)
+	GetSourceDownloadURL(ctx context.Context, request GetSourceDownloadURLRequestObject) (GetSourceDownloadURLResponseObject, error)
 
-func (response CreateSource401JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (PUT /api/v1/sources/{id}/inventory)
+	UpdateInventory(ctx context.Context, request UpdateInventoryRequestObject) (UpdateInventoryResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
+	// (GET /health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
 }
 
-type CreateSource500JSONResponse Error
-
-func (response CreateSource500JSONResponse) VisitCreateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	return json.NewEncoder(w).Encode(response)
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type DeleteSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type DeleteSourceResponseObject interface {
-	VisitDeleteSourceResponse(w http.ResponseWriter) error
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type DeleteSource200JSONResponse Source
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response DeleteSource200JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListAssessments operation middleware
+func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams) {
+	var request ListAssessmentsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type DeleteSource400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssessments(ctx, request.(ListAssessmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssessments")
+	}
 
-func (response DeleteSource400JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssessmentsResponseObject); ok {
+		if err := validResponse.VisitListAssessmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource401JSONResponse Error
-
-func (response DeleteSource401JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CreateAssessment operation middleware
+func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request) {
+	var request CreateAssessmentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteSource403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAssessment(ctx, request.(CreateAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAssessment")
+	}
 
-func (response DeleteSource403JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAssessmentResponseObject); ok {
+		if err := validResponse.VisitCreateAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSource404JSONResponse Error
-
-func (response DeleteSource404JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CancelJob operation middleware
+func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id int64) {
+	var request CancelJobRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type DeleteSource500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelJob")
+	}
 
-func (response DeleteSource500JSONResponse) VisitDeleteSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
+		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetJobRequestObject
 
-type GetSourceResponseObject interface {
-	VisitGetSourceResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type GetSource200JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJob")
+	}
 
-func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		if err := validResponse.VisitGetJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSource400JSONResponse Error
-
-func (response GetSource400JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateRVToolsAssessment operation middleware
+func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
+	var request CreateRVToolsAssessmentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-type GetSource401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRVToolsAssessment(ctx, request.(CreateRVToolsAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRVToolsAssessment")
+	}
 
-func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRVToolsAssessmentResponseObject); ok {
+		if err := validResponse.VisitCreateRVToolsAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSource403JSONResponse Error
-
-func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteAssessment operation middleware
+func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAssessmentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type GetSource404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAssessment(ctx, request.(DeleteAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAssessment")
+	}
 
-func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAssessmentResponseObject); ok {
+		if err := validResponse.VisitDeleteAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSource500JSONResponse Error
+// GetAssessment operation middleware
+func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAssessmentRequestObject
 
-func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessment(ctx, request.(GetAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessment")
+	}
 
-type UpdateSourceRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateSourceJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdateSourceResponseObject interface {
-	VisitUpdateSourceResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource200JSONResponse Source
+// UpdateAssessment operation middleware
+func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateAssessmentRequestObject
 
-func (response UpdateSource200JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateSource400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAssessment(ctx, request.(UpdateAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAssessment")
+	}
 
-func (response UpdateSource400JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAssessmentResponseObject); ok {
+		if err := validResponse.VisitUpdateAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource401JSONResponse Error
+// CalculateAssessmentClusterRequirements operation middleware
+func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateAssessmentClusterRequirementsRequestObject
 
-func (response UpdateSource401JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateAssessmentClusterRequirementsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateSource403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateAssessmentClusterRequirements(ctx, request.(CalculateAssessmentClusterRequirementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateAssessmentClusterRequirements")
+	}
 
-func (response UpdateSource403JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateAssessmentClusterRequirementsResponseObject); ok {
+		if err := validResponse.VisitCalculateAssessmentClusterRequirementsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSource404JSONResponse Error
-
-func (response UpdateSource404JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetAssessmentClusterRequirementsStoredInput operation middleware
+func (sh *strictHandler) GetAssessmentClusterRequirementsStoredInput(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetAssessmentClusterRequirementsStoredInputParams) {
+	var request GetAssessmentClusterRequirementsStoredInputRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type UpdateSource500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessmentClusterRequirementsStoredInput(ctx, request.(GetAssessmentClusterRequirementsStoredInputRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessmentClusterRequirementsStoredInput")
+	}
 
-func (response UpdateSource500JSONResponse) VisitUpdateSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentClusterRequirementsStoredInputResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentClusterRequirementsStoredInputResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HeadImageRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// CalculateMigrationComplexity operation middleware
+func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateMigrationComplexityRequestObject
 
-type HeadImageResponseObject interface {
-	VisitHeadImageResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type HeadImage200Response struct {
-}
+	var body CalculateMigrationComplexityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response HeadImage200Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateMigrationComplexity(ctx, request.(CalculateMigrationComplexityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateMigrationComplexity")
+	}
 
-type HeadImage400Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response HeadImage400Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateMigrationComplexityResponseObject); ok {
+		if err := validResponse.VisitCalculateMigrationComplexityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HeadImage401Response struct {
-}
+// GetAssessmentEnhancementData operation middleware
+func (sh *strictHandler) GetAssessmentEnhancementData(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAssessmentEnhancementDataRequestObject
 
-func (response HeadImage401Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	request.Id = id
 
-type HeadImage403Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessmentEnhancementData(ctx, request.(GetAssessmentEnhancementDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessmentEnhancementData")
+	}
 
-func (response HeadImage403Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type HeadImage404Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentEnhancementDataResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentEnhancementDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response HeadImage404Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// SaveAssessmentEnhancementData operation middleware
+func (sh *strictHandler) SaveAssessmentEnhancementData(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SaveAssessmentEnhancementDataRequestObject
 
-type HeadImage500Response struct {
-}
+	request.Id = id
 
-func (response HeadImage500Response) VisitHeadImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var body SaveAssessmentEnhancementDataJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSourceDownloadURLRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SaveAssessmentEnhancementData(ctx, request.(SaveAssessmentEnhancementDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SaveAssessmentEnhancementData")
+	}
 
-type GetSourceDownloadURLResponseObject interface {
-	VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SaveAssessmentEnhancementDataResponseObject); ok {
+		if err := validResponse.VisitSaveAssessmentEnhancementDataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceDownloadURL200JSONResponse PresignedUrl
+// CalculateAssessmentHardwareOptions operation middleware
+func (sh *strictHandler) CalculateAssessmentHardwareOptions(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateAssessmentHardwareOptionsRequestObject
 
-func (response GetSourceDownloadURL200JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateAssessmentHardwareOptionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSourceDownloadURL400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateAssessmentHardwareOptions(ctx, request.(CalculateAssessmentHardwareOptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateAssessmentHardwareOptions")
+	}
 
-func (response GetSourceDownloadURL400JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateAssessmentHardwareOptionsResponseObject); ok {
+		if err := validResponse.VisitCalculateAssessmentHardwareOptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceDownloadURL401JSONResponse Error
+// CalculateMigrationEstimation operation middleware
+func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateMigrationEstimationRequestObject
 
-func (response GetSourceDownloadURL401JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateMigrationEstimationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSourceDownloadURL403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateMigrationEstimation(ctx, request.(CalculateMigrationEstimationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateMigrationEstimation")
+	}
 
-func (response GetSourceDownloadURL403JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateMigrationEstimationResponseObject); ok {
+		if err := validResponse.VisitCalculateMigrationEstimationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSourceDownloadURL404JSONResponse Error
+// CalculateMigrationEstimationByComplexity operation middleware
+func (sh *strictHandler) CalculateMigrationEstimationByComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateMigrationEstimationByComplexityRequestObject
 
-func (response GetSourceDownloadURL404JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CalculateMigrationEstimationByComplexityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSourceDownloadURL500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateMigrationEstimationByComplexity(ctx, request.(CalculateMigrationEstimationByComplexityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateMigrationEstimationByComplexity")
+	}
 
-func (response GetSourceDownloadURL500JSONResponse) VisitGetSourceDownloadURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateMigrationEstimationByComplexityResponseObject); ok {
+		if err := validResponse.VisitCalculateMigrationEstimationByComplexityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventoryRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateInventoryJSONRequestBody
-}
+// GetAssessmentRightSizing operation middleware
+func (sh *strictHandler) GetAssessmentRightSizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetAssessmentRightSizingParams) {
+	var request GetAssessmentRightSizingRequestObject
 
-type UpdateInventoryResponseObject interface {
-	VisitUpdateInventoryResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type UpdateInventory200JSONResponse Source
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessmentRightSizing(ctx, request.(GetAssessmentRightSizingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessmentRightSizing")
+	}
 
-func (response UpdateInventory200JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentRightSizingResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentRightSizingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory400JSONResponse Error
+// UploadRVToolsAssessment operation middleware
+func (sh *strictHandler) UploadRVToolsAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UploadRVToolsAssessmentRequestObject
 
-func (response UpdateInventory400JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
-type UpdateInventory401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadRVToolsAssessment(ctx, request.(UploadRVToolsAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadRVToolsAssessment")
+	}
 
-func (response UpdateInventory401JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadRVToolsAssessmentResponseObject); ok {
+		if err := validResponse.VisitUploadRVToolsAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory403JSONResponse Error
+// UnshareAssessment operation middleware
+func (sh *strictHandler) UnshareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UnshareAssessmentRequestObject
 
-func (response UpdateInventory403JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UnshareAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateInventory404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnshareAssessment(ctx, request.(UnshareAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnshareAssessment")
+	}
 
-func (response UpdateInventory404JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnshareAssessmentResponseObject); ok {
+		if err := validResponse.VisitUnshareAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateInventory500JSONResponse Error
-
-func (response UpdateInventory500JSONResponse) VisitUpdateInventoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ShareAssessment operation middleware
+func (sh *strictHandler) ShareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ShareAssessmentRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
 
-type HealthRequestObject struct {
-}
+	var body ShareAssessmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ShareAssessment(ctx, request.(ShareAssessmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ShareAssessment")
+	}
 
-type Health200Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ShareAssessmentResponseObject); ok {
+		if err := validResponse.VisitShareAssessmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-
-	// (GET /api/v1/assessments)
-	ListAssessments(ctx context.Context, request ListAssessmentsRequestObject) (ListAssessmentsResponseObject, error)
+// ListAssessmentSnapshots operation middleware
+func (sh *strictHandler) ListAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListAssessmentSnapshotsRequestObject
 
-	// (POST /api/v1/assessments)
-	CreateAssessment(ctx context.Context, request CreateAssessmentRequestObject) (CreateAssessmentResponseObject, error)
+	request.Id = id
 
-	// (DELETE /api/v1/assessments/jobs/{id})
-	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssessmentSnapshots(ctx, request.(ListAssessmentSnapshotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssessmentSnapshots")
+	}
 
-	// (GET /api/v1/assessments/jobs/{id})
-	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/assessments/rvtools)
-	CreateRVToolsAssessment(ctx context.Context, request CreateRVToolsAssessmentRequestObject) (CreateRVToolsAssessmentResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssessmentSnapshotsResponseObject); ok {
+		if err := validResponse.VisitListAssessmentSnapshotsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/assessments/{id})
-	DeleteAssessment(ctx context.Context, request DeleteAssessmentRequestObject) (DeleteAssessmentResponseObject, error)
+// DiffAssessmentSnapshots operation middleware
+func (sh *strictHandler) DiffAssessmentSnapshots(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DiffAssessmentSnapshotsParams) {
+	var request DiffAssessmentSnapshotsRequestObject
 
-	// (GET /api/v1/assessments/{id})
-	GetAssessment(ctx context.Context, request GetAssessmentRequestObject) (GetAssessmentResponseObject, error)
+	request.Id = id
+	request.Params = params
 
-	// (PUT /api/v1/assessments/{id})
-	UpdateAssessment(ctx context.Context, request UpdateAssessmentRequestObject) (UpdateAssessmentResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffAssessmentSnapshots(ctx, request.(DiffAssessmentSnapshotsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffAssessmentSnapshots")
+	}
 
-	// (POST /api/v1/assessments/{id}/cluster-requirements)
-	CalculateAssessmentClusterRequirements(ctx context.Context, request CalculateAssessmentClusterRequirementsRequestObject) (CalculateAssessmentClusterRequirementsResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/assessments/{id}/cluster-requirements/stored-input)
-	GetAssessmentClusterRequirementsStoredInput(ctx context.Context, request GetAssessmentClusterRequirementsStoredInputRequestObject) (GetAssessmentClusterRequirementsStoredInputResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffAssessmentSnapshotsResponseObject); ok {
+		if err := validResponse.VisitDiffAssessmentSnapshotsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/v1/assessments/{id}/complexity-estimation)
-	CalculateMigrationComplexity(ctx context.Context, request CalculateMigrationComplexityRequestObject) (CalculateMigrationComplexityResponseObject, error)
+// GetAssessmentSnapshot operation middleware
+func (sh *strictHandler) GetAssessmentSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, snapshotId int) {
+	var request GetAssessmentSnapshotRequestObject
 
-	// (GET /api/v1/assessments/{id}/enhancement-data)
-	GetAssessmentEnhancementData(ctx context.Context, request GetAssessmentEnhancementDataRequestObject) (GetAssessmentEnhancementDataResponseObject, error)
+	request.Id = id
+	request.SnapshotId = snapshotId
 
-	// (POST /api/v1/assessments/{id}/enhancement-data)
-	SaveAssessmentEnhancementData(ctx context.Context, request SaveAssessmentEnhancementDataRequestObject) (SaveAssessmentEnhancementDataResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAssessmentSnapshot(ctx, request.(GetAssessmentSnapshotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAssessmentSnapshot")
+	}
 
-	// (POST /api/v1/assessments/{id}/migration-estimation)
-	CalculateMigrationEstimation(ctx context.Context, request CalculateMigrationEstimationRequestObject) (CalculateMigrationEstimationResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/assessments/{id}/migration-estimation/by-complexity)
-	CalculateMigrationEstimationByComplexity(ctx context.Context, request CalculateMigrationEstimationByComplexityRequestObject) (CalculateMigrationEstimationByComplexityResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAssessmentSnapshotResponseObject); ok {
+		if err := validResponse.VisitGetAssessmentSnapshotResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/assessments/{id}/share)
-	UnshareAssessment(ctx context.Context, request UnshareAssessmentRequestObject) (UnshareAssessmentResponseObject, error)
+// CalculateAssessmentTopologySizing operation middleware
+func (sh *strictHandler) CalculateAssessmentTopologySizing(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CalculateAssessmentTopologySizingRequestObject
 
-	// (POST /api/v1/assessments/{id}/share)
-	ShareAssessment(ctx context.Context, request ShareAssessmentRequestObject) (ShareAssessmentResponseObject, error)
+	request.Id = id
 
-	// (POST /api/v1/cluster-requirements)
-	CalculateClusterRequirements(ctx context.Context, request CalculateClusterRequirementsRequestObject) (CalculateClusterRequirementsResponseObject, error)
+	var body CalculateAssessmentTopologySizingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /api/v1/customers)
-	ListCustomers(ctx context.Context, request ListCustomersRequestObject) (ListCustomersResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CalculateAssessmentTopologySizing(ctx, request.(CalculateAssessmentTopologySizingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CalculateAssessmentTopologySizing")
+	}
 
-	// (DELETE /api/v1/customers/{username})
-	RemoveCustomer(ctx context.Context, request RemoveCustomerRequestObject) (RemoveCustomerResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/groups)
-	ListGroups(ctx context.Context, request ListGroupsRequestObject) (ListGroupsResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CalculateAssessmentTopologySizingResponseObject); ok {
+		if err := validResponse.VisitCalculateAssessmentTopologySizingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/v1/groups)
-	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
+// TransferAssessmentOwnership operation middleware
+func (sh *strictHandler) TransferAssessmentOwnership(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request TransferAssessmentOwnershipRequestObject
 
-	// (DELETE /api/v1/groups/{id})
-	DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error)
+	request.Id = id
 
-	// (GET /api/v1/groups/{id})
-	GetGroup(ctx context.Context, request GetGroupRequestObject) (GetGroupResponseObject, error)
+	var body TransferAssessmentOwnershipJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (PUT /api/v1/groups/{id})
-	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TransferAssessmentOwnership(ctx, request.(TransferAssessmentOwnershipRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TransferAssessmentOwnership")
+	}
 
-	// (GET /api/v1/groups/{id}/members)
-	ListGroupMembers(ctx context.Context, request ListGroupMembersRequestObject) (ListGroupMembersResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/groups/{id}/members)
-	CreateGroupMember(ctx context.Context, request CreateGroupMemberRequestObject) (CreateGroupMemberResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TransferAssessmentOwnershipResponseObject); ok {
+		if err := validResponse.VisitTransferAssessmentOwnershipResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/groups/{id}/members/{username})
-	RemoveGroupMember(ctx context.Context, request RemoveGroupMemberRequestObject) (RemoveGroupMemberResponseObject, error)
+// ListAssessmentVMs operation middleware
+func (sh *strictHandler) ListAssessmentVMs(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ListAssessmentVMsParams) {
+	var request ListAssessmentVMsRequestObject
 
-	// (PUT /api/v1/groups/{id}/members/{username})
-	UpdateGroupMember(ctx context.Context, request UpdateGroupMemberRequestObject) (UpdateGroupMemberResponseObject, error)
+	request.Id = id
+	request.Params = params
 
-	// (GET /api/v1/identity)
-	GetIdentity(ctx context.Context, request GetIdentityRequestObject) (GetIdentityResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAssessmentVMs(ctx, request.(ListAssessmentVMsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAssessmentVMs")
+	}
 
-	// (GET /api/v1/info)
-	GetInfo(ctx context.Context, request GetInfoRequestObject) (GetInfoResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/partners)
-	ListPartners(ctx context.Context, request ListPartnersRequestObject) (ListPartnersResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAssessmentVMsResponseObject); ok {
+		if err := validResponse.VisitListAssessmentVMsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/v1/partners/requests)
-	ListPartnerRequests(ctx context.Context, request ListPartnerRequestsRequestObject) (ListPartnerRequestsResponseObject, error)
+// ListConcernWaivers operation middleware
+func (sh *strictHandler) ListConcernWaivers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListConcernWaiversRequestObject
 
-	// (DELETE /api/v1/partners/requests/{id})
-	CancelPartnerRequest(ctx context.Context, request CancelPartnerRequestRequestObject) (CancelPartnerRequestResponseObject, error)
+	request.Id = id
 
-	// (PUT /api/v1/partners/requests/{id})
-	UpdatePartnerRequest(ctx context.Context, request UpdatePartnerRequestRequestObject) (UpdatePartnerRequestResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListConcernWaivers(ctx, request.(ListConcernWaiversRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListConcernWaivers")
+	}
 
-	// (DELETE /api/v1/partners/{id})
-	LeavePartner(ctx context.Context, request LeavePartnerRequestObject) (LeavePartnerResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/partners/{id})
-	GetPartner(ctx context.Context, request GetPartnerRequestObject) (GetPartnerResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListConcernWaiversResponseObject); ok {
+		if err := validResponse.VisitListConcernWaiversResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/v1/partners/{id}/request)
-	CreatePartnerRequest(ctx context.Context, request CreatePartnerRequestRequestObject) (CreatePartnerRequestResponseObject, error)
+// CreateConcernWaiver operation middleware
+func (sh *strictHandler) CreateConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreateConcernWaiverRequestObject
 
-	// (GET /api/v1/sources)
-	ListSources(ctx context.Context, request ListSourcesRequestObject) (ListSourcesResponseObject, error)
+	request.Id = id
 
-	// (POST /api/v1/sources)
-	CreateSource(ctx context.Context, request CreateSourceRequestObject) (CreateSourceResponseObject, error)
+	var body CreateConcernWaiverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (DELETE /api/v1/sources/{id})
-	DeleteSource(ctx context.Context, request DeleteSourceRequestObject) (DeleteSourceResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConcernWaiver(ctx, request.(CreateConcernWaiverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConcernWaiver")
+	}
 
-	// (GET /api/v1/sources/{id})
-	GetSource(ctx context.Context, request GetSourceRequestObject) (GetSourceResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/v1/sources/{id})
-	UpdateSource(ctx context.Context, request UpdateSourceRequestObject) (UpdateSourceResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConcernWaiverResponseObject); ok {
+		if err := validResponse.VisitCreateConcernWaiverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (HEAD /api/v1/sources/{id}/image)
-	HeadImage(ctx context.Context, request HeadImageRequestObject) (HeadImageResponseObject, error)
+// DeleteConcernWaiver operation middleware
+func (sh *strictHandler) DeleteConcernWaiver(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, waiverId openapi_types.UUID) {
+	var request DeleteConcernWaiverRequestObject
 
-	// (GET /api/v1/sources/{id}/image-url)
-	GetSourceDownloadURL(ctx context.Context, request GetSourceDownloadURLRequestObject) (GetSourceDownloadURLResponseObject, error)
+	request.Id = id
+	request.WaiverId = waiverId
 
-	// (PUT /api/v1/sources/{id}/inventory)
-	UpdateInventory(ctx context.Context, request UpdateInventoryRequestObject) (UpdateInventoryResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConcernWaiver(ctx, request.(DeleteConcernWaiverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConcernWaiver")
+	}
 
-	// (GET /health)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConcernWaiverResponseObject); ok {
+		if err := validResponse.VisitDeleteConcernWaiverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// PlanMigrationWaves operation middleware
+func (sh *strictHandler) PlanMigrationWaves(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PlanMigrationWavesRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Id = id
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	var body PlanMigrationWavesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PlanMigrationWaves(ctx, request.(PlanMigrationWavesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlanMigrationWaves")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PlanMigrationWavesResponseObject); ok {
+		if err := validResponse.VisitPlanMigrationWavesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListAssessments operation middleware
-func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request, params ListAssessmentsParams) {
-	var request ListAssessmentsRequestObject
+// ListAuditEntries operation middleware
+func (sh *strictHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	var request ListAuditEntriesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAssessments(ctx, request.(ListAssessmentsRequestObject))
+		return sh.ssi.ListAuditEntries(ctx, request.(ListAuditEntriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAssessments")
+		handler = middleware(handler, "ListAuditEntries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAssessmentsResponseObject); ok {
-		if err := validResponse.VisitListAssessmentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitListAuditEntriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4566,11 +8726,11 @@ func (sh *strictHandler) ListAssessments(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateAssessment operation middleware
-func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request) {
-	var request CreateAssessmentRequestObject
+// CalculateClusterRequirements operation middleware
+func (sh *strictHandler) CalculateClusterRequirements(w http.ResponseWriter, r *http.Request) {
+	var request CalculateClusterRequirementsRequestObject
 
-	var body CreateAssessmentJSONRequestBody
+	var body CalculateClusterRequirementsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4578,18 +8738,18 @@ func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAssessment(ctx, request.(CreateAssessmentRequestObject))
+		return sh.ssi.CalculateClusterRequirements(ctx, request.(CalculateClusterRequirementsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAssessment")
+		handler = middleware(handler, "CalculateClusterRequirements")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAssessmentResponseObject); ok {
-		if err := validResponse.VisitCreateAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CalculateClusterRequirementsResponseObject); ok {
+		if err := validResponse.VisitCalculateClusterRequirementsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4597,25 +8757,23 @@ func (sh *strictHandler) CreateAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CancelJob operation middleware
-func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id int64) {
-	var request CancelJobRequestObject
-
-	request.Id = id
+// ListCustomers operation middleware
+func (sh *strictHandler) ListCustomers(w http.ResponseWriter, r *http.Request) {
+	var request ListCustomersRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+		return sh.ssi.ListCustomers(ctx, request.(ListCustomersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelJob")
+		handler = middleware(handler, "ListCustomers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
-		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListCustomersResponseObject); ok {
+		if err := validResponse.VisitListCustomersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4623,25 +8781,25 @@ func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, id in
 	}
 }
 
-// GetJob operation middleware
-func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetJobRequestObject
+// RemoveCustomer operation middleware
+func (sh *strictHandler) RemoveCustomer(w http.ResponseWriter, r *http.Request, username string) {
+	var request RemoveCustomerRequestObject
 
-	request.Id = id
+	request.Username = username
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+		return sh.ssi.RemoveCustomer(ctx, request.(RemoveCustomerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJob")
+		handler = middleware(handler, "RemoveCustomer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJobResponseObject); ok {
-		if err := validResponse.VisitGetJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveCustomerResponseObject); ok {
+		if err := validResponse.VisitRemoveCustomerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4649,30 +8807,25 @@ func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id int64
 	}
 }
 
-// CreateRVToolsAssessment operation middleware
-func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.Request) {
-	var request CreateRVToolsAssessmentRequestObject
+// ListGroups operation middleware
+func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
+	var request ListGroupsRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRVToolsAssessment(ctx, request.(CreateRVToolsAssessmentRequestObject))
+		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRVToolsAssessment")
+		handler = middleware(handler, "ListGroups")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRVToolsAssessmentResponseObject); ok {
-		if err := validResponse.VisitCreateRVToolsAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
+		if err := validResponse.VisitListGroupsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4680,25 +8833,30 @@ func (sh *strictHandler) CreateRVToolsAssessment(w http.ResponseWriter, r *http.
 	}
 }
 
-// DeleteAssessment operation middleware
-func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteAssessmentRequestObject
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateGroupRequestObject
 
-	request.Id = id
+	var body CreateGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAssessment(ctx, request.(DeleteAssessmentRequestObject))
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAssessment")
+		handler = middleware(handler, "CreateGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAssessmentResponseObject); ok {
-		if err := validResponse.VisitDeleteAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4706,25 +8864,25 @@ func (sh *strictHandler) DeleteAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetAssessment operation middleware
-func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetAssessmentRequestObject
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteGroupRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAssessment(ctx, request.(GetAssessmentRequestObject))
+		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAssessment")
+		handler = middleware(handler, "DeleteGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssessmentResponseObject); ok {
-		if err := validResponse.VisitGetAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4732,32 +8890,25 @@ func (sh *strictHandler) GetAssessment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// UpdateAssessment operation middleware
-func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateAssessmentRequestObject
+// GetGroup operation middleware
+func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetGroupRequestObject
 
 	request.Id = id
 
-	var body UpdateAssessmentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAssessment(ctx, request.(UpdateAssessmentRequestObject))
+		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAssessment")
+		handler = middleware(handler, "GetGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAssessmentResponseObject); ok {
-		if err := validResponse.VisitUpdateAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
+		if err := validResponse.VisitGetGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4765,13 +8916,13 @@ func (sh *strictHandler) UpdateAssessment(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CalculateAssessmentClusterRequirements operation middleware
-func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateAssessmentClusterRequirementsRequestObject
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateGroupRequestObject
 
 	request.Id = id
 
-	var body CalculateAssessmentClusterRequirementsJSONRequestBody
+	var body UpdateGroupJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4779,18 +8930,18 @@ func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseW
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateAssessmentClusterRequirements(ctx, request.(CalculateAssessmentClusterRequirementsRequestObject))
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateAssessmentClusterRequirements")
+		handler = middleware(handler, "UpdateGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateAssessmentClusterRequirementsResponseObject); ok {
-		if err := validResponse.VisitCalculateAssessmentClusterRequirementsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4798,26 +8949,25 @@ func (sh *strictHandler) CalculateAssessmentClusterRequirements(w http.ResponseW
 	}
 }
 
-// GetAssessmentClusterRequirementsStoredInput operation middleware
-func (sh *strictHandler) GetAssessmentClusterRequirementsStoredInput(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetAssessmentClusterRequirementsStoredInputParams) {
-	var request GetAssessmentClusterRequirementsStoredInputRequestObject
+// ListGroupMembers operation middleware
+func (sh *strictHandler) ListGroupMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListGroupMembersRequestObject
 
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAssessmentClusterRequirementsStoredInput(ctx, request.(GetAssessmentClusterRequirementsStoredInputRequestObject))
+		return sh.ssi.ListGroupMembers(ctx, request.(ListGroupMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAssessmentClusterRequirementsStoredInput")
+		handler = middleware(handler, "ListGroupMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssessmentClusterRequirementsStoredInputResponseObject); ok {
-		if err := validResponse.VisitGetAssessmentClusterRequirementsStoredInputResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListGroupMembersResponseObject); ok {
+		if err := validResponse.VisitListGroupMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4825,13 +8975,13 @@ func (sh *strictHandler) GetAssessmentClusterRequirementsStoredInput(w http.Resp
 	}
 }
 
-// CalculateMigrationComplexity operation middleware
-func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateMigrationComplexityRequestObject
+// CreateGroupMember operation middleware
+func (sh *strictHandler) CreateGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreateGroupMemberRequestObject
 
 	request.Id = id
 
-	var body CalculateMigrationComplexityJSONRequestBody
+	var body CreateGroupMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4839,18 +8989,18 @@ func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateMigrationComplexity(ctx, request.(CalculateMigrationComplexityRequestObject))
+		return sh.ssi.CreateGroupMember(ctx, request.(CreateGroupMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateMigrationComplexity")
+		handler = middleware(handler, "CreateGroupMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateMigrationComplexityResponseObject); ok {
-		if err := validResponse.VisitCalculateMigrationComplexityResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateGroupMemberResponseObject); ok {
+		if err := validResponse.VisitCreateGroupMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4858,25 +9008,26 @@ func (sh *strictHandler) CalculateMigrationComplexity(w http.ResponseWriter, r *
 	}
 }
 
-// GetAssessmentEnhancementData operation middleware
-func (sh *strictHandler) GetAssessmentEnhancementData(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetAssessmentEnhancementDataRequestObject
+// RemoveGroupMember operation middleware
+func (sh *strictHandler) RemoveGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, username string) {
+	var request RemoveGroupMemberRequestObject
 
 	request.Id = id
+	request.Username = username
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAssessmentEnhancementData(ctx, request.(GetAssessmentEnhancementDataRequestObject))
+		return sh.ssi.RemoveGroupMember(ctx, request.(RemoveGroupMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAssessmentEnhancementData")
+		handler = middleware(handler, "RemoveGroupMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAssessmentEnhancementDataResponseObject); ok {
-		if err := validResponse.VisitGetAssessmentEnhancementDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveGroupMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveGroupMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4884,13 +9035,14 @@ func (sh *strictHandler) GetAssessmentEnhancementData(w http.ResponseWriter, r *
 	}
 }
 
-// SaveAssessmentEnhancementData operation middleware
-func (sh *strictHandler) SaveAssessmentEnhancementData(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request SaveAssessmentEnhancementDataRequestObject
+// UpdateGroupMember operation middleware
+func (sh *strictHandler) UpdateGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, username string) {
+	var request UpdateGroupMemberRequestObject
 
 	request.Id = id
+	request.Username = username
 
-	var body SaveAssessmentEnhancementDataJSONRequestBody
+	var body UpdateGroupMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4898,18 +9050,18 @@ func (sh *strictHandler) SaveAssessmentEnhancementData(w http.ResponseWriter, r
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SaveAssessmentEnhancementData(ctx, request.(SaveAssessmentEnhancementDataRequestObject))
+		return sh.ssi.UpdateGroupMember(ctx, request.(UpdateGroupMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SaveAssessmentEnhancementData")
+		handler = middleware(handler, "UpdateGroupMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SaveAssessmentEnhancementDataResponseObject); ok {
-		if err := validResponse.VisitSaveAssessmentEnhancementDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateGroupMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4917,32 +9069,23 @@ func (sh *strictHandler) SaveAssessmentEnhancementData(w http.ResponseWriter, r
 	}
 }
 
-// CalculateMigrationEstimation operation middleware
-func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateMigrationEstimationRequestObject
-
-	request.Id = id
-
-	var body CalculateMigrationEstimationJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// ListHardwareSkus operation middleware
+func (sh *strictHandler) ListHardwareSkus(w http.ResponseWriter, r *http.Request) {
+	var request ListHardwareSkusRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateMigrationEstimation(ctx, request.(CalculateMigrationEstimationRequestObject))
+		return sh.ssi.ListHardwareSkus(ctx, request.(ListHardwareSkusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateMigrationEstimation")
+		handler = middleware(handler, "ListHardwareSkus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateMigrationEstimationResponseObject); ok {
-		if err := validResponse.VisitCalculateMigrationEstimationResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListHardwareSkusResponseObject); ok {
+		if err := validResponse.VisitListHardwareSkusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4950,13 +9093,11 @@ func (sh *strictHandler) CalculateMigrationEstimation(w http.ResponseWriter, r *
 	}
 }
 
-// CalculateMigrationEstimationByComplexity operation middleware
-func (sh *strictHandler) CalculateMigrationEstimationByComplexity(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CalculateMigrationEstimationByComplexityRequestObject
-
-	request.Id = id
+// CreateHardwareSku operation middleware
+func (sh *strictHandler) CreateHardwareSku(w http.ResponseWriter, r *http.Request) {
+	var request CreateHardwareSkuRequestObject
 
-	var body CalculateMigrationEstimationByComplexityJSONRequestBody
+	var body CreateHardwareSkuJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4964,18 +9105,18 @@ func (sh *strictHandler) CalculateMigrationEstimationByComplexity(w http.Respons
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateMigrationEstimationByComplexity(ctx, request.(CalculateMigrationEstimationByComplexityRequestObject))
+		return sh.ssi.CreateHardwareSku(ctx, request.(CreateHardwareSkuRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateMigrationEstimationByComplexity")
+		handler = middleware(handler, "CreateHardwareSku")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateMigrationEstimationByComplexityResponseObject); ok {
-		if err := validResponse.VisitCalculateMigrationEstimationByComplexityResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateHardwareSkuResponseObject); ok {
+		if err := validResponse.VisitCreateHardwareSkuResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4983,25 +9124,25 @@ func (sh *strictHandler) CalculateMigrationEstimationByComplexity(w http.Respons
 	}
 }
 
-// UnshareAssessment operation middleware
-func (sh *strictHandler) UnshareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UnshareAssessmentRequestObject
+// DeleteHardwareSku operation middleware
+func (sh *strictHandler) DeleteHardwareSku(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteHardwareSkuRequestObject
 
-	request.Id = id
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UnshareAssessment(ctx, request.(UnshareAssessmentRequestObject))
+		return sh.ssi.DeleteHardwareSku(ctx, request.(DeleteHardwareSkuRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnshareAssessment")
+		handler = middleware(handler, "DeleteHardwareSku")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UnshareAssessmentResponseObject); ok {
-		if err := validResponse.VisitUnshareAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteHardwareSkuResponseObject); ok {
+		if err := validResponse.VisitDeleteHardwareSkuResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5009,25 +9150,32 @@ func (sh *strictHandler) UnshareAssessment(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// ShareAssessment operation middleware
-func (sh *strictHandler) ShareAssessment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ShareAssessmentRequestObject
+// UpdateHardwareSku operation middleware
+func (sh *strictHandler) UpdateHardwareSku(w http.ResponseWriter, r *http.Request, name string) {
+	var request UpdateHardwareSkuRequestObject
 
-	request.Id = id
+	request.Name = name
+
+	var body UpdateHardwareSkuJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ShareAssessment(ctx, request.(ShareAssessmentRequestObject))
+		return sh.ssi.UpdateHardwareSku(ctx, request.(UpdateHardwareSkuRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ShareAssessment")
+		handler = middleware(handler, "UpdateHardwareSku")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ShareAssessmentResponseObject); ok {
-		if err := validResponse.VisitShareAssessmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateHardwareSkuResponseObject); ok {
+		if err := validResponse.VisitUpdateHardwareSkuResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5035,30 +9183,23 @@ func (sh *strictHandler) ShareAssessment(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CalculateClusterRequirements operation middleware
-func (sh *strictHandler) CalculateClusterRequirements(w http.ResponseWriter, r *http.Request) {
-	var request CalculateClusterRequirementsRequestObject
-
-	var body CalculateClusterRequirementsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetIdentity operation middleware
+func (sh *strictHandler) GetIdentity(w http.ResponseWriter, r *http.Request) {
+	var request GetIdentityRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CalculateClusterRequirements(ctx, request.(CalculateClusterRequirementsRequestObject))
+		return sh.ssi.GetIdentity(ctx, request.(GetIdentityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CalculateClusterRequirements")
+		handler = middleware(handler, "GetIdentity")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CalculateClusterRequirementsResponseObject); ok {
-		if err := validResponse.VisitCalculateClusterRequirementsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetIdentityResponseObject); ok {
+		if err := validResponse.VisitGetIdentityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5066,23 +9207,23 @@ func (sh *strictHandler) CalculateClusterRequirements(w http.ResponseWriter, r *
 	}
 }
 
-// ListCustomers operation middleware
-func (sh *strictHandler) ListCustomers(w http.ResponseWriter, r *http.Request) {
-	var request ListCustomersRequestObject
+// GetInfo operation middleware
+func (sh *strictHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetInfoRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCustomers(ctx, request.(ListCustomersRequestObject))
+		return sh.ssi.GetInfo(ctx, request.(GetInfoRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCustomers")
+		handler = middleware(handler, "GetInfo")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCustomersResponseObject); ok {
-		if err := validResponse.VisitListCustomersResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
+		if err := validResponse.VisitGetInfoResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5090,25 +9231,23 @@ func (sh *strictHandler) ListCustomers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RemoveCustomer operation middleware
-func (sh *strictHandler) RemoveCustomer(w http.ResponseWriter, r *http.Request, username string) {
-	var request RemoveCustomerRequestObject
-
-	request.Username = username
+// ListEstimationSchemas operation middleware
+func (sh *strictHandler) ListEstimationSchemas(w http.ResponseWriter, r *http.Request) {
+	var request ListEstimationSchemasRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveCustomer(ctx, request.(RemoveCustomerRequestObject))
+		return sh.ssi.ListEstimationSchemas(ctx, request.(ListEstimationSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveCustomer")
+		handler = middleware(handler, "ListEstimationSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveCustomerResponseObject); ok {
-		if err := validResponse.VisitRemoveCustomerResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListEstimationSchemasResponseObject); ok {
+		if err := validResponse.VisitListEstimationSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5116,25 +9255,25 @@ func (sh *strictHandler) RemoveCustomer(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListGroups operation middleware
-func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
-	var request ListGroupsRequestObject
+// ListComplexityTables operation middleware
+func (sh *strictHandler) ListComplexityTables(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request ListComplexityTablesRequestObject
 
-	request.Params = params
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
+		return sh.ssi.ListComplexityTables(ctx, request.(ListComplexityTablesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroups")
+		handler = middleware(handler, "ListComplexityTables")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
-		if err := validResponse.VisitListGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListComplexityTablesResponseObject); ok {
+		if err := validResponse.VisitListComplexityTablesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5142,11 +9281,13 @@ func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// CreateGroup operation middleware
-func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
-	var request CreateGroupRequestObject
+// CreateComplexityTable operation middleware
+func (sh *strictHandler) CreateComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request CreateComplexityTableRequestObject
 
-	var body CreateGroupJSONRequestBody
+	request.OrgId = orgId
+
+	var body CreateComplexityTableJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5154,18 +9295,18 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+		return sh.ssi.CreateComplexityTable(ctx, request.(CreateComplexityTableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroup")
+		handler = middleware(handler, "CreateComplexityTable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
-		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateComplexityTableResponseObject); ok {
+		if err := validResponse.VisitCreateComplexityTableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5173,25 +9314,25 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteGroup operation middleware
-func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteGroupRequestObject
+// ResetComplexityTable operation middleware
+func (sh *strictHandler) ResetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request ResetComplexityTableRequestObject
 
-	request.Id = id
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+		return sh.ssi.ResetComplexityTable(ctx, request.(ResetComplexityTableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroup")
+		handler = middleware(handler, "ResetComplexityTable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResetComplexityTableResponseObject); ok {
+		if err := validResponse.VisitResetComplexityTableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5199,25 +9340,25 @@ func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetGroup operation middleware
-func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetGroupRequestObject
+// GetActiveComplexityTable operation middleware
+func (sh *strictHandler) GetActiveComplexityTable(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request GetActiveComplexityTableRequestObject
 
-	request.Id = id
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
+		return sh.ssi.GetActiveComplexityTable(ctx, request.(GetActiveComplexityTableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroup")
+		handler = middleware(handler, "GetActiveComplexityTable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
-		if err := validResponse.VisitGetGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetActiveComplexityTableResponseObject); ok {
+		if err := validResponse.VisitGetActiveComplexityTableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5225,32 +9366,26 @@ func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id ope
 	}
 }
 
-// UpdateGroup operation middleware
-func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateGroupRequestObject
-
-	request.Id = id
+// GetComplexityTable operation middleware
+func (sh *strictHandler) GetComplexityTable(w http.ResponseWriter, r *http.Request, orgId string, version string) {
+	var request GetComplexityTableRequestObject
 
-	var body UpdateGroupJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.OrgId = orgId
+	request.Version = version
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+		return sh.ssi.GetComplexityTable(ctx, request.(GetComplexityTableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroup")
+		handler = middleware(handler, "GetComplexityTable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
-		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetComplexityTableResponseObject); ok {
+		if err := validResponse.VisitGetComplexityTableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5258,25 +9393,25 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ListGroupMembers operation middleware
-func (sh *strictHandler) ListGroupMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ListGroupMembersRequestObject
+// ListPolicyBundles operation middleware
+func (sh *strictHandler) ListPolicyBundles(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request ListPolicyBundlesRequestObject
 
-	request.Id = id
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroupMembers(ctx, request.(ListGroupMembersRequestObject))
+		return sh.ssi.ListPolicyBundles(ctx, request.(ListPolicyBundlesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroupMembers")
+		handler = middleware(handler, "ListPolicyBundles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListGroupMembersResponseObject); ok {
-		if err := validResponse.VisitListGroupMembersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPolicyBundlesResponseObject); ok {
+		if err := validResponse.VisitListPolicyBundlesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5284,13 +9419,13 @@ func (sh *strictHandler) ListGroupMembers(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateGroupMember operation middleware
-func (sh *strictHandler) CreateGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request CreateGroupMemberRequestObject
+// CreatePolicyBundle operation middleware
+func (sh *strictHandler) CreatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request CreatePolicyBundleRequestObject
 
-	request.Id = id
+	request.OrgId = orgId
 
-	var body CreateGroupMemberJSONRequestBody
+	var body CreatePolicyBundleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5298,18 +9433,18 @@ func (sh *strictHandler) CreateGroupMember(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroupMember(ctx, request.(CreateGroupMemberRequestObject))
+		return sh.ssi.CreatePolicyBundle(ctx, request.(CreatePolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroupMember")
+		handler = middleware(handler, "CreatePolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateGroupMemberResponseObject); ok {
-		if err := validResponse.VisitCreateGroupMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePolicyBundleResponseObject); ok {
+		if err := validResponse.VisitCreatePolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5317,26 +9452,25 @@ func (sh *strictHandler) CreateGroupMember(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// RemoveGroupMember operation middleware
-func (sh *strictHandler) RemoveGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, username string) {
-	var request RemoveGroupMemberRequestObject
+// DeactivatePolicyBundle operation middleware
+func (sh *strictHandler) DeactivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request DeactivatePolicyBundleRequestObject
 
-	request.Id = id
-	request.Username = username
+	request.OrgId = orgId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveGroupMember(ctx, request.(RemoveGroupMemberRequestObject))
+		return sh.ssi.DeactivatePolicyBundle(ctx, request.(DeactivatePolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveGroupMember")
+		handler = middleware(handler, "DeactivatePolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveGroupMemberResponseObject); ok {
-		if err := validResponse.VisitRemoveGroupMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeactivatePolicyBundleResponseObject); ok {
+		if err := validResponse.VisitDeactivatePolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5344,33 +9478,52 @@ func (sh *strictHandler) RemoveGroupMember(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// UpdateGroupMember operation middleware
-func (sh *strictHandler) UpdateGroupMember(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, username string) {
-	var request UpdateGroupMemberRequestObject
+// GetActivePolicyBundle operation middleware
+func (sh *strictHandler) GetActivePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string) {
+	var request GetActivePolicyBundleRequestObject
 
-	request.Id = id
-	request.Username = username
+	request.OrgId = orgId
 
-	var body UpdateGroupMemberJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivePolicyBundle(ctx, request.(GetActivePolicyBundleRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivePolicyBundle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetActivePolicyBundleResponseObject); ok {
+		if err := validResponse.VisitGetActivePolicyBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPolicyBundle operation middleware
+func (sh *strictHandler) GetPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	var request GetPolicyBundleRequestObject
+
+	request.OrgId = orgId
+	request.Revision = revision
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroupMember(ctx, request.(UpdateGroupMemberRequestObject))
+		return sh.ssi.GetPolicyBundle(ctx, request.(GetPolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroupMember")
+		handler = middleware(handler, "GetPolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateGroupMemberResponseObject); ok {
-		if err := validResponse.VisitUpdateGroupMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPolicyBundleResponseObject); ok {
+		if err := validResponse.VisitGetPolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5378,23 +9531,26 @@ func (sh *strictHandler) UpdateGroupMember(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetIdentity operation middleware
-func (sh *strictHandler) GetIdentity(w http.ResponseWriter, r *http.Request) {
-	var request GetIdentityRequestObject
+// ActivatePolicyBundle operation middleware
+func (sh *strictHandler) ActivatePolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	var request ActivatePolicyBundleRequestObject
+
+	request.OrgId = orgId
+	request.Revision = revision
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentity(ctx, request.(GetIdentityRequestObject))
+		return sh.ssi.ActivatePolicyBundle(ctx, request.(ActivatePolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentity")
+		handler = middleware(handler, "ActivatePolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentityResponseObject); ok {
-		if err := validResponse.VisitGetIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(ActivatePolicyBundleResponseObject); ok {
+		if err := validResponse.VisitActivatePolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5402,23 +9558,33 @@ func (sh *strictHandler) GetIdentity(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetInfo operation middleware
-func (sh *strictHandler) GetInfo(w http.ResponseWriter, r *http.Request) {
-	var request GetInfoRequestObject
+// TestPolicyBundle operation middleware
+func (sh *strictHandler) TestPolicyBundle(w http.ResponseWriter, r *http.Request, orgId string, revision int) {
+	var request TestPolicyBundleRequestObject
+
+	request.OrgId = orgId
+	request.Revision = revision
+
+	var body TestPolicyBundleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInfo(ctx, request.(GetInfoRequestObject))
+		return sh.ssi.TestPolicyBundle(ctx, request.(TestPolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInfo")
+		handler = middleware(handler, "TestPolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInfoResponseObject); ok {
-		if err := validResponse.VisitGetInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(TestPolicyBundleResponseObject); ok {
+		if err := validResponse.VisitTestPolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/service/eventwrap"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/metrics"
 	"github.com/kubev2v/migration-planner/pkg/middleware"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
@@ -36,6 +37,8 @@ import (
 
 const (
 	gracefulShutdownTimeout = 5 * time.Second
+	// maxShareBodySize is the largest body of a share or unshare request read by emptyShareBodyMiddleware.
+	maxShareBodySize = 64 << 10
 )
 
 type Server struct {
@@ -152,6 +155,40 @@ func detectOldSchemaMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// emptyShareBodyMiddleware replaces the empty body of share and unshare requests with an empty
+// JSON object, which shares the assessment with the partner of the owner. Clients sent these
+// requests without a body before sharing with users and groups was added; the generated strict
+// handler fails to decode an empty body even though it is optional.
+func emptyShareBodyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimSuffix(r.URL.Path, "/")
+		if (r.Method != http.MethodPost && r.Method != http.MethodDelete) || !strings.HasSuffix(path, "/share") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxShareBodySize))
+		_ = r.Body.Close()
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "Failed to read request body", http.StatusInternalServerError)
+			return
+		}
+		if len(bytes.TrimSpace(body)) == 0 {
+			body = []byte("{}")
+			r.Header.Set("Content-Type", "application/json")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Middleware to inject ResponseWriter and *http.Request into context.
 // The Request is needed by http.ServeContent for handling byte-range requests.
 func WithResponseWriter(next http.Handler) http.Handler {
@@ -200,6 +237,7 @@ func (s *Server) Run(ctx context.Context) error {
 		middleware.Logger(),
 		chiMiddleware.Recoverer,
 		detectOldSchemaMiddleware,
+		emptyShareBodyMiddleware,
 		oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts),
 		WithResponseWriter,
 	)
@@ -210,7 +248,23 @@ func (s *Server) Run(ctx context.Context) error {
 		zap.S().Named("api_server").Warnf("Invalid sizer timeout, using default 60s: %v", err)
 		sizerTimeout = 60 * time.Second
 	}
-	sizerClient := client.NewSizerClient(s.cfg.Service.Sizer.ServiceURL, sizerTimeout)
+	var sizerClient client.Sizer
+	switch s.cfg.Service.Sizer.Engine {
+	case "local":
+		sizerClient = client.NewLocalSizer()
+	case "remote":
+		sizerClient = client.NewSizerClient(s.cfg.Service.Sizer.ServiceURL, sizerTimeout)
+		if s.cfg.Service.Sizer.FallbackToLocal {
+			backoff, err := time.ParseDuration(s.cfg.Service.Sizer.FallbackBackoff)
+			if err != nil {
+				zap.S().Named("api_server").Warnf("Invalid sizer fallback backoff, using default 30s: %v", err)
+				backoff = 30 * time.Second
+			}
+			sizerClient = client.NewFallbackSizer(sizerClient, client.NewLocalSizer(), backoff)
+		}
+	default:
+		return fmt.Errorf("unknown sizer engine %q, expected \"remote\" or \"local\"", s.cfg.Service.Sizer.Engine)
+	}
 
 	innerAccountsSvc := service.NewAccountsService(s.store)
 
@@ -225,19 +279,51 @@ func (s *Server) Run(ctx context.Context) error {
 		zap.S().Named("api_server").Infof("Admin group %q initialized with %d members", adminGroup.Name, len(adminGroup.Members))
 	}
 
+	estimationSvc := service.NewEstimationService(s.store).WithOpaValidator(s.opaValidator)
+	if s.cfg.Service.EstimationSchemasFile != "" {
+		registry, err := engines.LoadRegistry(s.cfg.Service.EstimationSchemasFile)
+		if err != nil {
+			return fmt.Errorf("failed to load estimation schemas: %w", err)
+		}
+		estimationSvc.WithSchemaRegistry(registry)
+		zap.S().Named("api_server").Infof("Loaded %d estimation schemas", len(registry.Names()))
+	}
+
+	innerHardwareCatalogSvc := service.NewHardwareCatalogService(s.store)
+	if s.cfg.Service.HardwareCatalogFile != "" {
+		skus, err := service.ParseHardwareCatalogFile(s.cfg.Service.HardwareCatalogFile)
+		if err != nil {
+			return fmt.Errorf("failed to load hardware catalog: %w", err)
+		}
+		innerHardwareCatalogSvc.WithFileSKUs(skus)
+		zap.S().Named("api_server").Infof("Loaded %d hardware skus", len(skus))
+	}
+
 	var (
-		partnerSvc    service.PartnerServicer
-		assessmentSvc service.AssessmentServicer
-		accountsSvc   service.AccountsServicer
+		partnerSvc         service.PartnerServicer
+		assessmentSvc      service.AssessmentServicer
+		accountsSvc        service.AccountsServicer
+		hardwareCatalogSvc service.HardwareCatalogServicer
+		complexityTableSvc service.ComplexityTableServicer
+		policyBundleSvc    service.PolicyBundleServicer
+		auditSvc           service.AuditServicer
 	)
 	partnerSvc = eventwrap.NewEventPartnerService(service.NewPartnerService(s.store, innerAccountsSvc), s.store)
 	assessmentSvc = eventwrap.NewEventAssessmentService(service.NewAssessmentService(s.store, s.opaValidator, innerAccountsSvc), s.store, innerAccountsSvc)
 	accountsSvc = innerAccountsSvc
+	hardwareCatalogSvc = innerHardwareCatalogSvc
+	complexityTableSvc = service.NewComplexityTableService(s.store)
+	policyBundleSvc = service.NewPolicyBundleService(s.store, s.opaValidator)
+	auditSvc = service.NewAuditService(s.store)
 
 	if s.cfg.Service.Auth.AuthenticationType != "none" {
 		partnerSvc = service.NewAuthzPartnerService(partnerSvc, innerAccountsSvc, s.store)
 		assessmentSvc = service.NewAuthzAssessmentService(assessmentSvc, s.store, innerAccountsSvc)
 		accountsSvc = service.NewAuthzAccountsService(accountsSvc)
+		hardwareCatalogSvc = service.NewAuthzHardwareCatalogService(hardwareCatalogSvc, innerAccountsSvc)
+		complexityTableSvc = service.NewAuthzComplexityTableService(complexityTableSvc, innerAccountsSvc)
+		policyBundleSvc = service.NewAuthzPolicyBundleService(policyBundleSvc, innerAccountsSvc)
+		auditSvc = service.NewAuthzAuditService(auditSvc, innerAccountsSvc)
 	}
 
 	enhancementDataSvc := service.NewAssessmentEnhancementDataService(s.store)
@@ -247,11 +333,15 @@ func (s *Server) Run(ctx context.Context) error {
 		assessmentSvc,
 		service.NewJobService(s.store, s.jobsClient.RiverClient, s.jobsClient.Queue),
 		eventwrap.NewEventSizerService(service.NewSizerService(sizerClient, s.store), s.store),
-		eventwrap.NewEventEstimationService(service.NewEstimationService(s.store), s.store),
+		eventwrap.NewEventEstimationService(estimationSvc, s.store),
 		partnerSvc,
 		accountsSvc,
 		enhancementDataSvc,
-	)
+	).WithHardwareCatalog(hardwareCatalogSvc).
+		WithComplexityTables(complexityTableSvc).
+		WithPolicyBundles(policyBundleSvc).
+		WithAuditLog(auditSvc).
+		WithOpaValidator(s.opaValidator)
 
 	server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
 	srv := http.Server{Addr: s.cfg.Service.Address, Handler: router}
@@ -1,6 +1,7 @@
 package apiserver
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -116,3 +117,71 @@ func TestOapiErrorHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestEmptyShareBodyMiddleware(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedBody string
+	}{
+		{
+			name:         "empty share body becomes an empty object",
+			method:       http.MethodPost,
+			path:         "/api/v1/assessments/a1/share",
+			expectedBody: "{}",
+		},
+		{
+			name:         "empty unshare body becomes an empty object",
+			method:       http.MethodDelete,
+			path:         "/api/v1/assessments/a1/share",
+			expectedBody: "{}",
+		},
+		{
+			name:         "share body is kept",
+			method:       http.MethodPost,
+			path:         "/api/v1/assessments/a1/share",
+			body:         `{"subjects":[{"type":"user","id":"u1"}]}`,
+			expectedBody: `{"subjects":[{"type":"user","id":"u1"}]}`,
+		},
+		{
+			name:   "other requests are not changed",
+			method: http.MethodPost,
+			path:   "/api/v1/assessments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := emptyShareBodyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				got = string(body)
+			}))
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			if got != tt.expectedBody {
+				t.Errorf("emptyShareBodyMiddleware() body = %q, want %q", got, tt.expectedBody)
+			}
+		})
+	}
+
+	t.Run("share body over the limit is rejected", func(t *testing.T) {
+		called := false
+		handler := emptyShareBodyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/assessments/a1/share", strings.NewReader(strings.Repeat(" ", maxShareBodySize+1))))
+
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("emptyShareBodyMiddleware() status = %v, want %v", w.Code, http.StatusRequestEntityTooLarge)
+		}
+		if called {
+			t.Errorf("emptyShareBodyMiddleware() called the next handler")
+		}
+	})
+}
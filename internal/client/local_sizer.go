@@ -0,0 +1,371 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Sizer computes the nodes needed to schedule the workloads of a SizerRequest.
+// SizerClient calls the remote sizer service; LocalSizer runs in process.
+type Sizer interface {
+	CalculateSizing(ctx context.Context, req *SizerRequest) (*SizerResponse, error)
+	HealthCheck(ctx context.Context) error
+}
+
+var (
+	_ Sizer = (*SizerClient)(nil)
+	_ Sizer = (*LocalSizer)(nil)
+	_ Sizer = (*FallbackSizer)(nil)
+)
+
+// LocalSizer is an in-process bin-packing implementation of the sizer service.
+//
+// Every replica of a workload schedules one instance of each of its services. Instances are
+// grouped with the services they must run with, then placed first-fit decreasing on the nodes
+// of the machine sets used by their workload: a new node is added, from the first machine set
+// the group fits on, when no existing node has room for it. Instances never share a node with
+// the services they avoid, nor with another replica of the same service.
+//
+// Zones are not modeled: all nodes are reported in a single zone.
+type LocalSizer struct{}
+
+func NewLocalSizer() *LocalSizer {
+	return &LocalSizer{}
+}
+
+// HealthCheck always succeeds, the sizer runs in process.
+func (l *LocalSizer) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// localZone is the zone in which LocalSizer reports its nodes.
+const localZone = "zone-1"
+
+type localNode struct {
+	name        string
+	machineSet  *MachineSet
+	cpu         float64
+	memory      float64
+	limitCPU    float64
+	limitMemory float64
+	services    []string
+	avoided     map[string]bool // services avoided by the services of the node
+}
+
+// placementGroup is a set of service instances that must run on the same node.
+type placementGroup struct {
+	services []ServiceDescriptor
+	cpu      float64
+	memory   float64
+}
+
+func (l *LocalSizer) CalculateSizing(ctx context.Context, req *SizerRequest) (*SizerResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("sizer request is empty")
+	}
+	machineSets, err := indexMachineSets(req.MachineSets)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*localNode
+	nodesPerSet := make(map[string]int)
+	for i := range req.Workloads {
+		w := &req.Workloads[i]
+		candidates, err := workloadMachineSets(w, machineSets)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range placementGroups(w) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			node := firstFit(nodes, candidates, g)
+			if node == nil {
+				ms := firstSetFitting(candidates, g)
+				if ms == nil {
+					return nil, fmt.Errorf("service %q is not schedulable: %.2f CPU / %.2f GB exceeds every node of the machine sets %s",
+						g.services[0].Name, g.cpu, g.memory, strings.Join(w.UsesMachines, ", "))
+				}
+				nodesPerSet[ms.Name]++
+				node = &localNode{
+					name:       fmt.Sprintf("%s-%d", ms.Name, nodesPerSet[ms.Name]),
+					machineSet: ms,
+					avoided:    make(map[string]bool),
+				}
+				nodes = append(nodes, node)
+			}
+			node.place(g)
+		}
+	}
+
+	return &SizerResponse{Success: true, Data: sizerData(nodes, req.Detailed)}, nil
+}
+
+func indexMachineSets(sets []MachineSet) (map[string]*MachineSet, error) {
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("sizer request has no machine sets")
+	}
+	index := make(map[string]*MachineSet, len(sets))
+	for i := range sets {
+		ms := &sets[i]
+		if ms.CPU <= 0 || ms.Memory <= 0 {
+			return nil, fmt.Errorf("machine set %q must have positive CPU and memory", ms.Name)
+		}
+		index[ms.Name] = ms
+	}
+	return index, nil
+}
+
+// workloadMachineSets returns the machine sets a workload may be scheduled on, in its order of preference.
+// A machine set that disallows workload scheduling only hosts workloads dedicated to it.
+func workloadMachineSets(w *Workload, machineSets map[string]*MachineSet) ([]*MachineSet, error) {
+	var candidates []*MachineSet
+	for _, name := range w.UsesMachines {
+		ms, ok := machineSets[name]
+		if !ok {
+			return nil, fmt.Errorf("workload %q uses unknown machine set %q", w.Name, name)
+		}
+		if len(ms.OnlyFor) > 0 && !slices.Contains(ms.OnlyFor, w.Name) {
+			continue
+		}
+		if ms.AllowWorkloadScheduling != nil && !*ms.AllowWorkloadScheduling && len(w.UsesMachines) > 1 {
+			continue
+		}
+		candidates = append(candidates, ms)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("workload %q can not be scheduled on any machine set", w.Name)
+	}
+	return candidates, nil
+}
+
+// placementGroups expands the replicas of a workload into groups of co-located instances,
+// largest first.
+func placementGroups(w *Workload) []placementGroup {
+	// union the services of the workload with the services they run with
+	parent := make([]int, len(w.Services))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	byName := make(map[string]int, len(w.Services))
+	for i, s := range w.Services {
+		byName[s.Name] = i
+	}
+	for i, s := range w.Services {
+		for _, other := range s.RunsWith {
+			if j, ok := byName[other]; ok {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	var roots []int
+	members := make(map[int][]int)
+	for i := range w.Services {
+		r := find(i)
+		if _, ok := members[r]; !ok {
+			roots = append(roots, r)
+		}
+		members[r] = append(members[r], i)
+	}
+
+	count := max(w.Count, 1)
+	groups := make([]placementGroup, 0, len(roots)*count)
+	for range count {
+		for _, r := range roots {
+			var g placementGroup
+			for _, i := range members[r] {
+				s := w.Services[i]
+				g.services = append(g.services, s)
+				g.cpu += s.RequiredCPU
+				g.memory += s.RequiredMemory
+			}
+			groups = append(groups, g)
+		}
+	}
+	// stable, so that replicas and equal groups keep the order of the request
+	slices.SortStableFunc(groups, func(a, b placementGroup) int {
+		switch {
+		case a.cpu+a.memory > b.cpu+b.memory:
+			return -1
+		case a.cpu+a.memory < b.cpu+b.memory:
+			return 1
+		}
+		return 0
+	})
+	return groups
+}
+
+func firstFit(nodes []*localNode, candidates []*MachineSet, g placementGroup) *localNode {
+	for _, n := range nodes {
+		if slices.Contains(candidates, n.machineSet) && n.fits(g) && !n.conflicts(g) {
+			return n
+		}
+	}
+	return nil
+}
+
+func firstSetFitting(candidates []*MachineSet, g placementGroup) *MachineSet {
+	for _, ms := range candidates {
+		if g.cpu <= float64(ms.CPU) && g.memory <= float64(ms.Memory) {
+			return ms
+		}
+	}
+	return nil
+}
+
+func (n *localNode) fits(g placementGroup) bool {
+	return n.cpu+g.cpu <= float64(n.machineSet.CPU) && n.memory+g.memory <= float64(n.machineSet.Memory)
+}
+
+// conflicts reports whether the group avoids, or is avoided by, a service of the node,
+// or holds another replica of a service of the node.
+func (n *localNode) conflicts(g placementGroup) bool {
+	for _, s := range g.services {
+		if slices.Contains(n.services, s.Name) || n.avoided[s.Name] {
+			return true
+		}
+		for _, avoided := range s.Avoid {
+			if slices.Contains(n.services, avoided) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n *localNode) place(g placementGroup) {
+	for _, s := range g.services {
+		n.services = append(n.services, s.Name)
+		for _, avoided := range s.Avoid {
+			n.avoided[avoided] = true
+		}
+		n.cpu += s.RequiredCPU
+		n.memory += s.RequiredMemory
+		n.limitCPU += max(s.LimitCPU, s.RequiredCPU)
+		n.limitMemory += max(s.LimitMemory, s.RequiredMemory)
+	}
+}
+
+// sizerData summarizes the nodes the way the sizer service does. Limits default to the requests
+// of the services that set none.
+func sizerData(nodes []*localNode, detailed bool) SizerData {
+	var data SizerData
+	var limitCPU, limitMemory float64
+	zone := Zone{Zone: localZone}
+	for _, n := range nodes {
+		data.TotalCPU += n.machineSet.CPU
+		data.TotalMemory += n.machineSet.Memory
+		data.ResourceConsumption.CPU += n.cpu
+		data.ResourceConsumption.Memory += n.memory
+		limitCPU += n.limitCPU
+		limitMemory += n.limitMemory
+		zone.Nodes = append(zone.Nodes, Node{
+			Node:           n.name,
+			MachineSet:     n.machineSet.Name,
+			IsControlPlane: n.machineSet.ControlPlaneReserved != nil,
+			Services:       n.services,
+			Resources: NodeResources{
+				CPU: CPUResources{
+					Requested:       n.cpu,
+					Total:           n.machineSet.CPU,
+					Limits:          n.limitCPU,
+					OverCommitRatio: ratio(n.limitCPU, float64(n.machineSet.CPU)),
+				},
+				Memory: MemoryResources{
+					Requested:       n.memory,
+					Total:           n.machineSet.Memory,
+					Limits:          n.limitMemory,
+					OverCommitRatio: ratio(n.limitMemory, float64(n.machineSet.Memory)),
+				},
+				Disks: DiskResources{Total: n.machineSet.NumberOfDisks},
+			},
+		})
+	}
+	data.NodeCount = len(nodes)
+	if len(nodes) > 0 {
+		data.Zones = 1
+	}
+	data.ResourceConsumption.Limits = &ResourceLimits{CPU: limitCPU, Memory: limitMemory}
+	data.ResourceConsumption.OverCommitRatio = &OverCommitRatio{
+		CPU:    ratio(limitCPU, data.ResourceConsumption.CPU),
+		Memory: ratio(limitMemory, data.ResourceConsumption.Memory),
+	}
+	if detailed {
+		data.Advanced = []Zone{zone}
+	}
+	return data
+}
+
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+// FallbackSizer calls the primary sizer, and the fallback one when the primary is unavailable.
+// Errors of the primary about the request itself are returned as is. Once the primary is
+// unavailable, requests go straight to the fallback sizer for the backoff period.
+type FallbackSizer struct {
+	primary  Sizer
+	fallback Sizer
+	backoff  time.Duration
+
+	mu               sync.Mutex
+	unavailableUntil time.Time
+}
+
+func NewFallbackSizer(primary, fallback Sizer, backoff time.Duration) *FallbackSizer {
+	return &FallbackSizer{primary: primary, fallback: fallback, backoff: backoff}
+}
+
+func (f *FallbackSizer) CalculateSizing(ctx context.Context, req *SizerRequest) (*SizerResponse, error) {
+	if f.inBackoff() {
+		return f.fallback.CalculateSizing(ctx, req)
+	}
+	resp, err := f.primary.CalculateSizing(ctx, req)
+	var unavailable *SizerUnavailableError
+	if err == nil || !errors.As(err, &unavailable) {
+		return resp, err
+	}
+	f.mu.Lock()
+	f.unavailableUntil = time.Now().Add(f.backoff)
+	f.mu.Unlock()
+	zap.S().Named("sizer").Warnw("sizer service unavailable, using the fallback sizer", "error", err, "backoff", f.backoff)
+	return f.fallback.CalculateSizing(ctx, req)
+}
+
+func (f *FallbackSizer) inBackoff() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return time.Now().Before(f.unavailableUntil)
+}
+
+// HealthCheck succeeds when either sizer is healthy.
+func (f *FallbackSizer) HealthCheck(ctx context.Context) error {
+	err := f.primary.HealthCheck(ctx)
+	if err == nil {
+		return nil
+	}
+	if fallbackErr := f.fallback.HealthCheck(ctx); fallbackErr != nil {
+		return errors.Join(err, fallbackErr)
+	}
+	zap.S().Named("sizer").Warnw("sizer service unhealthy, the fallback sizer is used", "error", err)
+	return nil
+}
@@ -0,0 +1,258 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func haSizerRequest(vmServices ...client.ServiceDescriptor) *client.SizerRequest {
+	notSchedulable := false
+	return &client.SizerRequest{
+		Platform: "BAREMETAL",
+		MachineSets: []client.MachineSet{
+			{Name: "worker", CPU: 16, Memory: 64, NumberOfDisks: 24},
+			{
+				Name:                    "controlPlane",
+				CPU:                     6,
+				Memory:                  16,
+				NumberOfDisks:           24,
+				AllowWorkloadScheduling: &notSchedulable,
+				ControlPlaneReserved:    &client.ControlPlaneReserved{CPU: 3.5, Memory: 13.39},
+			},
+		},
+		Workloads: []client.Workload{
+			{
+				Name:         "control-plane-services",
+				Count:        3,
+				UsesMachines: []string{"controlPlane"},
+				Services:     []client.ServiceDescriptor{{Name: "ControlPlane", RequiredCPU: 3.5, RequiredMemory: 13.39, Zones: 1}},
+			},
+			{
+				Name:         "vm-workload",
+				Count:        1,
+				UsesMachines: []string{"worker"},
+				Services:     vmServices,
+			},
+		},
+		Detailed: true,
+	}
+}
+
+func vmBatches(n int, cpu, memory float64) []client.ServiceDescriptor {
+	services := make([]client.ServiceDescriptor, n)
+	for i := range services {
+		services[i] = client.ServiceDescriptor{
+			Name:           "vms-batch-" + string(rune('a'+i)),
+			RequiredCPU:    cpu,
+			RequiredMemory: memory,
+			LimitCPU:       cpu * 2,
+			LimitMemory:    memory,
+			Zones:          1,
+		}
+	}
+	return services
+}
+
+func nodesOf(resp *client.SizerResponse) (controlPlane, workers []client.Node) {
+	for _, zone := range resp.Data.Advanced {
+		for _, node := range zone.Nodes {
+			if node.IsControlPlane {
+				controlPlane = append(controlPlane, node)
+			} else {
+				workers = append(workers, node)
+			}
+		}
+	}
+	return controlPlane, workers
+}
+
+type stubSizer struct {
+	resp      *client.SizerResponse
+	err       error
+	healthErr error
+	calls     int
+}
+
+func (s *stubSizer) CalculateSizing(ctx context.Context, req *client.SizerRequest) (*client.SizerResponse, error) {
+	s.calls++
+	return s.resp, s.err
+}
+
+func (s *stubSizer) HealthCheck(ctx context.Context) error { return s.healthErr }
+
+var _ = Describe("local sizer", func() {
+	var (
+		sizer *client.LocalSizer
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		sizer = client.NewLocalSizer()
+		ctx = context.Background()
+	})
+
+	It("packs services first-fit on the machine sets of their workload", func() {
+		// 2 batches of 6 CPU / 20 GB per 16 CPU / 64 GB worker
+		resp, err := sizer.CalculateSizing(ctx, haSizerRequest(vmBatches(5, 6, 20)...))
+
+		Expect(err).To(BeNil())
+		Expect(resp.Success).To(BeTrue())
+		Expect(resp.Data.NodeCount).To(Equal(6))
+		Expect(resp.Data.TotalCPU).To(Equal(3*16 + 3*6))
+		Expect(resp.Data.TotalMemory).To(Equal(3*64 + 3*16))
+		Expect(resp.Data.ResourceConsumption.CPU).To(BeNumerically("~", 5*6+3*3.5))
+		Expect(resp.Data.ResourceConsumption.Limits.CPU).To(BeNumerically("~", 5*12+3*3.5))
+
+		controlPlane, workers := nodesOf(resp)
+		Expect(controlPlane).To(HaveLen(3))
+		for _, node := range controlPlane {
+			Expect(node.Services).To(Equal([]string{"ControlPlane"}))
+		}
+		Expect(workers).To(HaveLen(3))
+		Expect(workers[0].Services).To(HaveLen(2))
+		Expect(workers[0].Resources.CPU.Requested).To(BeNumerically("~", 12))
+		Expect(workers[2].Services).To(HaveLen(1))
+	})
+
+	It("keeps services that avoid each other on separate nodes", func() {
+		services := vmBatches(3, 1, 1)
+		services[0].Avoid = []string{services[1].Name}
+
+		resp, err := sizer.CalculateSizing(ctx, haSizerRequest(services...))
+
+		Expect(err).To(BeNil())
+		_, workers := nodesOf(resp)
+		Expect(workers).To(HaveLen(2))
+		Expect(workers[0].Services).To(ConsistOf(services[0].Name, services[2].Name))
+		Expect(workers[1].Services).To(ConsistOf(services[1].Name))
+	})
+
+	It("schedules VMs on schedulable control plane nodes first", func() {
+		req := haSizerRequest(vmBatches(3, 2, 2)...)
+		schedulable := true
+		req.MachineSets[1].AllowWorkloadScheduling = &schedulable
+		req.Workloads[1].UsesMachines = []string{"controlPlane", "worker"}
+
+		resp, err := sizer.CalculateSizing(ctx, req)
+
+		Expect(err).To(BeNil())
+		// each control plane node has room for one 2 CPU batch next to the control plane services
+		controlPlane, workers := nodesOf(resp)
+		Expect(controlPlane).To(HaveLen(3))
+		Expect(workers).To(BeEmpty())
+		Expect(controlPlane[0].Services).To(Equal([]string{"ControlPlane", "vms-batch-a"}))
+	})
+
+	It("reports a schedulability error when co-located services exceed a node", func() {
+		services := vmBatches(3, 6, 20)
+		for i := range services {
+			for j := range services {
+				if i != j {
+					services[i].RunsWith = append(services[i].RunsWith, services[j].Name)
+				}
+			}
+		}
+
+		_, err := sizer.CalculateSizing(ctx, haSizerRequest(services...))
+
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not schedulable"))
+	})
+
+	It("rejects workloads using unknown machine sets", func() {
+		req := haSizerRequest(vmBatches(1, 1, 1)...)
+		req.Workloads[1].UsesMachines = []string{"gpu"}
+
+		_, err := sizer.CalculateSizing(ctx, req)
+
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`unknown machine set "gpu"`))
+	})
+})
+
+var _ = Describe("fallback sizer", func() {
+	var (
+		ctx      context.Context
+		fallback *stubSizer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fallback = &stubSizer{resp: &client.SizerResponse{Success: true, Data: client.SizerData{NodeCount: 4}}}
+	})
+
+	It("uses the fallback sizer when the sizer service fails", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sizer := client.NewFallbackSizer(client.NewSizerClient(server.URL, 5*time.Second), fallback, time.Minute)
+		resp, err := sizer.CalculateSizing(ctx, haSizerRequest())
+
+		Expect(err).To(BeNil())
+		Expect(resp.Data.NodeCount).To(Equal(4))
+		Expect(fallback.calls).To(Equal(1))
+	})
+
+	It("skips the sizer service during the backoff after it fails", func() {
+		primary := &stubSizer{err: &client.SizerUnavailableError{Err: errors.New("connection refused")}}
+		sizer := client.NewFallbackSizer(primary, fallback, time.Minute)
+
+		for range 3 {
+			_, err := sizer.CalculateSizing(ctx, haSizerRequest())
+			Expect(err).To(BeNil())
+		}
+
+		Expect(primary.calls).To(Equal(1))
+		Expect(fallback.calls).To(Equal(3))
+	})
+
+	It("calls the sizer service again after the backoff", func() {
+		primary := &stubSizer{err: &client.SizerUnavailableError{Err: errors.New("connection refused")}}
+		sizer := client.NewFallbackSizer(primary, fallback, 10*time.Millisecond)
+
+		_, err := sizer.CalculateSizing(ctx, haSizerRequest())
+		Expect(err).To(BeNil())
+
+		primary.err = nil
+		primary.resp = &client.SizerResponse{Success: true, Data: client.SizerData{NodeCount: 6}}
+		Eventually(func() int {
+			resp, err := sizer.CalculateSizing(ctx, haSizerRequest())
+			Expect(err).To(BeNil())
+			return resp.Data.NodeCount
+		}).WithTimeout(time.Second).Should(Equal(6))
+		Expect(primary.calls).To(Equal(2))
+	})
+
+	It("returns the errors of the sizer service about the request", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("service ControlPlane is not schedulable"))
+		}))
+		defer server.Close()
+
+		sizer := client.NewFallbackSizer(client.NewSizerClient(server.URL, 5*time.Second), fallback, time.Minute)
+		_, err := sizer.CalculateSizing(ctx, haSizerRequest())
+
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not schedulable"))
+		Expect(fallback.calls).To(Equal(0))
+	})
+
+	It("is healthy while the fallback sizer is", func() {
+		primary := &stubSizer{healthErr: errors.New("connection refused")}
+
+		Expect(client.NewFallbackSizer(primary, fallback, time.Minute).HealthCheck(ctx)).To(Succeed())
+
+		fallback.healthErr = errors.New("down")
+		Expect(client.NewFallbackSizer(primary, fallback, time.Minute).HealthCheck(ctx)).NotTo(Succeed())
+	})
+})
@@ -28,6 +28,16 @@ func NewSizerClient(baseURL string, timeout time.Duration) *SizerClient {
 	}
 }
 
+// SizerUnavailableError is returned when the sizer service can not be reached or fails
+// to process a request, as opposed to rejecting the request.
+type SizerUnavailableError struct {
+	Err error
+}
+
+func (e *SizerUnavailableError) Error() string { return e.Err.Error() }
+
+func (e *SizerUnavailableError) Unwrap() error { return e.Err }
+
 type SizerRequest struct {
 	Platform    string       `json:"platform"`
 	MachineSets []MachineSet `json:"machineSets"`
@@ -157,7 +167,7 @@ func (c *SizerClient) CalculateSizing(ctx context.Context, req *SizerRequest) (*
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("failed to call sizer service: %w", err)
+		return nil, &SizerUnavailableError{fmt.Errorf("failed to call sizer service: %w", err)}
 	}
 	defer func() {
 		_ = resp.Body.Close()
@@ -168,6 +178,9 @@ func (c *SizerClient) CalculateSizing(ctx context.Context, req *SizerRequest) (*
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &SizerUnavailableError{fmt.Errorf("sizer service returned status %d: %s", resp.StatusCode, string(bodyBytes))}
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("sizer service returned status %d: %s", resp.StatusCode, string(bodyBytes))
 	}
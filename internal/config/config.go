@@ -23,19 +23,22 @@ type dbConfig struct {
 }
 
 type svcConfig struct {
-	Address              string `envconfig:"MIGRATION_PLANNER_ADDRESS" default:":3443"`
-	AgentEndpointAddress string `envconfig:"MIGRATION_PLANNER_AGENT_ENDPOINT_ADDRESS" default:":7443"`
-	ImageEndpointAddress string `envconfig:"MIGRATION_PLANNER_IMAGE_ENDPOINT_ADDRESS" default:":11443"`
-	BaseUrl              string `envconfig:"MIGRATION_PLANNER_BASE_URL" default:"https://localhost:3443"`
-	BaseAgentEndpointUrl string `envconfig:"MIGRATION_PLANNER_BASE_AGENT_ENDPOINT_URL" default:"https://localhost:7443"`
-	BaseImageEndpointUrl string `envconfig:"MIGRATION_PLANNER_BASE_IMAGE_ENDPOINT_URL" default:"https://localhost:11443"`
-	LogLevel             string `envconfig:"MIGRATION_PLANNER_LOG_LEVEL" default:"info"`
-	Auth                 Auth
-	MigrationFolder      string `envconfig:"MIGRATION_PLANNER_MIGRATIONS_FOLDER" default:""`
-	OpaPoliciesFolder    string `envconfig:"MIGRATION_PLANNER_OPA_POLICIES_FOLDER" default:"/app/policies"`
-	IsoPath              string `envconfig:"MIGRATION_PLANNER_ISO_PATH" default:"rhcos-live-iso.x86_64.iso"`
-	Sizer                Sizer
-	AdminGroupFile       string `envconfig:"MIGRATION_PLANNER_ADMIN_GROUP_FILE" default:""`
+	Address               string `envconfig:"MIGRATION_PLANNER_ADDRESS" default:":3443"`
+	AgentEndpointAddress  string `envconfig:"MIGRATION_PLANNER_AGENT_ENDPOINT_ADDRESS" default:":7443"`
+	ImageEndpointAddress  string `envconfig:"MIGRATION_PLANNER_IMAGE_ENDPOINT_ADDRESS" default:":11443"`
+	BaseUrl               string `envconfig:"MIGRATION_PLANNER_BASE_URL" default:"https://localhost:3443"`
+	BaseAgentEndpointUrl  string `envconfig:"MIGRATION_PLANNER_BASE_AGENT_ENDPOINT_URL" default:"https://localhost:7443"`
+	BaseImageEndpointUrl  string `envconfig:"MIGRATION_PLANNER_BASE_IMAGE_ENDPOINT_URL" default:"https://localhost:11443"`
+	LogLevel              string `envconfig:"MIGRATION_PLANNER_LOG_LEVEL" default:"info"`
+	Auth                  Auth
+	MigrationFolder       string `envconfig:"MIGRATION_PLANNER_MIGRATIONS_FOLDER" default:""`
+	OpaPoliciesFolder     string `envconfig:"MIGRATION_PLANNER_OPA_POLICIES_FOLDER" default:"/app/policies"`
+	OpaPolicies           OpaPolicies
+	IsoPath               string `envconfig:"MIGRATION_PLANNER_ISO_PATH" default:"rhcos-live-iso.x86_64.iso"`
+	Sizer                 Sizer
+	AdminGroupFile        string `envconfig:"MIGRATION_PLANNER_ADMIN_GROUP_FILE" default:""`
+	EstimationSchemasFile string `envconfig:"MIGRATION_PLANNER_ESTIMATION_SCHEMAS_FILE" default:""`
+	HardwareCatalogFile   string `envconfig:"MIGRATION_PLANNER_HARDWARE_CATALOG_FILE" default:""`
 }
 
 type Auth struct {
@@ -45,9 +48,24 @@ type Auth struct {
 	AgentAuthenticationEnabled bool   `envconfig:"MIGRATION_PLANNER_AGENT_AUTH_ENABLED" default:"true"`
 }
 
+// OpaPolicies configures the reload of the global OPA policies. The policies are read from
+// OpaPoliciesFolder at startup, then from Bundle if set (an http(s):// or oci:// reference),
+// or else from OpaPoliciesFolder, every ReloadInterval. A ReloadInterval of 0 disables reloads.
+type OpaPolicies struct {
+	Bundle         string `envconfig:"MIGRATION_PLANNER_OPA_POLICIES_BUNDLE" default:""`
+	ReloadInterval string `envconfig:"MIGRATION_PLANNER_OPA_POLICIES_RELOAD_INTERVAL" default:"30s"`
+}
+
+// Sizer selects the engine computing cluster node counts: "remote" calls the sizer service at
+// ServiceURL, "local" runs the in-process sizer. With FallbackToLocal, the remote engine falls
+// back to the in-process one while the sizer service is unavailable, and skips the sizer service
+// for FallbackBackoff after it fails.
 type Sizer struct {
-	ServiceURL string `envconfig:"SIZER_SERVICE_URL" default:"http://migration-planner-sizer:9200"`
-	Timeout    string `envconfig:"SIZER_SERVICE_TIMEOUT" default:"60s"`
+	ServiceURL      string `envconfig:"SIZER_SERVICE_URL" default:"http://migration-planner-sizer:9200"`
+	Timeout         string `envconfig:"SIZER_SERVICE_TIMEOUT" default:"60s"`
+	Engine          string `envconfig:"SIZER_ENGINE" default:"remote"`
+	FallbackToLocal bool   `envconfig:"SIZER_FALLBACK_TO_LOCAL" default:"false"`
+	FallbackBackoff string `envconfig:"SIZER_FALLBACK_BACKOFF" default:"30s"`
 }
 
 type Kafka struct {
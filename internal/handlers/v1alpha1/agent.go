@@ -55,6 +55,7 @@ func (h *AgentHandler) UpdateSourceInventory(ctx context.Context, request agentS
 		AgentID:   request.Body.AgentId,
 		Inventory: data,
 		VCenterID: request.Body.Inventory.VcenterId,
+		VMs:       apiMappers.SourceVMsFromApi(request.Body.Vms),
 	})
 	if err != nil {
 		switch err.(type) {
@@ -110,6 +111,7 @@ func (h *AgentHandler) UpdateSource(ctx context.Context, request agentServer.Upd
 		SourceID:  request.Id,
 		Inventory: data,
 		VCenterID: inventory.VcenterId,
+		VMs:       apiMappers.SourceVMsFromApi(request.Body.Vms),
 	})
 	if err != nil {
 		switch err.(type) {
@@ -223,6 +225,34 @@ func (h *AgentHandler) DeleteSourceSubset(ctx context.Context, request agentServ
 	return agentServer.DeleteSourceSubset204Response{}, nil
 }
 
+func (h *AgentHandler) GetSourcePolicies(ctx context.Context, request agentServer.GetSourcePoliciesRequestObject) (agentServer.GetSourcePoliciesResponseObject, error) {
+	agentJWT := auth.MustHaveAgent(ctx)
+	if agentJWT.SourceID != request.Id.String() {
+		return agentServer.GetSourcePolicies403JSONResponse{
+			Message: fmt.Sprintf("agent is not authorized to get the policies of source %s", request.Id),
+		}, nil
+	}
+
+	bundle, err := h.srv.GetSourcePolicies(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return agentServer.GetSourcePolicies404JSONResponse{Message: err.Error()}, nil
+		default:
+			return agentServer.GetSourcePolicies500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+
+	// The module names are the ones the planner compiles the bundle with, so that they
+	// cannot clash with the global policies of the agent
+	response := v1alpha1.SourcePolicies{Modules: map[string]string{}}
+	if bundle != nil {
+		response.Revision = bundle.Revision
+		response.Modules = bundle.Policies()
+	}
+	return agentServer.GetSourcePolicies200JSONResponse(response), nil
+}
+
 // UpdateAgentStatus updates or creates a new agent resource
 // If the source has not agent than the agent is created.
 func (h *AgentHandler) UpdateAgentStatus(ctx context.Context, request agentServer.UpdateAgentStatusRequestObject) (agentServer.UpdateAgentStatusResponseObject, error) {
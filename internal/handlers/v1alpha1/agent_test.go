@@ -442,4 +442,51 @@ var _ = Describe("agent service", Ordered, func() {
 			gormdb.Exec("DELETE FROM sources;")
 		})
 	})
+
+	Context("Get source policies", func() {
+		It("returns only the global policies when the organization has no active policies", func() {
+			sourceID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := auth.NewTokenContext(context.TODO(), auth.AgentJWT{OrgID: "admin", SourceID: sourceID.String()})
+
+			srv := handlers.NewAgentHandler(service.NewAgentService(s))
+			resp, err := srv.GetSourcePolicies(ctx, server.GetSourcePoliciesRequestObject{Id: sourceID})
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal(server.GetSourcePolicies200JSONResponse{Revision: 0, Modules: map[string]string{}}))
+		})
+
+		It("returns the active policies of the organization of the source", func() {
+			sourceID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(`INSERT INTO policy_bundles (org_id, revision, name, modules, active, created_by) VALUES ('admin', 2, 'pci', '{"pci.rego": "package io.konveyor.forklift.vmware"}', true, 'admin');`)
+			Expect(tx.Error).To(BeNil())
+
+			ctx := auth.NewTokenContext(context.TODO(), auth.AgentJWT{OrgID: "admin", SourceID: sourceID.String()})
+
+			srv := handlers.NewAgentHandler(service.NewAgentService(s))
+			resp, err := srv.GetSourcePolicies(ctx, server.GetSourcePoliciesRequestObject{Id: sourceID})
+			Expect(err).To(BeNil())
+			Expect(resp).To(Equal(server.GetSourcePolicies200JSONResponse{
+				Revision: 2,
+				Modules:  map[string]string{"org/pci.rego": "package io.konveyor.forklift.vmware"},
+			}))
+		})
+
+		It("rejects the request when JWT source_id does not match the source", func() {
+			ctx := auth.NewTokenContext(context.TODO(), auth.AgentJWT{OrgID: "admin", SourceID: uuid.NewString()})
+
+			srv := handlers.NewAgentHandler(service.NewAgentService(s))
+			resp, err := srv.GetSourcePolicies(ctx, server.GetSourcePoliciesRequestObject{Id: uuid.New()})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.GetSourcePolicies403JSONResponse{}).String()))
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM policy_bundles;")
+			gormdb.Exec("DELETE FROM sources;")
+		})
+	})
 })
@@ -154,6 +154,9 @@ func (h *ServiceHandler) GetAssessment(ctx context.Context, request server.GetAs
 	if err != nil {
 		return server.GetAssessment500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
 	}
+	for i := range apiAssessment.Snapshots {
+		h.attachRemediations(&apiAssessment.Snapshots[i].Inventory)
+	}
 
 	return server.GetAssessment200JSONResponse(apiAssessment), nil
 }
@@ -174,9 +177,14 @@ func (h *ServiceHandler) UpdateAssessment(ctx context.Context, request server.Up
 
 	assessmentID := request.Id
 
-	updatedAssessment, err := h.assessmentSrv.UpdateAssessment(ctx, assessmentID, request.Body.Name)
+	inventory := mappers.AssessmentUpdateToInventory(*request.Body)
+
+	updatedAssessment, err := h.assessmentSrv.UpdateAssessment(ctx, assessmentID, request.Body.Name, inventory)
 	if err != nil {
 		switch err.(type) {
+		case *service.ErrInvalidRequest, *service.ErrInventoryHasNoVMs:
+			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+			return server.UpdateAssessment400JSONResponse{Message: err.Error()}, nil
 		case *service.ErrResourceNotFound:
 			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
 			return server.UpdateAssessment404JSONResponse{Message: err.Error()}, nil
@@ -241,13 +249,24 @@ func (h *ServiceHandler) ShareAssessment(ctx context.Context, request server.Sha
 		WithUUID("assessment_id", request.Id).
 		Build()
 
-	if err := h.assessmentSrv.ShareAssessment(ctx, request.Id); err != nil {
+	// without subjects, the assessment is shared with the partner of the customer
+	var err error
+	message := "assessment shared with partner"
+	if request.Body != nil && request.Body.Subjects != nil && len(*request.Body.Subjects) > 0 {
+		err = h.assessmentSrv.ShareAssessmentWith(ctx, request.Id,
+			mappers.ShareSubjectsToModel(*request.Body.Subjects), mappers.ShareRelationToModel(request.Body.Relation))
+		message = "assessment shared"
+	} else {
+		err = h.assessmentSrv.ShareAssessment(ctx, request.Id)
+	}
+
+	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
 			return server.ShareAssessment404JSONResponse{Message: err.Error()}, nil
 		case *service.ErrForbidden:
 			return server.ShareAssessment403JSONResponse{Message: err.Error()}, nil
-		case *service.ErrNotACustomer:
+		case *service.ErrNotACustomer, *service.ErrInvalidRequest:
 			return server.ShareAssessment400JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
@@ -256,7 +275,7 @@ func (h *ServiceHandler) ShareAssessment(ctx context.Context, request server.Sha
 	}
 
 	logger.Success().Log()
-	return server.ShareAssessment200JSONResponse{Message: strPtr("assessment shared with partner")}, nil
+	return server.ShareAssessment200JSONResponse{Message: strPtr(message)}, nil
 }
 
 // (DELETE /api/v1/assessments/{id}/share)
@@ -267,13 +286,23 @@ func (h *ServiceHandler) UnshareAssessment(ctx context.Context, request server.U
 		WithUUID("assessment_id", request.Id).
 		Build()
 
-	if err := h.assessmentSrv.UnshareAssessment(ctx, request.Id); err != nil {
+	// without subjects, the assessment is unshared from the partner of the customer
+	var err error
+	message := "assessment unshared from partner"
+	if request.Body != nil && request.Body.Subjects != nil && len(*request.Body.Subjects) > 0 {
+		err = h.assessmentSrv.UnshareAssessmentWith(ctx, request.Id, mappers.ShareSubjectsToModel(*request.Body.Subjects))
+		message = "assessment unshared"
+	} else {
+		err = h.assessmentSrv.UnshareAssessment(ctx, request.Id)
+	}
+
+	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
 			return server.UnshareAssessment404JSONResponse{Message: err.Error()}, nil
 		case *service.ErrForbidden:
 			return server.UnshareAssessment403JSONResponse{Message: err.Error()}, nil
-		case *service.ErrNotACustomer:
+		case *service.ErrNotACustomer, *service.ErrInvalidRequest:
 			return server.UnshareAssessment400JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
@@ -282,7 +311,45 @@ func (h *ServiceHandler) UnshareAssessment(ctx context.Context, request server.U
 	}
 
 	logger.Success().Log()
-	return server.UnshareAssessment200JSONResponse{Message: strPtr("assessment unshared from partner")}, nil
+	return server.UnshareAssessment200JSONResponse{Message: strPtr(message)}, nil
+}
+
+// (POST /api/v1/assessments/{id}/transfer)
+func (h *ServiceHandler) TransferAssessmentOwnership(ctx context.Context, request server.TransferAssessmentOwnershipRequestObject) (server.TransferAssessmentOwnershipResponseObject, error) {
+	logger := log.NewDebugLogger("assessment_handler").
+		WithContext(ctx).
+		Operation("transfer_assessment_ownership").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	if request.Body == nil {
+		return server.TransferAssessmentOwnership400JSONResponse{Message: "empty body"}, nil
+	}
+
+	assessment, err := h.assessmentSrv.TransferOwnership(ctx, request.Id, request.Body.Username)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.TransferAssessmentOwnership404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.TransferAssessmentOwnership403JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			return server.TransferAssessmentOwnership400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			return server.TransferAssessmentOwnership409JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.TransferAssessmentOwnership500JSONResponse{Message: fmt.Sprintf("failed to transfer assessment: %v", err)}, nil
+		}
+	}
+
+	apiAssessment, err := mappers.AssessmentToApi(*assessment)
+	if err != nil {
+		return server.TransferAssessmentOwnership500JSONResponse{Message: fmt.Sprintf("failed to transfer assessment: %v", err)}, nil
+	}
+
+	logger.Success().WithString("owner", request.Body.Username).Log()
+	return server.TransferAssessmentOwnership200JSONResponse(apiAssessment), nil
 }
 
 func strPtr(s string) *string { return &s }
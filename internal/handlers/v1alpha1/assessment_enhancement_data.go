@@ -24,8 +24,7 @@ func (h *ServiceHandler) SaveAssessmentEnhancementData(ctx context.Context, requ
 		WithUUID("assessment_id", request.Id).
 		Build()
 
-	_, err := h.assessmentSrv.GetAssessment(ctx, request.Id)
-	if err != nil {
+	if err := h.assessmentSrv.AuthorizeEdit(ctx, request.Id); err != nil {
 		switch err.(type) {
 		case *service.ErrForbidden:
 			logger.Error(err).Log()
@@ -35,7 +34,7 @@ func (h *ServiceHandler) SaveAssessmentEnhancementData(ctx context.Context, requ
 			return server.SaveAssessmentEnhancementData404JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
-			return server.SaveAssessmentEnhancementData500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
+			return server.SaveAssessmentEnhancementData500JSONResponse{Message: fmt.Sprintf("failed to authorize assessment edit: %v", err)}, nil
 		}
 	}
 
@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/assessments/{id}/vms)
+func (h *ServiceHandler) ListAssessmentVMs(ctx context.Context, request server.ListAssessmentVMsRequestObject) (server.ListAssessmentVMsResponseObject, error) {
+	logger := log.NewDebugLogger("assessment_vm_handler").
+		WithContext(ctx).
+		Operation("list_assessment_vms").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	filter, err := assessmentVMFilterFromParams(request.Params)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListAssessmentVMs400JSONResponse{Message: err.Error()}, nil
+	}
+
+	page, err := h.assessmentSrv.ListVMs(ctx, request.Id, filter)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrInvalidRequest:
+			logger.Error(err).Log()
+			return server.ListAssessmentVMs400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListAssessmentVMs404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			logger.Error(err).Log()
+			return server.ListAssessmentVMs403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListAssessmentVMs500JSONResponse{Message: fmt.Sprintf("failed to list vms: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(page.VMs)).WithInt("total", int(page.Total)).Log()
+
+	return server.ListAssessmentVMs200JSONResponse(mappers.AssessmentVMPageToApi(*page)), nil
+}
+
+// assessmentVMFilterFromParams maps the query parameters to the service filter.
+// Range checks of limit and offset are left to the service.
+func assessmentVMFilterFromParams(params api.ListAssessmentVMsParams) (*service.AssessmentVMFilter, error) {
+	filter := service.NewAssessmentVMFilter()
+
+	snapshotID, err := snapshotIDFromRequest(params.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	filter.SnapshotID = snapshotID
+
+	if params.Cluster != nil {
+		filter.Cluster = *params.Cluster
+	}
+	if params.Os != nil {
+		filter.OS = *params.Os
+	}
+	if params.PowerState != nil {
+		filter.PowerState = *params.PowerState
+	}
+	if params.ConcernId != nil {
+		filter.ConcernID = *params.ConcernId
+	}
+	if params.Category != nil {
+		switch *params.Category {
+		case api.Critical, api.Warning, api.Information:
+			filter.Category = string(*params.Category)
+		default:
+			return nil, fmt.Errorf("invalid category: %s", *params.Category)
+		}
+	}
+	if params.Sort != nil {
+		filter.SortBy = string(*params.Sort)
+	}
+	if params.Order != nil {
+		switch *params.Order {
+		case api.Asc:
+			filter.SortDesc = false
+		case api.Desc:
+			filter.SortDesc = true
+		default:
+			return nil, fmt.Errorf("invalid order: %s", *params.Order)
+		}
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+
+	return filter, nil
+}
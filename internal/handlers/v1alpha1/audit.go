@@ -0,0 +1,46 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/audit)
+func (h *ServiceHandler) ListAuditEntries(ctx context.Context, request server.ListAuditEntriesRequestObject) (server.ListAuditEntriesResponseObject, error) {
+	logger := log.NewDebugLogger("audit_handler").
+		WithContext(ctx).
+		Operation("list_audit_entries").
+		Build()
+
+	entries, err := h.auditSrv.ListEntries(ctx, mappers.AuditParamsToFilter(request.Params))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.ListAuditEntries403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.ListAuditEntries400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.ListAuditEntries500JSONResponse{Message: fmt.Sprintf("failed to list audit entries: %v", err)}, nil
+		}
+	}
+
+	if mappers.AuditExportAsCSV(request.Params) {
+		content, err := mappers.AuditEntriesToCSV(entries)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.ListAuditEntries500JSONResponse{Message: fmt.Sprintf("failed to export audit entries: %v", err)}, nil
+		}
+		logger.Success().WithInt("count", len(entries)).WithString("format", "csv").Log()
+		return server.ListAuditEntries200TextcsvResponse{Body: bytes.NewReader(content), ContentLength: int64(len(content))}, nil
+	}
+
+	logger.Success().WithInt("count", len(entries)).Log()
+	return server.ListAuditEntries200JSONResponse(mappers.AuditEntryListToApi(entries)), nil
+}
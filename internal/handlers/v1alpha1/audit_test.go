@@ -0,0 +1,107 @@
+package v1alpha1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeAuditService returns its entries, or its error, and keeps the last filter.
+type fakeAuditService struct {
+	entries model.AuditEntryList
+	err     error
+	filter  service.AuditFilter
+}
+
+func (f *fakeAuditService) ListEntries(_ context.Context, filter service.AuditFilter) (model.AuditEntryList, error) {
+	f.filter = filter
+	return f.entries, f.err
+}
+
+var _ = Describe("audit handler", func() {
+	var (
+		auditSrv *fakeAuditService
+		handler  *handlers.ServiceHandler
+		ctx      context.Context
+	)
+
+	createdAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		auditSrv = &fakeAuditService{entries: model.AuditEntryList{{
+			ID:           1,
+			CreatedAt:    createdAt,
+			RequestID:    "req-1",
+			Actor:        "customer",
+			Action:       model.AuditAssessmentShared,
+			ResourceType: string(model.AssessmentResource),
+			ResourceID:   "a1",
+			Subject:      "org:partner",
+			After:        model.MakeJSONField(model.AuditState{"relation": "viewer"}),
+		}}}
+		ctx = auth.NewTokenContext(context.Background(), auth.User{Username: "admin", Organization: "org"})
+		handler = handlers.NewServiceHandler(nil, nil, nil, nil, nil, nil, nil, nil).WithAuditLog(auditSrv)
+	})
+
+	It("returns the entries as JSON, 100 by default", func() {
+		actor := "customer"
+		resp, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{
+			Params: api.ListAuditEntriesParams{Actor: &actor},
+		})
+		Expect(err).To(BeNil())
+		entries, ok := resp.(server.ListAuditEntries200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Action).To(Equal("assessment.shared"))
+		Expect(entries[0].Subject).To(Equal("org:partner"))
+		Expect(entries[0].Before).To(BeNil())
+		Expect(*entries[0].After).To(HaveKeyWithValue("relation", "viewer"))
+		Expect(auditSrv.filter.Actor).To(Equal("customer"))
+		Expect(auditSrv.filter.Limit).To(Equal(100))
+	})
+
+	It("exports all the entries as CSV", func() {
+		format := api.Csv
+		resp, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{
+			Params: api.ListAuditEntriesParams{Format: &format},
+		})
+		Expect(err).To(BeNil())
+		export, ok := resp.(server.ListAuditEntries200TextcsvResponse)
+		Expect(ok).To(BeTrue())
+		Expect(auditSrv.filter.Limit).To(BeZero())
+
+		content, err := io.ReadAll(export.Body)
+		Expect(err).To(BeNil())
+		Expect(export.ContentLength).To(BeEquivalentTo(len(content)))
+		records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(2))
+		Expect(records[0][0]).To(Equal("id"))
+		Expect(records[1]).To(Equal([]string{"1", "2026-10-01T12:00:00Z", "req-1", "customer", "assessment.shared", "assessment", "a1", "org:partner", "", `{"relation":"viewer"}`}))
+	})
+
+	It("returns 403 for non admins", func() {
+		auditSrv.err = service.NewErrForbidden("audit log", "user")
+		resp, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{})
+		Expect(err).To(BeNil())
+		Expect(resp).To(BeAssignableToTypeOf(server.ListAuditEntries403JSONResponse{}))
+	})
+
+	It("returns 400 for invalid filters", func() {
+		auditSrv.err = service.NewErrInvalidRequest("since must be before until")
+		resp, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{})
+		Expect(err).To(BeNil())
+		Expect(resp).To(BeAssignableToTypeOf(server.ListAuditEntries400JSONResponse{}))
+	})
+})
@@ -0,0 +1,137 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables)
+func (h *ServiceHandler) ListComplexityTables(ctx context.Context, request server.ListComplexityTablesRequestObject) (server.ListComplexityTablesResponseObject, error) {
+	logger := log.NewDebugLogger("complexity_table_handler").
+		WithContext(ctx).
+		Operation("list_complexity_tables").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	tables, err := h.complexityTableSrv.ListTables(ctx, request.OrgId)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.ListComplexityTables403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		default:
+			return server.ListComplexityTables500JSONResponse{Message: fmt.Sprintf("failed to list complexity tables: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(tables)).Log()
+	return server.ListComplexityTables200JSONResponse(mappers.ComplexityTableListToApi(tables)), nil
+}
+
+// (POST /api/v1/organizations/{orgId}/complexity-tables)
+func (h *ServiceHandler) CreateComplexityTable(ctx context.Context, request server.CreateComplexityTableRequestObject) (server.CreateComplexityTableResponseObject, error) {
+	logger := log.NewDebugLogger("complexity_table_handler").
+		WithContext(ctx).
+		Operation("create_complexity_table").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	if request.Body == nil {
+		return server.CreateComplexityTable400JSONResponse{Message: "empty body"}, nil
+	}
+
+	table, err := h.complexityTableSrv.CreateTable(ctx, request.OrgId, mappers.ComplexityTableCreateToService(*request.Body))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.CreateComplexityTable403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.CreateComplexityTable400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			return server.CreateComplexityTable409JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CreateComplexityTable500JSONResponse{Message: fmt.Sprintf("failed to create complexity table: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithString("version", table.Version).Log()
+	return server.CreateComplexityTable201JSONResponse(mappers.ComplexityTableToApi(table)), nil
+}
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables/active)
+func (h *ServiceHandler) GetActiveComplexityTable(ctx context.Context, request server.GetActiveComplexityTableRequestObject) (server.GetActiveComplexityTableResponseObject, error) {
+	logger := log.NewDebugLogger("complexity_table_handler").
+		WithContext(ctx).
+		Operation("get_active_complexity_table").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	table, err := h.complexityTableSrv.GetActiveTable(ctx, request.OrgId)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.GetActiveComplexityTable403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		default:
+			return server.GetActiveComplexityTable500JSONResponse{Message: fmt.Sprintf("failed to get active complexity table: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithString("version", table.Version).Log()
+	return server.GetActiveComplexityTable200JSONResponse(mappers.ComplexityTableToApi(table)), nil
+}
+
+// (DELETE /api/v1/organizations/{orgId}/complexity-tables/active)
+func (h *ServiceHandler) ResetComplexityTable(ctx context.Context, request server.ResetComplexityTableRequestObject) (server.ResetComplexityTableResponseObject, error) {
+	logger := log.NewDebugLogger("complexity_table_handler").
+		WithContext(ctx).
+		Operation("reset_complexity_table").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	table, err := h.complexityTableSrv.ResetTable(ctx, request.OrgId)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.ResetComplexityTable403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		default:
+			return server.ResetComplexityTable500JSONResponse{Message: fmt.Sprintf("failed to reset complexity table: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.ResetComplexityTable200JSONResponse(mappers.ComplexityTableToApi(table)), nil
+}
+
+// (GET /api/v1/organizations/{orgId}/complexity-tables/{version})
+func (h *ServiceHandler) GetComplexityTable(ctx context.Context, request server.GetComplexityTableRequestObject) (server.GetComplexityTableResponseObject, error) {
+	logger := log.NewDebugLogger("complexity_table_handler").
+		WithContext(ctx).
+		Operation("get_complexity_table").
+		WithString("org_id", request.OrgId).
+		WithString("version", request.Version).
+		Build()
+
+	table, err := h.complexityTableSrv.GetTable(ctx, request.OrgId, request.Version)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.GetComplexityTable403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrResourceNotFound:
+			return server.GetComplexityTable404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.GetComplexityTable500JSONResponse{Message: fmt.Sprintf("failed to get complexity table: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.GetComplexityTable200JSONResponse(mappers.ComplexityTableToApi(table)), nil
+}
@@ -0,0 +1,99 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("complexity table handler", func() {
+	const orgID = "test-org"
+
+	var (
+		mockStore *MockStore
+		handler   *handlers.ServiceHandler
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		mockStore = NewMockStore()
+		ctx = auth.NewTokenContext(context.Background(), auth.User{Username: "admin", Organization: orgID})
+		handler = handlers.NewServiceHandler(nil, nil, nil, nil, nil, nil, nil, nil).
+			WithComplexityTables(service.NewComplexityTableService(mockStore))
+	})
+
+	tableCreate := func() *api.ComplexityTableCreate {
+		table := complexity.DefaultTable()
+		table.OSDifficultyScores["AlmaLinux 9"] = 1
+		return &api.ComplexityTableCreate{
+			OsDifficultyScores: table.OSDifficultyScores,
+			DiskSizeScores:     table.DiskSizeScores,
+			OsTiers:            table.OSTiers,
+		}
+	}
+
+	It("returns 201 and activates the new version", func() {
+		resp, err := handler.CreateComplexityTable(ctx, server.CreateComplexityTableRequestObject{OrgId: orgID, Body: tableCreate()})
+
+		Expect(err).To(BeNil())
+		created, ok := resp.(server.CreateComplexityTable201JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(created.Version).To(Equal("org-1"))
+		Expect(created.Active).To(BeTrue())
+		Expect(*created.CreatedBy).To(Equal("admin"))
+
+		activeResp, err := handler.GetActiveComplexityTable(ctx, server.GetActiveComplexityTableRequestObject{OrgId: orgID})
+		Expect(err).To(BeNil())
+		active, ok := activeResp.(server.GetActiveComplexityTable200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(active.Version).To(Equal("org-1"))
+		Expect(active.OsDifficultyScores["AlmaLinux 9"]).To(Equal(1))
+
+		listResp, err := handler.ListComplexityTables(ctx, server.ListComplexityTablesRequestObject{OrgId: orgID})
+		Expect(err).To(BeNil())
+		list, ok := listResp.(server.ListComplexityTables200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].CreatedBy).To(BeNil())
+	})
+
+	It("returns 400 for invalid tables", func() {
+		body := tableCreate()
+		delete(body.DiskSizeScores, "0-10TiB")
+
+		resp, err := handler.CreateComplexityTable(ctx, server.CreateComplexityTableRequestObject{OrgId: orgID, Body: body})
+
+		Expect(err).To(BeNil())
+		errorResp, ok := resp.(server.CreateComplexityTable400JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(errorResp.Message).To(ContainSubstring(`"0-10TiB" is not scored`))
+	})
+
+	It("returns 404 for an unknown version", func() {
+		resp, err := handler.GetComplexityTable(ctx, server.GetComplexityTableRequestObject{OrgId: orgID, Version: "org-7"})
+
+		Expect(err).To(BeNil())
+		_, ok := resp.(server.GetComplexityTable404JSONResponse)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("returns the built-in tables on reset", func() {
+		_, err := handler.CreateComplexityTable(ctx, server.CreateComplexityTableRequestObject{OrgId: orgID, Body: tableCreate()})
+		Expect(err).To(BeNil())
+
+		resp, err := handler.ResetComplexityTable(ctx, server.ResetComplexityTableRequestObject{OrgId: orgID})
+
+		Expect(err).To(BeNil())
+		reset, ok := resp.(server.ResetComplexityTable200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(reset.Version).To(Equal(complexity.DefaultTableVersion))
+		Expect(reset.Active).To(BeTrue())
+	})
+})
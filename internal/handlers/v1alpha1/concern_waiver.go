@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/assessments/{id}/waivers)
+func (h *ServiceHandler) ListConcernWaivers(ctx context.Context, request server.ListConcernWaiversRequestObject) (server.ListConcernWaiversResponseObject, error) {
+	logger := log.NewDebugLogger("concern_waiver_handler").
+		WithContext(ctx).
+		Operation("list_concern_waivers").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	waivers, err := h.assessmentSrv.ListConcernWaivers(ctx, request.Id)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.ListConcernWaivers404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.ListConcernWaivers403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.ListConcernWaivers500JSONResponse{Message: fmt.Sprintf("failed to list concern waivers: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(waivers)).Log()
+	return server.ListConcernWaivers200JSONResponse(mappers.ConcernWaiverListToApi(waivers, time.Now())), nil
+}
+
+// (POST /api/v1/assessments/{id}/waivers)
+func (h *ServiceHandler) CreateConcernWaiver(ctx context.Context, request server.CreateConcernWaiverRequestObject) (server.CreateConcernWaiverResponseObject, error) {
+	logger := log.NewDebugLogger("concern_waiver_handler").
+		WithContext(ctx).
+		Operation("create_concern_waiver").
+		WithUUID("assessment_id", request.Id).
+		WithRequestBody("request_body", request.Body).
+		Build()
+
+	if request.Body == nil {
+		return server.CreateConcernWaiver400JSONResponse{Message: "empty body"}, nil
+	}
+
+	waiver, err := h.assessmentSrv.CreateConcernWaiver(ctx, request.Id, mappers.ConcernWaiverCreateToService(*request.Body))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.CreateConcernWaiver404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.CreateConcernWaiver403JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			return server.CreateConcernWaiver400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CreateConcernWaiver500JSONResponse{Message: fmt.Sprintf("failed to create concern waiver: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithUUID("waiver_id", waiver.ID).Log()
+	return server.CreateConcernWaiver201JSONResponse(mappers.ConcernWaiverToApi(*waiver, time.Now())), nil
+}
+
+// (DELETE /api/v1/assessments/{id}/waivers/{waiverId})
+func (h *ServiceHandler) DeleteConcernWaiver(ctx context.Context, request server.DeleteConcernWaiverRequestObject) (server.DeleteConcernWaiverResponseObject, error) {
+	logger := log.NewDebugLogger("concern_waiver_handler").
+		WithContext(ctx).
+		Operation("delete_concern_waiver").
+		WithUUID("assessment_id", request.Id).
+		WithUUID("waiver_id", request.WaiverId).
+		Build()
+
+	if err := h.assessmentSrv.DeleteConcernWaiver(ctx, request.Id, request.WaiverId); err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.DeleteConcernWaiver404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.DeleteConcernWaiver403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.DeleteConcernWaiver500JSONResponse{Message: fmt.Sprintf("failed to delete concern waiver: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.DeleteConcernWaiver204Response{}, nil
+}
@@ -3,7 +3,9 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/api/server"
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
@@ -11,6 +13,7 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 	"github.com/kubev2v/migration-planner/pkg/log"
 )
 
@@ -38,6 +41,12 @@ func (h *ServiceHandler) CalculateMigrationComplexity(ctx context.Context, reque
 		return server.CalculateMigrationComplexity400JSONResponse{Message: "clusterId is required"}, nil
 	}
 
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateMigrationComplexity400JSONResponse{Message: err.Error()}, nil
+	}
+
 	if _, err := h.assessmentSrv.GetAssessment(ctx, assessmentID); err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
@@ -52,12 +61,15 @@ func (h *ServiceHandler) CalculateMigrationComplexity(ctx context.Context, reque
 		}
 	}
 
-	result, err := h.estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+	result, err := h.estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, snapshotID, request.Body.ComplexityTableVersion)
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
 			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
 			return server.CalculateMigrationComplexity404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+			return server.CalculateMigrationComplexity400JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
 			return server.CalculateMigrationComplexity500JSONResponse{Message: "failed to calculate migration complexity"}, nil
@@ -93,6 +105,11 @@ func (h *ServiceHandler) CalculateMigrationEstimationByComplexity(ctx context.Co
 		return server.CalculateMigrationEstimationByComplexity400JSONResponse{Message: "clusterId is required"}, nil
 	}
 
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		return server.CalculateMigrationEstimationByComplexity400JSONResponse{Message: err.Error()}, nil
+	}
+
 	var schemas []engines.Schema
 	if request.Body.EstimationSchema != nil {
 		for _, s := range *request.Body.EstimationSchema {
@@ -117,7 +134,7 @@ func (h *ServiceHandler) CalculateMigrationEstimationByComplexity(ctx context.Co
 		}
 	}
 
-	_, err := h.assessmentSrv.GetAssessment(ctx, assessmentID)
+	_, err = h.assessmentSrv.GetAssessment(ctx, assessmentID)
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
@@ -132,12 +149,15 @@ func (h *ServiceHandler) CalculateMigrationEstimationByComplexity(ctx context.Co
 		}
 	}
 
-	osDiskResult, err := h.estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID)
+	osDiskResult, err := h.estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, snapshotID, request.Body.ComplexityTableVersion)
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
 			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
 			return server.CalculateMigrationEstimationByComplexity404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+			return server.CalculateMigrationEstimationByComplexity400JSONResponse{Message: err.Error()}, nil
 		default:
 			logger.Error(err).Log()
 			return server.CalculateMigrationEstimationByComplexity500JSONResponse{Message: "failed to calculate complexity"}, nil
@@ -153,7 +173,7 @@ func (h *ServiceHandler) CalculateMigrationEstimationByComplexity(ctx context.Co
 			continue
 		}
 		diskGB := bucket.TotalSizeTB * 1024
-		bucketParams := h.estimationSrv.BuildBucketParams(baseParams, bucket.VMCount, diskGB)
+		bucketParams := h.estimationSrv.BuildBucketParams(userParams, bucket.VMCount, diskGB)
 		schemaResults, err := h.estimationSrv.RunEstimation(schemas, bucketParams)
 		if err != nil {
 			logger.Error(err).Log()
@@ -181,6 +201,7 @@ func (h *ServiceHandler) CalculateMigrationEstimationByComplexity(ctx context.Co
 		estimationCtx,
 		complexity.ComplexityMatrix,
 	)
+	apiResp.ComplexityTableVersion = osDiskResult.ComplexityTableVersion
 	return server.CalculateMigrationEstimationByComplexity200JSONResponse(apiResp), nil
 }
 
@@ -208,6 +229,12 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 		return server.CalculateMigrationEstimation400JSONResponse{Message: "clusterId is required"}, nil
 	}
 
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+	}
+
 	// Parse optional estimation schemas from request body
 	var schemas []engines.Schema
 	if request.Body.EstimationSchema != nil {
@@ -255,7 +282,7 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 		WithString("username", user.Username).
 		Log()
 
-	result, err := h.estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, schemas, userParams)
+	result, err := h.estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, snapshotID, schemas, userParams)
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
@@ -270,6 +297,41 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 		}
 	}
 
+	var simulations map[engines.Schema]*estimation.SimulationResult
+	if request.Body.Simulation != nil {
+		settings, err := simulationSettingsFromRequest(*request.Body.Simulation)
+		if err != nil {
+			logger.Error(err).Log()
+			return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+		}
+		simulations, err = h.estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, snapshotID, schemas, userParams, settings)
+		if err != nil {
+			logger.Error(err).Log()
+			switch err.(type) {
+			case *service.ErrResourceNotFound:
+				return server.CalculateMigrationEstimation404JSONResponse{Message: err.Error()}, nil
+			case *service.ErrInvalidSchema, *service.ErrInvalidEstimationParam, *service.ErrInvalidRequest:
+				return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+			default:
+				return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to simulate migration estimation"}, nil
+			}
+		}
+	}
+
+	var timelines map[engines.Schema]*timeline.Timeline
+	if request.Body.Timeline != nil {
+		timelines, err = h.estimationSrv.ProjectTimelines(result, userParams, mappers.TimelineRequestToCalendar(*request.Body.Timeline))
+		if err != nil {
+			logger.Error(err).Log()
+			switch err.(type) {
+			case *service.ErrInvalidRequest:
+				return server.CalculateMigrationEstimation400JSONResponse{Message: err.Error()}, nil
+			default:
+				return server.CalculateMigrationEstimation500JSONResponse{Message: "failed to project timeline"}, nil
+			}
+		}
+	}
+
 	logger.Success().
 		WithString("org_id", user.Organization).
 		WithString("username", user.Username).
@@ -283,5 +345,78 @@ func (h *ServiceHandler) CalculateMigrationEstimation(ctx context.Context, reque
 	estimationCtx := &service.EstimationContext{Schemas: resolvedSchemas, BaseParams: baseParams}
 
 	apiResponse := mappers.MigrationEstimationResultToAPI(result, estimationCtx)
+	if timelines != nil {
+		apiTimelines := mappers.TimelinesToAPI(timelines)
+		apiResponse.Timeline = &apiTimelines
+	}
+	if simulations != nil {
+		apiSimulations := mappers.SimulationResultsToAPI(simulations)
+		apiResponse.Simulation = &apiSimulations
+	}
 	return server.CalculateMigrationEstimation200JSONResponse(apiResponse), nil
 }
+
+// simulationSettingsFromRequest converts the simulation block of an estimation request,
+// checking that each distribution carries the fields of its type.
+func simulationSettingsFromRequest(req api.SimulationRequest) (service.SimulationSettings, error) {
+	var settings service.SimulationSettings
+	if req.Runs != nil {
+		if *req.Runs <= 0 {
+			return settings, fmt.Errorf("simulation runs must be positive")
+		}
+		settings.Runs = *req.Runs
+	}
+	if req.Seed != nil {
+		if *req.Seed < 0 {
+			return settings, fmt.Errorf("simulation seed must not be negative")
+		}
+		settings.Seed = uint64(*req.Seed)
+	}
+	if req.ParamDistributions == nil {
+		return settings, nil
+	}
+
+	missing := func(fields ...*float64) bool {
+		return slices.Contains(fields, nil)
+	}
+	settings.Distributions = make(map[string]estimation.Distribution, len(*req.ParamDistributions))
+	for key, d := range *req.ParamDistributions {
+		switch d.Type {
+		case api.Triangular:
+			if missing(d.Min, d.Mode, d.Max) {
+				return settings, fmt.Errorf("param %q: triangular distribution requires min, mode and max", key)
+			}
+			settings.Distributions[key] = estimation.Triangular{Min: *d.Min, Mode: *d.Mode, Max: *d.Max}
+		case api.Uniform:
+			if missing(d.Min, d.Max) {
+				return settings, fmt.Errorf("param %q: uniform distribution requires min and max", key)
+			}
+			settings.Distributions[key] = estimation.Uniform{Min: *d.Min, Max: *d.Max}
+		case api.Normal:
+			if missing(d.Mean, d.Stddev) {
+				return settings, fmt.Errorf("param %q: normal distribution requires mean and stddev", key)
+			}
+			settings.Distributions[key] = estimation.Normal{Mean: *d.Mean, StdDev: *d.Stddev}
+		default:
+			return settings, fmt.Errorf("param %q: unknown distribution type %q", key, d.Type)
+		}
+	}
+	return settings, nil
+}
+
+// (GET /api/v1/migration-estimation/schemas)
+func (h *ServiceHandler) ListEstimationSchemas(ctx context.Context, request server.ListEstimationSchemasRequestObject) (server.ListEstimationSchemasResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("list_estimation_schemas").
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	schemas := h.estimationSrv.ListEstimationSchemas()
+
+	logger.Success().WithInt("schema_count", len(schemas)).Log()
+
+	return server.ListEstimationSchemas200JSONResponse(mappers.EstimationSchemasToAPI(schemas)), nil
+}
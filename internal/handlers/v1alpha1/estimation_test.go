@@ -13,6 +13,8 @@ import (
 	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -658,6 +660,161 @@ var _ = Describe("estimation handler", func() {
 			})
 		})
 
+		Context("when request includes a timeline", func() {
+			BeforeEach(func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil, nil)
+			})
+
+			It("returns the phases of each schema on the calendar", func() {
+				streams := 2
+				workingDays := []api.TimelineRequestWorkingDays{api.Monday, api.Tuesday}
+				blackouts := []api.BlackoutPeriod{{
+					StartDate: openapi_types.Date{Time: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)},
+					EndDate:   openapi_types.Date{Time: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)},
+				}}
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id: assessmentID,
+					Body: &api.MigrationEstimationRequest{
+						ClusterId: clusterID,
+						Timeline: &api.TimelineRequest{
+							StartDate:       openapi_types.Date{Time: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+							WorkingDays:     &workingDays,
+							BlackoutPeriods: &blackouts,
+							ParallelStreams: &streams,
+						},
+					},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation200JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Timeline).NotTo(BeNil())
+				Expect(*response.Timeline).To(HaveKey("network-based"))
+				tl := (*response.Timeline)["network-based"]
+				Expect(tl.Phases).To(HaveLen(2))
+				Expect(tl.Phases[0].Name).To(Equal("Storage Migration"))
+				Expect(tl.StartDate.Time).To(Equal(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)))
+				// Only Mondays and Tuesdays, Tuesday 6th is a blackout
+				for _, p := range tl.Phases {
+					Expect(p.EndDate.Weekday()).To(BeElementOf(time.Monday, time.Tuesday))
+					Expect(p.EndDate.Time).NotTo(Equal(time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)))
+				}
+				Expect(tl.EarliestEndDate.Time).To(BeTemporally("<=", tl.EndDate.Time))
+			})
+
+			It("returns 400 for an inverted blackout period", func() {
+				blackouts := []api.BlackoutPeriod{{
+					StartDate: openapi_types.Date{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+					EndDate:   openapi_types.Date{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+				}}
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id: assessmentID,
+					Body: &api.MigrationEstimationRequest{
+						ClusterId: clusterID,
+						Timeline: &api.TimelineRequest{
+							StartDate:       openapi_types.Date{Time: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)},
+							BlackoutPeriods: &blackouts,
+						},
+					},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation400JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Message).To(ContainSubstring("blackout"))
+			})
+
+			It("omits the timeline when not requested", func() {
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id:   assessmentID,
+					Body: &api.MigrationEstimationRequest{ClusterId: clusterID},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation200JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Timeline).To(BeNil())
+			})
+		})
+
+		Context("when request includes a simulation", func() {
+			BeforeEach(func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
+				handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil, nil)
+			})
+
+			floatPtr := func(v float64) *float64 { return &v }
+
+			It("returns percentiles per schema and calculator", func() {
+				runs := 300
+				seed := int64(7)
+				dists := map[string]api.ParamDistribution{
+					"transfer_rate_mbps":       {Type: api.Triangular, Min: floatPtr(200), Mode: floatPtr(500), Max: floatPtr(1000)},
+					"troubleshoot_mins_per_vm": {Type: api.Normal, Mean: floatPtr(60), Stddev: floatPtr(10)},
+				}
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id: assessmentID,
+					Body: &api.MigrationEstimationRequest{
+						ClusterId:        clusterID,
+						EstimationSchema: &[]string{"network-based"},
+						Simulation:       &api.SimulationRequest{Runs: &runs, Seed: &seed, ParamDistributions: &dists},
+					},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation200JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Simulation).NotTo(BeNil())
+				sim := (*response.Simulation)["network-based"]
+				Expect(sim.Runs).To(Equal(300))
+				Expect(sim.Seed).To(Equal(int64(7)))
+				Expect(sim.Breakdown).To(HaveKey("Storage Migration"))
+				Expect(sim.Breakdown).To(HaveKey("Post-Migration Checks"))
+				p50, err := time.ParseDuration(sim.Total.P50)
+				Expect(err).To(BeNil())
+				p95, err := time.ParseDuration(sim.Total.P95)
+				Expect(err).To(BeNil())
+				Expect(p95).To(BeNumerically(">=", p50))
+			})
+
+			It("returns 400 when a distribution misses a field", func() {
+				dists := map[string]api.ParamDistribution{
+					"transfer_rate_mbps": {Type: api.Triangular, Min: floatPtr(200), Max: floatPtr(1000)},
+				}
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id: assessmentID,
+					Body: &api.MigrationEstimationRequest{
+						ClusterId:  clusterID,
+						Simulation: &api.SimulationRequest{ParamDistributions: &dists},
+					},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation400JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Message).To(ContainSubstring("requires min, mode and max"))
+			})
+
+			It("returns 400 for a distribution below the param minimum", func() {
+				dists := map[string]api.ParamDistribution{
+					"troubleshoot_mins_per_vm": {Type: api.Uniform, Min: floatPtr(0.5), Max: floatPtr(10)},
+				}
+				resp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+					Id: assessmentID,
+					Body: &api.MigrationEstimationRequest{
+						ClusterId:  clusterID,
+						Simulation: &api.SimulationRequest{ParamDistributions: &dists},
+					},
+				})
+
+				Expect(err).To(BeNil())
+				response, ok := resp.(server.CalculateMigrationEstimation400JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Message).To(ContainSubstring("below minimum"))
+			})
+		})
+
 		Context("when request includes params override", func() {
 			It("produces a shorter Storage Migration duration when transfer_rate_mbps is increased", func() {
 				mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
@@ -1095,4 +1252,128 @@ var _ = Describe("estimation handler", func() {
 			})
 		})
 	})
+
+	Describe("ListEstimationSchemas", func() {
+		It("returns the built-in schemas with their param keys", func() {
+			handler = handlers.NewServiceHandler(nil, nil, nil, nil, service.NewEstimationService(mockStore), nil, nil, nil)
+
+			resp, err := handler.ListEstimationSchemas(ctx, server.ListEstimationSchemasRequestObject{})
+
+			Expect(err).To(BeNil())
+			response, ok := resp.(server.ListEstimationSchemas200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response).To(HaveLen(2))
+			Expect(response[0].Name).To(Equal("network-based"))
+			Expect(response[0].ParamKeys).To(ContainElements("transfer_rate_mbps", "work_hours_per_day"))
+			Expect(response[0].Calculators).To(HaveLen(2))
+			Expect(response[0].Calculators[0].Name).To(Equal("Storage Migration"))
+			Expect(response[1].Name).To(Equal("storage-offload"))
+			Expect(response[1].ParamKeys).NotTo(ContainElement("transfer_rate_mbps"))
+		})
+
+		It("returns configured schemas, which can then be requested", func() {
+			registry, err := engines.NewRegistry(engines.SchemaDefinition{
+				Name: "change-windows",
+				Calculators: []engines.CalculatorDefinition{
+					{Type: engines.CalculatorStorageMigration, Params: map[string]float64{"transfer_rate_mbps": 100}},
+					{Type: engines.CalculatorChangeWindow, Params: map[string]float64{"change_window_hours": 2}},
+				},
+			})
+			Expect(err).To(BeNil())
+			mockStore.assessments[assessmentID] = createTestAssessmentForEstimationHandler(assessmentID, user.Username, user.Organization, clusterID)
+			handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil, nil), nil, nil,
+				service.NewEstimationService(mockStore).WithSchemaRegistry(registry), nil, nil, nil)
+
+			resp, err := handler.ListEstimationSchemas(ctx, server.ListEstimationSchemasRequestObject{})
+			Expect(err).To(BeNil())
+			response, ok := resp.(server.ListEstimationSchemas200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response).To(HaveLen(1))
+			Expect(response[0].Calculators[1].Params).To(HaveKeyWithValue("change_window_hours", 2.0))
+			Expect(response[0].ParamKeys).To(ContainElement("vms_per_change_window"))
+
+			estResp, err := handler.CalculateMigrationEstimation(ctx, server.CalculateMigrationEstimationRequestObject{
+				Id:   assessmentID,
+				Body: &api.MigrationEstimationRequest{ClusterId: clusterID},
+			})
+			Expect(err).To(BeNil())
+			estResponse, ok := estResp.(server.CalculateMigrationEstimation200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(estResponse.Estimation).To(HaveKey("change-windows"))
+			breakdown := estResponse.Estimation["change-windows"].Breakdown
+			Expect(breakdown["Storage Migration"].Reason).To(ContainSubstring("at 100 Mbps"))
+			Expect(breakdown["Change Windows"].Reason).To(ContainSubstring("of 2 h"))
+		})
+	})
+
+	Describe("PlanMigrationWaves", func() {
+		BeforeEach(func() {
+			handler = handlers.NewServiceHandler(nil, service.NewAssessmentService(mockStore, nil, nil), nil, nil, service.NewEstimationService(mockStore), nil, nil, nil)
+		})
+
+		It("returns 200 with waves ordered by complexity", func() {
+			mockStore.assessments[assessmentID] = createTestAssessmentForByComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
+			maxVMs := 8
+
+			resp, err := handler.PlanMigrationWaves(ctx, server.PlanMigrationWavesRequestObject{
+				Id:   assessmentID,
+				Body: &api.MigrationWavePlanRequest{ClusterId: clusterID, MaxVmsPerWave: &maxVMs},
+			})
+
+			Expect(err).To(BeNil())
+			response, ok := resp.(server.PlanMigrationWaves200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response.EstimationSchema).To(Equal("network-based"))
+			// 30 VMs of score 1 then 10 VMs of score 2, at most 8 per wave
+			Expect(response.Waves).To(HaveLen(6))
+			Expect(response.Waves[0].ComplexityScore).To(Equal(1))
+			Expect(response.Waves[0].VmCount).To(Equal(8))
+			Expect(response.Waves[3].VmCount).To(Equal(6))
+			Expect(response.Waves[4].ComplexityScore).To(Equal(2))
+			Expect(response.Waves[5].Number).To(Equal(6))
+			Expect(response.Waves[0].Estimation.Breakdown).To(HaveKey("Storage Migration"))
+			Expect(response.MaxTotalDuration).NotTo(BeEmpty())
+			Expect(response.EstimationContext).NotTo(BeNil())
+		})
+
+		It("returns 400 when a constraint is not positive", func() {
+			mockStore.assessments[assessmentID] = createTestAssessmentForByComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
+			maxGB := 0.0
+
+			resp, err := handler.PlanMigrationWaves(ctx, server.PlanMigrationWavesRequestObject{
+				Id:   assessmentID,
+				Body: &api.MigrationWavePlanRequest{ClusterId: clusterID, MaxDiskGbPerWave: &maxGB},
+			})
+
+			Expect(err).To(BeNil())
+			response, ok := resp.(server.PlanMigrationWaves400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response.Message).To(ContainSubstring("maxDiskGbPerWave"))
+		})
+
+		It("returns 400 for an unknown schema", func() {
+			mockStore.assessments[assessmentID] = createTestAssessmentForByComplexityHandler(assessmentID, user.Username, user.Organization, clusterID)
+			schema := "bogus"
+
+			resp, err := handler.PlanMigrationWaves(ctx, server.PlanMigrationWavesRequestObject{
+				Id:   assessmentID,
+				Body: &api.MigrationWavePlanRequest{ClusterId: clusterID, EstimationSchema: &schema},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.PlanMigrationWaves400JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("returns 404 when the assessment does not exist", func() {
+			resp, err := handler.PlanMigrationWaves(ctx, server.PlanMigrationWavesRequestObject{
+				Id:   uuid.New(),
+				Body: &api.MigrationWavePlanRequest{ClusterId: clusterID},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.PlanMigrationWaves404JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+	})
 })
@@ -1,6 +1,11 @@
 package v1alpha1
 
-import "github.com/kubev2v/migration-planner/internal/service"
+import (
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/opa"
+)
 
 type ServiceHandler struct {
 	sourceSrv          *service.SourceService
@@ -11,6 +16,11 @@ type ServiceHandler struct {
 	partnerSrv         service.PartnerServicer
 	accountsSrv        service.AccountsServicer
 	enhancementDataSrv service.AssessmentEnhancementDataServicer
+	hardwareCatalogSrv service.HardwareCatalogServicer
+	complexityTableSrv service.ComplexityTableServicer
+	policyBundleSrv    service.PolicyBundleServicer
+	auditSrv           service.AuditServicer
+	opaValidator       *opa.Validator
 }
 
 func NewServiceHandler(
@@ -34,3 +44,44 @@ func NewServiceHandler(
 		enhancementDataSrv: enhancementData,
 	}
 }
+
+// WithHardwareCatalog sets the hardware catalog served by the hardware SKU endpoints.
+func (h *ServiceHandler) WithHardwareCatalog(catalog service.HardwareCatalogServicer) *ServiceHandler {
+	h.hardwareCatalogSrv = catalog
+	return h
+}
+
+// WithComplexityTables sets the service managing the complexity scoring tables of the organizations.
+func (h *ServiceHandler) WithComplexityTables(tables service.ComplexityTableServicer) *ServiceHandler {
+	h.complexityTableSrv = tables
+	return h
+}
+
+// WithPolicyBundles sets the service managing the OPA policies of the organizations.
+func (h *ServiceHandler) WithPolicyBundles(bundles service.PolicyBundleServicer) *ServiceHandler {
+	h.policyBundleSrv = bundles
+	return h
+}
+
+// WithAuditLog sets the service reading the audit log of the access changes.
+func (h *ServiceHandler) WithAuditLog(audit service.AuditServicer) *ServiceHandler {
+	h.auditSrv = audit
+	return h
+}
+
+// WithOpaValidator sets the validator whose policy revision is reported by the info endpoint,
+// and whose remediation catalog is attached to the migration issues of the assessments.
+func (h *ServiceHandler) WithOpaValidator(validator *opa.Validator) *ServiceHandler {
+	h.opaValidator = validator
+	return h
+}
+
+// attachRemediations attaches the remediations of the current global policies to the migration
+// issues of the inventory, if the handler has a validator. Remediations are not versioned with the
+// snapshots: they are always looked up in the current catalog, whose revision they report, even
+// when the snapshot was evaluated with other policies.
+func (h *ServiceHandler) attachRemediations(inventory *api.Inventory) {
+	if h.opaValidator != nil {
+		mappers.AttachRemediations(inventory, h.opaValidator.Remediations())
+	}
+}
@@ -0,0 +1,229 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/hardware-skus)
+func (h *ServiceHandler) ListHardwareSkus(ctx context.Context, request server.ListHardwareSkusRequestObject) (server.ListHardwareSkusResponseObject, error) {
+	logger := log.NewDebugLogger("hardware_catalog_handler").
+		WithContext(ctx).
+		Operation("list_hardware_skus").
+		Build()
+
+	skus, err := h.hardwareCatalogSrv.ListSKUs(ctx)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListHardwareSkus500JSONResponse{Message: fmt.Sprintf("failed to list hardware skus: %v", err)}, nil
+	}
+
+	logger.Success().WithInt("count", len(skus)).Log()
+	return server.ListHardwareSkus200JSONResponse(mappers.HardwareSKUListToApi(skus)), nil
+}
+
+// (POST /api/v1/hardware-skus)
+func (h *ServiceHandler) CreateHardwareSku(ctx context.Context, request server.CreateHardwareSkuRequestObject) (server.CreateHardwareSkuResponseObject, error) {
+	logger := log.NewDebugLogger("hardware_catalog_handler").
+		WithContext(ctx).
+		Operation("create_hardware_sku").
+		Build()
+
+	if request.Body == nil {
+		return server.CreateHardwareSku400JSONResponse{Message: "empty body"}, nil
+	}
+
+	sku, err := h.hardwareCatalogSrv.CreateSKU(ctx, mappers.HardwareSKUCreateToService(*request.Body))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.CreateHardwareSku403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.CreateHardwareSku400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			return server.CreateHardwareSku409JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CreateHardwareSku500JSONResponse{Message: fmt.Sprintf("failed to create hardware sku: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithString("sku", sku.Name).Log()
+	return server.CreateHardwareSku201JSONResponse(mappers.HardwareSKUToApi(sku)), nil
+}
+
+// (PUT /api/v1/hardware-skus/{name})
+func (h *ServiceHandler) UpdateHardwareSku(ctx context.Context, request server.UpdateHardwareSkuRequestObject) (server.UpdateHardwareSkuResponseObject, error) {
+	logger := log.NewDebugLogger("hardware_catalog_handler").
+		WithContext(ctx).
+		Operation("update_hardware_sku").
+		WithString("sku", request.Name).
+		Build()
+
+	if request.Body == nil {
+		return server.UpdateHardwareSku400JSONResponse{Message: "empty body"}, nil
+	}
+
+	sku, err := h.hardwareCatalogSrv.UpdateSKU(ctx, mappers.HardwareSKUUpdateToService(request.Name, *request.Body))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.UpdateHardwareSku403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.UpdateHardwareSku400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			return server.UpdateHardwareSku404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.UpdateHardwareSku500JSONResponse{Message: fmt.Sprintf("failed to update hardware sku: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.UpdateHardwareSku200JSONResponse(mappers.HardwareSKUToApi(sku)), nil
+}
+
+// (DELETE /api/v1/hardware-skus/{name})
+func (h *ServiceHandler) DeleteHardwareSku(ctx context.Context, request server.DeleteHardwareSkuRequestObject) (server.DeleteHardwareSkuResponseObject, error) {
+	logger := log.NewDebugLogger("hardware_catalog_handler").
+		WithContext(ctx).
+		Operation("delete_hardware_sku").
+		WithString("sku", request.Name).
+		Build()
+
+	sku, err := h.hardwareCatalogSrv.DeleteSKU(ctx, request.Name)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.DeleteHardwareSku403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.DeleteHardwareSku400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			return server.DeleteHardwareSku404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.DeleteHardwareSku500JSONResponse{Message: fmt.Sprintf("failed to delete hardware sku: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.DeleteHardwareSku200JSONResponse(mappers.HardwareSKUToApi(sku)), nil
+}
+
+// (POST /api/v1/assessments/{id}/hardware-options)
+func (h *ServiceHandler) CalculateAssessmentHardwareOptions(ctx context.Context, request server.CalculateAssessmentHardwareOptionsRequestObject) (server.CalculateAssessmentHardwareOptionsResponseObject, error) {
+	logger := log.NewDebugLogger("sizer_handler").
+		WithContext(ctx).
+		Operation("calculate_assessment_hardware_options").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CalculateAssessmentHardwareOptions400JSONResponse{Message: "empty body"}, nil
+	}
+
+	assessmentID := request.Id
+
+	if err := validateHardwareOptionsRequest(request.Body); err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentHardwareOptions400JSONResponse{Message: err.Error()}, nil
+	}
+
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentHardwareOptions400JSONResponse{Message: err.Error()}, nil
+	}
+
+	if _, err := h.assessmentSrv.GetAssessment(ctx, assessmentID); err != nil {
+		logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.CalculateAssessmentHardwareOptions404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.CalculateAssessmentHardwareOptions403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CalculateAssessmentHardwareOptions500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
+		}
+	}
+
+	catalog, err := h.hardwareCatalogSrv.ListSKUs(ctx)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentHardwareOptions500JSONResponse{Message: fmt.Sprintf("failed to list hardware skus: %v", err)}, nil
+	}
+
+	if err := h.sizerSrv.Health(ctx); err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentHardwareOptions503JSONResponse{Message: fmt.Sprintf("sizer service unavailable: %v", err)}, nil
+	}
+
+	form := mappers.HardwareOptionsRequestToForm(*request.Body)
+	form.Sizing.SnapshotID = snapshotID
+
+	res, err := h.sizerSrv.CalculateHardwareOptions(ctx, assessmentID, &form, catalog)
+	if err != nil {
+		logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.CalculateAssessmentHardwareOptions404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidClusterInventory, *service.ErrInvalidRequest:
+			return server.CalculateAssessmentHardwareOptions400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CalculateAssessmentHardwareOptions500JSONResponse{Message: fmt.Sprintf("failed to calculate hardware options: %v", err)}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithString("username", user.Username).
+		WithInt("options", len(res.Options)).
+		Log()
+
+	return server.CalculateAssessmentHardwareOptions200JSONResponse(*res), nil
+}
+
+// validateHardwareOptionsRequest applies the node configuration checks of the cluster requirements
+// request; the API middleware enforces the schema in production, but tests bypass it.
+func validateHardwareOptionsRequest(body *api.HardwareOptionsRequest) error {
+	if body.ClusterId == "" {
+		return fmt.Errorf("clusterId is required")
+	}
+	if body.BaselineSku == nil {
+		if body.WorkerNodeCPU == nil || body.WorkerNodeMemory == nil {
+			return fmt.Errorf("either baselineSku or workerNodeCPU and workerNodeMemory is required")
+		}
+		if *body.WorkerNodeCPU <= 0 || *body.WorkerNodeMemory <= 0 {
+			return fmt.Errorf("worker node size must be greater than zero: CPU=%d, Memory=%d", *body.WorkerNodeCPU, *body.WorkerNodeMemory)
+		}
+		if err := validateWorkerNodeThreads(body.WorkerNodeThreads, *body.WorkerNodeCPU); err != nil {
+			return err
+		}
+	} else if body.WorkerNodeCPU != nil || body.WorkerNodeMemory != nil || body.WorkerNodeThreads != nil {
+		return fmt.Errorf("worker node fields cannot be set together with baselineSku")
+	}
+	if err := validateOverCommitRatios(body.CpuOverCommitRatio, body.MemoryOverCommitRatio); err != nil {
+		return err
+	}
+	if err := validateNoControlPlaneFieldsWhenHosted(
+		body.HostedControlPlane,
+		false,
+		body.ControlPlaneCPU != nil,
+		body.ControlPlaneMemory != nil,
+		body.ControlPlaneSchedulable != nil,
+	); err != nil {
+		return err
+	}
+	return validateSizingMode(body.SizingMode)
+}
@@ -23,6 +23,10 @@ func (s *ServiceHandler) GetInfo(ctx context.Context, request server.GetInfoRequ
 	if versionInfo.AgentVersionName != "" {
 		response.AgentVersionName = &versionInfo.AgentVersionName
 	}
+	if s.opaValidator != nil {
+		revision := s.opaValidator.Revision()
+		response.PolicyRevision = &revision
+	}
 
 	return server.GetInfo200JSONResponse(response), nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/pkg/opa"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,3 +53,23 @@ func TestGetInfo_AgentFields(t *testing.T) {
 		assert.NotEmpty(t, *successResponse.AgentVersionName, "AgentVersionName should not be empty if set")
 	}
 }
+
+func TestGetInfo_PolicyRevision(t *testing.T) {
+	validator, err := opa.NewValidator(map[string]string{"test.rego": "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns := []\n"})
+	assert.NoError(t, err)
+	handler := (&ServiceHandler{}).WithOpaValidator(validator)
+
+	response, err := handler.GetInfo(context.Background(), server.GetInfoRequestObject{})
+	assert.NoError(t, err)
+
+	successResponse, ok := response.(server.GetInfo200JSONResponse)
+	assert.True(t, ok, "Response should be GetInfo200JSONResponse")
+	if assert.NotNil(t, successResponse.PolicyRevision) {
+		assert.Equal(t, validator.Revision(), *successResponse.PolicyRevision)
+	}
+
+	// Without a validator, the revision is not reported
+	response, err = (&ServiceHandler{}).GetInfo(context.Background(), server.GetInfoRequestObject{})
+	assert.NoError(t, err)
+	assert.Nil(t, response.(server.GetInfo200JSONResponse).PolicyRevision)
+}
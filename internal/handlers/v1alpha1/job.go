@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"os"
 
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/api/server"
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/handlers/validator"
@@ -16,6 +18,9 @@ import (
 
 const maxUploadSize = 50 << 20 // 50 MiB
 
+// maxUploadFiles caps the number of files (one per vCenter) merged into one assessment.
+const maxUploadFiles = 10
+
 // (POST /api/v1/assessments/rvtools)
 func (h *ServiceHandler) CreateRVToolsAssessment(ctx context.Context, request server.CreateRVToolsAssessmentRequestObject) (server.CreateRVToolsAssessmentResponseObject, error) {
 	logger := log.NewDebugLogger("job_handler").
@@ -31,25 +36,168 @@ func (h *ServiceHandler) CreateRVToolsAssessment(ctx context.Context, request se
 		return server.CreateRVToolsAssessment400JSONResponse{Message: "empty body"}, nil
 	}
 
-	var name string
-	var tempFilePath string
-	var fileSize int64
+	upload := &rvtoolsUpload{}
 	cleanup := true
 	defer func() {
-		if cleanup && tempFilePath != "" {
-			_ = os.Remove(tempFilePath)
+		if cleanup {
+			upload.removeFiles()
 		}
 	}()
 
-partsLoop:
+	if err := readRVToolsUpload(request.Body, upload); err != nil {
+		logger.Error(err.err).WithString("step", err.step).Log()
+		if err.internal {
+			return server.CreateRVToolsAssessment500JSONResponse{Message: err.message}, nil
+		}
+		return server.CreateRVToolsAssessment400JSONResponse{Message: err.message}, nil
+	}
+
+	if err := validator.ValidateName(upload.name); err != nil {
+		logger.Error(err).WithString("step", "validation").Log()
+		return server.CreateRVToolsAssessment400JSONResponse{Message: err.Error()}, nil
+	}
+
+	logger.Step("files_received").WithInt("file_count", len(upload.files)).WithInt("file_size", int(upload.size)).Log()
+
+	jobArgs := upload.jobArgs(user)
+	jobArgs.Name = upload.name
+
+	job, err := h.jobSrv.CreateRVToolsJob(ctx, jobArgs)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CreateRVToolsAssessment500JSONResponse{Message: fmt.Sprintf("failed to create job: %v", err)}, nil
+	}
+	cleanup = false
+
+	logger.Success().WithParam("job_id", job.Id).Log()
+
+	return server.CreateRVToolsAssessment202JSONResponse(*job), nil
+}
+
+// (POST /api/v1/assessments/{id}/rvtools)
+func (h *ServiceHandler) UploadRVToolsAssessment(ctx context.Context, request server.UploadRVToolsAssessmentRequestObject) (server.UploadRVToolsAssessmentResponseObject, error) {
+	logger := log.NewDebugLogger("job_handler").
+		WithContext(ctx).
+		Operation("upload_rvtools_assessment").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.UploadRVToolsAssessment400JSONResponse{Message: "empty body"}, nil
+	}
+
+	assessment, err := h.assessmentSrv.GetAssessment(ctx, request.Id)
+	if err == nil {
+		err = h.assessmentSrv.AuthorizeEdit(ctx, request.Id)
+	}
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrForbidden:
+			logger.Error(err).Log()
+			return server.UploadRVToolsAssessment403JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.UploadRVToolsAssessment404JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.UploadRVToolsAssessment500JSONResponse{Message: fmt.Sprintf("failed to authorize assessment edit: %v", err)}, nil
+		}
+	}
+	if assessment.SourceType != service.SourceTypeRvtools {
+		logger.Error(fmt.Errorf("assessment of type %s", assessment.SourceType)).WithString("step", "validation").Log()
+		return server.UploadRVToolsAssessment400JSONResponse{Message: fmt.Sprintf("only rvtools assessments can be uploaded again, assessment %s is of type %s", request.Id, assessment.SourceType)}, nil
+	}
+
+	upload := &rvtoolsUpload{}
+	cleanup := true
+	defer func() {
+		if cleanup {
+			upload.removeFiles()
+		}
+	}()
+
+	if err := readRVToolsUpload(request.Body, upload); err != nil {
+		logger.Error(err.err).WithString("step", err.step).Log()
+		if err.internal {
+			return server.UploadRVToolsAssessment500JSONResponse{Message: err.message}, nil
+		}
+		return server.UploadRVToolsAssessment400JSONResponse{Message: err.message}, nil
+	}
+
+	logger.Step("files_received").WithInt("file_count", len(upload.files)).WithInt("file_size", int(upload.size)).Log()
+
+	jobArgs := upload.jobArgs(user)
+	jobArgs.Name = assessment.Name
+	jobArgs.AssessmentID = &assessment.ID
+
+	job, err := h.jobSrv.CreateRVToolsJob(ctx, jobArgs)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.UploadRVToolsAssessment500JSONResponse{Message: fmt.Sprintf("failed to create job: %v", err)}, nil
+	}
+	cleanup = false
+
+	logger.Success().WithParam("job_id", job.Id).Log()
+
+	return server.UploadRVToolsAssessment202JSONResponse(*job), nil
+}
+
+// rvtoolsUpload is the multipart form of an RVTools upload.
+type rvtoolsUpload struct {
+	name  string
+	files []jobs.RVToolsJobFile
+	size  int64
+}
+
+// uploadError is an error reading an RVTools upload. Internal errors are not caused by the client.
+type uploadError struct {
+	step     string
+	message  string
+	internal bool
+	err      error
+}
+
+func newUploadError(step string, err error, message string) *uploadError {
+	return &uploadError{step: step, message: message, err: err}
+}
+
+func (u *rvtoolsUpload) removeFiles() {
+	for _, f := range u.files {
+		_ = os.Remove(f.Path)
+	}
+}
+
+// jobArgs returns the arguments of the job ingesting the uploaded files for the user.
+func (u *rvtoolsUpload) jobArgs(user auth.User) jobs.RVToolsJobArgs {
+	args := jobs.RVToolsJobArgs{
+		FilePath:   u.files[0].Path,
+		FileFormat: u.files[0].Format,
+		OrgID:      user.Organization,
+		Username:   user.Username,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+	}
+	if len(u.files) > 1 {
+		args.Files = u.files
+	}
+	return args
+}
+
+// readRVToolsUpload reads the parts of an RVTools upload into upload, writing the files to temp
+// files. The files written are recorded in upload even if reading fails, for the caller to remove.
+func readRVToolsUpload(body *multipart.Reader, upload *rvtoolsUpload) *uploadError {
+	format := jobs.FileFormatRVTools
 	for {
-		part, err := request.Body.NextPart()
+		part, err := body.NextPart()
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			logger.Error(err).WithString("step", "parse_multipart").Log()
-			return server.CreateRVToolsAssessment400JSONResponse{Message: fmt.Sprintf("failed to parse form: %v", err)}, nil
+			return newUploadError("parse_multipart", err, fmt.Sprintf("failed to parse form: %v", err))
 		}
 
 		switch part.FormName() {
@@ -57,94 +205,96 @@ partsLoop:
 			nameBytes, err := io.ReadAll(io.LimitReader(part, 1024))
 			_ = part.Close()
 			if err != nil {
-				logger.Error(err).WithString("step", "read_name").Log()
-				return server.CreateRVToolsAssessment400JSONResponse{Message: fmt.Sprintf("failed to read name: %v", err)}, nil
+				return newUploadError("read_name", err, fmt.Sprintf("failed to read name: %v", err))
+			}
+			upload.name = string(nameBytes)
+		case "format":
+			formatBytes, err := io.ReadAll(io.LimitReader(part, 64))
+			_ = part.Close()
+			if err != nil {
+				return newUploadError("read_format", err, fmt.Sprintf("failed to read format: %v", err))
+			}
+			switch f := api.AssessmentRvtoolsFormFormat(formatBytes); f {
+			case api.AssessmentRvtoolsFormFormatRvtools, api.AssessmentRvtoolsFormFormatGovcJson:
+				format = string(f)
+			default:
+				return newUploadError("validation", fmt.Errorf("unsupported format %q", f), fmt.Sprintf("unsupported format %q: must be one of %q, %q", f, jobs.FileFormatRVTools, jobs.FileFormatGovcJSON))
 			}
-			name = string(nameBytes)
 		case "file":
-			tmpFile, err := os.CreateTemp("", "rvtools-upload-*.xlsx")
+			if len(upload.files) == maxUploadFiles {
+				_ = part.Close()
+				return newUploadError("validation", fmt.Errorf("too many files"), fmt.Sprintf("at most %d files can be merged into one assessment", maxUploadFiles))
+			}
+			tmpFile, err := os.CreateTemp("", uploadFilePattern(format))
 			if err != nil {
 				_ = part.Close()
-				logger.Error(err).WithString("step", "create_temp_file").Log()
-				return server.CreateRVToolsAssessment500JSONResponse{Message: "failed to create temp file"}, nil
+				return &uploadError{step: "create_temp_file", message: "failed to create temp file", internal: true, err: err}
 			}
 			n, copyErr := io.Copy(tmpFile, io.LimitReader(part, maxUploadSize+1))
 			closeErr := tmpFile.Close()
 			_ = part.Close()
 			if copyErr != nil {
 				_ = os.Remove(tmpFile.Name())
-				logger.Error(copyErr).WithString("step", "write_temp_file").Log()
-				return server.CreateRVToolsAssessment400JSONResponse{Message: fmt.Sprintf("failed to read file: %v", copyErr)}, nil
+				return newUploadError("write_temp_file", copyErr, fmt.Sprintf("failed to read file: %v", copyErr))
 			}
 			if closeErr != nil {
 				_ = os.Remove(tmpFile.Name())
-				logger.Error(closeErr).WithString("step", "close_temp_file").Log()
-				return server.CreateRVToolsAssessment500JSONResponse{Message: "failed to write temp file"}, nil
+				return &uploadError{step: "close_temp_file", message: "failed to write temp file", internal: true, err: closeErr}
 			}
 			if n == 0 {
 				_ = os.Remove(tmpFile.Name())
-				logger.Error(fmt.Errorf("rvtools file is empty")).WithString("step", "validation").Log()
-				return server.CreateRVToolsAssessment400JSONResponse{Message: "rvtools file is empty"}, nil
+				return newUploadError("validation", fmt.Errorf("rvtools file is empty"), "rvtools file is empty")
 			}
 			if n > maxUploadSize {
 				_ = os.Remove(tmpFile.Name())
-				logger.Error(fmt.Errorf("file exceeds maximum upload size")).WithString("step", "validation").Log()
-				return server.CreateRVToolsAssessment400JSONResponse{Message: fmt.Sprintf("file exceeds maximum upload size of %d MiB", maxUploadSize>>20)}, nil
+				return newUploadError("validation", fmt.Errorf("file exceeds maximum upload size"), fmt.Sprintf("file exceeds maximum upload size of %d MiB", maxUploadSize>>20))
 			}
-			tempFilePath = tmpFile.Name()
-			fileSize = n
-			break partsLoop
+			upload.files = append(upload.files, jobs.RVToolsJobFile{Path: tmpFile.Name(), Format: format})
+			upload.size += n
 		default:
 			_ = part.Close()
 		}
 	}
 
-	if err := validator.ValidateName(name); err != nil {
-		logger.Error(err).WithString("step", "validation").Log()
-		return server.CreateRVToolsAssessment400JSONResponse{Message: err.Error()}, nil
-	}
-	if tempFilePath == "" {
-		logger.Error(fmt.Errorf("file is required")).Log()
-		return server.CreateRVToolsAssessment400JSONResponse{Message: "file is required"}, nil
+	if len(upload.files) == 0 {
+		return newUploadError("validation", fmt.Errorf("file is required"), "file is required")
 	}
-	if err := validateXLSXFile(tempFilePath); err != nil {
-		logger.Error(err).WithString("step", "validation").Log()
-		return server.CreateRVToolsAssessment400JSONResponse{Message: err.Error()}, nil
-	}
-
-	logger.Step("file_received").WithInt("file_size", int(fileSize)).Log()
-
-	jobArgs := jobs.RVToolsJobArgs{
-		Name:      name,
-		FilePath:  tempFilePath,
-		OrgID:     user.Organization,
-		Username:  user.Username,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
+	for _, f := range upload.files {
+		if err := validateUploadedFile(f.Path, f.Format); err != nil {
+			return newUploadError("validation", err, err.Error())
+		}
 	}
+	return nil
+}
 
-	job, err := h.jobSrv.CreateRVToolsJob(ctx, jobArgs)
-	if err != nil {
-		logger.Error(err).Log()
-		return server.CreateRVToolsAssessment500JSONResponse{Message: fmt.Sprintf("failed to create job: %v", err)}, nil
+// uploadFilePattern returns the temp file name pattern for an upload of the given format.
+func uploadFilePattern(format string) string {
+	if format == jobs.FileFormatGovcJSON {
+		return "govc-upload-*.json"
 	}
-	cleanup = false
-
-	logger.Success().WithParam("job_id", job.Id).Log()
-
-	return server.CreateRVToolsAssessment202JSONResponse(*job), nil
+	return "rvtools-upload-*.xlsx"
 }
 
-func validateXLSXFile(path string) error {
+// validateUploadedFile runs a fast preliminary check on the file header for the given format.
+func validateUploadedFile(path, format string) error {
 	f, err := os.Open(path)
 	if err != nil {
 		return fmt.Errorf("opening file: %w", err)
 	}
 	defer func() { _ = f.Close() }()
 
+	if format == jobs.FileFormatGovcJSON {
+		header := make([]byte, 512)
+		n, err := io.ReadFull(f, header)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return validator.NewErrInvalidFile("file is not a valid JSON document")
+		}
+		return validator.ValidateJSONObjectStart(header[:n])
+	}
+
 	header := make([]byte, 4)
 	if _, err := io.ReadFull(f, header); err != nil {
-		return validator.NewErrInvalidFile("file is not a valid Excel file")
+		return validator.NewErrInvalidFile("file is not a valid Excel file or zip of CSV files")
 	}
 	return validator.ValidateXLSXMagicBytes(header)
 }
@@ -3,6 +3,7 @@ package v1alpha1_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/kubev2v/migration-planner/internal/api/server"
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/client"
@@ -20,11 +22,13 @@ import (
 	"github.com/kubev2v/migration-planner/internal/store"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
 )
 
 var _ = Describe("job handler", Ordered, func() {
 	var (
 		s           store.Store
+		gormdb      *gorm.DB
 		testServer  *httptest.Server
 		sizerClient *client.SizerClient
 	)
@@ -35,6 +39,7 @@ var _ = Describe("job handler", Ordered, func() {
 		db, err := store.InitDB(cfg)
 		Expect(err).To(BeNil())
 		s = store.NewStore(db)
+		gormdb = db
 
 		// Create a minimal sizer client to prevent nil pointer panics
 		// This test server responds to health checks to satisfy sizer service requirements
@@ -207,4 +212,148 @@ var _ = Describe("job handler", Ordered, func() {
 			Expect(errorResp.Message).To(ContainSubstring("invalid"))
 		})
 	})
+
+	Context("CreateRVToolsAssessment - file format", func() {
+		var ctx context.Context
+		var srv *handlers.ServiceHandler
+
+		createMultipartReader := func(format string, fileContent []byte) *multipart.Reader {
+			var b bytes.Buffer
+			w := multipart.NewWriter(&b)
+
+			namePart, _ := w.CreateFormField("name")
+			_, _ = io.WriteString(namePart, "govc-assessment")
+
+			formatPart, _ := w.CreateFormField("format")
+			_, _ = io.WriteString(formatPart, format)
+
+			filePart, _ := w.CreateFormFile("file", "export.json")
+			_, _ = filePart.Write(fileContent)
+
+			_ = w.Close()
+
+			return multipart.NewReader(&b, w.Boundary())
+		}
+
+		BeforeEach(func() {
+			ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "test-user", Organization: "test-org"})
+			srv = handlers.NewServiceHandler(service.NewSourceService(s, nil), service.NewAssessmentService(s, nil, nil), service.NewJobService(s, nil, ""), service.NewSizerService(sizerClient, s), nil, nil, nil, nil)
+		})
+
+		It("returns 400 when format is unsupported", func() {
+			resp, err := srv.CreateRVToolsAssessment(ctx, server.CreateRVToolsAssessmentRequestObject{
+				Body: createMultipartReader("powercli-xml", []byte(`{"elements": []}`)),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateRVToolsAssessment400JSONResponse{}).String()))
+			Expect(resp.(server.CreateRVToolsAssessment400JSONResponse).Message).To(ContainSubstring("unsupported format"))
+		})
+
+		It("returns 400 when a govc-json upload is not a JSON document", func() {
+			resp, err := srv.CreateRVToolsAssessment(ctx, server.CreateRVToolsAssessmentRequestObject{
+				Body: createMultipartReader("govc-json", []byte{0x50, 0x4B, 0x03, 0x04}),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateRVToolsAssessment400JSONResponse{}).String()))
+			Expect(resp.(server.CreateRVToolsAssessment400JSONResponse).Message).To(ContainSubstring("not a valid JSON document"))
+		})
+	})
+
+	Context("CreateRVToolsAssessment - multiple files", func() {
+		var ctx context.Context
+		var srv *handlers.ServiceHandler
+
+		createMultipartReader := func(files ...[]byte) *multipart.Reader {
+			var b bytes.Buffer
+			w := multipart.NewWriter(&b)
+
+			namePart, _ := w.CreateFormField("name")
+			_, _ = io.WriteString(namePart, "multi-vcenter-assessment")
+
+			for i, content := range files {
+				filePart, _ := w.CreateFormFile("file", fmt.Sprintf("vcenter-%d.xlsx", i+1))
+				_, _ = filePart.Write(content)
+			}
+
+			_ = w.Close()
+
+			return multipart.NewReader(&b, w.Boundary())
+		}
+
+		BeforeEach(func() {
+			ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "test-user", Organization: "test-org"})
+			srv = handlers.NewServiceHandler(service.NewSourceService(s, nil), service.NewAssessmentService(s, nil, nil), service.NewJobService(s, nil, ""), service.NewSizerService(sizerClient, s), nil, nil, nil, nil)
+		})
+
+		It("returns 400 when one of the files is invalid", func() {
+			resp, err := srv.CreateRVToolsAssessment(ctx, server.CreateRVToolsAssessmentRequestObject{
+				Body: createMultipartReader([]byte{0x50, 0x4B, 0x03, 0x04}, []byte("not an excel file")),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateRVToolsAssessment400JSONResponse{}).String()))
+			Expect(resp.(server.CreateRVToolsAssessment400JSONResponse).Message).To(ContainSubstring("not a valid Excel file"))
+		})
+
+		It("returns 400 when too many files are uploaded", func() {
+			files := make([][]byte, 11)
+			for i := range files {
+				files[i] = []byte{0x50, 0x4B, 0x03, 0x04}
+			}
+			resp, err := srv.CreateRVToolsAssessment(ctx, server.CreateRVToolsAssessmentRequestObject{
+				Body: createMultipartReader(files...),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.CreateRVToolsAssessment400JSONResponse{}).String()))
+			Expect(resp.(server.CreateRVToolsAssessment400JSONResponse).Message).To(ContainSubstring("at most 10 files"))
+		})
+	})
+
+	Context("UploadRVToolsAssessment", func() {
+		var ctx context.Context
+		var srv *handlers.ServiceHandler
+
+		createMultipartReader := func() *multipart.Reader {
+			var b bytes.Buffer
+			w := multipart.NewWriter(&b)
+
+			filePart, _ := w.CreateFormFile("file", "vcenter.xlsx")
+			_, _ = filePart.Write([]byte{0x50, 0x4B, 0x03, 0x04})
+
+			_ = w.Close()
+
+			return multipart.NewReader(&b, w.Boundary())
+		}
+
+		BeforeEach(func() {
+			ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "test-user", Organization: "test-org"})
+			srv = handlers.NewServiceHandler(service.NewSourceService(s, nil), service.NewAssessmentService(s, nil, nil), service.NewJobService(s, nil, ""), service.NewSizerService(sizerClient, s), nil, nil, nil, nil)
+		})
+
+		It("returns 404 when the assessment does not exist", func() {
+			resp, err := srv.UploadRVToolsAssessment(ctx, server.UploadRVToolsAssessmentRequestObject{
+				Id:   uuid.New(),
+				Body: createMultipartReader(),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.UploadRVToolsAssessment404JSONResponse{}).String()))
+		})
+
+		It("returns 400 when the assessment is not an rvtools assessment", func() {
+			assessmentID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID, "inventory-assessment", "test-user", "test-org", "Test", "User", service.SourceTypeInventory, "NULL"))
+			Expect(tx.Error).To(BeNil())
+
+			resp, err := srv.UploadRVToolsAssessment(ctx, server.UploadRVToolsAssessmentRequestObject{
+				Id:   assessmentID,
+				Body: createMultipartReader(),
+			})
+			Expect(err).To(BeNil())
+			Expect(reflect.TypeOf(resp).String()).To(Equal(reflect.TypeOf(server.UploadRVToolsAssessment400JSONResponse{}).String()))
+			Expect(resp.(server.UploadRVToolsAssessment400JSONResponse).Message).To(ContainSubstring("only rvtools assessments"))
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM assessments;")
+		})
+	})
 })
@@ -0,0 +1,139 @@
+package mappers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// DefaultAuditLimit is the number of entries returned as JSON when no limit is requested.
+const DefaultAuditLimit = 100
+
+// AuditParamsToFilter converts the query parameters. JSON responses are limited to
+// DefaultAuditLimit entries by default, CSV exports are not.
+func AuditParamsToFilter(params api.ListAuditEntriesParams) service.AuditFilter {
+	filter := service.AuditFilter{
+		Since: params.Since,
+		Until: params.Until,
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.Action != nil {
+		filter.Action = *params.Action
+	}
+	if params.ResourceType != nil {
+		filter.ResourceType = *params.ResourceType
+	}
+	if params.ResourceId != nil {
+		filter.ResourceID = *params.ResourceId
+	}
+	if params.Subject != nil {
+		filter.Subject = *params.Subject
+	}
+	if params.RequestId != nil {
+		filter.RequestID = *params.RequestId
+	}
+	switch {
+	case params.Limit != nil:
+		filter.Limit = *params.Limit
+	case !AuditExportAsCSV(params):
+		filter.Limit = DefaultAuditLimit
+	}
+	if params.Offset != nil {
+		filter.Offset = *params.Offset
+	}
+	return filter
+}
+
+// AuditExportAsCSV reports whether the entries are requested as CSV.
+func AuditExportAsCSV(params api.ListAuditEntriesParams) bool {
+	return params.Format != nil && *params.Format == api.Csv
+}
+
+func AuditEntryToApi(entry model.AuditEntry) api.AuditEntry {
+	return api.AuditEntry{
+		Id:           entry.ID,
+		CreatedAt:    entry.CreatedAt,
+		RequestId:    entry.RequestID,
+		Actor:        entry.Actor,
+		Action:       string(entry.Action),
+		ResourceType: entry.ResourceType,
+		ResourceId:   entry.ResourceID,
+		Subject:      entry.Subject,
+		Before:       auditStateToApi(entry.Before),
+		After:        auditStateToApi(entry.After),
+	}
+}
+
+func AuditEntryListToApi(entries model.AuditEntryList) api.AuditEntryList {
+	result := make(api.AuditEntryList, len(entries))
+	for i, entry := range entries {
+		result[i] = AuditEntryToApi(entry)
+	}
+	return result
+}
+
+func auditStateToApi(state *model.JSONField[model.AuditState]) *map[string]interface{} {
+	if state == nil || state.Data == nil {
+		return nil
+	}
+	m := map[string]interface{}(state.Data)
+	return &m
+}
+
+// AuditEntriesToCSV writes the entries as CSV with a header row. The states before and after
+// the change are written as JSON.
+func AuditEntriesToCSV(entries model.AuditEntryList) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"id", "createdAt", "requestId", "actor", "action", "resourceType", "resourceId", "subject", "before", "after"}); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		before, err := auditStateToCSV(entry.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := auditStateToCSV(entry.After)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.Write([]string{
+			strconv.FormatInt(entry.ID, 10),
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			entry.RequestID,
+			entry.Actor,
+			string(entry.Action),
+			entry.ResourceType,
+			entry.ResourceID,
+			entry.Subject,
+			before,
+			after,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func auditStateToCSV(state *model.JSONField[model.AuditState]) (string, error) {
+	if state == nil || state.Data == nil {
+		return "", nil
+	}
+	doc, err := json.Marshal(state.Data)
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+)
+
+func ComplexityTableCreateToService(req api.ComplexityTableCreate) complexity.Table {
+	return complexity.Table{
+		OSDifficultyScores: req.OsDifficultyScores,
+		DiskSizeScores:     req.DiskSizeScores,
+		OSTiers:            req.OsTiers,
+	}
+}
+
+func ComplexityTableToApi(table service.ComplexityTable) api.ComplexityTable {
+	result := api.ComplexityTable{
+		Version:            table.Version,
+		Active:             table.Active,
+		CreatedAt:          table.CreatedAt,
+		OsDifficultyScores: table.OSDifficultyScores,
+		DiskSizeScores:     table.DiskSizeScores,
+		OsTiers:            table.OSTiers,
+	}
+	if table.CreatedBy != "" {
+		result.CreatedBy = &table.CreatedBy
+	}
+	return result
+}
+
+func ComplexityTableListToApi(tables []service.ComplexityTable) api.ComplexityTableList {
+	result := make(api.ComplexityTableList, len(tables))
+	for i, table := range tables {
+		result[i] = ComplexityTableToApi(table)
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+func ConcernWaiverCreateToService(req api.ConcernWaiverCreate) service.ConcernWaiverForm {
+	form := service.ConcernWaiverForm{
+		ConcernID:     req.ConcernId,
+		Justification: req.Justification,
+		ExpiresAt:     req.ExpiresAt,
+	}
+	if req.Vms != nil {
+		form.VMIDs = *req.Vms
+	}
+	return form
+}
+
+// ConcernWaiverToApi converts a waiver; expired is evaluated at now.
+func ConcernWaiverToApi(waiver model.ConcernWaiver, now time.Time) api.ConcernWaiver {
+	vms := []string(waiver.VMIDs)
+	if vms == nil {
+		vms = []string{}
+	}
+	return api.ConcernWaiver{
+		Id:            waiver.ID,
+		ConcernId:     waiver.ConcernID,
+		Vms:           vms,
+		Justification: waiver.Justification,
+		CreatedBy:     waiver.CreatedBy,
+		CreatedAt:     waiver.CreatedAt,
+		ExpiresAt:     waiver.ExpiresAt,
+		Expired:       waiver.Expired(now),
+	}
+}
+
+func ConcernWaiverListToApi(waivers model.ConcernWaiverList, now time.Time) api.ConcernWaiverList {
+	result := make(api.ConcernWaiverList, len(waivers))
+	for i, waiver := range waivers {
+		result[i] = ConcernWaiverToApi(waiver, now)
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package mappers
+
+import (
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+)
+
+func HardwareSKUCreateToService(req api.HardwareSkuCreate) service.HardwareSKU {
+	return service.HardwareSKU{
+		Name:       req.Name,
+		Cores:      req.Cores,
+		Threads:    req.Threads,
+		MemoryGB:   req.MemoryGB,
+		Price:      req.Price,
+		RackUnits:  req.RackUnits,
+		PowerWatts: req.PowerWatts,
+	}
+}
+
+func HardwareSKUUpdateToService(name string, req api.HardwareSkuUpdate) service.HardwareSKU {
+	return service.HardwareSKU{
+		Name:       name,
+		Cores:      req.Cores,
+		Threads:    req.Threads,
+		MemoryGB:   req.MemoryGB,
+		Price:      req.Price,
+		RackUnits:  req.RackUnits,
+		PowerWatts: req.PowerWatts,
+	}
+}
+
+func HardwareSKUToApi(sku service.HardwareSKU) api.HardwareSku {
+	return api.HardwareSku{
+		Name:       sku.Name,
+		Cores:      sku.Cores,
+		Threads:    sku.Threads,
+		MemoryGB:   sku.MemoryGB,
+		Price:      sku.Price,
+		RackUnits:  sku.RackUnits,
+		PowerWatts: sku.PowerWatts,
+		Source:     api.HardwareSkuSource(sku.Source),
+	}
+}
+
+func HardwareSKUListToApi(skus []service.HardwareSKU) api.HardwareSkuList {
+	result := make(api.HardwareSkuList, len(skus))
+	for i, sku := range skus {
+		result[i] = HardwareSKUToApi(sku)
+	}
+	return result
+}
+
+func HardwareOptionsRequestToForm(apiReq api.HardwareOptionsRequest) mappers.HardwareOptionsRequestForm {
+	form := mappers.HardwareOptionsRequestForm{
+		Sizing: mappers.ClusterRequirementsRequestForm{
+			ClusterID:               apiReq.ClusterId,
+			CpuOverCommitRatio:      string(apiReq.CpuOverCommitRatio),
+			MemoryOverCommitRatio:   string(apiReq.MemoryOverCommitRatio),
+			WorkerNodeThreads:       apiReq.WorkerNodeThreads,
+			HostedControlPlane:      apiReq.HostedControlPlane,
+			ControlPlaneSchedulable: apiReq.ControlPlaneSchedulable,
+			ControlPlaneCPU:         apiReq.ControlPlaneCPU,
+			ControlPlaneMemory:      apiReq.ControlPlaneMemory,
+		},
+	}
+	if apiReq.WorkerNodeCPU != nil {
+		form.Sizing.WorkerNodeCPU = *apiReq.WorkerNodeCPU
+	}
+	if apiReq.WorkerNodeMemory != nil {
+		form.Sizing.WorkerNodeMemory = *apiReq.WorkerNodeMemory
+	}
+	if apiReq.SizingMode != nil {
+		form.Sizing.SizingMode = string(*apiReq.SizingMode)
+	}
+	if apiReq.Skus != nil {
+		form.SKUs = *apiReq.Skus
+	}
+	if apiReq.BaselineSku != nil {
+		form.BaselineSKU = *apiReq.BaselineSku
+	}
+	return form
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/auth"
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/internal/util"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 )
 
 // mapLabels converts API labels to map[string]string
@@ -155,6 +157,23 @@ func ClusterRequirementsRequestToForm(apiReq v1alpha1.ClusterRequirementsRequest
 		CompactMode:             apiReq.CompactMode,
 	}
 
+	if apiReq.SizingMode != nil {
+		form.SizingMode = string(*apiReq.SizingMode)
+	}
+	if apiReq.RightSized != nil {
+		form.RightSized = *apiReq.RightSized
+	}
+
+	if apiReq.Storage != nil {
+		form.Storage = &mappers.StorageSizingForm{
+			Backend:               string(apiReq.Storage.Backend),
+			DataChunks:            apiReq.Storage.ErasureCodingDataChunks,
+			CodingChunks:          apiReq.Storage.ErasureCodingCodingChunks,
+			ThinProvisioningRatio: apiReq.Storage.ThinProvisioningRatio,
+			OSDDiskSizeGB:         apiReq.Storage.OsdDiskSizeGB,
+		}
+	}
+
 	// Convert ControlPlaneNodeCount from API type to int pointer
 	if apiReq.ControlPlaneNodeCount != nil {
 		nodeCount := int(*apiReq.ControlPlaneNodeCount)
@@ -164,6 +183,38 @@ func ClusterRequirementsRequestToForm(apiReq v1alpha1.ClusterRequirementsRequest
 	return form
 }
 
+func TopologySizingRequestToForm(apiReq v1alpha1.TopologySizingRequest) mappers.TopologySizingRequestForm {
+	form := mappers.TopologySizingRequestForm{
+		Sizing: mappers.ClusterRequirementsRequestForm{
+			CpuOverCommitRatio:      string(apiReq.CpuOverCommitRatio),
+			MemoryOverCommitRatio:   string(apiReq.MemoryOverCommitRatio),
+			WorkerNodeCPU:           apiReq.WorkerNodeCPU,
+			WorkerNodeMemory:        apiReq.WorkerNodeMemory,
+			WorkerNodeThreads:       apiReq.WorkerNodeThreads,
+			HostedControlPlane:      apiReq.HostedControlPlane,
+			ControlPlaneSchedulable: apiReq.ControlPlaneSchedulable,
+			ControlPlaneCPU:         apiReq.ControlPlaneCPU,
+			ControlPlaneMemory:      apiReq.ControlPlaneMemory,
+			CompactMode:             apiReq.CompactMode,
+		},
+		TargetClusters: make([]mappers.TargetClusterForm, 0, len(apiReq.TargetClusters)),
+	}
+
+	if apiReq.ControlPlaneNodeCount != nil {
+		nodeCount := int(*apiReq.ControlPlaneNodeCount)
+		form.Sizing.ControlPlaneNodeCount = &nodeCount
+	}
+
+	for _, target := range apiReq.TargetClusters {
+		form.TargetClusters = append(form.TargetClusters, mappers.TargetClusterForm{
+			Name:             target.Name,
+			SourceClusterIDs: target.SourceClusterIds,
+		})
+	}
+
+	return form
+}
+
 func AssessmentFormToCreateForm(resource v1alpha1.AssessmentForm, user auth.User) mappers.AssessmentCreateForm {
 	form := mappers.AssessmentCreateForm{
 		ID:       uuid.New(),
@@ -199,8 +250,120 @@ func AssessmentFormToCreateForm(resource v1alpha1.AssessmentForm, user auth.User
 	return form
 }
 
+// AssessmentUpdateToInventory returns the inventory uploaded again with the update, nil if none.
+func AssessmentUpdateToInventory(resource v1alpha1.AssessmentUpdate) []byte {
+	if resource.Inventory == nil {
+		return nil
+	}
+	if resource.Inventory.CreatedAt == nil {
+		now := time.Now().UTC()
+		resource.Inventory.CreatedAt = &now
+	}
+	data, _ := json.Marshal(resource.Inventory) // cannot fail, it was decoded from JSON
+	return data
+}
+
+// ShareSubjectsToModel converts the subjects of a share request to relation subjects.
+// Groups are organizations in the relation model.
+func ShareSubjectsToModel(subjects []v1alpha1.ShareSubject) []model.Subject {
+	result := make([]model.Subject, 0, len(subjects))
+	for _, s := range subjects {
+		switch s.Type {
+		case v1alpha1.ShareSubjectTypeUser:
+			result = append(result, model.NewUserSubject(s.Id))
+		case v1alpha1.ShareSubjectTypeGroup:
+			result = append(result, model.NewOrgSubject(s.Id))
+		default:
+			result = append(result, model.Subject{Kind: model.SubjectType(s.Type), ID: s.Id})
+		}
+	}
+	return result
+}
+
+// ShareRelationToModel returns the relation granted by a share request, viewer by default.
+func ShareRelationToModel(relation *v1alpha1.AssessmentShareRequestRelation) model.Relation {
+	if relation == nil {
+		return model.ViewerRelation
+	}
+	return model.Relation(*relation)
+}
+
 func InventoryToForm(inventory v1alpha1.Inventory) mappers.InventoryForm {
 	return mappers.InventoryForm{
 		Data: inventory,
 	}
 }
+
+// SourceVMsFromApi converts the per-VM records reported with a source inventory. Nil when the
+// inventory update carries none.
+func SourceVMsFromApi(vms *[]v1alpha1.AssessmentVM) []model.SourceVM {
+	if vms == nil {
+		return nil
+	}
+
+	result := make([]model.SourceVM, 0, len(*vms))
+	for _, vm := range *vms {
+		concerns := make([]model.VMConcern, 0, len(vm.Concerns))
+		for _, c := range vm.Concerns {
+			concerns = append(concerns, model.VMConcern{
+				ID:         c.Id,
+				Label:      c.Label,
+				Category:   c.Category,
+				Assessment: c.Assessment,
+			})
+		}
+
+		result = append(result, model.SourceVM{
+			VMID:               vm.Id,
+			Name:               vm.Name,
+			ClusterID:          vm.ClusterId,
+			ClusterName:        vm.ClusterName,
+			VCenterID:          vm.VcenterId,
+			Datacenter:         vm.Datacenter,
+			Host:               vm.Host,
+			OS:                 vm.Os,
+			PowerState:         vm.PowerState,
+			CpuCount:           vm.CpuCount,
+			MemoryMB:           vm.MemoryMB,
+			DiskGB:             vm.DiskGB,
+			IsTemplate:         vm.IsTemplate,
+			MigrationExcluded:  vm.MigrationExcluded,
+			Concerns:           *model.MakeJSONField(concerns),
+			CpuUsagePercent:    vm.CpuUsagePercent,
+			MemoryUsagePercent: vm.MemoryUsagePercent,
+		})
+	}
+	return result
+}
+
+var weekdays = map[v1alpha1.TimelineRequestWorkingDays]time.Weekday{
+	v1alpha1.Monday:    time.Monday,
+	v1alpha1.Tuesday:   time.Tuesday,
+	v1alpha1.Wednesday: time.Wednesday,
+	v1alpha1.Thursday:  time.Thursday,
+	v1alpha1.Friday:    time.Friday,
+	v1alpha1.Saturday:  time.Saturday,
+	v1alpha1.Sunday:    time.Sunday,
+}
+
+// TimelineRequestToCalendar converts the timeline block of an estimation request. The work hours
+// per day are left unset, to be taken from the estimation params.
+func TimelineRequestToCalendar(req v1alpha1.TimelineRequest) timeline.Calendar {
+	cal := timeline.Calendar{Start: req.StartDate.Time}
+	if req.WorkingDays != nil {
+		for _, d := range *req.WorkingDays {
+			if wd, ok := weekdays[d]; ok {
+				cal.WorkingDays = append(cal.WorkingDays, wd)
+			}
+		}
+	}
+	if req.BlackoutPeriods != nil {
+		for _, b := range *req.BlackoutPeriods {
+			cal.Blackouts = append(cal.Blackouts, timeline.DateRange{Start: b.StartDate.Time, End: b.EndDate.Time})
+		}
+	}
+	if req.ParallelStreams != nil {
+		cal.Streams = *req.ParallelStreams
+	}
+	return cal
+}
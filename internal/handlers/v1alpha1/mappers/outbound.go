@@ -14,6 +14,7 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -268,96 +269,17 @@ func AssessmentToApi(a model.Assessment) (api.Assessment, error) {
 		OwnerLastName:  a.OwnerLastName,
 		CreatedAt:      a.CreatedAt,
 		Snapshots:      make([]api.Snapshot, len(a.Snapshots)),
+
+		ComplexityTableVersion: a.ComplexityTableVersion,
 	}
 
 	// Convert snapshots
 	for i, snapshot := range a.Snapshots {
-		assessment.Snapshots[i] = api.Snapshot{
-			CreatedAt: snapshot.CreatedAt,
-		}
-		if len(snapshot.Inventory) > 0 {
-			inventory := api.Inventory{}
-			switch snapshot.Version {
-			case 1:
-				invV1 := api.InventoryData{}
-				if err := json.Unmarshal(snapshot.Inventory, &invV1); err != nil {
-					return api.Assessment{}, err
-				}
-				// Normalize to prevent null values from database
-				normalizeInventoryData(&invV1)
-				inventory.Vcenter = &invV1
-				inventory.VcenterId = invV1.Vcenter.Id
-				// Ensure clusters is initialized
-				if inventory.Clusters == nil {
-					inventory.Clusters = make(map[string]api.InventoryData)
-				}
-			case 2:
-				if err := json.Unmarshal(snapshot.Inventory, &inventory); err != nil {
-					return api.Assessment{}, err
-				}
-				// Ensure clusters map is never nil (fix for null values from database)
-				if inventory.Clusters == nil {
-					inventory.Clusters = make(map[string]api.InventoryData)
-				}
-				// Normalize vcenter and all cluster inventories to prevent null values
-				if inventory.Vcenter != nil {
-					normalizeInventoryData(inventory.Vcenter)
-				}
-				for clusterID, clusterData := range inventory.Clusters {
-					normalizeInventoryData(&clusterData)
-					inventory.Clusters[clusterID] = clusterData
-				}
-			default:
-				return api.Assessment{}, fmt.Errorf("unsupported snapshot version: %d", snapshot.Version)
-			}
-			assessment.Snapshots[i].Inventory = inventory
-		} else {
-			// Initialize empty inventory with non-nil Clusters
-			assessment.Snapshots[i].Inventory = api.Inventory{
-				Clusters: make(map[string]api.InventoryData),
-			}
-		}
-
-		// Convert subset inventories for this snapshot
-		if len(snapshot.SubsetInventories) > 0 {
-			subsets := make([]api.AssessmentSubsetInventory, len(snapshot.SubsetInventories))
-			for j := range snapshot.SubsetInventories {
-				subset := snapshot.SubsetInventories[j] // Create local copy to avoid pointer aliasing
-
-				// Unmarshal inventory (required field)
-				var subsetInv api.Inventory
-				if len(subset.Inventory) > 0 {
-					if err := json.Unmarshal(subset.Inventory, &subsetInv); err != nil {
-						return api.Assessment{}, fmt.Errorf("failed to unmarshal subset inventory: %w", err)
-					}
-					// Ensure clusters map is never nil
-					if subsetInv.Clusters == nil {
-						subsetInv.Clusters = make(map[string]api.InventoryData)
-					}
-					// Normalize vcenter and all cluster inventories
-					if subsetInv.Vcenter != nil {
-						normalizeInventoryData(subsetInv.Vcenter)
-					}
-					for clusterID, clusterData := range subsetInv.Clusters {
-						normalizeInventoryData(&clusterData)
-						subsetInv.Clusters[clusterID] = clusterData
-					}
-				} else {
-					// Empty inventory with non-nil Clusters
-					subsetInv.Clusters = make(map[string]api.InventoryData)
-				}
-
-				subsets[j] = api.AssessmentSubsetInventory{
-					Id:        subset.ID,
-					Name:      subset.Name,
-					VcenterId: subset.VCenterID,
-					VmsCount:  subset.VMsCount,
-					CreatedAt: subset.CreatedAt,
-					Inventory: subsetInv,
-				}
-			}
-			assessment.Snapshots[i].SubsetInventories = &subsets
+		apiSnapshot, err := SnapshotToApi(snapshot)
+		if err != nil {
+			return api.Assessment{}, err
 		}
+		assessment.Snapshots[i] = apiSnapshot
 	}
 
 	// Set source type based on source field
@@ -380,6 +302,9 @@ func AssessmentToApi(a model.Assessment) (api.Assessment, error) {
 		}
 		for i, s := range a.Sharing.SharedWith {
 			sharing.SharedWith[i] = api.SharingSubject{Type: s.Type, Id: s.ID, Name: s.Name}
+			if s.Relation != "" {
+				sharing.SharedWith[i].Relation = util.ToStrPtr(string(s.Relation))
+			}
 		}
 		if a.Sharing.SharedBy != nil {
 			sharing.SharedBy = &api.SharingSubject{Type: a.Sharing.SharedBy.Type, Id: a.Sharing.SharedBy.ID, Name: a.Sharing.SharedBy.Name}
@@ -390,6 +315,236 @@ func AssessmentToApi(a model.Assessment) (api.Assessment, error) {
 	return assessment, nil
 }
 
+// SnapshotToApi converts a single assessment snapshot, including its subset inventories.
+func SnapshotToApi(snapshot model.Snapshot) (api.Snapshot, error) {
+	apiSnapshot := api.Snapshot{
+		Id:        int(snapshot.ID),
+		CreatedAt: snapshot.CreatedAt,
+	}
+	if len(snapshot.Inventory) > 0 {
+		inventory := api.Inventory{}
+		switch snapshot.Version {
+		case 1:
+			invV1 := api.InventoryData{}
+			if err := json.Unmarshal(snapshot.Inventory, &invV1); err != nil {
+				return api.Snapshot{}, err
+			}
+			// Normalize to prevent null values from database
+			normalizeInventoryData(&invV1)
+			inventory.Vcenter = &invV1
+			inventory.VcenterId = invV1.Vcenter.Id
+			// Ensure clusters is initialized
+			if inventory.Clusters == nil {
+				inventory.Clusters = make(map[string]api.InventoryData)
+			}
+		case 2:
+			if err := json.Unmarshal(snapshot.Inventory, &inventory); err != nil {
+				return api.Snapshot{}, err
+			}
+			// Ensure clusters map is never nil (fix for null values from database)
+			if inventory.Clusters == nil {
+				inventory.Clusters = make(map[string]api.InventoryData)
+			}
+			// Normalize vcenter and all cluster inventories to prevent null values
+			if inventory.Vcenter != nil {
+				normalizeInventoryData(inventory.Vcenter)
+			}
+			for clusterID, clusterData := range inventory.Clusters {
+				normalizeInventoryData(&clusterData)
+				inventory.Clusters[clusterID] = clusterData
+			}
+		default:
+			return api.Snapshot{}, fmt.Errorf("unsupported snapshot version: %d", snapshot.Version)
+		}
+		apiSnapshot.Inventory = inventory
+	} else {
+		// Initialize empty inventory with non-nil Clusters
+		apiSnapshot.Inventory = api.Inventory{
+			Clusters: make(map[string]api.InventoryData),
+		}
+	}
+
+	// Convert subset inventories for this snapshot
+	if len(snapshot.SubsetInventories) > 0 {
+		subsets := make([]api.AssessmentSubsetInventory, len(snapshot.SubsetInventories))
+		for j := range snapshot.SubsetInventories {
+			subset := snapshot.SubsetInventories[j] // Create local copy to avoid pointer aliasing
+
+			// Unmarshal inventory (required field)
+			var subsetInv api.Inventory
+			if len(subset.Inventory) > 0 {
+				if err := json.Unmarshal(subset.Inventory, &subsetInv); err != nil {
+					return api.Snapshot{}, fmt.Errorf("failed to unmarshal subset inventory: %w", err)
+				}
+				// Ensure clusters map is never nil
+				if subsetInv.Clusters == nil {
+					subsetInv.Clusters = make(map[string]api.InventoryData)
+				}
+				// Normalize vcenter and all cluster inventories
+				if subsetInv.Vcenter != nil {
+					normalizeInventoryData(subsetInv.Vcenter)
+				}
+				for clusterID, clusterData := range subsetInv.Clusters {
+					normalizeInventoryData(&clusterData)
+					subsetInv.Clusters[clusterID] = clusterData
+				}
+			} else {
+				// Empty inventory with non-nil Clusters
+				subsetInv.Clusters = make(map[string]api.InventoryData)
+			}
+
+			subsets[j] = api.AssessmentSubsetInventory{
+				Id:        subset.ID,
+				Name:      subset.Name,
+				VcenterId: subset.VCenterID,
+				VmsCount:  subset.VMsCount,
+				CreatedAt: subset.CreatedAt,
+				Inventory: subsetInv,
+			}
+		}
+		apiSnapshot.SubsetInventories = &subsets
+	}
+
+	return apiSnapshot, nil
+}
+
+// SnapshotListToApi converts the snapshot history of an assessment.
+func SnapshotListToApi(snapshots []model.Snapshot) (api.SnapshotList, error) {
+	snapshotList := make([]api.Snapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		s, err := SnapshotToApi(snapshot)
+		if err != nil {
+			return api.SnapshotList{}, err
+		}
+		snapshotList[i] = s
+	}
+	return snapshotList, nil
+}
+
+// SnapshotDiffToAPI converts the service snapshot diff to the API response type.
+func SnapshotDiffToAPI(diff service.SnapshotDiff) api.SnapshotDiff {
+	clusters := make([]api.ClusterSnapshotDiff, len(diff.Clusters))
+	for i, c := range diff.Clusters {
+		osInfo := make([]api.OsCountDiff, len(c.OSInfo))
+		for j, entry := range c.OSInfo {
+			osInfo[j] = api.OsCountDiff{
+				Name:  entry.Name,
+				From:  entry.From,
+				To:    entry.To,
+				Delta: entry.Delta(),
+			}
+		}
+
+		clusters[i] = api.ClusterSnapshotDiff{
+			ClusterId:            c.ClusterID,
+			Status:               api.ClusterSnapshotDiffStatus(c.Status),
+			VmsTotal:             countDiffToAPI(c.VMsTotal),
+			VmsTotalMigratable:   countDiffToAPI(c.VMsTotalMigratable),
+			DiskGB:               countDiffToAPI(c.DiskGB),
+			RamGB:                countDiffToAPI(c.RamGB),
+			OsInfo:               osInfo,
+			MigrationWarnings:    issuesDiffToAPI(c.MigrationWarnings),
+			NotMigratableReasons: issuesDiffToAPI(c.NotMigratableReasons),
+			Hosts: api.HostsDiff{
+				Total:   countDiffToAPI(c.Hosts.Total),
+				Added:   c.Hosts.Added,
+				Removed: c.Hosts.Removed,
+			},
+			Datastores: api.DatastoresDiff{
+				Total:   countDiffToAPI(c.Datastores.Total),
+				Added:   c.Datastores.Added,
+				Removed: c.Datastores.Removed,
+			},
+			VmsAdded:   snapshotVMsToAPI(c.VMsAdded),
+			VmsRemoved: snapshotVMsToAPI(c.VMsRemoved),
+		}
+	}
+
+	return api.SnapshotDiff{
+		FromSnapshotId: int(diff.FromSnapshotID),
+		ToSnapshotId:   int(diff.ToSnapshotID),
+		Clusters:       clusters,
+	}
+}
+
+func snapshotVMsToAPI(vms []service.SnapshotVM) *[]api.SnapshotDiffVM {
+	if vms == nil {
+		return nil
+	}
+	result := make([]api.SnapshotDiffVM, len(vms))
+	for i, vm := range vms {
+		result[i] = api.SnapshotDiffVM{Id: vm.ID, Name: vm.Name}
+	}
+	return &result
+}
+
+func countDiffToAPI(c service.CountDiff) api.CountDiff {
+	return api.CountDiff{From: c.From, To: c.To, Delta: c.Delta()}
+}
+
+func issuesDiffToAPI(d service.IssuesDiff) api.MigrationIssuesDiff {
+	changed := make([]api.MigrationIssueCountDiff, len(d.Changed))
+	for i, c := range d.Changed {
+		changed[i] = api.MigrationIssueCountDiff{
+			Id:    c.ID,
+			Label: c.Label,
+			From:  c.From,
+			To:    c.To,
+			Delta: c.Delta(),
+		}
+	}
+
+	return api.MigrationIssuesDiff{
+		New:      d.New,
+		Resolved: d.Resolved,
+		Changed:  changed,
+	}
+}
+
+// AssessmentVMPageToApi converts a page of assessment VMs to the API response type.
+func AssessmentVMPageToApi(page service.AssessmentVMPage) api.AssessmentVMList {
+	vms := make([]api.AssessmentVM, len(page.VMs))
+	for i, vm := range page.VMs {
+		concerns := make([]api.VMConcern, len(vm.Concerns.Data))
+		for j, c := range vm.Concerns.Data {
+			concerns[j] = api.VMConcern{
+				Id:         c.ID,
+				Label:      c.Label,
+				Category:   c.Category,
+				Assessment: c.Assessment,
+			}
+		}
+
+		vms[i] = api.AssessmentVM{
+			Id:                 vm.VMID,
+			Name:               vm.Name,
+			ClusterId:          vm.ClusterID,
+			ClusterName:        vm.ClusterName,
+			VcenterId:          vm.VCenterID,
+			Datacenter:         vm.Datacenter,
+			Host:               vm.Host,
+			Os:                 vm.OS,
+			PowerState:         vm.PowerState,
+			CpuCount:           vm.CpuCount,
+			MemoryMB:           vm.MemoryMB,
+			DiskGB:             vm.DiskGB,
+			CpuUsagePercent:    vm.CpuUsagePercent,
+			MemoryUsagePercent: vm.MemoryUsagePercent,
+			IsTemplate:         vm.IsTemplate,
+			MigrationExcluded:  vm.MigrationExcluded,
+			Concerns:           concerns,
+		}
+	}
+
+	return api.AssessmentVMList{
+		SnapshotId: int(page.SnapshotID),
+		Vms:        vms,
+		Total:      int(page.Total),
+		Limit:      page.Limit,
+		Offset:     page.Offset,
+	}
+}
+
 func AssessmentListToApi(assessments []model.Assessment) (api.AssessmentList, error) {
 	assessmentList := make([]api.Assessment, len(assessments))
 	for i, assessment := range assessments {
@@ -462,12 +617,25 @@ func MigrationComplexityResultToAPI(result service.MigrationComplexityResult) ap
 		}
 	}
 
+	byApplication := make([]api.ComplexityApplicationEntry, len(result.ComplexityByApplication))
+	for i, entry := range result.ComplexityByApplication {
+		byApplication[i] = api.ComplexityApplicationEntry{
+			Application:       entry.ID,
+			Name:              entry.Name,
+			Score:             entry.Score,
+			MigrationApproach: entry.Approach,
+			VmCount:           entry.VMCount,
+		}
+	}
+
 	return api.MigrationComplexityResponse{
-		ComplexityByDisk:   byDisk,
-		ComplexityByOS:     byOS,
-		ComplexityByOSName: byOSName,
-		DiskSizeRatings:    result.DiskSizeRatings,
-		OsRatings:          result.OSRatings,
+		ComplexityByDisk:        byDisk,
+		ComplexityByOS:          byOS,
+		ComplexityByOSName:      byOSName,
+		ComplexityByApplication: byApplication,
+		DiskSizeRatings:         result.DiskSizeRatings,
+		OsRatings:               result.OSRatings,
+		ComplexityTableVersion:  result.ComplexityTableVersion,
 	}
 }
 
@@ -497,6 +665,110 @@ func MigrationEstimationResultToAPI(
 	}
 }
 
+// EstimationSchemasToAPI converts the registered estimation schemas to the API schema list.
+func EstimationSchemasToAPI(defs []engines.SchemaDefinition) api.EstimationSchemaList {
+	result := make(api.EstimationSchemaList, 0, len(defs))
+	for _, def := range defs {
+		calcs := make([]api.EstimationSchemaCalculator, 0, len(def.Calculators))
+		for _, c := range def.Calculators {
+			params := make(map[string]float64, len(c.Params))
+			for k, v := range c.Params {
+				params[k] = v
+			}
+			calcs = append(calcs, api.EstimationSchemaCalculator{
+				Type:   c.Type,
+				Name:   c.CalculatorName(),
+				Params: params,
+			})
+		}
+		schema := api.EstimationSchema{
+			Name:        string(def.Name),
+			Calculators: calcs,
+			ParamKeys:   def.ParamKeys(),
+		}
+		if def.Description != "" {
+			schema.Description = &def.Description
+		}
+		result = append(result, schema)
+	}
+	return result
+}
+
+// WavePlanToAPI converts a migration wave plan to the API response.
+func WavePlanToAPI(plan *service.WavePlan, estimationCtx *service.EstimationContext) api.MigrationWavePlanResponse {
+	waves := make([]api.MigrationWave, 0, len(plan.Waves))
+	for _, w := range plan.Waves {
+		waves = append(waves, api.MigrationWave{
+			Number:             w.Number,
+			ComplexityScore:    w.ComplexityScore,
+			VmCount:            w.VMCount,
+			TotalDiskGB:        w.TotalDiskGB,
+			Estimation:         schemaResultToAPI(w.Estimation),
+			ExceedsConstraints: w.ExceedsConstraints,
+		})
+	}
+
+	resp := api.MigrationWavePlanResponse{
+		EstimationSchema: string(plan.Schema),
+		Waves:            waves,
+		MinTotalDuration: plan.MinTotalDuration.String(),
+		MaxTotalDuration: plan.MaxTotalDuration.String(),
+	}
+	if estimationCtx != nil {
+		params := paramMapToAPI(estimationCtx.BaseParams)
+		schemas := make([]string, len(estimationCtx.Schemas))
+		for i, s := range estimationCtx.Schemas {
+			schemas[i] = string(s)
+		}
+		resp.EstimationContext = &api.EstimationContext{Schemas: &schemas, Params: &params}
+	}
+	return resp
+}
+
+// TimelinesToAPI converts the schema-keyed timelines to the API timeline block.
+func TimelinesToAPI(timelines map[engines.Schema]*timeline.Timeline) map[string]api.MigrationTimeline {
+	result := make(map[string]api.MigrationTimeline, len(timelines))
+	for schema, tl := range timelines {
+		phases := make([]api.TimelinePhase, 0, len(tl.Phases))
+		for _, p := range tl.Phases {
+			phases = append(phases, api.TimelinePhase{
+				Name:            p.Name,
+				StartDate:       openapi_types.Date{Time: p.Start},
+				EndDate:         openapi_types.Date{Time: p.End},
+				EarliestEndDate: openapi_types.Date{Time: p.EarliestEnd},
+			})
+		}
+		result[string(schema)] = api.MigrationTimeline{
+			StartDate:       openapi_types.Date{Time: tl.Start},
+			EndDate:         openapi_types.Date{Time: tl.End},
+			EarliestEndDate: openapi_types.Date{Time: tl.EarliestEnd},
+			Phases:          phases,
+		}
+	}
+	return result
+}
+
+// SimulationResultsToAPI converts the schema-keyed Monte Carlo results to the API simulation block.
+func SimulationResultsToAPI(results map[engines.Schema]*estimation.SimulationResult) map[string]api.SimulationResult {
+	toAPI := func(p estimation.Percentiles) api.DurationPercentiles {
+		return api.DurationPercentiles{P50: p.P50.String(), P80: p.P80.String(), P95: p.P95.String()}
+	}
+	out := make(map[string]api.SimulationResult, len(results))
+	for schema, r := range results {
+		breakdown := make(map[string]api.DurationPercentiles, len(r.Breakdown))
+		for name, p := range r.Breakdown {
+			breakdown[name] = toAPI(p)
+		}
+		out[string(schema)] = api.SimulationResult{
+			Runs:      r.Runs,
+			Seed:      int64(r.Seed),
+			Total:     toAPI(r.Total),
+			Breakdown: breakdown,
+		}
+	}
+	return out
+}
+
 // paramMapToAPI converts a slice of estimation.Param to the map[string]float32 used in EstimationContext.
 func paramMapToAPI(params []estimation.Param) map[string]float32 {
 	m := make(map[string]float32, len(params))
@@ -12,6 +12,7 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/opa"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -70,6 +71,22 @@ var _ = Describe("MigrationComplexityResultToAPI", func() {
 		Expect(resp.ComplexityByOSName).To(HaveLen(1))
 		Expect(resp.DiskSizeRatings).To(HaveKey("0-10TB"))
 		Expect(resp.OsRatings).To(HaveKey("Red Hat Enterprise Linux 9"))
+		Expect(resp.ComplexityByApplication).NotTo(BeNil())
+		Expect(resp.ComplexityByApplication).To(BeEmpty())
+	})
+
+	It("maps complexityByApplication", func() {
+		result := service.MigrationComplexityResult{
+			ComplexityByApplication: []complexity.ApplicationEntry{
+				{ID: "oracle-db", Name: "Oracle Database", Score: 4, Approach: complexity.ApproachVendorValidated, VMCount: 3},
+			},
+		}
+
+		resp := mappers.MigrationComplexityResultToAPI(result)
+
+		Expect(resp.ComplexityByApplication).To(Equal([]api.ComplexityApplicationEntry{
+			{Application: "oracle-db", Name: "Oracle Database", Score: 4, MigrationApproach: "vendor_validated", VmCount: 3},
+		}))
 	})
 })
 
@@ -150,3 +167,38 @@ var _ = Describe("OsDiskEstimationResultToAPI", func() {
 
 	})
 })
+
+var _ = Describe("AttachRemediations", func() {
+	It("sets the remediation of the catalog on the migration issues", func() {
+		validator, err := opa.NewValidator(map[string]string{
+			"test.rego": "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns contains flag if {\n\tfalse\n\tflag := {}\n}\n",
+			opa.RemediationCatalogFile: `{"remediations": [
+				{"concernId": "test.cbt", "steps": ["Enable CBT."], "effortHoursPerVM": 0.5, "docLinks": ["https://example.com/cbt"]}
+			]}`,
+		})
+		Expect(err).To(BeNil())
+
+		cbt, other := "test.cbt", "test.other"
+		inventory := api.Inventory{
+			Vcenter: &api.InventoryData{Vms: api.VMs{
+				MigrationWarnings: []api.MigrationIssue{{Id: &cbt, Count: 2}, {Id: &other, Count: 1}, {Label: "no ID", Count: 1}},
+			}},
+			Clusters: map[string]api.InventoryData{
+				"cluster-1": {Vms: api.VMs{NotMigratableReasons: []api.MigrationIssue{{Id: &cbt, Count: 1}}}},
+			},
+		}
+
+		mappers.AttachRemediations(&inventory, validator.Remediations())
+
+		warnings := inventory.Vcenter.Vms.MigrationWarnings
+		Expect(warnings[0].Remediation).NotTo(BeNil())
+		Expect(warnings[0].Remediation.Steps).To(Equal([]string{"Enable CBT."}))
+		Expect(warnings[0].Remediation.EffortHoursPerVm).To(Equal(0.5))
+		Expect(*warnings[0].Remediation.DocLinks).To(Equal([]string{"https://example.com/cbt"}))
+		Expect(warnings[0].Remediation.AutomationHint).To(BeNil())
+		Expect(warnings[0].Remediation.PolicyRevision).To(Equal(validator.Revision()))
+		Expect(warnings[1].Remediation).To(BeNil())
+		Expect(warnings[2].Remediation).To(BeNil())
+		Expect(inventory.Clusters["cluster-1"].Vms.NotMigratableReasons[0].Remediation).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,77 @@
+package mappers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+func PolicyBundleCreateToService(req api.PolicyBundleCreate) service.PolicyBundleForm {
+	form := service.PolicyBundleForm{
+		Name:    req.Name,
+		Modules: req.Modules,
+	}
+	if req.Description != nil {
+		form.Description = *req.Description
+	}
+	return form
+}
+
+// PolicyBundleTestVMsToService decodes the sample VMs as the VMs the policies are evaluated for.
+func PolicyBundleTestVMsToService(req api.PolicyBundleTestRequest) ([]models.VM, error) {
+	vms := make([]models.VM, len(req.Vms))
+	for i, raw := range req.Vms {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("vm %d: %w", i, err)
+		}
+		if err := json.Unmarshal(data, &vms[i]); err != nil {
+			return nil, fmt.Errorf("vm %d: %w", i, err)
+		}
+	}
+	return vms, nil
+}
+
+func PolicyBundleToApi(bundle model.PolicyBundle) api.PolicyBundle {
+	result := api.PolicyBundle{
+		Revision:  bundle.Revision,
+		Name:      bundle.Name,
+		Active:    bundle.Active,
+		Modules:   bundle.Modules.Data,
+		CreatedBy: bundle.CreatedBy,
+		CreatedAt: bundle.CreatedAt,
+	}
+	if bundle.Description != "" {
+		result.Description = &bundle.Description
+	}
+	return result
+}
+
+func PolicyBundleListToApi(bundles model.PolicyBundleList) api.PolicyBundleList {
+	result := make(api.PolicyBundleList, len(bundles))
+	for i, bundle := range bundles {
+		result[i] = PolicyBundleToApi(bundle)
+	}
+	return result
+}
+
+func PolicyTestResultsToApi(results []service.PolicyTestResult) api.PolicyBundleTestResult {
+	result := api.PolicyBundleTestResult{Results: make([]api.PolicyBundleVMResult, len(results))}
+	for i, r := range results {
+		concerns := make([]api.VMConcern, len(r.Concerns))
+		for j, c := range r.Concerns {
+			concerns[j] = api.VMConcern{
+				Id:         c.Id,
+				Label:      c.Label,
+				Category:   c.Category,
+				Assessment: c.Assessment,
+			}
+		}
+		result.Results[i] = api.PolicyBundleVMResult{Vm: r.VM, Concerns: concerns}
+	}
+	return result
+}
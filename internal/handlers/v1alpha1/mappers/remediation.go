@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/pkg/opa"
+)
+
+// AttachRemediations sets the remediation of the catalog on the migration issues of the vCenter
+// and cluster inventories. Issues without an ID or without a remediation in the catalog are left
+// unchanged.
+func AttachRemediations(inventory *api.Inventory, catalog *opa.RemediationCatalog) {
+	if inventory.Vcenter != nil {
+		attachRemediations(&inventory.Vcenter.Vms, catalog)
+	}
+	for clusterID, data := range inventory.Clusters {
+		attachRemediations(&data.Vms, catalog)
+		inventory.Clusters[clusterID] = data
+	}
+}
+
+func attachRemediations(vms *api.VMs, catalog *opa.RemediationCatalog) {
+	for _, issues := range [][]api.MigrationIssue{vms.NotMigratableReasons, vms.MigrationWarnings} {
+		for i := range issues {
+			if issues[i].Id == nil {
+				continue
+			}
+			if r, found := catalog.Lookup(*issues[i].Id); found {
+				issues[i].Remediation = remediationToApi(r, catalog.Revision())
+			}
+		}
+	}
+}
+
+func remediationToApi(r opa.Remediation, policyRevision string) *api.Remediation {
+	remediation := &api.Remediation{
+		Steps:            r.Steps,
+		EffortHoursPerVm: r.EffortHoursPerVM,
+		PolicyRevision:   policyRevision,
+	}
+	if len(r.DocLinks) > 0 {
+		remediation.DocLinks = &r.DocLinks
+	}
+	if r.AutomationHint != "" {
+		remediation.AutomationHint = &r.AutomationHint
+	}
+	return remediation
+}
@@ -0,0 +1,202 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles)
+func (h *ServiceHandler) ListPolicyBundles(ctx context.Context, request server.ListPolicyBundlesRequestObject) (server.ListPolicyBundlesResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("list_policy_bundles").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	bundles, err := h.policyBundleSrv.ListBundles(ctx, request.OrgId)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.ListPolicyBundles403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		default:
+			return server.ListPolicyBundles500JSONResponse{Message: fmt.Sprintf("failed to list policy bundles: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("count", len(bundles)).Log()
+	return server.ListPolicyBundles200JSONResponse(mappers.PolicyBundleListToApi(bundles)), nil
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles)
+func (h *ServiceHandler) CreatePolicyBundle(ctx context.Context, request server.CreatePolicyBundleRequestObject) (server.CreatePolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("create_policy_bundle").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	if request.Body == nil {
+		return server.CreatePolicyBundle400JSONResponse{Message: "empty body"}, nil
+	}
+
+	bundle, err := h.policyBundleSrv.CreateBundle(ctx, request.OrgId, mappers.PolicyBundleCreateToService(*request.Body))
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.CreatePolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.CreatePolicyBundle400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrDuplicateKey:
+			return server.CreatePolicyBundle409JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CreatePolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to create policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("revision", bundle.Revision).Log()
+	return server.CreatePolicyBundle201JSONResponse(mappers.PolicyBundleToApi(*bundle)), nil
+}
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles/active)
+func (h *ServiceHandler) GetActivePolicyBundle(ctx context.Context, request server.GetActivePolicyBundleRequestObject) (server.GetActivePolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("get_active_policy_bundle").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	bundle, err := h.policyBundleSrv.GetActiveBundle(ctx, request.OrgId)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.GetActivePolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrResourceNotFound:
+			return server.GetActivePolicyBundle404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.GetActivePolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to get active policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("revision", bundle.Revision).Log()
+	return server.GetActivePolicyBundle200JSONResponse(mappers.PolicyBundleToApi(*bundle)), nil
+}
+
+// (DELETE /api/v1/organizations/{orgId}/policy-bundles/active)
+func (h *ServiceHandler) DeactivatePolicyBundle(ctx context.Context, request server.DeactivatePolicyBundleRequestObject) (server.DeactivatePolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("deactivate_policy_bundle").
+		WithString("org_id", request.OrgId).
+		Build()
+
+	if err := h.policyBundleSrv.DeactivateBundle(ctx, request.OrgId); err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.DeactivatePolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		default:
+			return server.DeactivatePolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to deactivate policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.DeactivatePolicyBundle204Response{}, nil
+}
+
+// (GET /api/v1/organizations/{orgId}/policy-bundles/{revision})
+func (h *ServiceHandler) GetPolicyBundle(ctx context.Context, request server.GetPolicyBundleRequestObject) (server.GetPolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("get_policy_bundle").
+		WithString("org_id", request.OrgId).
+		WithInt("revision", request.Revision).
+		Build()
+
+	bundle, err := h.policyBundleSrv.GetBundle(ctx, request.OrgId, request.Revision)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.GetPolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrResourceNotFound:
+			return server.GetPolicyBundle404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.GetPolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to get policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.GetPolicyBundle200JSONResponse(mappers.PolicyBundleToApi(*bundle)), nil
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/activate)
+func (h *ServiceHandler) ActivatePolicyBundle(ctx context.Context, request server.ActivatePolicyBundleRequestObject) (server.ActivatePolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("activate_policy_bundle").
+		WithString("org_id", request.OrgId).
+		WithInt("revision", request.Revision).
+		Build()
+
+	bundle, err := h.policyBundleSrv.ActivateBundle(ctx, request.OrgId, request.Revision)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.ActivatePolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.ActivatePolicyBundle400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			return server.ActivatePolicyBundle404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.ActivatePolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to activate policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().Log()
+	return server.ActivatePolicyBundle200JSONResponse(mappers.PolicyBundleToApi(*bundle)), nil
+}
+
+// (POST /api/v1/organizations/{orgId}/policy-bundles/{revision}/test)
+func (h *ServiceHandler) TestPolicyBundle(ctx context.Context, request server.TestPolicyBundleRequestObject) (server.TestPolicyBundleResponseObject, error) {
+	logger := log.NewDebugLogger("policy_bundle_handler").
+		WithContext(ctx).
+		Operation("test_policy_bundle").
+		WithString("org_id", request.OrgId).
+		WithInt("revision", request.Revision).
+		Build()
+
+	if request.Body == nil {
+		return server.TestPolicyBundle400JSONResponse{Message: "empty body"}, nil
+	}
+	vms, err := mappers.PolicyBundleTestVMsToService(*request.Body)
+	if err != nil {
+		return server.TestPolicyBundle400JSONResponse{Message: fmt.Sprintf("invalid vms: %v", err)}, nil
+	}
+
+	results, err := h.policyBundleSrv.TestBundle(ctx, request.OrgId, request.Revision, vms)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrForbidden:
+			return server.TestPolicyBundle403JSONResponse{Message: "you do not have permission to perform this action"}, nil
+		case *service.ErrInvalidRequest:
+			return server.TestPolicyBundle400JSONResponse{Message: err.Error()}, nil
+		case *service.ErrResourceNotFound:
+			return server.TestPolicyBundle404JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.TestPolicyBundle500JSONResponse{Message: fmt.Sprintf("failed to test policy bundle: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("vms", len(results)).Log()
+	return server.TestPolicyBundle200JSONResponse(mappers.PolicyTestResultsToApi(results)), nil
+}
@@ -0,0 +1,114 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	handlers "github.com/kubev2v/migration-planner/internal/handlers/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("policy bundle handler", func() {
+	const (
+		orgID        = "test-org"
+		globalPolicy = "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns contains flag if {\n\tinput.name == \"legacy\"\n\tflag := {\"id\": \"global\", \"category\": \"Warning\", \"label\": \"legacy\", \"assessment\": \"legacy\"}\n}\n"
+		pciPolicy    = "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns contains flag if {\n\t\"pci\" in input.labels\n\tflag := {\"id\": \"org.pci\", \"category\": \"Critical\", \"label\": \"PCI\", \"assessment\": \"PCI VM\"}\n}\n"
+	)
+
+	var (
+		mockStore *MockStore
+		handler   *handlers.ServiceHandler
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		mockStore = NewMockStore()
+		ctx = auth.NewTokenContext(context.Background(), auth.User{Username: "admin", Organization: orgID})
+		validator, err := opa.NewValidator(map[string]string{"concerns.rego": globalPolicy})
+		Expect(err).To(BeNil())
+		handler = handlers.NewServiceHandler(nil, nil, nil, nil, nil, nil, nil, nil).
+			WithPolicyBundles(service.NewPolicyBundleService(mockStore, validator))
+	})
+
+	create := func(modules map[string]string) server.CreatePolicyBundleResponseObject {
+		resp, err := handler.CreatePolicyBundle(ctx, server.CreatePolicyBundleRequestObject{
+			OrgId: orgID,
+			Body:  &api.PolicyBundleCreate{Name: "pci", Modules: modules},
+		})
+		Expect(err).To(BeNil())
+		return resp
+	}
+
+	It("uploads, tests and activates a bundle", func() {
+		created, ok := create(map[string]string{"pci.rego": pciPolicy}).(server.CreatePolicyBundle201JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(created.Revision).To(Equal(1))
+		Expect(created.Active).To(BeFalse())
+
+		testResp, err := handler.TestPolicyBundle(ctx, server.TestPolicyBundleRequestObject{
+			OrgId:    orgID,
+			Revision: 1,
+			Body: &api.PolicyBundleTestRequest{Vms: []map[string]interface{}{
+				{"name": "legacy", "labels": []string{"pci"}},
+			}},
+		})
+		Expect(err).To(BeNil())
+		tested, ok := testResp.(server.TestPolicyBundle200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(tested.Results).To(HaveLen(1))
+		Expect(tested.Results[0].Vm).To(Equal("legacy"))
+		Expect(tested.Results[0].Concerns).To(ConsistOf(HaveField("Id", "global"), HaveField("Id", "org.pci")))
+
+		activateResp, err := handler.ActivatePolicyBundle(ctx, server.ActivatePolicyBundleRequestObject{OrgId: orgID, Revision: 1})
+		Expect(err).To(BeNil())
+		activated, ok := activateResp.(server.ActivatePolicyBundle200JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(activated.Active).To(BeTrue())
+
+		activeResp, err := handler.GetActivePolicyBundle(ctx, server.GetActivePolicyBundleRequestObject{OrgId: orgID})
+		Expect(err).To(BeNil())
+		Expect(activeResp).To(BeAssignableToTypeOf(server.GetActivePolicyBundle200JSONResponse{}))
+
+		deactivateResp, err := handler.DeactivatePolicyBundle(ctx, server.DeactivatePolicyBundleRequestObject{OrgId: orgID})
+		Expect(err).To(BeNil())
+		Expect(deactivateResp).To(Equal(server.DeactivatePolicyBundle204Response{}))
+
+		activeResp, err = handler.GetActivePolicyBundle(ctx, server.GetActivePolicyBundleRequestObject{OrgId: orgID})
+		Expect(err).To(BeNil())
+		Expect(activeResp).To(BeAssignableToTypeOf(server.GetActivePolicyBundle404JSONResponse{}))
+	})
+
+	It("returns 400 for policies that do not compile", func() {
+		resp := create(map[string]string{"pci.rego": "package io.konveyor.forklift.vmware\n\nconcerns contains flag if {\n"})
+
+		errorResp, ok := resp.(server.CreatePolicyBundle400JSONResponse)
+		Expect(ok).To(BeTrue())
+		Expect(errorResp.Message).To(ContainSubstring("failed to parse policy org/pci.rego"))
+	})
+
+	It("returns 400 for sample VMs that are not VMs", func() {
+		_, ok := create(map[string]string{"pci.rego": pciPolicy}).(server.CreatePolicyBundle201JSONResponse)
+		Expect(ok).To(BeTrue())
+
+		resp, err := handler.TestPolicyBundle(ctx, server.TestPolicyBundleRequestObject{
+			OrgId:    orgID,
+			Revision: 1,
+			Body:     &api.PolicyBundleTestRequest{Vms: []map[string]interface{}{{"labels": "pci"}}},
+		})
+
+		Expect(err).To(BeNil())
+		Expect(resp).To(BeAssignableToTypeOf(server.TestPolicyBundle400JSONResponse{}))
+	})
+
+	It("returns 404 for an unknown revision", func() {
+		resp, err := handler.GetPolicyBundle(ctx, server.GetPolicyBundleRequestObject{OrgId: orgID, Revision: 7})
+
+		Expect(err).To(BeNil())
+		Expect(resp).To(BeAssignableToTypeOf(server.GetPolicyBundle404JSONResponse{}))
+	})
+})
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/assessments/{id}/right-sizing)
+func (h *ServiceHandler) GetAssessmentRightSizing(ctx context.Context, request server.GetAssessmentRightSizingRequestObject) (server.GetAssessmentRightSizingResponseObject, error) {
+	logger := log.NewDebugLogger("sizer_handler").
+		WithContext(ctx).
+		Operation("get_assessment_right_sizing").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	snapshotID, err := snapshotIDFromRequest(request.Params.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetAssessmentRightSizing400JSONResponse{Message: err.Error()}, nil
+	}
+
+	var clusterID string
+	if request.Params.ClusterId != nil {
+		clusterID = *request.Params.ClusterId
+	}
+
+	if _, err := h.assessmentSrv.GetAssessment(ctx, request.Id); err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.GetAssessmentRightSizing404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.GetAssessmentRightSizing403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.GetAssessmentRightSizing500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
+		}
+	}
+
+	report, err := h.sizerSrv.GetRightSizing(ctx, request.Id, snapshotID, clusterID)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.GetAssessmentRightSizing404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidRequest:
+			return server.GetAssessmentRightSizing400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.GetAssessmentRightSizing500JSONResponse{Message: fmt.Sprintf("failed to get right-sizing: %v", err)}, nil
+		}
+	}
+
+	logger.Success().
+		WithInt("vms_right_sized", report.Totals.VmsRightSized).
+		Log()
+
+	return server.GetAssessmentRightSizing200JSONResponse(*report), nil
+}
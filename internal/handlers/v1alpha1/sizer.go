@@ -59,6 +59,17 @@ func validateWorkerNodeThreads(threads *int, cpu int) error {
 	return nil
 }
 
+func validateSizingMode(mode *api.SizingMode) error {
+	if mode == nil {
+		return nil
+	}
+	switch *mode {
+	case api.SizingModeBatched, api.SizingModePerVm:
+		return nil
+	}
+	return fmt.Errorf("invalid sizingMode: %s. Valid values are: batched, perVm", *mode)
+}
+
 // (GET /api/v1/assessments/{id}/cluster-requirements/stored-input)
 func (h *ServiceHandler) GetAssessmentClusterRequirementsStoredInput(ctx context.Context, request server.GetAssessmentClusterRequirementsStoredInputRequestObject) (server.GetAssessmentClusterRequirementsStoredInputResponseObject, error) {
 	logger := log.NewDebugLogger("sizer_handler").
@@ -188,7 +199,18 @@ func (h *ServiceHandler) CalculateAssessmentClusterRequirements(ctx context.Cont
 		return server.CalculateAssessmentClusterRequirements400JSONResponse{Message: err.Error()}, nil
 	}
 
-	_, err := h.assessmentSrv.GetAssessment(ctx, assessmentID)
+	if err := validateSizingMode(request.Body.SizingMode); err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentClusterRequirements400JSONResponse{Message: err.Error()}, nil
+	}
+
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentClusterRequirements400JSONResponse{Message: err.Error()}, nil
+	}
+
+	_, err = h.assessmentSrv.GetAssessment(ctx, assessmentID)
 	if err != nil {
 		switch err.(type) {
 		case *service.ErrResourceNotFound:
@@ -215,8 +237,20 @@ func (h *ServiceHandler) CalculateAssessmentClusterRequirements(ctx context.Cont
 		WithString("username", user.Username).
 		Log()
 
+	// Readers can calculate the requirements, only editors change the stored input
+	readOnly := false
+	if err := h.assessmentSrv.AuthorizeEdit(ctx, assessmentID); err != nil {
+		if _, ok := err.(*service.ErrForbidden); !ok {
+			logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+			return server.CalculateAssessmentClusterRequirements500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
+		}
+		readOnly = true
+	}
+
 	// Convert API request to domain model
 	domainRequest := mappers.ClusterRequirementsRequestToForm(*request.Body)
+	domainRequest.SnapshotID = snapshotID
+	domainRequest.ReadOnly = readOnly
 
 	res, err := h.sizerSrv.CalculateClusterRequirements(ctx, assessmentID, &domainRequest)
 	if err != nil {
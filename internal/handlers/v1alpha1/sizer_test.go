@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,22 +21,28 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 // MockStore is a mock implementation of store.Store
 type MockStore struct {
-	assessments     map[uuid.UUID]*model.Assessment
-	clusterInputs   map[string]*model.AssessmentClusterSizingInput
-	enhancementData map[string]*model.AssessmentEnhancementData
-	getError        error
+	assessments      map[uuid.UUID]*model.Assessment
+	clusterInputs    map[string]*model.AssessmentClusterSizingInput
+	hardwareSKUs     map[string]*model.HardwareSKU
+	complexityTables model.ComplexityTableList
+	policyBundles    model.PolicyBundleList
+	waivers          model.ConcernWaiverList
+	enhancementData  map[string]*model.AssessmentEnhancementData
+	getError         error
 }
 
 func NewMockStore() *MockStore {
 	return &MockStore{
 		assessments:     make(map[uuid.UUID]*model.Assessment),
 		clusterInputs:   make(map[string]*model.AssessmentClusterSizingInput),
+		hardwareSKUs:    make(map[string]*model.HardwareSKU),
 		enhancementData: make(map[string]*model.AssessmentEnhancementData),
 	}
 }
@@ -63,7 +71,7 @@ func (f *ForbiddenAssessmentService) CreateAssessment(_ context.Context, _ mappe
 	return nil, service.NewErrForbidden("assessment", "create")
 }
 
-func (f *ForbiddenAssessmentService) UpdateAssessment(_ context.Context, id uuid.UUID, _ *string) (*model.Assessment, error) {
+func (f *ForbiddenAssessmentService) UpdateAssessment(_ context.Context, id uuid.UUID, _ *string, _ []byte) (*model.Assessment, error) {
 	return nil, service.NewErrForbidden("assessment", id.String())
 }
 
@@ -79,6 +87,50 @@ func (f *ForbiddenAssessmentService) UnshareAssessment(_ context.Context, id uui
 	return service.NewErrForbidden("assessment", id.String())
 }
 
+func (f *ForbiddenAssessmentService) ShareAssessmentWith(_ context.Context, id uuid.UUID, _ []model.Subject, _ model.Relation) error {
+	return service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) UnshareAssessmentWith(_ context.Context, id uuid.UUID, _ []model.Subject) error {
+	return service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) TransferOwnership(_ context.Context, id uuid.UUID, _ string) (*model.Assessment, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) AuthorizeEdit(_ context.Context, id uuid.UUID) error {
+	return service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) ListSnapshots(_ context.Context, id uuid.UUID) ([]model.Snapshot, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) GetSnapshot(_ context.Context, id uuid.UUID, _ uint) (*model.Snapshot, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) DiffSnapshots(_ context.Context, id uuid.UUID, _, _ uint) (*service.SnapshotDiff, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) ListVMs(_ context.Context, id uuid.UUID, _ *service.AssessmentVMFilter) (*service.AssessmentVMPage, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) ListConcernWaivers(_ context.Context, id uuid.UUID) (model.ConcernWaiverList, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) CreateConcernWaiver(_ context.Context, id uuid.UUID, _ service.ConcernWaiverForm) (*model.ConcernWaiver, error) {
+	return nil, service.NewErrForbidden("assessment", id.String())
+}
+
+func (f *ForbiddenAssessmentService) DeleteConcernWaiver(_ context.Context, id uuid.UUID, _ uuid.UUID) error {
+	return service.NewErrForbidden("assessment", id.String())
+}
+
 func (m *MockStore) Source() store.Source {
 	panic("Source() not implemented in MockStore for this test")
 }
@@ -87,10 +139,18 @@ func (m *MockStore) SourceSubsetInventory() store.SourceSubsetInventory {
 	panic("SourceSubsetInventory() not implemented in MockStore for this test")
 }
 
+func (m *MockStore) SourceVM() store.SourceVM {
+	panic("SourceVM() not implemented in MockStore for this test")
+}
+
 func (m *MockStore) AssessmentSubsetInventory() store.AssessmentSubsetInventory {
 	panic("AssessmentSubsetInventory() not implemented in MockStore for this test")
 }
 
+func (m *MockStore) AssessmentVM() store.AssessmentVM {
+	panic("AssessmentVM() not implemented in MockStore for this test")
+}
+
 func (m *MockStore) Agent() store.Agent {
 	panic("Agent() not implemented in MockStore for this test")
 }
@@ -119,6 +179,22 @@ func (m *MockStore) ClusterSizingInput() store.ClusterSizingInput {
 	return &MockClusterSizingInputStore{store: m}
 }
 
+func (m *MockStore) HardwareSKU() store.HardwareSKU {
+	return &MockHardwareSKUStore{store: m}
+}
+
+func (m *MockStore) ComplexityTable() store.ComplexityTable {
+	return &MockComplexityTableStore{store: m}
+}
+
+func (m *MockStore) PolicyBundle() store.PolicyBundle {
+	return &MockPolicyBundleStore{store: m}
+}
+
+func (m *MockStore) ConcernWaiver() store.ConcernWaiver {
+	return &MockConcernWaiverStore{store: m}
+}
+
 func (m *MockStore) AssessmentEnhancementData() store.AssessmentEnhancementData {
 	return &MockAssessmentEnhancementDataStore{store: m}
 }
@@ -141,6 +217,10 @@ func (m *MockStore) Outbox() store.Outbox {
 	panic("Outbox() not implemented in MockStore for this test")
 }
 
+func (m *MockStore) AuditLog() store.AuditLog {
+	panic("AuditLog() not implemented in MockStore for this test")
+}
+
 func (m *MockStore) Close() error {
 	return nil
 }
@@ -153,6 +233,211 @@ type MockClusterSizingInputStore struct {
 	store *MockStore
 }
 
+type MockHardwareSKUStore struct {
+	store *MockStore
+}
+
+func (m *MockHardwareSKUStore) List(ctx context.Context) (model.HardwareSKUList, error) {
+	skus := make(model.HardwareSKUList, 0, len(m.store.hardwareSKUs))
+	for _, sku := range m.store.hardwareSKUs {
+		skus = append(skus, *sku)
+	}
+	slices.SortFunc(skus, func(a, b model.HardwareSKU) int { return strings.Compare(a.Name, b.Name) })
+	return skus, nil
+}
+
+func (m *MockHardwareSKUStore) Get(ctx context.Context, name string) (*model.HardwareSKU, error) {
+	sku, ok := m.store.hardwareSKUs[name]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return sku, nil
+}
+
+func (m *MockHardwareSKUStore) Create(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	if _, ok := m.store.hardwareSKUs[sku.Name]; ok {
+		return nil, store.ErrDuplicateKey
+	}
+	m.store.hardwareSKUs[sku.Name] = &sku
+	return &sku, nil
+}
+
+func (m *MockHardwareSKUStore) Update(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	if _, ok := m.store.hardwareSKUs[sku.Name]; !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	m.store.hardwareSKUs[sku.Name] = &sku
+	return &sku, nil
+}
+
+func (m *MockHardwareSKUStore) Delete(ctx context.Context, name string) error {
+	if _, ok := m.store.hardwareSKUs[name]; !ok {
+		return store.ErrRecordNotFound
+	}
+	delete(m.store.hardwareSKUs, name)
+	return nil
+}
+
+type MockComplexityTableStore struct {
+	store *MockStore
+}
+
+func (m *MockComplexityTableStore) List(ctx context.Context, orgID string) (model.ComplexityTableList, error) {
+	var tables model.ComplexityTableList
+	for _, table := range slices.Backward(m.store.complexityTables) {
+		if table.OrgID == orgID {
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
+
+func (m *MockComplexityTableStore) Get(ctx context.Context, orgID, version string) (*model.ComplexityTable, error) {
+	for _, table := range m.store.complexityTables {
+		if table.OrgID == orgID && table.Version == version {
+			return &table, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockComplexityTableStore) GetActive(ctx context.Context, orgID string) (*model.ComplexityTable, error) {
+	for _, table := range m.store.complexityTables {
+		if table.OrgID == orgID && table.Active {
+			return &table, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockComplexityTableStore) Create(ctx context.Context, table model.ComplexityTable) (*model.ComplexityTable, error) {
+	_ = m.Deactivate(ctx, table.OrgID)
+	table.Revision = 1
+	for _, t := range m.store.complexityTables {
+		if t.OrgID == table.OrgID {
+			table.Revision = t.Revision + 1
+		}
+	}
+	table.Version = model.ComplexityTableVersion(table.Revision)
+	table.Tables.Data.Version = table.Version
+	table.Active = true
+	table.CreatedAt = time.Now()
+	m.store.complexityTables = append(m.store.complexityTables, table)
+	return &table, nil
+}
+
+func (m *MockComplexityTableStore) Deactivate(ctx context.Context, orgID string) error {
+	for i := range m.store.complexityTables {
+		if m.store.complexityTables[i].OrgID == orgID {
+			m.store.complexityTables[i].Active = false
+		}
+	}
+	return nil
+}
+
+type MockConcernWaiverStore struct {
+	store *MockStore
+}
+
+func (m *MockConcernWaiverStore) List(ctx context.Context, assessmentID uuid.UUID) (model.ConcernWaiverList, error) {
+	var waivers model.ConcernWaiverList
+	for _, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID {
+			waivers = append(waivers, waiver)
+		}
+	}
+	return waivers, nil
+}
+
+func (m *MockConcernWaiverStore) Get(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) (*model.ConcernWaiver, error) {
+	for _, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID && waiver.ID == id {
+			return &waiver, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockConcernWaiverStore) Create(ctx context.Context, waiver model.ConcernWaiver) (*model.ConcernWaiver, error) {
+	waiver.CreatedAt = time.Now()
+	m.store.waivers = append(m.store.waivers, waiver)
+	return &waiver, nil
+}
+
+func (m *MockConcernWaiverStore) Delete(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) error {
+	for i, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID && waiver.ID == id {
+			m.store.waivers = slices.Delete(m.store.waivers, i, i+1)
+			return nil
+		}
+	}
+	return store.ErrRecordNotFound
+}
+
+type MockPolicyBundleStore struct {
+	store *MockStore
+}
+
+func (m *MockPolicyBundleStore) List(ctx context.Context, orgID string) (model.PolicyBundleList, error) {
+	var bundles model.PolicyBundleList
+	for _, bundle := range slices.Backward(m.store.policyBundles) {
+		if bundle.OrgID == orgID {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bundles, nil
+}
+
+func (m *MockPolicyBundleStore) Get(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	for _, bundle := range m.store.policyBundles {
+		if bundle.OrgID == orgID && bundle.Revision == revision {
+			return &bundle, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) GetActive(ctx context.Context, orgID string) (*model.PolicyBundle, error) {
+	for _, bundle := range m.store.policyBundles {
+		if bundle.OrgID == orgID && bundle.Active {
+			return &bundle, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) Create(ctx context.Context, bundle model.PolicyBundle) (*model.PolicyBundle, error) {
+	bundle.Revision = 1
+	for _, b := range m.store.policyBundles {
+		if b.OrgID == bundle.OrgID {
+			bundle.Revision = b.Revision + 1
+		}
+	}
+	bundle.Active = false
+	bundle.CreatedAt = time.Now()
+	m.store.policyBundles = append(m.store.policyBundles, bundle)
+	return &bundle, nil
+}
+
+func (m *MockPolicyBundleStore) Activate(ctx context.Context, orgID string, revision int) error {
+	for i := range m.store.policyBundles {
+		if m.store.policyBundles[i].OrgID == orgID && m.store.policyBundles[i].Revision == revision {
+			m.store.policyBundles[i].Active = true
+			return nil
+		}
+	}
+	return store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) Deactivate(ctx context.Context, orgID string) error {
+	for i := range m.store.policyBundles {
+		if m.store.policyBundles[i].OrgID == orgID {
+			m.store.policyBundles[i].Active = false
+		}
+	}
+	return nil
+}
+
 func (m *MockAssessmentStore) Get(ctx context.Context, id uuid.UUID) (*model.Assessment, error) {
 	if m.store.getError != nil {
 		return nil, m.store.getError
@@ -176,10 +461,22 @@ func (m *MockAssessmentStore) Update(ctx context.Context, assessmentID uuid.UUID
 	panic("Update() not implemented in MockAssessmentStore for this test")
 }
 
+func (m *MockAssessmentStore) UpdateOwner(ctx context.Context, assessmentID uuid.UUID, username string) (*model.Assessment, error) {
+	panic("UpdateOwner() not implemented in MockAssessmentStore for this test")
+}
+
 func (m *MockAssessmentStore) Delete(ctx context.Context, id uuid.UUID) error {
 	panic("Delete() not implemented in MockAssessmentStore for this test")
 }
 
+func (m *MockAssessmentStore) ListSnapshots(ctx context.Context, assessmentID uuid.UUID) ([]model.Snapshot, error) {
+	panic("ListSnapshots() not implemented in MockAssessmentStore for this test")
+}
+
+func (m *MockAssessmentStore) GetSnapshot(ctx context.Context, assessmentID uuid.UUID, snapshotID uint) (*model.Snapshot, error) {
+	panic("GetSnapshot() not implemented in MockAssessmentStore for this test")
+}
+
 func (m *MockClusterSizingInputStore) Upsert(ctx context.Context, input model.AssessmentClusterSizingInput) (*model.AssessmentClusterSizingInput, error) {
 	key := fmt.Sprintf("%s/%s", input.AssessmentID, input.ExternalClusterID)
 	copied := input
@@ -1257,6 +1554,41 @@ var _ = Describe("sizer handler", func() {
 				Expect(errorResp.Message).To(ContainSubstring("Valid values are: 1:1, 1:2, 1:4, 1:6, 1:8"))
 			})
 
+			It("returns 400 when sizing mode is invalid", func() {
+				mode := api.SizingMode("perHost")
+				request := &api.ClusterRequirementsRequest{
+					ClusterId:             clusterID,
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					WorkerNodeCPU:         8,
+					WorkerNodeMemory:      16,
+					SizingMode:            &mode,
+				}
+
+				testServer = createTestSizerServer(nil, http.StatusOK, false)
+				sizerClient = client.NewSizerClient(testServer.URL, 5*time.Second)
+				handler = handlers.NewServiceHandler(
+					nil, // sourceService
+					service.NewAssessmentService(mockStore, nil, nil),
+					nil, // jobService
+					service.NewSizerService(sizerClient, mockStore),
+					nil, // estimationService
+					nil,
+					nil,
+					nil,
+				)
+
+				resp, err := handler.CalculateAssessmentClusterRequirements(ctx, server.CalculateAssessmentClusterRequirementsRequestObject{
+					Id:   assessmentID,
+					Body: request,
+				})
+
+				Expect(err).To(BeNil())
+				errorResp, ok := resp.(server.CalculateAssessmentClusterRequirements400JSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(errorResp.Message).To(ContainSubstring("invalid sizingMode: perHost"))
+			})
+
 			It("returns 400 when workerNodeThreads is less than workerNodeCPU", func() {
 				threads := 8
 				request := &api.ClusterRequirementsRequest{
@@ -1850,6 +2182,206 @@ var _ = Describe("sizer handler", func() {
 		})
 	})
 
+	Describe("CalculateAssessmentTopologySizing", func() {
+		BeforeEach(func() {
+			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, user.Username, user.Organization, clusterID)
+			handler = handlers.NewServiceHandler(
+				nil,
+				service.NewAssessmentService(mockStore, nil, nil),
+				nil,
+				service.NewSizerService(client.NewLocalSizer(), mockStore),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+		})
+
+		It("returns 200 with the sizing of every target cluster", func() {
+			resp, err := handler.CalculateAssessmentTopologySizing(ctx, server.CalculateAssessmentTopologySizingRequestObject{
+				Id: assessmentID,
+				Body: &api.TopologySizingRequest{
+					TargetClusters:        []api.TargetClusterMapping{{Name: "ocp-1", SourceClusterIds: []string{clusterID}}},
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					WorkerNodeCPU:         8,
+					WorkerNodeMemory:      16,
+				},
+			})
+
+			Expect(err).To(BeNil())
+			okResp, ok := resp.(server.CalculateAssessmentTopologySizing200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(okResp.TargetClusters).To(HaveLen(1))
+			Expect(okResp.TargetClusters[0].InventoryTotals.TotalVMs).To(Equal(10))
+			Expect(okResp.Totals.TotalNodes).To(Equal(okResp.TargetClusters[0].ClusterSizing.TotalNodes))
+		})
+
+		It("returns 400 without target clusters", func() {
+			resp, err := handler.CalculateAssessmentTopologySizing(ctx, server.CalculateAssessmentTopologySizingRequestObject{
+				Id: assessmentID,
+				Body: &api.TopologySizingRequest{
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					WorkerNodeCPU:         8,
+					WorkerNodeMemory:      16,
+				},
+			})
+
+			Expect(err).To(BeNil())
+			errorResp, ok := resp.(server.CalculateAssessmentTopologySizing400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(errorResp.Message).To(ContainSubstring("at least one target cluster"))
+		})
+
+		It("returns 404 for an unknown source cluster", func() {
+			resp, err := handler.CalculateAssessmentTopologySizing(ctx, server.CalculateAssessmentTopologySizingRequestObject{
+				Id: assessmentID,
+				Body: &api.TopologySizingRequest{
+					TargetClusters:        []api.TargetClusterMapping{{Name: "ocp-1", SourceClusterIds: []string{"unknown"}}},
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					WorkerNodeCPU:         8,
+					WorkerNodeMemory:      16,
+				},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.CalculateAssessmentTopologySizing404JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Describe("CalculateAssessmentHardwareOptions", func() {
+		BeforeEach(func() {
+			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, user.Username, user.Organization, clusterID)
+			catalog := service.NewHardwareCatalogService(mockStore).WithFileSKUs([]service.HardwareSKU{
+				{Name: "std-16c-64g", Cores: 16, MemoryGB: 64, Price: 8000, RackUnits: 1, PowerWatts: 450, Source: service.HardwareSKUSourceFile},
+			})
+			handler = handlers.NewServiceHandler(
+				nil,
+				service.NewAssessmentService(mockStore, nil, nil),
+				nil,
+				service.NewSizerService(client.NewLocalSizer(), mockStore),
+				nil,
+				nil,
+				nil,
+				nil,
+			).WithHardwareCatalog(catalog)
+		})
+
+		It("returns 200 with the options of the catalog", func() {
+			resp, err := handler.CalculateAssessmentHardwareOptions(ctx, server.CalculateAssessmentHardwareOptionsRequestObject{
+				Id: assessmentID,
+				Body: &api.HardwareOptionsRequest{
+					ClusterId:             clusterID,
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					WorkerNodeCPU:         util.IntPtr(8),
+					WorkerNodeMemory:      util.IntPtr(16),
+				},
+			})
+
+			Expect(err).To(BeNil())
+			okResp, ok := resp.(server.CalculateAssessmentHardwareOptions200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(okResp.Options).To(HaveLen(1))
+			Expect(okResp.Options[0].Sku.Name).To(Equal("std-16c-64g"))
+			Expect(okResp.Options[0].TotalCost).To(Equal(float64(okResp.Options[0].NodeCount) * 8000))
+		})
+
+		It("returns 400 without a baseline", func() {
+			resp, err := handler.CalculateAssessmentHardwareOptions(ctx, server.CalculateAssessmentHardwareOptionsRequestObject{
+				Id: assessmentID,
+				Body: &api.HardwareOptionsRequest{
+					ClusterId:             clusterID,
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+				},
+			})
+
+			Expect(err).To(BeNil())
+			errorResp, ok := resp.(server.CalculateAssessmentHardwareOptions400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(errorResp.Message).To(ContainSubstring("either baselineSku or workerNodeCPU and workerNodeMemory"))
+		})
+
+		It("returns 404 for an unknown baseline SKU", func() {
+			baseline := "unknown"
+			resp, err := handler.CalculateAssessmentHardwareOptions(ctx, server.CalculateAssessmentHardwareOptionsRequestObject{
+				Id: assessmentID,
+				Body: &api.HardwareOptionsRequest{
+					ClusterId:             clusterID,
+					CpuOverCommitRatio:    api.CpuOneToFour,
+					MemoryOverCommitRatio: api.MemoryOneToTwo,
+					BaselineSku:           &baseline,
+				},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.CalculateAssessmentHardwareOptions404JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("returns 400 when a SKU of the catalog file is deleted", func() {
+			resp, err := handler.DeleteHardwareSku(ctx, server.DeleteHardwareSkuRequestObject{Name: "std-16c-64g"})
+
+			Expect(err).To(BeNil())
+			errorResp, ok := resp.(server.DeleteHardwareSku400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(errorResp.Message).To(ContainSubstring("read-only"))
+		})
+	})
+
+	Describe("GetAssessmentRightSizing", func() {
+		BeforeEach(func() {
+			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, user.Username, user.Organization, clusterID)
+			handler = handlers.NewServiceHandler(
+				nil,
+				service.NewAssessmentService(mockStore, nil, nil),
+				nil,
+				service.NewSizerService(client.NewLocalSizer(), mockStore),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+		})
+
+		It("returns 400 for an invalid snapshot ID", func() {
+			resp, err := handler.GetAssessmentRightSizing(ctx, server.GetAssessmentRightSizingRequestObject{
+				Id:     assessmentID,
+				Params: api.GetAssessmentRightSizingParams{SnapshotId: util.IntPtr(0)},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.GetAssessmentRightSizing400JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("returns 404 for an unknown cluster", func() {
+			unknown := "unknown-cluster"
+			resp, err := handler.GetAssessmentRightSizing(ctx, server.GetAssessmentRightSizingRequestObject{
+				Id:     assessmentID,
+				Params: api.GetAssessmentRightSizingParams{ClusterId: &unknown},
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.GetAssessmentRightSizing404JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("returns 404 for an unknown assessment", func() {
+			resp, err := handler.GetAssessmentRightSizing(ctx, server.GetAssessmentRightSizingRequestObject{
+				Id: uuid.New(),
+			})
+
+			Expect(err).To(BeNil())
+			_, ok := resp.(server.GetAssessmentRightSizing404JSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
 	Describe("GetAssessmentClusterRequirementsStoredInput", func() {
 		BeforeEach(func() {
 			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, user.Username, user.Organization, clusterID)
@@ -0,0 +1,138 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (GET /api/v1/assessments/{id}/snapshots)
+func (h *ServiceHandler) ListAssessmentSnapshots(ctx context.Context, request server.ListAssessmentSnapshotsRequestObject) (server.ListAssessmentSnapshotsResponseObject, error) {
+	logger := log.NewDebugLogger("snapshot_handler").
+		WithContext(ctx).
+		Operation("list_assessment_snapshots").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	snapshots, err := h.assessmentSrv.ListSnapshots(ctx, request.Id)
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.ListAssessmentSnapshots404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			logger.Error(err).Log()
+			return server.ListAssessmentSnapshots403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.ListAssessmentSnapshots500JSONResponse{Message: fmt.Sprintf("failed to list snapshots: %v", err)}, nil
+		}
+	}
+
+	apiSnapshots, err := mappers.SnapshotListToApi(snapshots)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.ListAssessmentSnapshots500JSONResponse{Message: fmt.Sprintf("failed to list snapshots: %v", err)}, nil
+	}
+	for i := range apiSnapshots {
+		h.attachRemediations(&apiSnapshots[i].Inventory)
+	}
+
+	logger.Success().WithInt("count", len(apiSnapshots)).Log()
+
+	return server.ListAssessmentSnapshots200JSONResponse(apiSnapshots), nil
+}
+
+// (GET /api/v1/assessments/{id}/snapshots/{snapshotId})
+func (h *ServiceHandler) GetAssessmentSnapshot(ctx context.Context, request server.GetAssessmentSnapshotRequestObject) (server.GetAssessmentSnapshotResponseObject, error) {
+	logger := log.NewDebugLogger("snapshot_handler").
+		WithContext(ctx).
+		Operation("get_assessment_snapshot").
+		WithUUID("assessment_id", request.Id).
+		WithInt("snapshot_id", request.SnapshotId).
+		Build()
+
+	if request.SnapshotId <= 0 {
+		logger.Error(fmt.Errorf("invalid snapshot id: %d", request.SnapshotId)).Log()
+		return server.GetAssessmentSnapshot400JSONResponse{Message: fmt.Sprintf("invalid snapshot id: %d", request.SnapshotId)}, nil
+	}
+
+	snapshot, err := h.assessmentSrv.GetSnapshot(ctx, request.Id, uint(request.SnapshotId))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.GetAssessmentSnapshot404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			logger.Error(err).Log()
+			return server.GetAssessmentSnapshot403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.GetAssessmentSnapshot500JSONResponse{Message: fmt.Sprintf("failed to get snapshot: %v", err)}, nil
+		}
+	}
+
+	apiSnapshot, err := mappers.SnapshotToApi(*snapshot)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.GetAssessmentSnapshot500JSONResponse{Message: fmt.Sprintf("failed to get snapshot: %v", err)}, nil
+	}
+	h.attachRemediations(&apiSnapshot.Inventory)
+
+	logger.Success().Log()
+
+	return server.GetAssessmentSnapshot200JSONResponse(apiSnapshot), nil
+}
+
+// (GET /api/v1/assessments/{id}/snapshots/diff)
+func (h *ServiceHandler) DiffAssessmentSnapshots(ctx context.Context, request server.DiffAssessmentSnapshotsRequestObject) (server.DiffAssessmentSnapshotsResponseObject, error) {
+	logger := log.NewDebugLogger("snapshot_handler").
+		WithContext(ctx).
+		Operation("diff_assessment_snapshots").
+		WithUUID("assessment_id", request.Id).
+		WithInt("from", request.Params.From).
+		WithInt("to", request.Params.To).
+		Build()
+
+	if request.Params.From <= 0 || request.Params.To <= 0 {
+		err := fmt.Errorf("invalid snapshot ids: from=%d to=%d", request.Params.From, request.Params.To)
+		logger.Error(err).Log()
+		return server.DiffAssessmentSnapshots400JSONResponse{Message: err.Error()}, nil
+	}
+
+	diff, err := h.assessmentSrv.DiffSnapshots(ctx, request.Id, uint(request.Params.From), uint(request.Params.To))
+	if err != nil {
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			logger.Error(err).Log()
+			return server.DiffAssessmentSnapshots404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			logger.Error(err).Log()
+			return server.DiffAssessmentSnapshots403JSONResponse{Message: err.Error()}, nil
+		default:
+			logger.Error(err).Log()
+			return server.DiffAssessmentSnapshots500JSONResponse{Message: fmt.Sprintf("failed to diff snapshots: %v", err)}, nil
+		}
+	}
+
+	logger.Success().WithInt("cluster_count", len(diff.Clusters)).Log()
+
+	return server.DiffAssessmentSnapshots200JSONResponse(mappers.SnapshotDiffToAPI(*diff)), nil
+}
+
+// snapshotIDFromRequest validates the optional snapshotId of a request body.
+// A nil result means the latest snapshot should be used.
+func snapshotIDFromRequest(snapshotID *int) (*uint, error) {
+	if snapshotID == nil {
+		return nil, nil
+	}
+	if *snapshotID <= 0 {
+		return nil, fmt.Errorf("invalid snapshotId: %d", *snapshotID)
+	}
+	id := uint(*snapshotID)
+	return &id, nil
+}
@@ -200,6 +200,7 @@ func (s *ServiceHandler) UpdateInventory(ctx context.Context, request server.Upd
 		SourceID:  request.Id,
 		Inventory: data,
 		VCenterID: request.Body.Inventory.VcenterId,
+		VMs:       mappers.SourceVMsFromApi(request.Body.Vms),
 	})
 	if err != nil {
 		switch err.(type) {
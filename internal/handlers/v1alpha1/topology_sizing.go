@@ -0,0 +1,119 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (POST /api/v1/assessments/{id}/topology-sizing)
+func (h *ServiceHandler) CalculateAssessmentTopologySizing(ctx context.Context, request server.CalculateAssessmentTopologySizingRequestObject) (server.CalculateAssessmentTopologySizingResponseObject, error) {
+	logger := log.NewDebugLogger("sizer_handler").
+		WithContext(ctx).
+		Operation("calculate_assessment_topology_sizing").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.CalculateAssessmentTopologySizing400JSONResponse{Message: "empty body"}, nil
+	}
+
+	assessmentID := request.Id
+
+	if err := validateTopologySizingRequest(request.Body); err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentTopologySizing400JSONResponse{Message: err.Error()}, nil
+	}
+
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentTopologySizing400JSONResponse{Message: err.Error()}, nil
+	}
+
+	if _, err := h.assessmentSrv.GetAssessment(ctx, assessmentID); err != nil {
+		logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.CalculateAssessmentTopologySizing404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.CalculateAssessmentTopologySizing403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CalculateAssessmentTopologySizing500JSONResponse{Message: fmt.Sprintf("failed to get assessment: %v", err)}, nil
+		}
+	}
+
+	if err := h.sizerSrv.Health(ctx); err != nil {
+		logger.Error(err).Log()
+		return server.CalculateAssessmentTopologySizing503JSONResponse{Message: fmt.Sprintf("sizer service unavailable: %v", err)}, nil
+	}
+
+	form := mappers.TopologySizingRequestToForm(*request.Body)
+	form.Sizing.SnapshotID = snapshotID
+
+	res, err := h.sizerSrv.CalculateTopologySizing(ctx, assessmentID, &form)
+	if err != nil {
+		logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.CalculateAssessmentTopologySizing404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidClusterInventory, *service.ErrInvalidRequest:
+			return server.CalculateAssessmentTopologySizing400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.CalculateAssessmentTopologySizing500JSONResponse{Message: fmt.Sprintf("failed to calculate topology sizing: %v", err)}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithString("username", user.Username).
+		WithInt("total_nodes", res.Totals.TotalNodes).
+		Log()
+
+	return server.CalculateAssessmentTopologySizing200JSONResponse(*res), nil
+}
+
+// validateTopologySizingRequest applies the node configuration checks of the cluster requirements
+// request; the API middleware enforces the schema in production, but tests bypass it.
+func validateTopologySizingRequest(body *api.TopologySizingRequest) error {
+	if len(body.TargetClusters) == 0 {
+		return fmt.Errorf("at least one target cluster is required")
+	}
+	if body.WorkerNodeCPU <= 0 || body.WorkerNodeMemory <= 0 {
+		return fmt.Errorf("worker node size must be greater than zero: CPU=%d, Memory=%d", body.WorkerNodeCPU, body.WorkerNodeMemory)
+	}
+	if err := validateOverCommitRatios(body.CpuOverCommitRatio, body.MemoryOverCommitRatio); err != nil {
+		return err
+	}
+	if err := validateNoControlPlaneFieldsWhenHosted(
+		body.HostedControlPlane,
+		body.ControlPlaneNodeCount != nil,
+		body.ControlPlaneCPU != nil,
+		body.ControlPlaneMemory != nil,
+		body.ControlPlaneSchedulable != nil,
+	); err != nil {
+		return err
+	}
+	var cpNodeCount *int
+	if body.ControlPlaneNodeCount != nil {
+		count := int(*body.ControlPlaneNodeCount)
+		if count != 1 && count != 3 {
+			return fmt.Errorf("invalid controlPlaneNodeCount: %d", count)
+		}
+		cpNodeCount = &count
+	}
+	if err := validateCompactMode(body.CompactMode, body.HostedControlPlane, cpNodeCount, body.ControlPlaneSchedulable); err != nil {
+		return err
+	}
+	return validateWorkerNodeThreads(body.WorkerNodeThreads, body.WorkerNodeCPU)
+}
@@ -0,0 +1,136 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/api/server"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/handlers/v1alpha1/mappers"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/log"
+)
+
+// (POST /api/v1/assessments/{id}/waves)
+func (h *ServiceHandler) PlanMigrationWaves(ctx context.Context, request server.PlanMigrationWavesRequestObject) (server.PlanMigrationWavesResponseObject, error) {
+	logger := log.NewDebugLogger("estimation_handler").
+		WithContext(ctx).
+		Operation("plan_migration_waves").
+		WithUUID("assessment_id", request.Id).
+		Build()
+
+	user := auth.MustHaveUser(ctx)
+	logger.Step("extract_user").WithString("org_id", user.Organization).WithString("username", user.Username).Log()
+
+	if request.Body == nil {
+		logger.Error(fmt.Errorf("empty request body")).Log()
+		return server.PlanMigrationWaves400JSONResponse{Message: "empty body"}, nil
+	}
+
+	assessmentID := request.Id
+	clusterID := request.Body.ClusterId
+
+	if clusterID == "" {
+		logger.Error(fmt.Errorf("clusterId is required")).Log()
+		return server.PlanMigrationWaves400JSONResponse{Message: "clusterId is required"}, nil
+	}
+
+	snapshotID, err := snapshotIDFromRequest(request.Body.SnapshotId)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.PlanMigrationWaves400JSONResponse{Message: err.Error()}, nil
+	}
+
+	constraints, err := waveConstraintsFromRequest(request.Body)
+	if err != nil {
+		logger.Error(err).Log()
+		return server.PlanMigrationWaves400JSONResponse{Message: err.Error()}, nil
+	}
+
+	var schema engines.Schema
+	if request.Body.EstimationSchema != nil {
+		schema = engines.Schema(*request.Body.EstimationSchema)
+	}
+
+	var userParams []estimation.Param
+	if request.Body.Params != nil {
+		for k, v := range *request.Body.Params {
+			userParams = append(userParams, estimation.Param{Key: k, Value: v})
+		}
+	}
+
+	if err := h.estimationSrv.ValidateParams(userParams); err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrInvalidEstimationParam:
+			return server.PlanMigrationWaves400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.PlanMigrationWaves500JSONResponse{Message: "failed to validate params"}, nil
+		}
+	}
+
+	if _, err := h.assessmentSrv.GetAssessment(ctx, assessmentID); err != nil {
+		logger.Error(err).WithUUID("assessment_id", assessmentID).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.PlanMigrationWaves404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrForbidden:
+			return server.PlanMigrationWaves403JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.PlanMigrationWaves500JSONResponse{Message: "failed to get assessment"}, nil
+		}
+	}
+
+	plan, err := h.estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, snapshotID, schema, userParams, constraints)
+	if err != nil {
+		logger.Error(err).Log()
+		switch err.(type) {
+		case *service.ErrResourceNotFound:
+			return server.PlanMigrationWaves404JSONResponse{Message: err.Error()}, nil
+		case *service.ErrInvalidSchema, *service.ErrInvalidRequest, *service.ErrInvalidClusterInventory:
+			return server.PlanMigrationWaves400JSONResponse{Message: err.Error()}, nil
+		default:
+			return server.PlanMigrationWaves500JSONResponse{Message: "failed to plan migration waves"}, nil
+		}
+	}
+
+	logger.Success().
+		WithString("org_id", user.Organization).
+		WithString("username", user.Username).
+		WithInt("wave_count", len(plan.Waves)).
+		Log()
+
+	estimationCtx := &service.EstimationContext{
+		Schemas:    []engines.Schema{plan.Schema},
+		BaseParams: h.estimationSrv.BuildBaseParams(userParams),
+	}
+	return server.PlanMigrationWaves200JSONResponse(mappers.WavePlanToAPI(plan, estimationCtx)), nil
+}
+
+// waveConstraintsFromRequest converts the optional wave bounds of the request; absent bounds are unbounded.
+func waveConstraintsFromRequest(body *api.MigrationWavePlanRequest) (service.WaveConstraints, error) {
+	var constraints service.WaveConstraints
+	if body.MaxWaveDurationHours != nil {
+		if *body.MaxWaveDurationHours <= 0 {
+			return constraints, fmt.Errorf("maxWaveDurationHours must be positive")
+		}
+		constraints.MaxWaveDuration = time.Duration(*body.MaxWaveDurationHours * float64(time.Hour))
+	}
+	if body.MaxVmsPerWave != nil {
+		if *body.MaxVmsPerWave <= 0 {
+			return constraints, fmt.Errorf("maxVmsPerWave must be positive")
+		}
+		constraints.MaxVMsPerWave = *body.MaxVmsPerWave
+	}
+	if body.MaxDiskGbPerWave != nil {
+		if *body.MaxDiskGbPerWave <= 0 {
+			return constraints, fmt.Errorf("maxDiskGbPerWave must be positive")
+		}
+		constraints.MaxDiskGBPerWave = *body.MaxDiskGbPerWave
+	}
+	return constraints, nil
+}
@@ -52,10 +52,21 @@ func ValidateName(name string) error {
 }
 
 // ValidateXLSXMagicBytes checks if the file starts with ZIP magic bytes.
-// XLSX files are ZIP archives, so this is a fast preliminary check.
+// RVTools uploads are either XLSX files or zip archives of per-tab CSV files,
+// both of which are ZIP archives, so this is a fast preliminary check.
 func ValidateXLSXMagicBytes(data []byte) error {
 	if len(data) < 4 || !bytes.Equal(data[:4], xlsxMagicBytes) {
-		return NewErrInvalidFile("file is not a valid Excel file")
+		return NewErrInvalidFile("file is not a valid Excel file or zip of CSV files")
+	}
+	return nil
+}
+
+// ValidateJSONObjectStart checks if the first non-whitespace byte opens a JSON object.
+// govc JSON exports are single JSON documents, so this is a fast preliminary check.
+func ValidateJSONObjectStart(data []byte) error {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return NewErrInvalidFile("file is not a valid JSON document")
 	}
 	return nil
 }
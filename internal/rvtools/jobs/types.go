@@ -1,16 +1,40 @@
 package jobs
 
 import (
+	"github.com/google/uuid"
 	"github.com/riverqueue/river"
 )
 
+// Formats accepted for the uploaded inventory file.
+const (
+	FileFormatRVTools  = "rvtools"
+	FileFormatGovcJSON = "govc-json"
+)
+
+// RVToolsJobFile is an uploaded inventory file, typically the export of one vCenter.
+type RVToolsJobFile struct {
+	Path   string `json:"path"`
+	Format string `json:"format,omitempty"` // empty means FileFormatRVTools
+}
+
 type RVToolsJobArgs struct {
-	Name      string `json:"name"`
-	FilePath  string `json:"file_path"`
-	OrgID     string `json:"org_id"`
-	Username  string `json:"username"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
+	Name         string           `json:"name"`
+	FilePath     string           `json:"file_path"`
+	FileFormat   string           `json:"file_format,omitempty"` // empty means FileFormatRVTools
+	OrgID        string           `json:"org_id"`
+	Username     string           `json:"username"`
+	FirstName    string           `json:"first_name"`
+	LastName     string           `json:"last_name"`
+	Files        []RVToolsJobFile `json:"files,omitempty"`         // set when several files are merged; includes FilePath
+	AssessmentID *uuid.UUID       `json:"assessment_id,omitempty"` // set to add a snapshot to the assessment instead of creating one
+}
+
+// InputFiles returns the files to ingest into the assessment.
+func (a RVToolsJobArgs) InputFiles() []RVToolsJobFile {
+	if len(a.Files) > 0 {
+		return a.Files
+	}
+	return []RVToolsJobFile{{Path: a.FilePath, Format: a.FileFormat}}
 }
 
 func (RVToolsJobArgs) Kind() string {
@@ -16,28 +16,31 @@ import (
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/events/kafka"
 	"github.com/kubev2v/migration-planner/pkg/inventory/converters"
 	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/opa"
 	pkgstore "github.com/kubev2v/migration-planner/pkg/store"
 )
 
 type RVToolsWorker struct {
 	river.WorkerDefaults[RVToolsJobArgs]
 	store     store.Store
-	validator duckdb_parser.Validator
+	validator *opa.Validator
 }
 
-func NewRVToolsWorker(store store.Store, validator duckdb_parser.Validator) *RVToolsWorker {
+func NewRVToolsWorker(store store.Store, validator *opa.Validator) *RVToolsWorker {
 	return &RVToolsWorker{
 		store:     store,
 		validator: validator,
 	}
 }
 
-// createParser creates a new per-job DuckDB instance and parser.
+// createParser creates a new per-job DuckDB instance and parser validating the VMs with validator.
 // The caller is responsible for closing the returned *sql.DB when done.
-func (w *RVToolsWorker) createParser() (*duckdb_parser.Parser, *sql.DB, error) {
+func (w *RVToolsWorker) createParser(validator duckdb_parser.Validator) (*duckdb_parser.Parser, *sql.DB, error) {
 	db, err := sql.Open("duckdb", "")
 	if err != nil {
 		return nil, nil, fmt.Errorf("opening duckdb: %w", err)
@@ -48,7 +51,7 @@ func (w *RVToolsWorker) createParser() (*duckdb_parser.Parser, *sql.DB, error) {
 		return nil, nil, fmt.Errorf("setting duckdb extension directory: %w", err)
 	}
 	qi := pkgstore.NewQueryInterceptor(db)
-	parser := duckdb_parser.New(qi, w.validator)
+	parser := duckdb_parser.New(qi, validator)
 	if err := parser.Init(); err != nil {
 		_ = db.Close()
 		return nil, nil, fmt.Errorf("initializing duckdb schema: %w", err)
@@ -76,40 +79,104 @@ func (w *RVToolsWorker) Work(ctx context.Context, job *river.Job[RVToolsJobArgs]
 		Operation("process_rvtools_job").
 		WithParam("job_id", job.ID).
 		WithString("assessment_name", job.Args.Name).
+		WithString("file_format", job.Args.FileFormat).
+		WithInt("file_count", len(job.Args.InputFiles())).
 		Build()
 
 	logger.Step("job_started").Log()
 
-	filePath := job.Args.FilePath
-	defer func() { _ = os.Remove(filePath) }()
+	files := job.Args.InputFiles()
+	defer func() {
+		for _, f := range files {
+			_ = os.Remove(f.Path)
+		}
+	}()
+
+	// A snapshot added to an assessment is validated with the policies of the organization of the
+	// assessment; the user uploading it may be of another organization the assessment is shared with
+	var assessment *model.Assessment
+	orgID := job.Args.OrgID
+	if job.Args.AssessmentID != nil {
+		a, err := w.store.Assessment().Get(ctx, *job.Args.AssessmentID)
+		if err != nil {
+			return w.failJob(ctx, logger, job.ID, "get_assessment", err, fmt.Sprintf("failed to get assessment %s: %v", *job.Args.AssessmentID, err))
+		}
+		assessment, orgID = a, a.OrgID
+	}
+
+	// Validate the VMs with the global policies plus the active policies of the organization
+	validator, policyRevision, err := w.policyValidator(ctx, orgID)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "get_policy_bundle", err, fmt.Sprintf("failed to load organization policies: %v", err))
+	}
+	logger.Step("policy_bundle").WithInt("revision", policyRevision).Log()
 
 	// Create per-job DuckDB instance for isolation
-	parser, duckDB, err := w.createParser()
+	parser, duckDB, err := w.createParser(validator)
 	if err != nil {
 		return w.failJob(ctx, logger, job.ID, "create_parser", err, fmt.Sprintf("failed to create DuckDB parser: %v", err))
 	}
 	defer func() { _ = duckDB.Close() }()
 
+	// Score the VMs with the complexity tables of the organization, or those of the assessment
+	table, err := w.complexityTable(ctx, orgID, assessment)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "get_complexity_table", err, fmt.Sprintf("failed to get complexity tables: %v", err))
+	}
+	parser.WithComplexityTable(table)
+	logger.Step("complexity_table").WithString("version", table.Version).Log()
+
 	// Update status to validating before ingestion (which includes OPA validation)
 	if err := w.updateJobStatus(ctx, job.ID, model.JobStatusValidating, "", nil); err != nil {
 		logger.Error(err).WithString("step", "update_validating_status").Log()
 	}
 
-	// Ingest RVTools file using duckdb_parser
-	validationResult, err := parser.IngestRvTools(ctx, filePath)
-	if err != nil {
-		return w.failJob(ctx, logger, job.ID, "ingest_rvtools", err, fmt.Sprintf("error ingesting RVTools file: %v", err))
-	}
+	// Each file is ingested and validated on its own, then staged until all files are merged
+	merge := len(files) > 1
+	for i, file := range files {
+		var filePrefix string
+		if merge {
+			filePrefix = fmt.Sprintf("file %d: ", i+1)
+		}
+
+		// Ingest the uploaded file using duckdb_parser
+		var validationResult duckdb_parser.ValidationResult
+		switch file.Format {
+		case FileFormatGovcJSON:
+			validationResult, err = parser.IngestGovcJSON(ctx, file.Path)
+			if err != nil {
+				return w.failJob(ctx, logger, job.ID, "ingest_govc_json", err, fmt.Sprintf("%serror ingesting govc JSON file: %v", filePrefix, err))
+			}
+		default:
+			validationResult, err = parser.IngestRvTools(ctx, file.Path)
+			if err != nil {
+				return w.failJob(ctx, logger, job.ID, "ingest_rvtools", err, fmt.Sprintf("%serror ingesting RVTools file: %v", filePrefix, err))
+			}
+		}
+
+		// Check for validation errors
+		if validationResult.HasErrors() {
+			validationErr := fmt.Errorf("validation failed: %v", validationResult.Errors)
+			return w.failJob(ctx, logger, job.ID, "validate_rvtools", validationErr, fmt.Sprintf("%sRVTools validation failed: %v", filePrefix, validationResult.Errors[0].Message))
+		}
+
+		// Log any warnings
+		for _, warning := range validationResult.Warnings {
+			logger.Step("validation_warning").WithInt("file", i+1).WithString("code", warning.Code).WithString("message", warning.Message).Log()
+		}
 
-	// Check for validation errors
-	if validationResult.HasErrors() {
-		validationErr := fmt.Errorf("validation failed: %v", validationResult.Errors)
-		return w.failJob(ctx, logger, job.ID, "validate_rvtools", validationErr, fmt.Sprintf("RVTools validation failed: %v", validationResult.Errors[0].Message))
+		if merge {
+			if err := parser.StageSource(ctx); err != nil {
+				return w.failJob(ctx, logger, job.ID, "stage_source", err, fmt.Sprintf("%serror merging file: %v", filePrefix, err))
+			}
+		}
 	}
 
-	// Log any warnings
-	for _, warning := range validationResult.Warnings {
-		logger.Step("validation_warning").WithString("code", warning.Code).WithString("message", warning.Message).Log()
+	if merge {
+		logger.Step("merging_files").WithInt("file_count", len(files)).Log()
+		if err := parser.MergeStagedSources(ctx); err != nil {
+			return w.failJob(ctx, logger, job.ID, "merge_sources", err, fmt.Sprintf("error merging files: %v", err))
+		}
 	}
 
 	// Update status to parsing
@@ -125,6 +192,17 @@ func (w *RVToolsWorker) Work(ctx context.Context, job *river.Job[RVToolsJobArgs]
 	}
 	inventory := converters.ToAPI(inv)
 
+	// Load per-VM data so it outlives the per-job DuckDB instance
+	logger.Step("loading_vms").Log()
+	vms, err := parser.VMs(ctx, duckdb_parser.Filters{}, duckdb_parser.Options{})
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "load_vms", err, fmt.Sprintf("error loading VMs: %v", err))
+	}
+	clusterIDs, err := parser.ClusterIDs(ctx)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "load_cluster_ids", err, fmt.Sprintf("error loading cluster IDs: %v", err))
+	}
+
 	// Marshal inventory to JSON
 	inventoryJSON, err := json.Marshal(inventory)
 	if err != nil {
@@ -137,38 +215,61 @@ func (w *RVToolsWorker) Work(ctx context.Context, job *river.Job[RVToolsJobArgs]
 		return err
 	}
 
+	if assessment != nil {
+		return w.addSnapshot(ctx, logger, job.ID, assessment.ID, inventoryJSON, vms, clusterIDs)
+	}
+
 	logger.Step("creating_assessment").Log()
 
 	// Build assessment model
-	assessment := model.Assessment{
+	newAssessment := model.Assessment{
 		ID:         uuid.New(),
 		Name:       job.Args.Name,
 		OrgID:      job.Args.OrgID,
 		Username:   job.Args.Username,
 		SourceType: "rvtools",
+
+		ComplexityTableVersion: table.Version,
 	}
 	if job.Args.FirstName != "" {
-		assessment.OwnerFirstName = &job.Args.FirstName
+		newAssessment.OwnerFirstName = &job.Args.FirstName
 	}
 	if job.Args.LastName != "" {
-		assessment.OwnerLastName = &job.Args.LastName
+		newAssessment.OwnerLastName = &job.Args.LastName
+	}
+
+	// The assessment and its VM records are written together
+	txCtx, err := w.store.NewTransactionContext(ctx)
+	if err != nil {
+		return w.failJob(ctx, logger, job.ID, "begin_transaction", err, fmt.Sprintf("failed to create assessment: %v", err))
 	}
 
 	// RVTools assessments don't have subset inventories
-	createdAssessment, err := w.store.Assessment().Create(ctx, assessment, inventoryJSON, nil)
+	createdAssessment, err := w.store.Assessment().Create(txCtx, newAssessment, inventoryJSON, nil)
 	if err != nil {
+		_, _ = store.Rollback(txCtx)
 		var errMsg string
 		if errors.Is(err, store.ErrDuplicateKey) {
-			errMsg = fmt.Sprintf("assessment with name '%s' already exists", assessment.Name)
+			errMsg = fmt.Sprintf("assessment with name '%s' already exists", newAssessment.Name)
 		} else {
 			errMsg = fmt.Sprintf("failed to create assessment: %v", err)
 		}
 		return w.failJob(ctx, logger, job.ID, "create_assessment", err, errMsg)
 	}
+
+	assessmentVMs := toAssessmentVMs(createdAssessment.ID, createdAssessment.Snapshots[0].ID, vms, clusterIDs)
+	if err := w.store.AssessmentVM().CreateBatch(txCtx, assessmentVMs); err != nil {
+		_, _ = store.Rollback(txCtx)
+		return w.failJob(ctx, logger, job.ID, "create_assessment_vms", err, fmt.Sprintf("failed to store assessment VMs: %v", err))
+	}
+
+	if _, err := store.Commit(txCtx); err != nil {
+		return w.failJob(ctx, logger, job.ID, "commit_assessment", err, fmt.Sprintf("failed to create assessment: %v", err))
+	}
 	w.store.RequestMetricsCacheRefresh()
 
 	updates := store.NewRelationshipBuilder().
-		With(model.NewAssessmentResource(assessment.ID.String()), model.OwnerRelation, model.NewUserSubject(job.Args.Username)).
+		With(model.NewAssessmentResource(newAssessment.ID.String()), model.OwnerRelation, model.NewUserSubject(job.Args.Username)).
 		Build()
 
 	if err := w.store.Authz().WriteRelationships(ctx, updates); err != nil {
@@ -202,11 +303,141 @@ func (w *RVToolsWorker) Work(ctx context.Context, job *river.Job[RVToolsJobArgs]
 	logger.Success().
 		WithUUID("assessment_id", createdAssessment.ID).
 		WithString("assessment_name", createdAssessment.Name).
+		WithInt("vm_count", len(assessmentVMs)).
 		Log()
 
 	return nil
 }
 
+// addSnapshot adds the inventory and the VM records of the uploaded files to the assessment as a new snapshot.
+func (w *RVToolsWorker) addSnapshot(ctx context.Context, logger *log.OperationTracer, jobID int64, assessmentID uuid.UUID, inventoryJSON []byte, vms []models.VM, clusterIDs map[string]string) error {
+	logger.Step("adding_snapshot").WithUUID("assessment_id", assessmentID).Log()
+
+	// The snapshot and its VM records are written together
+	txCtx, err := w.store.NewTransactionContext(ctx)
+	if err != nil {
+		return w.failJob(ctx, logger, jobID, "begin_transaction", err, fmt.Sprintf("failed to update assessment: %v", err))
+	}
+
+	updatedAssessment, err := w.store.Assessment().Update(txCtx, assessmentID, nil, inventoryJSON)
+	if err != nil {
+		_, _ = store.Rollback(txCtx)
+		return w.failJob(ctx, logger, jobID, "update_assessment", err, fmt.Sprintf("failed to update assessment: %v", err))
+	}
+
+	assessmentVMs := toAssessmentVMs(assessmentID, updatedAssessment.Snapshots[0].ID, vms, clusterIDs)
+	if err := w.store.AssessmentVM().CreateBatch(txCtx, assessmentVMs); err != nil {
+		_, _ = store.Rollback(txCtx)
+		return w.failJob(ctx, logger, jobID, "create_assessment_vms", err, fmt.Sprintf("failed to store assessment VMs: %v", err))
+	}
+
+	if _, err := store.Commit(txCtx); err != nil {
+		return w.failJob(ctx, logger, jobID, "commit_snapshot", err, fmt.Sprintf("failed to update assessment: %v", err))
+	}
+	w.store.RequestMetricsCacheRefresh()
+
+	if err := w.updateJobStatus(ctx, jobID, model.JobStatusCompleted, "", &assessmentID); err != nil {
+		logger.Error(err).WithString("step", "update_completed_status").Log()
+	}
+
+	logger.Success().
+		WithUUID("assessment_id", assessmentID).
+		WithParam("snapshot_id", updatedAssessment.Snapshots[0].ID).
+		WithInt("vm_count", len(assessmentVMs)).
+		Log()
+
+	return nil
+}
+
+// complexityTable returns the active complexity tables of the organization, or the built-in tables.
+// A snapshot added to an assessment is scored with the tables of the assessment, like its other
+// snapshots.
+func (w *RVToolsWorker) complexityTable(ctx context.Context, orgID string, assessment *model.Assessment) (complexity.Table, error) {
+	if assessment != nil {
+		if assessment.ComplexityTableVersion == "" || assessment.ComplexityTableVersion == complexity.DefaultTableVersion {
+			return complexity.DefaultTable(), nil
+		}
+		stored, err := w.store.ComplexityTable().Get(ctx, orgID, assessment.ComplexityTableVersion)
+		if err != nil {
+			return complexity.Table{}, err
+		}
+		return stored.Tables.Data, nil
+	}
+	active, err := w.store.ComplexityTable().GetActive(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return complexity.DefaultTable(), nil
+		}
+		return complexity.Table{}, err
+	}
+	return active.Tables.Data, nil
+}
+
+// policyValidator returns the validator of the global policies plus the active policies of the
+// organization, and the revision of the latter (0 when only the global policies apply). It fails
+// if the active policies no longer compile with the global ones, e.g. after the global policies
+// were reloaded, rather than evaluating the VMs without the policies of the organization.
+func (w *RVToolsWorker) policyValidator(ctx context.Context, orgID string) (duckdb_parser.Validator, int, error) {
+	if w.validator == nil {
+		return nil, 0, nil
+	}
+	active, err := w.store.PolicyBundle().GetActive(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			// Evaluate all the VMs with the same policies, even if they are reloaded meanwhile
+			return w.validator.Snapshot(), 0, nil
+		}
+		return nil, 0, err
+	}
+	validator, err := w.validator.WithPolicies(active.Policies())
+	if err != nil {
+		return nil, 0, fmt.Errorf("active policy bundle revision %d does not compile with the global policies revision %s, activate a compatible revision: %w",
+			active.Revision, w.validator.Revision(), err)
+	}
+	return validator, active.Revision, nil
+}
+
+// toAssessmentVMs converts the parsed VMs into the per-VM records of the snapshot.
+// clusterIDs maps cluster names to the keys of the inventory clusters.
+func toAssessmentVMs(assessmentID uuid.UUID, snapshotID uint, vms []models.VM, clusterIDs map[string]string) []model.AssessmentVM {
+	result := make([]model.AssessmentVM, 0, len(vms))
+	for _, vm := range vms {
+		concerns := make([]model.VMConcern, 0, len(vm.Concerns))
+		for _, c := range vm.Concerns {
+			concerns = append(concerns, model.VMConcern{
+				ID:         c.Id,
+				Label:      c.Label,
+				Category:   c.Category,
+				Assessment: c.Assessment,
+			})
+		}
+
+		result = append(result, model.AssessmentVM{
+			SnapshotID:        snapshotID,
+			VMID:              vm.ID,
+			AssessmentID:      assessmentID,
+			Name:              vm.Name,
+			ClusterID:         clusterIDs[vm.Cluster],
+			ClusterName:       vm.Cluster,
+			VCenterID:         vm.VCenterID,
+			Datacenter:        vm.Datacenter,
+			Host:              vm.Host,
+			OS:                vm.EffectiveGuestName(),
+			PowerState:        vm.PowerState,
+			CpuCount:          int(vm.CpuCount),
+			MemoryMB:          int(vm.MemoryMB),
+			DiskGB:            int(vm.TotalDiskCapacityMiB / 1024),
+			IsTemplate:        vm.IsTemplate,
+			MigrationExcluded: vm.MigrationExcluded,
+			Concerns:          *model.MakeJSONField(concerns),
+
+			CpuUsagePercent:    vm.CpuUsagePercent(),
+			MemoryUsagePercent: vm.MemoryUsagePercent(),
+		})
+	}
+	return result
+}
+
 // updateJobStatus updates the job's metadata with the current status using job store.
 func (w *RVToolsWorker) updateJobStatus(ctx context.Context, jobID int64, status, errorMsg string, assessmentID *uuid.UUID) error {
 	metadata := model.RVToolsJobMetadata{
@@ -245,8 +245,63 @@ func (s *AccountsService) UpdateGroup(ctx context.Context, group model.Group) (m
 	return result, nil
 }
 
+// DeleteGroup deletes a group with its members and partner requests. The removal of each member
+// and the termination of each active partner request are recorded in the audit log, and the
+// assessments the customers shared with the group are unshared.
 func (s *AccountsService) DeleteGroup(ctx context.Context, id uuid.UUID) error {
-	return s.store.Accounts().DeleteGroup(ctx, id)
+	ctx, err := s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	members, err := s.store.Accounts().ListMembers(ctx, store.NewMemberQueryFilter().ByGroupID(id))
+	if err != nil {
+		return err
+	}
+	updates := store.NewRelationshipBuilder()
+	for _, member := range members {
+		updates.Without(model.NewOrgResource(id.String()), model.MemberRelation, model.NewUserSubject(member.Username))
+		if err := recordAudit(ctx, s.store, model.AuditEntry{
+			Action:       model.AuditGroupMemberRemoved,
+			ResourceType: model.AuditGroupResource,
+			ResourceID:   id.String(),
+			Subject:      model.NewUserSubject(member.Username).String(),
+			Before:       auditState(model.AuditState{"email": member.Email}),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := s.store.Authz().WriteRelationships(ctx, updates.Build()); err != nil {
+		return fmt.Errorf("failed to remove member authz relations: %w", err)
+	}
+
+	requests, err := s.store.PartnerCustomer().List(ctx, store.NewPartnerQueryFilter().ByPartnerID(id.String()).ByActiveStatus())
+	if err != nil {
+		return err
+	}
+	for _, pc := range requests {
+		action := model.AuditPartnerRequestCancelled
+		if pc.RequestStatus == model.RequestStatusAccepted {
+			action = model.AuditPartnerCustomerRemoved
+			if err := revokeSharedAssessments(ctx, s.store, "", pc.Username, pc.PartnerID); err != nil {
+				return err
+			}
+		}
+		if err := auditPartnerRequest(ctx, s.store, "", action, pc,
+			model.AuditState{"status": model.RequestStatusCancelled}); err != nil {
+			return err
+		}
+	}
+
+	if err := s.store.Accounts().DeleteGroup(ctx, id); err != nil {
+		return err
+	}
+
+	_, err = store.Commit(ctx)
+	return err
 }
 
 func (s *AccountsService) GetMember(ctx context.Context, username string) (model.Member, error) {
@@ -319,6 +374,16 @@ func (s *AccountsService) CreateMember(ctx context.Context, member model.Member)
 		return model.Member{}, fmt.Errorf("failed to write member authz relation: %w", err)
 	}
 
+	if err := recordAudit(ctx, s.store, model.AuditEntry{
+		Action:       model.AuditGroupMemberAdded,
+		ResourceType: model.AuditGroupResource,
+		ResourceID:   member.GroupID.String(),
+		Subject:      model.NewUserSubject(member.Username).String(),
+		After:        auditState(model.AuditState{"email": created.Email}),
+	}); err != nil {
+		return model.Member{}, err
+	}
+
 	if _, err := store.Commit(ctx); err != nil {
 		return model.Member{}, err
 	}
@@ -348,6 +413,14 @@ func (s *AccountsService) UpdateGroupMember(ctx context.Context, groupID uuid.UU
 	member.CreatedAt = existing.CreatedAt
 	member.Group = nil
 
+	ctx, err = s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return model.Member{}, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
 	result, err := s.store.Accounts().UpdateMember(ctx, member)
 	if err != nil {
 		if errors.Is(err, store.ErrRecordNotFound) {
@@ -355,6 +428,21 @@ func (s *AccountsService) UpdateGroupMember(ctx context.Context, groupID uuid.UU
 		}
 		return model.Member{}, err
 	}
+
+	if err := recordAudit(ctx, s.store, model.AuditEntry{
+		Action:       model.AuditGroupMemberUpdated,
+		ResourceType: model.AuditGroupResource,
+		ResourceID:   groupID.String(),
+		Subject:      model.NewUserSubject(username).String(),
+		Before:       auditState(model.AuditState{"email": existing.Email}),
+		After:        auditState(model.AuditState{"email": result.Email}),
+	}); err != nil {
+		return model.Member{}, err
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return model.Member{}, err
+	}
 	return result, nil
 }
 
@@ -394,6 +482,16 @@ func (s *AccountsService) RemoveGroupMember(ctx context.Context, groupID uuid.UU
 		return fmt.Errorf("failed to remove member authz relation: %w", err)
 	}
 
+	if err := recordAudit(ctx, s.store, model.AuditEntry{
+		Action:       model.AuditGroupMemberRemoved,
+		ResourceType: model.AuditGroupResource,
+		ResourceID:   groupID.String(),
+		Subject:      model.NewUserSubject(username).String(),
+		Before:       auditState(model.AuditState{"email": member.Email}),
+	}); err != nil {
+		return err
+	}
+
 	if _, err := store.Commit(ctx); err != nil {
 		return err
 	}
@@ -232,8 +232,37 @@ var _ = Describe("accounts service", Ordered, func() {
 				Expect(count).To(Equal(0))
 			})
 
+			It("records the removed members and partner requests in the audit log", func() {
+				orgID := uuid.New()
+				requestID := uuid.New()
+
+				tx := gormdb.Exec(fmt.Sprintf(insertAccountsGroupStm, orgID, "To Delete", "desc", "partner", "icon", "Acme", "NULL"))
+				Expect(tx.Error).To(BeNil())
+				tx = gormdb.Exec(fmt.Sprintf(insertAccountsMemberStm, uuid.New(), "partner-member", "member@acme.com", orgID))
+				Expect(tx.Error).To(BeNil())
+				tx = gormdb.Exec(insertAccountsPartnerCustomerStm, requestID, "customer", orgID.String(), "accepted", "Name", "Contact", "555", "c@e.com", "Loc")
+				Expect(tx.Error).To(BeNil())
+
+				err := svc.DeleteGroup(context.TODO(), orgID)
+				Expect(err).To(BeNil())
+
+				entries, err := s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByResourceID(orgID.String()), nil)
+				Expect(err).To(BeNil())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Action).To(Equal(model.AuditGroupMemberRemoved))
+				Expect(entries[0].Subject).To(Equal("user:partner-member"))
+
+				entries, err = s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByResourceID(requestID.String()), nil)
+				Expect(err).To(BeNil())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Action).To(Equal(model.AuditPartnerCustomerRemoved))
+			})
+
 			AfterEach(func() {
+				gormdb.Exec("DELETE FROM partners_customers;")
+				gormdb.Exec("DELETE FROM members;")
 				gormdb.Exec("DELETE FROM groups;")
+				gormdb.Exec("TRUNCATE audit_log;")
 			})
 		})
 	})
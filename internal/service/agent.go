@@ -69,12 +69,7 @@ func (as *AgentService) UpdateSourceInventory(ctx context.Context, updateForm ma
 
 	source = mappers.UpdateSourceFromApi(source, updateForm.VCenterID, updateForm.Inventory)
 	source.UpdateType = "auto"
-	updatedSource, err := as.store.Source().Update(ctx, *source)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update source: %w", err)
-	}
-
-	return updatedSource, nil
+	return as.updateSourceAndVMs(ctx, *source, updateForm.VMs)
 }
 
 /*
@@ -104,11 +99,33 @@ func (as *AgentService) UpdateSource(ctx context.Context, updateForm mappers.Sou
 	source = mappers.UpdateSourceFromApi(source, updateForm.VCenterID, updateForm.Inventory)
 	source.UpdateType = "auto" // Set update_type to auto for agent updates
 
-	updatedSource, err := as.store.Source().Update(ctx, *source)
+	return as.updateSourceAndVMs(ctx, *source, updateForm.VMs)
+}
+
+// updateSourceAndVMs stores the new inventory of the source together with its VM records, so that
+// the records never describe another inventory than the one of the source.
+func (as *AgentService) updateSourceAndVMs(ctx context.Context, source model.Source, vms []model.SourceVM) (*model.Source, error) {
+	ctx, err := as.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	updatedSource, err := as.store.Source().Update(ctx, source)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update source: %w", err)
 	}
 
+	if err := as.store.SourceVM().Replace(ctx, source.ID, vms); err != nil {
+		return nil, fmt.Errorf("failed to update source vms: %w", err)
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return updatedSource, nil
 }
 
@@ -219,6 +236,27 @@ func (as *AgentService) DeleteSourceSubset(ctx context.Context, sourceID, subset
 	return nil
 }
 
+// GetSourcePolicies returns the active OPA policies of the organization of the source, which the
+// agent evaluates with the global policies. It returns nil when only the global policies apply.
+func (as *AgentService) GetSourcePolicies(ctx context.Context, sourceID uuid.UUID) (*model.PolicyBundle, error) {
+	source, err := as.store.Source().Get(ctx, sourceID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrSourceNotFound(sourceID)
+		}
+		return nil, fmt.Errorf("failed to fetch source: %w", err)
+	}
+
+	bundle, err := as.store.PolicyBundle().GetActive(ctx, source.OrgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active policy bundle: %w", err)
+	}
+	return bundle, nil
+}
+
 // UpdateAgentStatus updates or creates a new agent resource
 // If the source has not agent than the agent is created.
 func (as *AgentService) UpdateAgentStatus(ctx context.Context, updateForm mappers.AgentUpdateForm) (*model.Agent, bool, error) {
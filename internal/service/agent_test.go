@@ -12,6 +12,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service"
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gorm.io/gorm"
@@ -447,6 +448,51 @@ var _ = Describe("agent service", Ordered, func() {
 			Expect(updateType).To(Equal("auto"))
 		})
 
+		It("replaces the VM records of the source", func() {
+			sourceID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertSourceWithUsernameStm, sourceID, "admin", "admin"))
+			Expect(tx.Error).To(BeNil())
+
+			inventoryJSON, _ := json.Marshal(v1alpha1.Inventory{VcenterId: "vcenter"})
+			vm := func(id string) model.SourceVM {
+				return model.SourceVM{VMID: id, Name: id, Concerns: *model.MakeJSONField([]model.VMConcern{})}
+			}
+
+			srv := service.NewAgentService(s)
+			_, err := srv.UpdateSource(context.TODO(), mappers.SourceInventoryUpdateForm{
+				SourceID:  sourceID,
+				VCenterID: "vcenter",
+				Inventory: inventoryJSON,
+				VMs:       []model.SourceVM{vm("vm-1"), vm("vm-2")},
+			})
+			Expect(err).To(BeNil())
+
+			_, err = srv.UpdateSource(context.TODO(), mappers.SourceInventoryUpdateForm{
+				SourceID:  sourceID,
+				VCenterID: "vcenter",
+				Inventory: inventoryJSON,
+				VMs:       []model.SourceVM{vm("vm-3")},
+			})
+			Expect(err).To(BeNil())
+
+			vms, err := s.SourceVM().List(context.TODO(), sourceID)
+			Expect(err).To(BeNil())
+			Expect(vms).To(HaveLen(1))
+			Expect(vms[0].VMID).To(Equal("vm-3"))
+
+			// An update without VM records leaves none describing the previous inventory
+			_, err = srv.UpdateSource(context.TODO(), mappers.SourceInventoryUpdateForm{
+				SourceID:  sourceID,
+				VCenterID: "vcenter",
+				Inventory: inventoryJSON,
+			})
+			Expect(err).To(BeNil())
+
+			vms, err = s.SourceVM().List(context.TODO(), sourceID)
+			Expect(err).To(BeNil())
+			Expect(vms).To(BeEmpty())
+		})
+
 		It("returns error when source doesn't exist", func() {
 			inventoryJSON, _ := json.Marshal(v1alpha1.Inventory{})
 
@@ -17,6 +17,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service/eventwrap"
 	"github.com/kubev2v/migration-planner/internal/service/mappers"
 	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/pkg/events/kafka"
 	"github.com/kubev2v/migration-planner/pkg/events/notification"
 )
@@ -331,6 +332,36 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(assessment.Snapshots).To(HaveLen(1))
 			})
 
+			It("copies the VM records of the source into the snapshot", func() {
+				sourceID := uuid.New()
+				inventoryJSON := `{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":2},"infra":{"totalHosts":1}}}`
+
+				tx := gormdb.Exec(fmt.Sprintf(insertSourceStm, sourceID, "test-source", "user1", "org1", inventoryJSON))
+				Expect(tx.Error).To(BeNil())
+				Expect(s.SourceVM().Replace(context.TODO(), sourceID, []model.SourceVM{
+					{VMID: "vm-1", Name: "web", CpuCount: 2, MemoryMB: 4096, Concerns: *model.MakeJSONField([]model.VMConcern{})},
+					{VMID: "vm-2", Name: "db", CpuCount: 4, MemoryMB: 8192, Concerns: *model.MakeJSONField([]model.VMConcern{})},
+				})).To(Succeed())
+
+				assessment, err := svc.CreateAssessment(context.TODO(), mappers.AssessmentCreateForm{
+					ID:       uuid.New(),
+					Name:     "Test Assessment",
+					OrgID:    "org1",
+					Username: "user1",
+					Source:   service.SourceTypeAgent,
+					SourceID: &sourceID,
+				})
+				Expect(err).To(BeNil())
+
+				page, err := svc.ListVMs(context.TODO(), assessment.ID, service.NewAssessmentVMFilter())
+				Expect(err).To(BeNil())
+				Expect(page.SnapshotID).To(Equal(assessment.Snapshots[0].ID))
+				Expect(page.Total).To(Equal(int64(2)))
+				Expect(page.VMs[0].Name).To(Equal("db"))
+				Expect(page.VMs[0].CpuCount).To(Equal(4))
+				Expect(page.VMs[1].Name).To(Equal("web"))
+			})
+
 			It("fails when user orgID is different than source orgID", func() {
 				// Create a source in different org
 				sourceID := uuid.New()
@@ -579,7 +610,7 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(tx.Error).To(BeNil())
 
 				newName := "Updated Name"
-				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName, nil)
 
 				Expect(err).To(BeNil())
 				Expect(updatedAssessment).ToNot(BeNil())
@@ -593,6 +624,40 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(snapshotCount).To(Equal(2)) // Original + new snapshot from source
 			})
 
+			It("copies the VM records of the source into the new snapshot", func() {
+				sourceID := uuid.New()
+				inventoryJSON := `{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":1},"infra":{"totalHosts":1}}}`
+
+				tx := gormdb.Exec(fmt.Sprintf(insertSourceStm, sourceID, "test-source", "user1", "org1", inventoryJSON))
+				Expect(tx.Error).To(BeNil())
+				Expect(s.SourceVM().Replace(context.TODO(), sourceID, []model.SourceVM{
+					{VMID: "vm-1", Name: "web", CpuCount: 2, MemoryMB: 4096, Concerns: *model.MakeJSONField([]model.VMConcern{})},
+				})).To(Succeed())
+
+				assessmentID := uuid.New()
+				tx = gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID.String(), "Original Name", "org1", "user1", "John", "Doe", service.SourceTypeAgent, fmt.Sprintf("'%s'", sourceID)))
+				Expect(tx.Error).To(BeNil())
+				tx = gormdb.Exec(fmt.Sprintf(insertSnapshotStm, assessmentID.String(), `{"vcenter_id":"old-vcenter","vcenter":{"vms":{"total":10},"infra":{"totalHosts":5}}}`))
+				Expect(tx.Error).To(BeNil())
+
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, nil, nil)
+				Expect(err).To(BeNil())
+				Expect(updatedAssessment.Snapshots).To(HaveLen(2))
+
+				page, err := svc.ListVMs(context.TODO(), assessmentID, service.NewAssessmentVMFilter())
+				Expect(err).To(BeNil())
+				Expect(page.SnapshotID).To(Equal(updatedAssessment.Snapshots[0].ID))
+				Expect(page.Total).To(Equal(int64(1)))
+				Expect(page.VMs[0].Name).To(Equal("web"))
+
+				// The first snapshot was taken before the source reported its VMs
+				previous := updatedAssessment.Snapshots[1].ID
+				filter := service.NewAssessmentVMFilter()
+				filter.SnapshotID = &previous
+				_, err = svc.ListVMs(context.TODO(), assessmentID, filter)
+				Expect(err).To(HaveOccurred())
+			})
+
 			It("only updates name when name is provided without creating new snapshot", func() {
 				// Create a source with inventory
 				sourceID := uuid.New()
@@ -611,7 +676,7 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(tx.Error).To(BeNil())
 
 				newName := "Updated Name"
-				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName, nil)
 
 				Expect(err).To(BeNil())
 				Expect(updatedAssessment.Name).To(Equal("Updated Name"))
@@ -648,7 +713,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// First update
 				newName1 := "Updated Name 1"
-				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName1)
+				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName1, nil)
 				Expect(err).To(BeNil())
 
 				// Should have 2 snapshots now
@@ -658,7 +723,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// Second update
 				newName2 := "Updated Name 2"
-				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName2)
+				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName2, nil)
 				Expect(err).To(BeNil())
 
 				// Should have 3 snapshots now
@@ -668,7 +733,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// Third update
 				newName3 := "Updated Name 3"
-				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName3)
+				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName3, nil)
 				Expect(err).To(BeNil())
 
 				// Should have 4 snapshots now
@@ -699,7 +764,7 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(tx.Error).To(BeNil())
 
 				newName := "Updated Name"
-				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName, nil)
 
 				// Should succeed and only update name (no new snapshot since source_id is now NULL)
 				Expect(err).To(BeNil())
@@ -726,7 +791,7 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(tx.Error).To(BeNil())
 
 				newName := "Updated Name"
-				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName, nil)
 
 				Expect(err).To(BeNil())
 				Expect(updatedAssessment).ToNot(BeNil())
@@ -747,13 +812,59 @@ var _ = Describe("assessment service", Ordered, func() {
 				Expect(tx.Error).To(BeNil())
 
 				newName := "Updated Name"
-				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName, nil)
 
 				Expect(err).To(BeNil())
 				Expect(updatedAssessment.Name).To(Equal("Updated Name"))
 				Expect(updatedAssessment.SourceType).To(Equal(service.SourceTypeRvtools))
 			})
 
+			It("adds a snapshot of the inventory uploaded again", func() {
+				assessmentID := uuid.New()
+				tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID.String(), "Original Name", "org1", "user1", "John", "Doe", service.SourceTypeInventory, "NULL"))
+				Expect(tx.Error).To(BeNil())
+				tx = gormdb.Exec(fmt.Sprintf(insertSnapshotStm, assessmentID.String(), `{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":10},"infra":{"totalHosts":5}}}`))
+				Expect(tx.Error).To(BeNil())
+
+				inventory := []byte(`{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":12},"infra":{"totalHosts":6}}}`)
+				updatedAssessment, err := svc.UpdateAssessment(context.TODO(), assessmentID, nil, inventory)
+
+				Expect(err).To(BeNil())
+				Expect(updatedAssessment.Name).To(Equal("Original Name"))
+				Expect(updatedAssessment.Snapshots).To(HaveLen(2))
+				Expect(string(updatedAssessment.Snapshots[0].Inventory)).To(ContainSubstring(`"total": 12`))
+				Expect(string(updatedAssessment.Snapshots[1].Inventory)).To(ContainSubstring(`"total": 10`))
+			})
+
+			It("rejects an uploaded inventory without VMs", func() {
+				assessmentID := uuid.New()
+				tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID.String(), "Original Name", "org1", "user1", "John", "Doe", service.SourceTypeInventory, "NULL"))
+				Expect(tx.Error).To(BeNil())
+
+				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, nil, []byte(`{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":0}}}`))
+
+				Expect(err).ToNot(BeNil())
+				var noVMs *service.ErrInventoryHasNoVMs
+				Expect(errors.As(err, &noVMs)).To(BeTrue())
+			})
+
+			It("rejects an uploaded inventory for rvtools assessments", func() {
+				assessmentID := uuid.New()
+				tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID.String(), "Original Name", "org1", "user1", "John", "Doe", service.SourceTypeRvtools, "NULL"))
+				Expect(tx.Error).To(BeNil())
+
+				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, nil, []byte(`{"vcenter_id":"test-vcenter","vcenter":{"vms":{"total":12}}}`))
+
+				Expect(err).ToNot(BeNil())
+				var invalidReq *service.ErrInvalidRequest
+				Expect(errors.As(err, &invalidReq)).To(BeTrue())
+
+				var snapshotCount int
+				tx = gormdb.Raw("SELECT COUNT(*) FROM snapshots WHERE assessment_id = ?", assessmentID).Scan(&snapshotCount)
+				Expect(tx.Error).To(BeNil())
+				Expect(snapshotCount).To(Equal(0))
+			})
+
 			It("maintains only one snapshot after multiple updates for non-sourceID assessments", func() {
 				// Create assessment without sourceID (inventory type)
 				assessmentID := uuid.New()
@@ -772,7 +883,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// First update
 				newName1 := "Updated Name 1"
-				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName1)
+				_, err := svc.UpdateAssessment(context.TODO(), assessmentID, &newName1, nil)
 				Expect(err).To(BeNil())
 
 				// Should still have only 1 snapshot (no new snapshot created)
@@ -782,7 +893,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// Second update
 				newName2 := "Updated Name 2"
-				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName2)
+				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName2, nil)
 				Expect(err).To(BeNil())
 
 				// Should still have only 1 snapshot
@@ -792,7 +903,7 @@ var _ = Describe("assessment service", Ordered, func() {
 
 				// Third update
 				newName3 := "Updated Name 3"
-				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName3)
+				_, err = svc.UpdateAssessment(context.TODO(), assessmentID, &newName3, nil)
 				Expect(err).To(BeNil())
 
 				// Should still have only 1 snapshot
@@ -806,7 +917,7 @@ var _ = Describe("assessment service", Ordered, func() {
 			nonExistentID := uuid.New()
 			newName := "Updated Name"
 
-			updatedAssessment, err := svc.UpdateAssessment(context.TODO(), nonExistentID, &newName)
+			updatedAssessment, err := svc.UpdateAssessment(context.TODO(), nonExistentID, &newName, nil)
 
 			Expect(err).ToNot(BeNil())
 			Expect(updatedAssessment).To(BeNil())
@@ -1137,6 +1248,21 @@ var _ = Describe("assessment service", Ordered, func() {
 			Expect(count).To(Equal(int64(1)))
 		})
 
+		It("keeps the editor relation of the partner", func() {
+			tx := gormdb.Exec(fmt.Sprintf("INSERT INTO partners_customers (id, username, partner_id, request_status, name, contact_name, contact_phone, email, location) VALUES ('%s', 'customer1', '%s', 'accepted', 'Name', 'Contact', '555', 'c@e.com', 'Loc');", uuid.New(), partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "editor", "org", partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("customer1", "org1")
+			err := svc.ShareAssessment(ctx, assessmentID)
+			Expect(err).To(BeNil())
+
+			var relations []string
+			gormdb.Raw("SELECT relation FROM relations WHERE resource = 'assessment' AND resource_id = ? AND subject_namespace = 'org' AND subject_id = ?", assessmentID.String(), partnerGroupID.String()).Scan(&relations)
+			Expect(relations).To(ConsistOf("editor"))
+		})
+
 		It("returns ErrNotACustomer when user has no partner", func() {
 			ctx := ctxWithUser("regular-user", "org1")
 			err := svc.ShareAssessment(ctx, assessmentID)
@@ -1210,6 +1336,23 @@ var _ = Describe("assessment service", Ordered, func() {
 			Expect(outboxCount).To(Equal(int64(1)))
 		})
 
+		It("removes the editor relation of the partner", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "editor", "org", partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("customer1", "org1")
+			err := svc.UnshareAssessment(ctx, assessmentID)
+			Expect(err).To(BeNil())
+
+			var count int64
+			gormdb.Raw("SELECT COUNT(*) FROM relations WHERE resource = 'assessment' AND resource_id = ? AND subject_namespace = 'org'", assessmentID.String()).Scan(&count)
+			Expect(count).To(Equal(int64(0)))
+
+			var outboxCount int64
+			gormdb.Raw(countOutboxByTypeStm, kafka.UnshareAssessmentEventType).Scan(&outboxCount)
+			Expect(outboxCount).To(Equal(int64(1)))
+		})
+
 		It("is idempotent — unsharing when not shared does not error", func() {
 			ctx := ctxWithUser("customer1", "org1")
 			err := svc.UnshareAssessment(ctx, assessmentID)
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+const (
+	DefaultAssessmentVMLimit = 100
+	MaxAssessmentVMLimit     = 1000
+)
+
+// AssessmentVMFilter selects the VMs of an assessment snapshot.
+// Empty string fields are not applied.
+type AssessmentVMFilter struct {
+	SnapshotID *uint // latest snapshot when nil
+	Cluster    string
+	OS         string
+	PowerState string
+	ConcernID  string
+	Category   string
+	SortBy     string
+	SortDesc   bool
+	Limit      int
+	Offset     int
+}
+
+func NewAssessmentVMFilter() *AssessmentVMFilter {
+	return &AssessmentVMFilter{
+		SortBy: "name",
+		Limit:  DefaultAssessmentVMLimit,
+	}
+}
+
+// AssessmentVMPage is a page of VMs along with the number of VMs matching the filter.
+type AssessmentVMPage struct {
+	SnapshotID uint
+	VMs        model.AssessmentVMList
+	Total      int64
+	Limit      int
+	Offset     int
+}
+
+func (as *AssessmentService) ListVMs(ctx context.Context, id uuid.UUID, filter *AssessmentVMFilter) (*AssessmentVMPage, error) {
+	logger := as.logger.WithContext(ctx)
+	tracer := logger.Operation("list_vms").
+		WithUUID("assessment_id", id).
+		WithString("snapshot_id", snapshotIDString(filter.SnapshotID)).
+		WithString("cluster", filter.Cluster).
+		WithString("os", filter.OS).
+		WithString("power_state", filter.PowerState).
+		WithString("concern_id", filter.ConcernID).
+		WithString("category", filter.Category).
+		WithString("sort_by", filter.SortBy).
+		WithInt("limit", filter.Limit).
+		WithInt("offset", filter.Offset).
+		Build()
+
+	if filter.Limit <= 0 || filter.Limit > MaxAssessmentVMLimit {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("limit must be between 1 and %d", MaxAssessmentVMLimit))
+	}
+	if filter.Offset < 0 {
+		return nil, NewErrInvalidRequest("offset must not be negative")
+	}
+	if filter.SortBy != "" && !store.IsValidAssessmentVMSortField(filter.SortBy) {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("unsupported sort field %q", filter.SortBy))
+	}
+
+	assessment, err := as.store.Assessment().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAssessmentNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	snapshot, err := selectSnapshot(assessment, filter.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	storeFilter := store.NewAssessmentVMQueryFilter().BySnapshotID(snapshot.ID)
+	if filter.Cluster != "" {
+		storeFilter = storeFilter.ByCluster(filter.Cluster)
+	}
+	if filter.OS != "" {
+		storeFilter = storeFilter.ByOS(filter.OS)
+	}
+	if filter.PowerState != "" {
+		storeFilter = storeFilter.ByPowerState(filter.PowerState)
+	}
+	if filter.ConcernID != "" {
+		storeFilter = storeFilter.ByConcernID(filter.ConcernID)
+	}
+	if filter.Category != "" {
+		storeFilter = storeFilter.ByConcernCategory(filter.Category)
+	}
+
+	total, err := as.store.AssessmentVM().Count(ctx, storeFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count vms: %w", err)
+	}
+	// An empty page must not be mistaken for a snapshot without VMs
+	if total == 0 {
+		recorded, err := as.store.AssessmentVM().Count(ctx, store.NewAssessmentVMQueryFilter().BySnapshotID(snapshot.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to count vms: %w", err)
+		}
+		if recorded == 0 {
+			return nil, NewErrSnapshotHasNoVMRecords(snapshot.ID, id)
+		}
+	}
+
+	options := store.NewAssessmentVMQueryOptions().
+		WithSort(filter.SortBy, filter.SortDesc).
+		WithLimit(filter.Limit).
+		WithOffset(filter.Offset)
+
+	vms, err := as.store.AssessmentVM().List(ctx, storeFilter, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vms: %w", err)
+	}
+
+	tracer.Success().
+		WithInt("snapshot_id", int(snapshot.ID)).
+		WithInt("count", len(vms)).
+		WithInt("total", int(total)).
+		Log()
+
+	return &AssessmentVMPage{
+		SnapshotID: snapshot.ID,
+		VMs:        vms,
+		Total:      total,
+		Limit:      filter.Limit,
+		Offset:     filter.Offset,
+	}, nil
+}
+
+// copySourceVMs copies the VM records last reported for the source into a snapshot of the
+// assessment, and returns how many were copied.
+func copySourceVMs(ctx context.Context, s store.Store, sourceID, assessmentID uuid.UUID, snapshotID uint) (int, error) {
+	sourceVMs, err := s.SourceVM().List(ctx, sourceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch source vms: %w", err)
+	}
+
+	vms := make([]model.AssessmentVM, 0, len(sourceVMs))
+	for _, vm := range sourceVMs {
+		vms = append(vms, vm.ToAssessmentVM(assessmentID, snapshotID))
+	}
+	if err := s.AssessmentVM().CreateBatch(ctx, vms); err != nil {
+		return 0, fmt.Errorf("failed to store assessment vms: %w", err)
+	}
+	return len(vms), nil
+}
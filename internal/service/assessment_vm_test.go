@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("assessment vms", func() {
+	var (
+		mockStore     *MockStore
+		assessmentSrv *service.AssessmentService
+		ctx           context.Context
+		assessmentID  uuid.UUID
+	)
+
+	BeforeEach(func() {
+		mockStore = NewMockStore()
+		assessmentSrv = service.NewAssessmentService(mockStore, nil, nil)
+		ctx = context.Background()
+		assessmentID = uuid.New()
+
+		mockStore.assessments[assessmentID] = &model.Assessment{
+			ID:        assessmentID,
+			Snapshots: []model.Snapshot{{ID: 7}, {ID: 3}},
+		}
+		mockStore.vms = model.AssessmentVMList{
+			{SnapshotID: 7, VMID: "vm-1", AssessmentID: assessmentID, Name: "db-01"},
+			{SnapshotID: 7, VMID: "vm-2", AssessmentID: assessmentID, Name: "web-01"},
+		}
+	})
+
+	It("lists the vms of the latest snapshot by default", func() {
+		page, err := assessmentSrv.ListVMs(ctx, assessmentID, service.NewAssessmentVMFilter())
+		Expect(err).To(BeNil())
+		Expect(page.SnapshotID).To(Equal(uint(7)))
+		Expect(page.VMs).To(HaveLen(2))
+		Expect(page.Total).To(Equal(int64(2)))
+		Expect(page.Limit).To(Equal(service.DefaultAssessmentVMLimit))
+		Expect(page.Offset).To(Equal(0))
+	})
+
+	It("uses the requested snapshot", func() {
+		filter := service.NewAssessmentVMFilter()
+		filter.SnapshotID = util.Ptr(uint(3))
+
+		page, err := assessmentSrv.ListVMs(ctx, assessmentID, filter)
+		Expect(err).To(BeNil())
+		Expect(page.SnapshotID).To(Equal(uint(3)))
+	})
+
+	It("returns not found for an unknown snapshot", func() {
+		filter := service.NewAssessmentVMFilter()
+		filter.SnapshotID = util.Ptr(uint(99))
+
+		_, err := assessmentSrv.ListVMs(ctx, assessmentID, filter)
+		Expect(err).NotTo(BeNil())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("returns not found for a snapshot without VM records", func() {
+		mockStore.vms = nil
+
+		_, err := assessmentSrv.ListVMs(ctx, assessmentID, service.NewAssessmentVMFilter())
+		Expect(err).NotTo(BeNil())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("no per-VM records"))
+	})
+
+	It("returns not found for an unknown assessment", func() {
+		_, err := assessmentSrv.ListVMs(ctx, uuid.New(), service.NewAssessmentVMFilter())
+		Expect(err).NotTo(BeNil())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+	})
+
+	DescribeTable("rejects invalid pagination and sort",
+		func(mutate func(f *service.AssessmentVMFilter)) {
+			filter := service.NewAssessmentVMFilter()
+			mutate(filter)
+
+			_, err := assessmentSrv.ListVMs(ctx, assessmentID, filter)
+			Expect(err).NotTo(BeNil())
+			_, ok := err.(*service.ErrInvalidRequest)
+			Expect(ok).To(BeTrue())
+		},
+		Entry("zero limit", func(f *service.AssessmentVMFilter) { f.Limit = 0 }),
+		Entry("limit above maximum", func(f *service.AssessmentVMFilter) { f.Limit = service.MaxAssessmentVMLimit + 1 }),
+		Entry("negative offset", func(f *service.AssessmentVMFilter) { f.Offset = -1 }),
+		Entry("unknown sort field", func(f *service.AssessmentVMFilter) { f.SortBy = "uuid" }),
+	)
+})
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/requestid"
+)
+
+// recordAudit appends an entry to the audit log. It must be called in the transaction of the
+// change, so the entry is committed or rolled back with it. The request ID is taken from ctx, and
+// the actor defaults to the user of ctx.
+func recordAudit(ctx context.Context, s store.Store, entry model.AuditEntry) error {
+	if entry.Actor == "" {
+		if user, found := auth.UserFromContext(ctx); found {
+			entry.Actor = user.Username
+		}
+	}
+	entry.RequestID = requestid.FromContext(ctx)
+	if _, err := s.AuditLog().Append(ctx, entry); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// auditState returns the state recorded before or after a change.
+func auditState(state model.AuditState) *model.JSONField[model.AuditState] {
+	return model.MakeJSONField(state)
+}
+
+// sharingRelationsOf returns the viewer or editor relation of each subject the assessment is shared with.
+func sharingRelationsOf(ctx context.Context, s store.Store, id uuid.UUID) (map[model.Subject]model.Relation, error) {
+	rels, err := s.Authz().ListRelationships(ctx, model.NewAssessmentResource(id.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list relationships of assessment %s: %w", id, err)
+	}
+	relations := make(map[model.Subject]model.Relation, len(rels))
+	for _, rel := range rels {
+		if slices.Contains(sharingRelations, rel.Relation) {
+			relations[rel.Subject] = rel.Relation
+		}
+	}
+	return relations, nil
+}
+
+// auditSharing records the change of the relation of a subject on an assessment. An empty
+// relation is no access; nothing is recorded if the relation is unchanged. An empty actor is the
+// user of ctx.
+func auditSharing(ctx context.Context, s store.Store, actor string, id uuid.UUID, subject model.Subject, before, after model.Relation) error {
+	if before == after {
+		return nil
+	}
+	entry := model.AuditEntry{
+		Actor:        actor,
+		Action:       model.AuditAssessmentShared,
+		ResourceType: string(model.AssessmentResource),
+		ResourceID:   id.String(),
+		Subject:      subject.String(),
+	}
+	if before != "" {
+		entry.Before = auditState(model.AuditState{"relation": before})
+	}
+	if after != "" {
+		entry.After = auditState(model.AuditState{"relation": after})
+	} else {
+		entry.Action = model.AuditAssessmentUnshared
+	}
+	return recordAudit(ctx, s, entry)
+}
+
+// AuditFilter selects the entries of the audit log. Empty fields are ignored.
+type AuditFilter struct {
+	Actor        string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Subject      string
+	RequestID    string
+	Since        *time.Time
+	Until        *time.Time
+	// Limit is the maximum number of entries to return, 0 returns all of them.
+	Limit  int
+	Offset int
+}
+
+type AuditServicer interface {
+	ListEntries(ctx context.Context, filter AuditFilter) (model.AuditEntryList, error)
+}
+
+type AuditService struct {
+	store store.Store
+}
+
+func NewAuditService(store store.Store) *AuditService {
+	return &AuditService{store: store}
+}
+
+// ListEntries returns the entries of the audit log matching the filter, the most recent first.
+func (s *AuditService) ListEntries(ctx context.Context, filter AuditFilter) (model.AuditEntryList, error) {
+	if filter.Limit < 0 || filter.Offset < 0 {
+		return nil, NewErrInvalidRequest("limit and offset must be non-negative")
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return nil, NewErrInvalidRequest("since must be before until")
+	}
+
+	f := store.NewAuditQueryFilter()
+	if filter.Actor != "" {
+		f = f.ByActor(filter.Actor)
+	}
+	if filter.Action != "" {
+		f = f.ByAction(model.AuditAction(filter.Action))
+	}
+	if filter.ResourceType != "" {
+		f = f.ByResourceType(filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		f = f.ByResourceID(filter.ResourceID)
+	}
+	if filter.Subject != "" {
+		f = f.BySubject(filter.Subject)
+	}
+	if filter.RequestID != "" {
+		f = f.ByRequestID(filter.RequestID)
+	}
+	if filter.Since != nil {
+		f = f.Since(*filter.Since)
+	}
+	if filter.Until != nil {
+		f = f.Until(*filter.Until)
+	}
+
+	opts := store.NewAuditQueryOptions()
+	if filter.Limit > 0 {
+		opts = opts.WithLimit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		opts = opts.WithOffset(filter.Offset)
+	}
+
+	return s.store.AuditLog().List(ctx, f, opts)
+}
+
+// AuthzAuditService restricts the audit log to admins.
+type AuthzAuditService struct {
+	inner       AuditServicer
+	accountsSvc *AccountsService
+}
+
+func NewAuthzAuditService(inner AuditServicer, accounts *AccountsService) AuditServicer {
+	return &AuthzAuditService{inner: inner, accountsSvc: accounts}
+}
+
+func (a *AuthzAuditService) ListEntries(ctx context.Context, filter AuditFilter) (model.AuditEntryList, error) {
+	user := auth.MustHaveUser(ctx)
+	identity, err := a.accountsSvc.GetIdentity(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("authz: failed to get identity: %w", err)
+	}
+	if identity.Kind != KindAdmin {
+		return nil, NewErrForbidden("audit log", user.Username)
+	}
+	return a.inner.ListEntries(ctx, filter)
+}
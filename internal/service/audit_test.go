@@ -0,0 +1,33 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("audit service", func() {
+	var auditSrv *service.AuditService
+
+	BeforeEach(func() {
+		auditSrv = service.NewAuditService(NewMockStore())
+	})
+
+	It("rejects a negative limit or offset", func() {
+		_, err := auditSrv.ListEntries(context.TODO(), service.AuditFilter{Limit: -1})
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+
+		_, err = auditSrv.ListEntries(context.TODO(), service.AuditFilter{Offset: -1})
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+	})
+
+	It("rejects a period ending before it starts", func() {
+		since := time.Now()
+		until := since.Add(-time.Hour)
+		_, err := auditSrv.ListEntries(context.TODO(), service.AuditFilter{Since: &since, Until: &until})
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+	})
+})
@@ -25,11 +25,6 @@ func NewAuthzAssessmentService(inner AssessmentServicer, s store.Store, accounts
 func (a *AuthzAssessmentService) ListAssessments(ctx context.Context, filter *AssessmentFilter) ([]model.Assessment, error) {
 	user := auth.MustHaveUser(ctx)
 
-	identity, err := a.accountsSrv.GetIdentity(ctx, user)
-	if err != nil {
-		return []model.Assessment{}, err
-	}
-
 	resources, err := a.store.Authz().ListResources(ctx, user.Username, model.AssessmentResource)
 	if err != nil {
 		return nil, fmt.Errorf("authz: failed to list resources: %w", err)
@@ -82,12 +77,6 @@ func (a *AuthzAssessmentService) ListAssessments(ctx context.Context, filter *As
 				return nil, err
 			}
 			assessments[i].Sharing = sharingM
-			assessments[i].Permissions = slices.DeleteFunc(assessments[i].Permissions, func(s model.Permission) bool {
-				if identity.Kind == KindRegular || identity.Kind == KindPartner {
-					return s.String() == "share" || s.String() == "edit"
-				}
-				return s.String() == "edit"
-			})
 		} else {
 			ownerName := ""
 			if assessment.OwnerFirstName != nil && assessment.OwnerLastName != nil {
@@ -97,7 +86,6 @@ func (a *AuthzAssessmentService) ListAssessments(ctx context.Context, filter *As
 		}
 	}
 
-	// per design, the regular user cannot share so remove the permission
 	return assessments, nil
 }
 
@@ -130,17 +118,6 @@ func (a *AuthzAssessmentService) GetAssessment(ctx context.Context, id uuid.UUID
 			return nil, err
 		}
 		assessment.Sharing = sharingM
-
-		identity, err := a.accountsSrv.GetIdentity(ctx, user)
-		if err != nil {
-			return nil, err
-		}
-		assessment.Permissions = slices.DeleteFunc(assessment.Permissions, func(s model.Permission) bool {
-			if identity.Kind == KindRegular || identity.Kind == KindPartner {
-				return s.String() == "share" || s.String() == "edit"
-			}
-			return s.String() == "edit"
-		})
 	} else {
 		ownerName := ""
 		if assessment.OwnerFirstName != nil && assessment.OwnerLastName != nil {
@@ -183,7 +160,7 @@ func (a *AuthzAssessmentService) CreateAssessment(ctx context.Context, createFor
 	return assessment, nil
 }
 
-func (a *AuthzAssessmentService) UpdateAssessment(ctx context.Context, id uuid.UUID, name *string) (*model.Assessment, error) {
+func (a *AuthzAssessmentService) UpdateAssessment(ctx context.Context, id uuid.UUID, name *string, inventory []byte) (*model.Assessment, error) {
 	user := auth.MustHaveUser(ctx)
 
 	_, err := a.inner.GetAssessment(ctx, id)
@@ -200,7 +177,7 @@ func (a *AuthzAssessmentService) UpdateAssessment(ctx context.Context, id uuid.U
 		return nil, NewErrForbidden("assessment", id.String())
 	}
 
-	return a.inner.UpdateAssessment(ctx, id, name)
+	return a.inner.UpdateAssessment(ctx, id, name, inventory)
 }
 
 func (a *AuthzAssessmentService) DeleteAssessment(ctx context.Context, id uuid.UUID) error {
@@ -245,11 +222,181 @@ func (a *AuthzAssessmentService) DeleteAssessment(ctx context.Context, id uuid.U
 }
 
 func (a *AuthzAssessmentService) ShareAssessment(ctx context.Context, id uuid.UUID) error {
+	if err := a.checkSharePermission(ctx, id); err != nil {
+		return err
+	}
+	return a.inner.ShareAssessment(ctx, id)
+}
+
+func (a *AuthzAssessmentService) UnshareAssessment(ctx context.Context, id uuid.UUID) error {
+	if err := a.checkSharePermission(ctx, id); err != nil {
+		return err
+	}
+	return a.inner.UnshareAssessment(ctx, id)
+}
+
+func (a *AuthzAssessmentService) ShareAssessmentWith(ctx context.Context, id uuid.UUID, subjects []model.Subject, relation model.Relation) error {
+	if err := a.checkSharePermission(ctx, id); err != nil {
+		return err
+	}
+	return a.inner.ShareAssessmentWith(ctx, id, subjects, relation)
+}
+
+func (a *AuthzAssessmentService) UnshareAssessmentWith(ctx context.Context, id uuid.UUID, subjects []model.Subject) error {
+	if err := a.checkSharePermission(ctx, id); err != nil {
+		return err
+	}
+	return a.inner.UnshareAssessmentWith(ctx, id, subjects)
+}
+
+// TransferOwnership makes another user the owner of the assessment. Only the owner can transfer
+// it, and keeps editor access. The new owner must already have access to the assessment through a
+// direct grant, so the assessment cannot be left to a mistyped user; this grant is replaced by the
+// owner relation. The grants of the partner of the previous owner are revoked: they are tied to the
+// customer relationship of the previous owner, which would no longer revoke them.
+func (a *AuthzAssessmentService) TransferOwnership(ctx context.Context, id uuid.UUID, username string) (*model.Assessment, error) {
 	user := auth.MustHaveUser(ctx)
 
 	// get assessment first to capture the 404 if any
-	_, err := a.inner.GetAssessment(ctx, id)
+	if _, err := a.inner.GetAssessment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	resource := model.NewAssessmentResource(id.String())
+	rels, err := a.store.Authz().ListRelationships(ctx, resource)
 	if err != nil {
+		return nil, fmt.Errorf("authz: failed to list relationships: %w", err)
+	}
+	previousOwner := model.NewUserSubject(user.Username)
+	if !slices.ContainsFunc(rels, func(r model.Relationship) bool {
+		return r.Relation == model.OwnerRelation && r.Subject == previousOwner
+	}) {
+		return nil, NewErrForbidden("assessment", id.String())
+	}
+
+	newOwner := model.NewUserSubject(username)
+	if username != user.Username && !slices.ContainsFunc(rels, func(r model.Relationship) bool {
+		return r.Subject == newOwner && slices.Contains(sharingRelations, r.Relation)
+	}) {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("the assessment must be shared with %s before transferring it", username))
+	}
+
+	identity, err := a.accountsSrv.GetIdentity(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("authz: failed to get identity: %w", err)
+	}
+
+	ctx, err = a.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	if _, err := a.inner.TransferOwnership(ctx, id, username); err != nil {
+		return nil, err
+	}
+
+	builder := store.NewRelationshipBuilder().
+		Without(resource, model.OwnerRelation, previousOwner).
+		With(resource, model.EditorRelation, previousOwner).
+		Without(resource, model.ViewerRelation, newOwner).
+		Without(resource, model.EditorRelation, newOwner).
+		With(resource, model.OwnerRelation, newOwner)
+	if identity.Kind == KindCustomer && identity.PartnerID != nil {
+		partner := model.NewOrgSubject(*identity.PartnerID)
+		for _, rel := range rels {
+			if rel.Subject != partner || !slices.Contains(sharingRelations, rel.Relation) {
+				continue
+			}
+			builder.Without(resource, rel.Relation, partner)
+			if err := auditSharing(ctx, a.store, user.Username, id, partner, rel.Relation, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := a.store.Authz().WriteRelationships(ctx, builder.Build()); err != nil {
+		return nil, fmt.Errorf("authz: failed to transfer owner relation: %w", err)
+	}
+
+	if err := recordAudit(ctx, a.store, model.AuditEntry{
+		Action:       model.AuditAssessmentTransferred,
+		ResourceType: string(model.AssessmentResource),
+		ResourceID:   id.String(),
+		Subject:      newOwner.String(),
+		Before:       auditState(model.AuditState{"owner": user.Username}),
+		After:        auditState(model.AuditState{"owner": username, "previousOwnerRelation": model.EditorRelation}),
+	}); err != nil {
+		return nil, err
+	}
+
+	if ctx, err = store.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	// the permissions and the sharing state are now the ones of an editor
+	return a.GetAssessment(ctx, id)
+}
+
+func (a *AuthzAssessmentService) AuthorizeEdit(ctx context.Context, id uuid.UUID) error {
+	return a.checkEditPermission(ctx, id)
+}
+
+func (a *AuthzAssessmentService) ListSnapshots(ctx context.Context, id uuid.UUID) ([]model.Snapshot, error) {
+	if err := a.checkReadPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.ListSnapshots(ctx, id)
+}
+
+func (a *AuthzAssessmentService) GetSnapshot(ctx context.Context, id uuid.UUID, snapshotID uint) (*model.Snapshot, error) {
+	if err := a.checkReadPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.GetSnapshot(ctx, id, snapshotID)
+}
+
+func (a *AuthzAssessmentService) DiffSnapshots(ctx context.Context, id uuid.UUID, fromSnapshotID, toSnapshotID uint) (*SnapshotDiff, error) {
+	if err := a.checkReadPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.DiffSnapshots(ctx, id, fromSnapshotID, toSnapshotID)
+}
+
+func (a *AuthzAssessmentService) ListVMs(ctx context.Context, id uuid.UUID, filter *AssessmentVMFilter) (*AssessmentVMPage, error) {
+	if err := a.checkReadPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.ListVMs(ctx, id, filter)
+}
+
+func (a *AuthzAssessmentService) ListConcernWaivers(ctx context.Context, id uuid.UUID) (model.ConcernWaiverList, error) {
+	if err := a.checkReadPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.ListConcernWaivers(ctx, id)
+}
+
+func (a *AuthzAssessmentService) CreateConcernWaiver(ctx context.Context, id uuid.UUID, form ConcernWaiverForm) (*model.ConcernWaiver, error) {
+	if err := a.checkEditPermission(ctx, id); err != nil {
+		return nil, err
+	}
+	return a.inner.CreateConcernWaiver(ctx, id, form)
+}
+
+func (a *AuthzAssessmentService) DeleteConcernWaiver(ctx context.Context, id uuid.UUID, waiverID uuid.UUID) error {
+	if err := a.checkEditPermission(ctx, id); err != nil {
+		return err
+	}
+	return a.inner.DeleteConcernWaiver(ctx, id, waiverID)
+}
+
+func (a *AuthzAssessmentService) checkReadPermission(ctx context.Context, id uuid.UUID) error {
+	user := auth.MustHaveUser(ctx)
+
+	// get assessment first to capture the 404 if any
+	if _, err := a.inner.GetAssessment(ctx, id); err != nil {
 		return err
 	}
 
@@ -258,19 +405,38 @@ func (a *AuthzAssessmentService) ShareAssessment(ctx context.Context, id uuid.UU
 		return fmt.Errorf("authz: failed to get permissions: %w", err)
 	}
 
-	if !model.SharePermission.In(resource.Permissions) {
+	if !model.ReadPermission.In(resource.Permissions) {
 		return NewErrForbidden("assessment", id.String())
 	}
 
-	return a.inner.ShareAssessment(ctx, id)
+	return nil
 }
 
-func (a *AuthzAssessmentService) UnshareAssessment(ctx context.Context, id uuid.UUID) error {
+func (a *AuthzAssessmentService) checkEditPermission(ctx context.Context, id uuid.UUID) error {
 	user := auth.MustHaveUser(ctx)
 
 	// get assessment first to capture the 404 if any
-	_, err := a.inner.GetAssessment(ctx, id)
+	if _, err := a.inner.GetAssessment(ctx, id); err != nil {
+		return err
+	}
+
+	resource, err := a.store.Authz().GetPermissions(ctx, user.Username, model.NewAssessmentResource(id.String()))
 	if err != nil {
+		return fmt.Errorf("authz: failed to get permissions: %w", err)
+	}
+
+	if !model.EditPermission.In(resource.Permissions) {
+		return NewErrForbidden("assessment", id.String())
+	}
+
+	return nil
+}
+
+func (a *AuthzAssessmentService) checkSharePermission(ctx context.Context, id uuid.UUID) error {
+	user := auth.MustHaveUser(ctx)
+
+	// get assessment first to capture the 404 if any
+	if _, err := a.inner.GetAssessment(ctx, id); err != nil {
 		return err
 	}
 
@@ -283,7 +449,7 @@ func (a *AuthzAssessmentService) UnshareAssessment(ctx context.Context, id uuid.
 		return NewErrForbidden("assessment", id.String())
 	}
 
-	return a.inner.UnshareAssessment(ctx, id)
+	return nil
 }
 
 func (a *AuthzAssessmentService) buildOwnerSharing(ctx context.Context, rels []model.Relationship) (*model.Sharing, error) {
@@ -308,7 +474,7 @@ func (a *AuthzAssessmentService) buildOwnerSharing(ctx context.Context, rels []m
 
 			name = group.Name
 		}
-		shared = append(shared, model.SharingSubject{Type: st, ID: r.Subject.ID, Name: name})
+		shared = append(shared, model.SharingSubject{Type: st, ID: r.Subject.ID, Name: name, Relation: r.Relation})
 	}
 	return &model.Sharing{
 		IsShared:   len(shared) > 0,
@@ -119,7 +119,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			Expect(assessments).To(HaveLen(1))
 		})
 
-		It("regular owner gets share and edit stripped, viewer gets read", func() {
+		It("regular owner gets all permissions, viewer gets read", func() {
 			var resourceID string
 			tx := gormdb.Raw("SELECT resource_id FROM relations WHERE subject_id = 'user1' LIMIT 1").Scan(&resourceID)
 			Expect(tx.Error).To(BeNil())
@@ -127,13 +127,13 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", resourceID, "viewer", "user", "user3"))
 			Expect(tx.Error).To(BeNil())
 
-			// Regular owner does not see share or edit
+			// Regular owner can edit, share with users and groups and delete
 			ctx := ctxWithUser("user1", "org1")
 			filter := service.NewAssessmentFilter("user1", "org1")
 			assessments, err := svc.ListAssessments(ctx, filter)
 			Expect(err).To(BeNil())
 			for _, a := range assessments {
-				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.DeletePermission))
+				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 			}
 
 			// Viewer sees only read
@@ -157,14 +157,14 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			assessments, err := svc.ListAssessments(ctx, filter)
 			Expect(err).To(BeNil())
 			for _, a := range assessments {
-				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.SharePermission, model.DeletePermission))
+				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 			}
 
 			gormdb.Exec("DELETE FROM partners_customers")
 			gormdb.Exec("DELETE FROM groups")
 		})
 
-		It("partner owner gets share stripped like regular", func() {
+		It("partner owner keeps share permission like regular", func() {
 			partnerGroupID := uuid.New()
 			tx := gormdb.Exec(fmt.Sprintf("INSERT INTO groups (id, name, description, kind, icon, company, parent_id) VALUES ('%s', 'Partner', 'desc', 'partner', 'icon', 'Acme', NULL);", partnerGroupID))
 			Expect(tx.Error).To(BeNil())
@@ -176,7 +176,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			assessments, err := svc.ListAssessments(ctx, filter)
 			Expect(err).To(BeNil())
 			for _, a := range assessments {
-				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.DeletePermission))
+				Expect(a.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 			}
 
 			gormdb.Exec("DELETE FROM members")
@@ -287,17 +287,17 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			Expect(errors.As(err, &forbidden)).To(BeTrue())
 		})
 
-		It("regular owner gets share and edit stripped, viewer gets read", func() {
+		It("regular owner gets all permissions, viewer gets read", func() {
 			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
 			Expect(tx.Error).To(BeNil())
 			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "viewer", "user", "viewer-user"))
 			Expect(tx.Error).To(BeNil())
 
-			// Regular owner does not see share or edit
+			// Regular owner can edit, share with users and groups and delete
 			ctx := ctxWithUser("user1", "org1")
 			assessment, err := svc.GetAssessment(ctx, assessmentID)
 			Expect(err).To(BeNil())
-			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.DeletePermission))
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 
 			// Viewer sees only read
 			ctx = ctxWithUser("viewer-user", "org1")
@@ -318,13 +318,13 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			ctx := ctxWithUser("user1", "org1")
 			assessment, err := svc.GetAssessment(ctx, assessmentID)
 			Expect(err).To(BeNil())
-			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.SharePermission, model.DeletePermission))
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 
 			gormdb.Exec("DELETE FROM partners_customers")
 			gormdb.Exec("DELETE FROM groups")
 		})
 
-		It("partner owner gets share stripped like regular", func() {
+		It("partner owner keeps share permission like regular", func() {
 			partnerGroupID := uuid.New()
 			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
 			Expect(tx.Error).To(BeNil())
@@ -336,7 +336,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			ctx := ctxWithUser("user1", "org1")
 			assessment, err := svc.GetAssessment(ctx, assessmentID)
 			Expect(err).To(BeNil())
-			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.DeletePermission))
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
 
 			gormdb.Exec("DELETE FROM members")
 			gormdb.Exec("DELETE FROM groups")
@@ -364,6 +364,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			Expect(assessment.Sharing.SharedBy).To(BeNil())
 
 			for _, s := range assessment.Sharing.SharedWith {
+				Expect(s.Relation).To(Equal(model.ViewerRelation))
 				if s.Type == "group" {
 					Expect(s.Name).To(Equal("Get Partner"))
 				}
@@ -505,7 +506,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 
 			ctx := ctxWithUser("user1", "org1")
 			newName := "Updated Name"
-			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName)
+			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName, nil)
 
 			Expect(err).To(BeNil())
 			Expect(assessment).ToNot(BeNil())
@@ -518,7 +519,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 
 			ctx := ctxWithUser("viewer-user", "org1")
 			newName := "Should Fail"
-			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName)
+			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName, nil)
 
 			Expect(err).ToNot(BeNil())
 			Expect(assessment).To(BeNil())
@@ -529,7 +530,7 @@ var _ = Describe("authz assessment service", Ordered, func() {
 		It("returns ErrForbidden when user has no relation", func() {
 			ctx := ctxWithUser("unauthorized-user", "org1")
 			newName := "Should Fail"
-			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName)
+			assessment, err := svc.UpdateAssessment(ctx, assessmentID, &newName, nil)
 
 			Expect(err).ToNot(BeNil())
 			Expect(assessment).To(BeNil())
@@ -724,4 +725,246 @@ var _ = Describe("authz assessment service", Ordered, func() {
 			gormdb.Exec("DELETE FROM assessments;")
 		})
 	})
+
+	Context("ShareAssessmentWith", func() {
+		var assessmentID uuid.UUID
+		var groupID uuid.UUID
+
+		BeforeEach(func() {
+			assessmentID = uuid.New()
+			groupID = uuid.New()
+
+			tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID, "Share With Authz Test", "org1", "user1", "John", "Doe", service.SourceTypeInventory, "NULL"))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf("INSERT INTO groups (id, name, description, kind, icon, company, parent_id) VALUES ('%s', 'Team', 'desc', 'partner', 'icon', 'Acme', NULL);", groupID))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf("INSERT INTO members (id, username, email, group_id) VALUES ('%s', 'team-member', 'member@test.com', '%s');", uuid.New(), groupID))
+			Expect(tx.Error).To(BeNil())
+		})
+
+		It("regular owner shares with a user and a group", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("user1", "org1")
+			err := svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{
+				model.NewUserSubject("colleague"),
+				model.NewOrgSubject(groupID.String()),
+			}, model.ViewerRelation)
+			Expect(err).To(BeNil())
+
+			// the colleague and the group members can read the assessment
+			for _, username := range []string{"colleague", "team-member"} {
+				assessment, err := svc.GetAssessment(ctxWithUser(username, "org1"), assessmentID)
+				Expect(err).To(BeNil())
+				Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission))
+			}
+
+			// the owner sees the grants
+			assessment, err := svc.GetAssessment(ctx, assessmentID)
+			Expect(err).To(BeNil())
+			Expect(assessment.Sharing.SharedWith).To(ConsistOf(
+				model.SharingSubject{Type: "user", ID: "colleague", Name: "colleague", Relation: model.ViewerRelation},
+				model.SharingSubject{Type: "group", ID: groupID.String(), Name: "Team", Relation: model.ViewerRelation},
+			))
+
+			err = svc.UnshareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewUserSubject("colleague")})
+			Expect(err).To(BeNil())
+
+			_, err = svc.GetAssessment(ctxWithUser("colleague", "org1"), assessmentID)
+			var forbidden *service.ErrForbidden
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+			_, err = svc.GetAssessment(ctxWithUser("team-member", "org1"), assessmentID)
+			Expect(err).To(BeNil())
+		})
+
+		It("rejects unknown groups and the owner", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("user1", "org1")
+			var invalid *service.ErrInvalidRequest
+			err := svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewOrgSubject(uuid.NewString())}, model.ViewerRelation)
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+			err = svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewUserSubject("user1")}, model.ViewerRelation)
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+
+			var count int64
+			gormdb.Raw("SELECT COUNT(*) FROM relations WHERE resource_id = ? AND relation = 'viewer'", assessmentID.String()).Scan(&count)
+			Expect(count).To(Equal(int64(0)))
+		})
+
+		It("returns ErrForbidden when user is only a viewer", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "viewer", "user", "user2"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("user2", "org1")
+			err := svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewUserSubject("user3")}, model.ViewerRelation)
+			var forbidden *service.ErrForbidden
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+		})
+
+		It("editor can read and edit but not share or delete", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("user1", "org1")
+			err := svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewUserSubject("colleague")}, model.EditorRelation)
+			Expect(err).To(BeNil())
+
+			editorCtx := ctxWithUser("colleague", "org1")
+			assessment, err := svc.GetAssessment(editorCtx, assessmentID)
+			Expect(err).To(BeNil())
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission))
+			Expect(svc.AuthorizeEdit(editorCtx, assessmentID)).To(BeNil())
+
+			var forbidden *service.ErrForbidden
+			err = svc.ShareAssessmentWith(editorCtx, assessmentID, []model.Subject{model.NewUserSubject("user3")}, model.ViewerRelation)
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+			err = svc.DeleteAssessment(editorCtx, assessmentID)
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+
+			// sharing again replaces the relation
+			err = svc.ShareAssessmentWith(ctx, assessmentID, []model.Subject{model.NewUserSubject("colleague")}, model.ViewerRelation)
+			Expect(err).To(BeNil())
+			err = svc.AuthorizeEdit(editorCtx, assessmentID)
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+		})
+
+		It("records the sharing changes in the audit log", func() {
+			tx := gormdb.Exec("TRUNCATE audit_log;")
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+
+			ctx := ctxWithUser("user1", "org1")
+			colleague := []model.Subject{model.NewUserSubject("colleague")}
+			Expect(svc.ShareAssessmentWith(ctx, assessmentID, colleague, model.ViewerRelation)).To(Succeed())
+			Expect(svc.ShareAssessmentWith(ctx, assessmentID, colleague, model.ViewerRelation)).To(Succeed())
+			Expect(svc.ShareAssessmentWith(ctx, assessmentID, colleague, model.EditorRelation)).To(Succeed())
+			Expect(svc.UnshareAssessmentWith(ctx, assessmentID, colleague)).To(Succeed())
+
+			entries, err := s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByResourceID(assessmentID.String()), nil)
+			Expect(err).To(BeNil())
+			// the most recent first, sharing again with the same relation changes nothing
+			Expect(entries).To(HaveLen(3))
+			Expect(entries[0].Action).To(Equal(model.AuditAssessmentUnshared))
+			Expect(entries[0].Before.Data).To(HaveKeyWithValue("relation", "editor"))
+			Expect(entries[0].After).To(BeNil())
+			Expect(entries[1].Action).To(Equal(model.AuditAssessmentShared))
+			Expect(entries[1].Before.Data).To(HaveKeyWithValue("relation", "viewer"))
+			Expect(entries[1].After.Data).To(HaveKeyWithValue("relation", "editor"))
+			Expect(entries[2].Before).To(BeNil())
+			for _, entry := range entries {
+				Expect(entry.Actor).To(Equal("user1"))
+				Expect(entry.Subject).To(Equal("user:colleague"))
+			}
+		})
+
+		It("rejects the owner relation", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+
+			err := svc.ShareAssessmentWith(ctxWithUser("user1", "org1"), assessmentID, []model.Subject{model.NewUserSubject("colleague")}, model.OwnerRelation)
+			var invalid *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM relations;")
+			gormdb.Exec("DELETE FROM members;")
+			gormdb.Exec("DELETE FROM groups;")
+			gormdb.Exec("DELETE FROM snapshots;")
+			gormdb.Exec("DELETE FROM assessments;")
+		})
+	})
+	Context("TransferOwnership", func() {
+		var assessmentID uuid.UUID
+
+		BeforeEach(func() {
+			assessmentID = uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID, "Transfer Authz Test", "org1", "user1", "John", "Doe", service.SourceTypeInventory, "NULL"))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "owner", "user", "user1"))
+			Expect(tx.Error).To(BeNil())
+		})
+
+		It("makes the user the owner and keeps the previous owner as editor", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "viewer", "user", "user2"))
+			Expect(tx.Error).To(BeNil())
+
+			assessment, err := svc.TransferOwnership(ctxWithUser("user1", "org1"), assessmentID, "user2")
+			Expect(err).To(BeNil())
+			Expect(assessment.Username).To(Equal("user2"))
+			Expect(assessment.OwnerFirstName).To(BeNil())
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission))
+
+			assessment, err = svc.GetAssessment(ctxWithUser("user2", "org1"), assessmentID)
+			Expect(err).To(BeNil())
+			Expect(assessment.Permissions).To(ConsistOf(model.ReadPermission, model.EditPermission, model.SharePermission, model.DeletePermission))
+			Expect(assessment.Sharing.SharedWith).To(ConsistOf(
+				model.SharingSubject{Type: "user", ID: "user1", Name: "user1", Relation: model.EditorRelation},
+			))
+		})
+
+		It("returns ErrForbidden when user is not the owner", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "editor", "user", "user2"))
+			Expect(tx.Error).To(BeNil())
+
+			_, err := svc.TransferOwnership(ctxWithUser("user2", "org1"), assessmentID, "user2")
+			var forbidden *service.ErrForbidden
+			Expect(errors.As(err, &forbidden)).To(BeTrue())
+		})
+
+		It("returns ErrInvalidRequest when transferring to the owner", func() {
+			_, err := svc.TransferOwnership(ctxWithUser("user1", "org1"), assessmentID, "user1")
+			var invalid *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+		})
+
+		It("returns ErrInvalidRequest when the new owner has no access", func() {
+			_, err := svc.TransferOwnership(ctxWithUser("user1", "org1"), assessmentID, "usr2")
+			var invalid *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+
+			assessment, err := svc.GetAssessment(ctxWithUser("user1", "org1"), assessmentID)
+			Expect(err).To(BeNil())
+			Expect(assessment.Username).To(Equal("user1"))
+		})
+
+		It("revokes the access of the partner of the previous owner", func() {
+			partnerGroupID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf("INSERT INTO groups (id, name, description, kind, icon, company, parent_id) VALUES ('%s', 'Partner', 'desc', 'partner', 'icon', 'Acme', NULL);", partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf("INSERT INTO partners_customers (id, username, partner_id, request_status, name, contact_name, contact_phone, email, location) VALUES ('%s', 'user1', '%s', 'accepted', 'Name', 'Contact', '555', 'c@e.com', 'Loc');", uuid.New(), partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "viewer", "org", partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", assessmentID, "viewer", "user", "user2"))
+			Expect(tx.Error).To(BeNil())
+
+			_, err := svc.TransferOwnership(ctxWithUser("user1", "org1"), assessmentID, "user2")
+			Expect(err).To(BeNil())
+
+			assessment, err := svc.GetAssessment(ctxWithUser("user2", "org1"), assessmentID)
+			Expect(err).To(BeNil())
+			Expect(assessment.Sharing.SharedWith).To(ConsistOf(
+				model.SharingSubject{Type: "user", ID: "user1", Name: "user1", Relation: model.EditorRelation},
+			))
+
+			entries, err := s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByResourceID(assessmentID.String()).ByAction(model.AuditAssessmentUnshared), nil)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Subject).To(Equal("org:" + partnerGroupID.String()))
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM relations;")
+			gormdb.Exec("DELETE FROM partners_customers;")
+			gormdb.Exec("DELETE FROM groups;")
+			gormdb.Exec("DELETE FROM snapshots;")
+			gormdb.Exec("DELETE FROM assessments;")
+			gormdb.Exec("TRUNCATE audit_log;")
+		})
+	})
 })
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+)
+
+// AuthzComplexityTableService lets the members of an organization and the admins read its
+// complexity scoring tables and restricts their changes to admins.
+type AuthzComplexityTableService struct {
+	inner       ComplexityTableServicer
+	accountsSvc *AccountsService
+}
+
+func NewAuthzComplexityTableService(inner ComplexityTableServicer, accounts *AccountsService) ComplexityTableServicer {
+	return &AuthzComplexityTableService{inner: inner, accountsSvc: accounts}
+}
+
+func (a *AuthzComplexityTableService) ListTables(ctx context.Context, orgID string) ([]ComplexityTable, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return a.inner.ListTables(ctx, orgID)
+}
+
+func (a *AuthzComplexityTableService) GetTable(ctx context.Context, orgID, version string) (ComplexityTable, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return ComplexityTable{}, err
+	}
+	return a.inner.GetTable(ctx, orgID, version)
+}
+
+func (a *AuthzComplexityTableService) GetActiveTable(ctx context.Context, orgID string) (ComplexityTable, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return ComplexityTable{}, err
+	}
+	return a.inner.GetActiveTable(ctx, orgID)
+}
+
+func (a *AuthzComplexityTableService) CreateTable(ctx context.Context, orgID string, table complexity.Table) (ComplexityTable, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return ComplexityTable{}, err
+	}
+	return a.inner.CreateTable(ctx, orgID, table)
+}
+
+func (a *AuthzComplexityTableService) ResetTable(ctx context.Context, orgID string) (ComplexityTable, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return ComplexityTable{}, err
+	}
+	return a.inner.ResetTable(ctx, orgID)
+}
+
+func (a *AuthzComplexityTableService) requireMemberOrAdmin(ctx context.Context, orgID string) error {
+	if auth.MustHaveUser(ctx).Organization == orgID {
+		return nil
+	}
+	return a.requireAdmin(ctx)
+}
+
+func (a *AuthzComplexityTableService) requireAdmin(ctx context.Context) error {
+	user := auth.MustHaveUser(ctx)
+	identity, err := a.accountsSvc.GetIdentity(ctx, user)
+	if err != nil {
+		return fmt.Errorf("authz: failed to get identity: %w", err)
+	}
+	if identity.Kind != KindAdmin {
+		return NewErrForbidden("complexity tables", user.Username)
+	}
+	return nil
+}
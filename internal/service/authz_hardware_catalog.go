@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+)
+
+// AuthzHardwareCatalogService lets every user read the hardware catalog and restricts its
+// changes to admins.
+type AuthzHardwareCatalogService struct {
+	inner       HardwareCatalogServicer
+	accountsSvc *AccountsService
+}
+
+func NewAuthzHardwareCatalogService(inner HardwareCatalogServicer, accounts *AccountsService) HardwareCatalogServicer {
+	return &AuthzHardwareCatalogService{inner: inner, accountsSvc: accounts}
+}
+
+func (a *AuthzHardwareCatalogService) ListSKUs(ctx context.Context) ([]HardwareSKU, error) {
+	return a.inner.ListSKUs(ctx)
+}
+
+func (a *AuthzHardwareCatalogService) GetSKU(ctx context.Context, name string) (HardwareSKU, error) {
+	return a.inner.GetSKU(ctx, name)
+}
+
+func (a *AuthzHardwareCatalogService) CreateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return HardwareSKU{}, err
+	}
+	return a.inner.CreateSKU(ctx, sku)
+}
+
+func (a *AuthzHardwareCatalogService) UpdateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return HardwareSKU{}, err
+	}
+	return a.inner.UpdateSKU(ctx, sku)
+}
+
+func (a *AuthzHardwareCatalogService) DeleteSKU(ctx context.Context, name string) (HardwareSKU, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return HardwareSKU{}, err
+	}
+	return a.inner.DeleteSKU(ctx, name)
+}
+
+func (a *AuthzHardwareCatalogService) requireAdmin(ctx context.Context) error {
+	user := auth.MustHaveUser(ctx)
+	identity, err := a.accountsSvc.GetIdentity(ctx, user)
+	if err != nil {
+		return fmt.Errorf("authz: failed to get identity: %w", err)
+	}
+	if identity.Kind != KindAdmin {
+		return NewErrForbidden("hardware skus", user.Username)
+	}
+	return nil
+}
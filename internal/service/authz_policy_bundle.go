@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+// AuthzPolicyBundleService lets the members of an organization and the admins read and test its
+// policies and restricts their changes to admins.
+type AuthzPolicyBundleService struct {
+	inner       PolicyBundleServicer
+	accountsSvc *AccountsService
+}
+
+func NewAuthzPolicyBundleService(inner PolicyBundleServicer, accounts *AccountsService) PolicyBundleServicer {
+	return &AuthzPolicyBundleService{inner: inner, accountsSvc: accounts}
+}
+
+func (a *AuthzPolicyBundleService) ListBundles(ctx context.Context, orgID string) (model.PolicyBundleList, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return a.inner.ListBundles(ctx, orgID)
+}
+
+func (a *AuthzPolicyBundleService) GetBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return a.inner.GetBundle(ctx, orgID, revision)
+}
+
+func (a *AuthzPolicyBundleService) GetActiveBundle(ctx context.Context, orgID string) (*model.PolicyBundle, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return a.inner.GetActiveBundle(ctx, orgID)
+}
+
+func (a *AuthzPolicyBundleService) CreateBundle(ctx context.Context, orgID string, form PolicyBundleForm) (*model.PolicyBundle, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return a.inner.CreateBundle(ctx, orgID, form)
+}
+
+func (a *AuthzPolicyBundleService) ActivateBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	if err := a.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return a.inner.ActivateBundle(ctx, orgID, revision)
+}
+
+func (a *AuthzPolicyBundleService) DeactivateBundle(ctx context.Context, orgID string) error {
+	if err := a.requireAdmin(ctx); err != nil {
+		return err
+	}
+	return a.inner.DeactivateBundle(ctx, orgID)
+}
+
+func (a *AuthzPolicyBundleService) TestBundle(ctx context.Context, orgID string, revision int, vms []models.VM) ([]PolicyTestResult, error) {
+	if err := a.requireMemberOrAdmin(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return a.inner.TestBundle(ctx, orgID, revision, vms)
+}
+
+func (a *AuthzPolicyBundleService) requireMemberOrAdmin(ctx context.Context, orgID string) error {
+	if auth.MustHaveUser(ctx).Organization == orgID {
+		return nil
+	}
+	return a.requireAdmin(ctx)
+}
+
+func (a *AuthzPolicyBundleService) requireAdmin(ctx context.Context) error {
+	user := auth.MustHaveUser(ctx)
+	identity, err := a.accountsSvc.GetIdentity(ctx, user)
+	if err != nil {
+		return fmt.Errorf("authz: failed to get identity: %w", err)
+	}
+	if identity.Kind != KindAdmin {
+		return NewErrForbidden("policy bundles", user.Username)
+	}
+	return nil
+}
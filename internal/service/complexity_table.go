@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+)
+
+type ComplexityTableServicer interface {
+	ListTables(ctx context.Context, orgID string) ([]ComplexityTable, error)
+	GetTable(ctx context.Context, orgID, version string) (ComplexityTable, error)
+	GetActiveTable(ctx context.Context, orgID string) (ComplexityTable, error)
+	CreateTable(ctx context.Context, orgID string, table complexity.Table) (ComplexityTable, error)
+	ResetTable(ctx context.Context, orgID string) (ComplexityTable, error)
+}
+
+// ComplexityTable is a version of the complexity scoring tables available to an organization:
+// the built-in tables or one of the versions of the organization.
+type ComplexityTable struct {
+	complexity.Table
+	Active bool
+	// CreatedBy and CreatedAt are empty for the built-in tables.
+	CreatedBy string
+	CreatedAt *time.Time
+}
+
+// ComplexityTableService manages the complexity scoring tables of the organizations. Every
+// change stores a new version, so that the assessments scored with a previous version can still
+// be recomputed with it.
+type ComplexityTableService struct {
+	store store.Store
+}
+
+func NewComplexityTableService(store store.Store) *ComplexityTableService {
+	return &ComplexityTableService{store: store}
+}
+
+// ListTables returns the built-in tables followed by the versions of the organization, the
+// latest first.
+func (s *ComplexityTableService) ListTables(ctx context.Context, orgID string) ([]ComplexityTable, error) {
+	stored, err := s.store.ComplexityTable().List(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list complexity tables: %w", err)
+	}
+	tables := make([]ComplexityTable, 0, len(stored)+1)
+	builtin := ComplexityTable{Table: complexity.DefaultTable(), Active: true}
+	for _, table := range stored {
+		if table.Active {
+			builtin.Active = false
+		}
+	}
+	tables = append(tables, builtin)
+	for _, table := range stored {
+		tables = append(tables, complexityTableFromModel(table))
+	}
+	return tables, nil
+}
+
+func (s *ComplexityTableService) GetTable(ctx context.Context, orgID, version string) (ComplexityTable, error) {
+	if version == complexity.DefaultTableVersion {
+		active, err := s.GetActiveTable(ctx, orgID)
+		if err != nil {
+			return ComplexityTable{}, err
+		}
+		return ComplexityTable{Table: complexity.DefaultTable(), Active: active.Version == version}, nil
+	}
+	stored, err := s.store.ComplexityTable().Get(ctx, orgID, version)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return ComplexityTable{}, NewErrResourceNotFoundByStr(version, "complexity table")
+		}
+		return ComplexityTable{}, fmt.Errorf("failed to get complexity table: %w", err)
+	}
+	return complexityTableFromModel(*stored), nil
+}
+
+// GetActiveTable returns the tables the organization scores new assessments with.
+func (s *ComplexityTableService) GetActiveTable(ctx context.Context, orgID string) (ComplexityTable, error) {
+	table, err := activeComplexityTable(ctx, s.store, orgID)
+	if err != nil {
+		return ComplexityTable{}, err
+	}
+	if table.Version == complexity.DefaultTableVersion {
+		return ComplexityTable{Table: table, Active: true}, nil
+	}
+	return s.GetTable(ctx, orgID, table.Version)
+}
+
+// CreateTable validates the tables and stores them as the new active version of the organization.
+func (s *ComplexityTableService) CreateTable(ctx context.Context, orgID string, table complexity.Table) (ComplexityTable, error) {
+	if err := table.Validate(); err != nil {
+		return ComplexityTable{}, NewErrInvalidRequest(err.Error())
+	}
+
+	ctx, err := s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return ComplexityTable{}, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	created, err := s.store.ComplexityTable().Create(ctx, model.ComplexityTable{
+		OrgID:     orgID,
+		Tables:    *model.MakeJSONField(table),
+		CreatedBy: auth.MustHaveUser(ctx).Username,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return ComplexityTable{}, NewErrDuplicateKey("complexity table", orgID)
+		}
+		return ComplexityTable{}, fmt.Errorf("failed to create complexity table: %w", err)
+	}
+	if _, err := store.Commit(ctx); err != nil {
+		return ComplexityTable{}, err
+	}
+	return complexityTableFromModel(*created), nil
+}
+
+// ResetTable reverts the organization to the built-in tables. Its versions are kept.
+func (s *ComplexityTableService) ResetTable(ctx context.Context, orgID string) (ComplexityTable, error) {
+	if err := s.store.ComplexityTable().Deactivate(ctx, orgID); err != nil {
+		return ComplexityTable{}, fmt.Errorf("failed to reset complexity table: %w", err)
+	}
+	return ComplexityTable{Table: complexity.DefaultTable(), Active: true}, nil
+}
+
+// activeComplexityTable returns the active tables of the organization, or the built-in tables.
+func activeComplexityTable(ctx context.Context, s store.Store, orgID string) (complexity.Table, error) {
+	active, err := s.ComplexityTable().GetActive(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return complexity.DefaultTable(), nil
+		}
+		return complexity.Table{}, fmt.Errorf("failed to get active complexity table: %w", err)
+	}
+	return active.Tables.Data, nil
+}
+
+// complexityTableForAssessment returns the tables to score the assessment with: the given
+// version, or the version the assessment was scored with.
+func complexityTableForAssessment(ctx context.Context, s store.Store, assessment *model.Assessment, version *string) (complexity.Table, error) {
+	v := assessment.ComplexityTableVersion
+	if version != nil {
+		v = *version
+	}
+	if v == "" || v == complexity.DefaultTableVersion {
+		return complexity.DefaultTable(), nil
+	}
+	stored, err := s.ComplexityTable().Get(ctx, assessment.OrgID, v)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return complexity.Table{}, NewErrResourceNotFoundByStr(v, "complexity table")
+		}
+		return complexity.Table{}, fmt.Errorf("failed to get complexity table: %w", err)
+	}
+	return stored.Tables.Data, nil
+}
+
+func complexityTableFromModel(table model.ComplexityTable) ComplexityTable {
+	createdAt := table.CreatedAt
+	return ComplexityTable{
+		Table:     table.Tables.Data,
+		Active:    table.Active,
+		CreatedBy: table.CreatedBy,
+		CreatedAt: &createdAt,
+	}
+}
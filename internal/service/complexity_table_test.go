@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("complexity tables", func() {
+	const orgID = "org-a"
+
+	var (
+		ctx       context.Context
+		mockStore *MockStore
+		tableSvc  *service.ComplexityTableService
+	)
+
+	BeforeEach(func() {
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "admin"})
+		mockStore = NewMockStore()
+		tableSvc = service.NewComplexityTableService(mockStore)
+	})
+
+	customTable := func(almaScore int) complexity.Table {
+		table := complexity.DefaultTable()
+		table.OSDifficultyScores["AlmaLinux 9"] = almaScore
+		return table
+	}
+
+	It("serves the built-in tables when the organization has no version", func() {
+		active, err := tableSvc.GetActiveTable(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(active.Version).To(Equal(complexity.DefaultTableVersion))
+		Expect(active.Active).To(BeTrue())
+		Expect(active.CreatedAt).To(BeNil())
+
+		tables, err := tableSvc.ListTables(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(tables).To(HaveLen(1))
+		Expect(tables[0].Version).To(Equal(complexity.DefaultTableVersion))
+	})
+
+	It("activates every new version and keeps the previous ones", func() {
+		first, err := tableSvc.CreateTable(ctx, orgID, customTable(1))
+		Expect(err).To(BeNil())
+		Expect(first.Version).To(Equal("org-1"))
+		Expect(first.CreatedBy).To(Equal("admin"))
+
+		second, err := tableSvc.CreateTable(ctx, orgID, customTable(2))
+		Expect(err).To(BeNil())
+		Expect(second.Version).To(Equal("org-2"))
+
+		tables, err := tableSvc.ListTables(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(tables).To(HaveLen(3))
+		Expect([]string{tables[0].Version, tables[1].Version, tables[2].Version}).To(Equal([]string{complexity.DefaultTableVersion, "org-2", "org-1"}))
+		Expect([]bool{tables[0].Active, tables[1].Active, tables[2].Active}).To(Equal([]bool{false, true, false}))
+
+		active, err := tableSvc.GetActiveTable(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(active.Version).To(Equal("org-2"))
+		Expect(active.ClassifyOS("AlmaLinux 9 (64-bit)")).To(Equal(2))
+
+		previous, err := tableSvc.GetTable(ctx, orgID, "org-1")
+		Expect(err).To(BeNil())
+		Expect(previous.Active).To(BeFalse())
+		Expect(previous.ClassifyOS("AlmaLinux 9 (64-bit)")).To(Equal(1))
+	})
+
+	It("does not share versions between organizations", func() {
+		_, err := tableSvc.CreateTable(ctx, orgID, customTable(1))
+		Expect(err).To(BeNil())
+
+		_, err = tableSvc.GetTable(ctx, "org-b", "org-1")
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+
+		active, err := tableSvc.GetActiveTable(ctx, "org-b")
+		Expect(err).To(BeNil())
+		Expect(active.Version).To(Equal(complexity.DefaultTableVersion))
+	})
+
+	It("rejects invalid tables", func() {
+		table := customTable(1)
+		table.DiskSizeScores["50+TiB"] = 7
+
+		_, err := tableSvc.CreateTable(ctx, orgID, table)
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		Expect(mockStore.complexityTables).To(BeEmpty())
+	})
+
+	It("reverts to the built-in tables on reset", func() {
+		_, err := tableSvc.CreateTable(ctx, orgID, customTable(1))
+		Expect(err).To(BeNil())
+
+		reset, err := tableSvc.ResetTable(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(reset.Version).To(Equal(complexity.DefaultTableVersion))
+
+		active, err := tableSvc.GetActiveTable(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(active.Version).To(Equal(complexity.DefaultTableVersion))
+
+		builtin, err := tableSvc.GetTable(ctx, orgID, complexity.DefaultTableVersion)
+		Expect(err).To(BeNil())
+		Expect(builtin.Active).To(BeTrue())
+
+		_, err = tableSvc.GetTable(ctx, orgID, "org-1")
+		Expect(err).To(BeNil())
+	})
+})
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+const (
+	maxWaiverVMs                 = 1000
+	maxWaiverJustificationLength = 2000
+	maxWaiverConcernIDLength     = 255
+)
+
+// ConcernWaiverForm is a concern waiver to create. An empty VMIDs waives the concern for all the VMs.
+type ConcernWaiverForm struct {
+	ConcernID     string
+	VMIDs         []string
+	Justification string
+	ExpiresAt     *time.Time
+}
+
+func (as *AssessmentService) ListConcernWaivers(ctx context.Context, id uuid.UUID) (model.ConcernWaiverList, error) {
+	if _, err := as.getAssessment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	waivers, err := as.store.ConcernWaiver().List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list concern waivers: %w", err)
+	}
+	return waivers, nil
+}
+
+func (as *AssessmentService) CreateConcernWaiver(ctx context.Context, id uuid.UUID, form ConcernWaiverForm) (*model.ConcernWaiver, error) {
+	logger := as.logger.WithContext(ctx)
+	tracer := logger.Operation("create_concern_waiver").
+		WithUUID("assessment_id", id).
+		WithString("concern_id", form.ConcernID).
+		WithInt("vm_count", len(form.VMIDs)).
+		Build()
+
+	waiver, err := newConcernWaiver(id, form, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	waiver.CreatedBy = auth.MustHaveUser(ctx).Username
+
+	if _, err := as.getAssessment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	created, err := as.store.ConcernWaiver().Create(ctx, *waiver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create concern waiver: %w", err)
+	}
+
+	tracer.Success().WithUUID("waiver_id", created.ID).Log()
+	return created, nil
+}
+
+func (as *AssessmentService) DeleteConcernWaiver(ctx context.Context, id uuid.UUID, waiverID uuid.UUID) error {
+	logger := as.logger.WithContext(ctx)
+	tracer := logger.Operation("delete_concern_waiver").
+		WithUUID("assessment_id", id).
+		WithUUID("waiver_id", waiverID).
+		Build()
+
+	if _, err := as.getAssessment(ctx, id); err != nil {
+		return err
+	}
+
+	if err := as.store.ConcernWaiver().Delete(ctx, id, waiverID); err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return NewErrResourceNotFoundByStr(waiverID.String(), "concern waiver")
+		}
+		return fmt.Errorf("failed to delete concern waiver: %w", err)
+	}
+
+	tracer.Success().Log()
+	return nil
+}
+
+// getAssessment returns the assessment, ErrResourceNotFound if it doesn't exist.
+func (as *AssessmentService) getAssessment(ctx context.Context, id uuid.UUID) (*model.Assessment, error) {
+	assessment, err := as.store.Assessment().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAssessmentNotFound(id)
+		}
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+	return assessment, nil
+}
+
+// newConcernWaiver validates the form and returns the waiver to create.
+func newConcernWaiver(assessmentID uuid.UUID, form ConcernWaiverForm, now time.Time) (*model.ConcernWaiver, error) {
+	concernID := strings.TrimSpace(form.ConcernID)
+	if concernID == "" || len(concernID) > maxWaiverConcernIDLength {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("concern ID must be between 1 and %d characters", maxWaiverConcernIDLength))
+	}
+	justification := strings.TrimSpace(form.Justification)
+	if justification == "" || len(justification) > maxWaiverJustificationLength {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("justification must be between 1 and %d characters", maxWaiverJustificationLength))
+	}
+	if len(form.VMIDs) > maxWaiverVMs {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("a waiver applies to at most %d VMs", maxWaiverVMs))
+	}
+	vmIDs := make(model.StringArray, 0, len(form.VMIDs))
+	for _, vmID := range form.VMIDs {
+		if vmID = strings.TrimSpace(vmID); vmID == "" {
+			return nil, NewErrInvalidRequest("VM IDs must not be empty")
+		}
+		vmIDs = append(vmIDs, vmID)
+	}
+	slices.Sort(vmIDs)
+	if form.ExpiresAt != nil && !form.ExpiresAt.After(now) {
+		return nil, NewErrInvalidRequest("expiration must be in the future")
+	}
+
+	return &model.ConcernWaiver{
+		ID:            uuid.New(),
+		AssessmentID:  assessmentID,
+		ConcernID:     concernID,
+		VMIDs:         slices.Compact(vmIDs),
+		Justification: justification,
+		ExpiresAt:     form.ExpiresAt,
+	}, nil
+}
+
+// applyConcernWaivers applies the active waivers of the assessment to the VM aggregates of its
+// snapshots, see waiveInventory. The snapshots are not stored back.
+func applyConcernWaivers(ctx context.Context, s store.Store, assessmentID uuid.UUID, snapshots []model.Snapshot) error {
+	waivers, err := s.ConcernWaiver().List(ctx, assessmentID)
+	if err != nil {
+		return fmt.Errorf("failed to list concern waivers: %w", err)
+	}
+	waivers = waivers.Active(time.Now())
+	if len(waivers) == 0 {
+		return nil
+	}
+
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		// v1 snapshots predate the per-VM records and the cluster inventories
+		if snapshot.Version != model.SnapshotVersionV2 || len(snapshot.Inventory) == 0 {
+			continue
+		}
+
+		inventory, err := parseSnapshotInventory(snapshot)
+		if err != nil {
+			return err
+		}
+
+		var vms model.AssessmentVMList
+		filter := store.NewAssessmentVMQueryFilter().BySnapshotID(snapshot.ID)
+		count, err := s.AssessmentVM().Count(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to count vms: %w", err)
+		}
+		if count > 0 {
+			filter = store.NewAssessmentVMQueryFilter().BySnapshotID(snapshot.ID).ByAnyConcernID(waivers.ConcernIDs())
+			if vms, err = s.AssessmentVM().List(ctx, filter, nil); err != nil {
+				return fmt.Errorf("failed to list vms: %w", err)
+			}
+		}
+
+		waiveInventory(inventory, waivers, vms, count > 0)
+		if snapshot.Inventory, err = json.Marshal(inventory); err != nil {
+			return fmt.Errorf("failed to marshal inventory: %w", err)
+		}
+	}
+	return nil
+}
+
+// waiveInventory applies waivers to the vCenter and cluster VM aggregates of an inventory.
+//
+// With the per-VM records of the inventory (vms holds the records of the VMs having a waived
+// concern), the waived concerns are moved from the migration issues to the waived issues and
+// the VMs left without critical concerns become migratable. Without per-VM records (agent
+// inventories), only the waivers for all the VMs apply: their issues are moved to the waived
+// issues, but the VM counts cannot be recomputed and are left unchanged.
+func waiveInventory(inventory *api.Inventory, waivers model.ConcernWaiverList, vms model.AssessmentVMList, hasVMRecords bool) {
+	if !hasVMRecords {
+		waivers = slices.DeleteFunc(slices.Clone(waivers), func(w model.ConcernWaiver) bool { return !w.AllVMs() })
+	}
+
+	if inventory.Vcenter != nil {
+		waiveVMs(&inventory.Vcenter.Vms, waivers, vms, hasVMRecords)
+	}
+	for clusterID, data := range inventory.Clusters {
+		clusterVMs := slices.DeleteFunc(slices.Clone(vms), func(vm model.AssessmentVM) bool { return vm.ClusterID != clusterID })
+		waiveVMs(&data.Vms, waivers, clusterVMs, hasVMRecords)
+		inventory.Clusters[clusterID] = data
+	}
+}
+
+func waiveVMs(aggregates *api.VMs, waivers model.ConcernWaiverList, vms model.AssessmentVMList, hasVMRecords bool) {
+	var waived []api.WaivedMigrationIssue
+	addWaived := func(id, label, assessment, category string, count int) {
+		i := slices.IndexFunc(waived, func(w api.WaivedMigrationIssue) bool { return w.Id == id && w.Category == category })
+		if i < 0 {
+			waived = append(waived, api.WaivedMigrationIssue{Id: id, Label: label, Assessment: &assessment, Category: category})
+			i = len(waived) - 1
+		}
+		waived[i].Count += count
+	}
+
+	if !hasVMRecords {
+		for _, category := range []string{"Critical", "Warning"} {
+			issues := issuesOfCategory(aggregates, category)
+			*issues = slices.DeleteFunc(*issues, func(issue api.MigrationIssue) bool {
+				if issue.Id == nil || !slices.ContainsFunc(waivers, func(w model.ConcernWaiver) bool { return w.ConcernID == *issue.Id }) {
+					return false
+				}
+				addWaived(*issue.Id, issue.Label, issue.Assessment, category, issue.Count)
+				return true
+			})
+		}
+	} else {
+		for _, vm := range vms {
+			if vm.MigrationExcluded {
+				continue
+			}
+			before := map[string]bool{}
+			after := map[string]bool{}
+			for _, concern := range vm.Concerns.Data {
+				before[concern.Category] = true
+				if !waivers.Waives(concern.ID, vm.VMID) {
+					after[concern.Category] = true
+					continue
+				}
+				addWaived(concern.ID, concern.Label, concern.Assessment, concern.Category, 1)
+				if issues := issuesOfCategory(aggregates, concern.Category); issues != nil {
+					decrementIssue(issues, concern.ID)
+				}
+			}
+
+			if before["Critical"] && !after["Critical"] {
+				aggregates.TotalMigratable++
+			}
+			// migratable with warnings counts the VMs with a warning and no critical concern
+			withWarningsBefore := !before["Critical"] && before["Warning"]
+			withWarningsAfter := !after["Critical"] && after["Warning"]
+			if withWarningsBefore != withWarningsAfter && aggregates.TotalMigratableWithWarnings != nil {
+				if withWarningsAfter {
+					*aggregates.TotalMigratableWithWarnings++
+				} else {
+					*aggregates.TotalMigratableWithWarnings = max(*aggregates.TotalMigratableWithWarnings-1, 0)
+				}
+			}
+			if aggregates.IssuesBreakdown != nil {
+				for category := range before {
+					if !after[category] {
+						decrementBreakdown(aggregates.IssuesBreakdown, category)
+					}
+				}
+			}
+		}
+	}
+
+	if len(waived) > 0 {
+		slices.SortFunc(waived, func(a, b api.WaivedMigrationIssue) int {
+			return strings.Compare(a.Category+"/"+a.Id, b.Category+"/"+b.Id)
+		})
+		aggregates.WaivedIssues = &waived
+	}
+}
+
+// issuesOfCategory returns the migration issues listing the concerns of a category, nil for
+// the categories without a list.
+func issuesOfCategory(aggregates *api.VMs, category string) *[]api.MigrationIssue {
+	switch category {
+	case "Critical":
+		return &aggregates.NotMigratableReasons
+	case "Warning":
+		return &aggregates.MigrationWarnings
+	}
+	return nil
+}
+
+// decrementIssue decrements the count of the issue with the ID, removing it when no VM is left.
+func decrementIssue(issues *[]api.MigrationIssue, id string) {
+	i := slices.IndexFunc(*issues, func(issue api.MigrationIssue) bool { return issue.Id != nil && *issue.Id == id })
+	if i < 0 {
+		return
+	}
+	(*issues)[i].Count--
+	if (*issues)[i].Count <= 0 {
+		*issues = slices.Delete(*issues, i, i+1)
+	}
+}
+
+func decrementBreakdown(breakdown *api.IssuesBreakdown, category string) {
+	var count *int
+	switch category {
+	case "Critical":
+		count = &breakdown.Critical
+	case "Warning":
+		count = &breakdown.Warning
+	case "Information":
+		count = &breakdown.Information
+	case "Advisory":
+		count = &breakdown.Advisory
+	case "Error":
+		count = &breakdown.Error
+	default:
+		return
+	}
+	*count = max(*count-1, 0)
+}
@@ -0,0 +1,194 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("concern waivers", func() {
+	var (
+		mockStore     *MockStore
+		assessmentSrv *service.AssessmentService
+		ctx           context.Context
+		assessmentID  uuid.UUID
+	)
+
+	critical := model.VMConcern{ID: "vm.critical", Label: "Critical issue", Category: "Critical", Assessment: "Fix it."}
+	cbt := model.VMConcern{ID: "vmware.changed_block_tracking.disabled", Label: "CBT disabled", Category: "Warning", Assessment: "Enable CBT."}
+
+	vmsData := func() api.VMs {
+		return api.VMs{
+			Total:                       3,
+			TotalMigratable:             1,
+			TotalMigratableWithWarnings: util.Ptr(0),
+			NotMigratableReasons:        []api.MigrationIssue{{Id: util.Ptr(critical.ID), Label: critical.Label, Assessment: critical.Assessment, Count: 2}},
+			MigrationWarnings:           []api.MigrationIssue{{Id: util.Ptr(cbt.ID), Label: cbt.Label, Assessment: cbt.Assessment, Count: 2}},
+			IssuesBreakdown:             &api.IssuesBreakdown{Critical: 2, Warning: 2},
+		}
+	}
+
+	addSnapshot := func() {
+		inventory, err := json.Marshal(api.Inventory{
+			VcenterId: "vcenter",
+			Vcenter:   &api.InventoryData{Vms: vmsData()},
+			Clusters:  map[string]api.InventoryData{"cluster-1": {Vms: vmsData()}},
+		})
+		Expect(err).To(BeNil())
+		mockStore.assessments[assessmentID].Snapshots = []model.Snapshot{{ID: 7, Version: model.SnapshotVersionV2, Inventory: inventory}}
+	}
+
+	addWaiver := func(concernID string, vmIDs ...string) {
+		_, err := assessmentSrv.CreateConcernWaiver(ctx, assessmentID, service.ConcernWaiverForm{
+			ConcernID:     concernID,
+			VMIDs:         vmIDs,
+			Justification: "accepted by the architects",
+		})
+		Expect(err).To(BeNil())
+	}
+
+	latestVMs := func() api.VMs {
+		assessment, err := assessmentSrv.GetAssessment(ctx, assessmentID)
+		Expect(err).To(BeNil())
+		var inventory api.Inventory
+		Expect(json.Unmarshal(assessment.Snapshots[0].Inventory, &inventory)).To(Succeed())
+		Expect(inventory.Clusters["cluster-1"].Vms).To(Equal(inventory.Vcenter.Vms))
+		return inventory.Vcenter.Vms
+	}
+
+	BeforeEach(func() {
+		mockStore = NewMockStore()
+		assessmentSrv = service.NewAssessmentService(mockStore, nil, nil)
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "architect", Organization: "org"})
+		assessmentID = uuid.New()
+		mockStore.assessments[assessmentID] = &model.Assessment{ID: assessmentID}
+		addSnapshot()
+	})
+
+	Context("with per-VM records", func() {
+		BeforeEach(func() {
+			mockStore.vms = model.AssessmentVMList{
+				{SnapshotID: 7, VMID: "vm-1", AssessmentID: assessmentID, ClusterID: "cluster-1", Concerns: model.JSONField[[]model.VMConcern]{Data: []model.VMConcern{critical, cbt}}},
+				{SnapshotID: 7, VMID: "vm-2", AssessmentID: assessmentID, ClusterID: "cluster-1", Concerns: model.JSONField[[]model.VMConcern]{Data: []model.VMConcern{critical, cbt}}},
+				{SnapshotID: 7, VMID: "vm-3", AssessmentID: assessmentID, ClusterID: "cluster-1", Concerns: model.JSONField[[]model.VMConcern]{Data: []model.VMConcern{}}},
+			}
+		})
+
+		It("leaves the aggregates unchanged without waivers", func() {
+			Expect(latestVMs()).To(Equal(vmsData()))
+		})
+
+		It("recomputes the aggregates with the waived concerns shown separately", func() {
+			addWaiver(cbt.ID)
+			addWaiver(critical.ID, "vm-1")
+
+			vms := latestVMs()
+			Expect(vms.TotalMigratable).To(Equal(2))
+			Expect(*vms.TotalMigratableWithWarnings).To(Equal(0))
+			Expect(vms.NotMigratableReasons).To(HaveLen(1))
+			Expect(vms.NotMigratableReasons[0].Count).To(Equal(1))
+			Expect(vms.MigrationWarnings).To(BeEmpty())
+			Expect(*vms.IssuesBreakdown).To(Equal(api.IssuesBreakdown{Critical: 1}))
+			Expect(*vms.WaivedIssues).To(Equal([]api.WaivedMigrationIssue{
+				{Id: critical.ID, Label: critical.Label, Assessment: &critical.Assessment, Category: "Critical", Count: 1},
+				{Id: cbt.ID, Label: cbt.Label, Assessment: &cbt.Assessment, Category: "Warning", Count: 2},
+			}))
+		})
+
+		It("counts a VM left with a warning as migratable with warnings", func() {
+			addWaiver(critical.ID, "vm-1")
+
+			vms := latestVMs()
+			Expect(vms.TotalMigratable).To(Equal(2))
+			Expect(*vms.TotalMigratableWithWarnings).To(Equal(1))
+			Expect(*vms.IssuesBreakdown).To(Equal(api.IssuesBreakdown{Critical: 1, Warning: 2}))
+		})
+
+		It("keeps the counts of the VMs still critical when their warnings are waived", func() {
+			addWaiver(cbt.ID)
+
+			vms := latestVMs()
+			Expect(vms.TotalMigratable).To(Equal(1))
+			Expect(*vms.TotalMigratableWithWarnings).To(Equal(0))
+			Expect(vms.MigrationWarnings).To(BeEmpty())
+		})
+
+		It("ignores the expired waivers", func() {
+			addWaiver(cbt.ID)
+			mockStore.waivers[0].ExpiresAt = util.Ptr(time.Now().Add(-time.Minute))
+
+			Expect(latestVMs()).To(Equal(vmsData()))
+		})
+
+		It("stops applying a deleted waiver", func() {
+			addWaiver(cbt.ID)
+			Expect(assessmentSrv.DeleteConcernWaiver(ctx, assessmentID, mockStore.waivers[0].ID)).To(Succeed())
+
+			Expect(latestVMs()).To(Equal(vmsData()))
+		})
+	})
+
+	Context("without per-VM records", func() {
+		It("moves the issues waived for all the VMs and keeps the counts", func() {
+			addWaiver(cbt.ID)
+			addWaiver(critical.ID, "vm-1")
+
+			vms := latestVMs()
+			expected := vmsData()
+			expected.MigrationWarnings = []api.MigrationIssue{}
+			expected.WaivedIssues = &[]api.WaivedMigrationIssue{
+				{Id: cbt.ID, Label: cbt.Label, Assessment: &cbt.Assessment, Category: "Warning", Count: 2},
+			}
+			Expect(vms).To(Equal(expected))
+		})
+	})
+
+	It("records the author of a waiver", func() {
+		waiver, err := assessmentSrv.CreateConcernWaiver(ctx, assessmentID, service.ConcernWaiverForm{
+			ConcernID:     cbt.ID,
+			VMIDs:         []string{"vm-2", "vm-1", "vm-2"},
+			Justification: "  accepted  ",
+			ExpiresAt:     util.Ptr(time.Now().Add(time.Hour)),
+		})
+		Expect(err).To(BeNil())
+		Expect(waiver.CreatedBy).To(Equal("architect"))
+		Expect(waiver.Justification).To(Equal("accepted"))
+		Expect(waiver.VMIDs).To(Equal(model.StringArray{"vm-1", "vm-2"}))
+
+		waivers, err := assessmentSrv.ListConcernWaivers(ctx, assessmentID)
+		Expect(err).To(BeNil())
+		Expect(waivers).To(HaveLen(1))
+	})
+
+	DescribeTable("rejects invalid waivers",
+		func(form service.ConcernWaiverForm) {
+			_, err := assessmentSrv.CreateConcernWaiver(ctx, assessmentID, form)
+			Expect(err).NotTo(BeNil())
+			_, ok := err.(*service.ErrInvalidRequest)
+			Expect(ok).To(BeTrue())
+		},
+		Entry("no concern", service.ConcernWaiverForm{Justification: "accepted"}),
+		Entry("no justification", service.ConcernWaiverForm{ConcernID: cbt.ID, Justification: " "}),
+		Entry("empty VM ID", service.ConcernWaiverForm{ConcernID: cbt.ID, Justification: "accepted", VMIDs: []string{""}}),
+		Entry("expiration in the past", service.ConcernWaiverForm{ConcernID: cbt.ID, Justification: "accepted", ExpiresAt: util.Ptr(time.Now().Add(-time.Hour))}),
+	)
+
+	It("returns not found for an unknown assessment or waiver", func() {
+		_, err := assessmentSrv.ListConcernWaivers(ctx, uuid.New())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+
+		err = assessmentSrv.DeleteConcernWaiver(ctx, assessmentID, uuid.New())
+		_, ok = err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+	})
+})
@@ -83,6 +83,16 @@ func NewErrClusterNotFound(clusterID string, assessmentID uuid.UUID) *ErrResourc
 	return &ErrResourceNotFound{fmt.Errorf("cluster %s not found in assessment %s", clusterID, assessmentID)}
 }
 
+func NewErrSnapshotNotFound(snapshotID uint, assessmentID uuid.UUID) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("snapshot %d not found in assessment %s", snapshotID, assessmentID)}
+}
+
+// NewErrSnapshotHasNoVMRecords reports a snapshot without per-VM records: only the snapshots
+// ingested from RVTools files have them, agent and inventory snapshots only keep aggregates.
+func NewErrSnapshotHasNoVMRecords(snapshotID uint, assessmentID uuid.UUID) *ErrResourceNotFound {
+	return &ErrResourceNotFound{fmt.Errorf("snapshot %d of assessment %s has no per-VM records, only RVTools assessments carry them", snapshotID, assessmentID)}
+}
+
 func NewErrClusterRequirementsNotFound(clusterID string, assessmentID uuid.UUID) *ErrResourceNotFound {
 	return &ErrResourceNotFound{fmt.Errorf("no cluster requirements input found for cluster %s in assessment %s", clusterID, assessmentID)}
 }
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -16,7 +15,9 @@ import (
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 	"github.com/kubev2v/migration-planner/pkg/log"
+	"github.com/kubev2v/migration-planner/pkg/opa"
 )
 
 // MigrationComplexityResult holds the output of a complexity estimation run.
@@ -26,6 +27,10 @@ type MigrationComplexityResult struct {
 	ComplexityByOSName []complexity.OSNameEntry         // one entry per distinct OS name
 	DiskSizeRatings    map[string]complexity.Score      // static tier label → score lookup
 	OSRatings          map[string]complexity.Score      // per-inventory OS name → score
+
+	ComplexityByApplication []complexity.ApplicationEntry // one entry per detected application
+
+	ComplexityTableVersion string // version of the complexity tables scoring the breakdowns
 }
 
 // MigrationAssessmentResult represents the result of a migration assessment calculation
@@ -33,39 +38,69 @@ type MigrationAssessmentResult struct {
 	MinTotalDuration time.Duration
 	MaxTotalDuration time.Duration
 	Breakdown        map[string]estimation.Estimation
+	Phases           []string // breakdown keys in calculator execution order
+	StreamedPhases   []string // phases split across parallel migration streams
 }
 
 type EstimationServicer interface {
-	CalculateMigrationEstimation(ctx context.Context, assessmentID uuid.UUID, clusterID string, schemas []engines.Schema, userParams []estimation.Param) (map[engines.Schema]*MigrationAssessmentResult, error)
-	CalculateMigrationComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string) (*MigrationComplexityResult, error)
-	CalculateOsDiskComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string) (*OsDiskComplexityResult, error)
+	CalculateMigrationEstimation(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, schemas []engines.Schema, userParams []estimation.Param) (map[engines.Schema]*MigrationAssessmentResult, error)
+	CalculateMigrationComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, complexityTableVersion *string) (*MigrationComplexityResult, error)
+	CalculateOsDiskComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, complexityTableVersion *string) (*OsDiskComplexityResult, error)
 	ValidateParams(userParams []estimation.Param) error
 	BuildBaseParams(userParams []estimation.Param) []estimation.Param
-	BuildBucketParams(baseParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param
+	BuildBucketParams(userParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param
 	RunEstimation(schemas []engines.Schema, params []estimation.Param) (map[engines.Schema]*MigrationAssessmentResult, error)
+	ListEstimationSchemas() []engines.SchemaDefinition
+	ProjectTimelines(results map[engines.Schema]*MigrationAssessmentResult, userParams []estimation.Param, cal timeline.Calendar) (map[engines.Schema]*timeline.Timeline, error)
+	PlanMigrationWaves(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, schema engines.Schema, userParams []estimation.Param, constraints WaveConstraints) (*WavePlan, error)
+	SimulateMigrationEstimation(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, schemas []engines.Schema, userParams []estimation.Param, settings SimulationSettings) (map[engines.Schema]*estimation.SimulationResult, error)
 }
 
 // EstimationService orchestrates the migration time estimation workflow.
 // It retrieves assessment and inventory data from the store and runs them
 // through the estimation Engine to produce a MigrationAssessmentResult.
 type EstimationService struct {
-	store  store.Store
-	logger *log.StructuredLogger
+	store        store.Store
+	registry     *engines.Registry
+	opaValidator *opa.Validator
+	logger       *log.StructuredLogger
 }
 
-// NewEstimationService creates an EstimationService.
+// NewEstimationService creates an EstimationService running the built-in estimation schemas.
 func NewEstimationService(store store.Store) *EstimationService {
 	return &EstimationService{
-		store:  store,
-		logger: log.NewDebugLogger("estimation_service"),
+		store:    store,
+		registry: engines.DefaultRegistry(),
+		logger:   log.NewDebugLogger("estimation_service"),
 	}
 }
 
-// CalculateMigrationEstimation calculates migration time estimation for a given assessment and cluster
+// WithSchemaRegistry replaces the estimation schemas run by the service, e.g. with the
+// schemas loaded from the configuration file.
+func (es *EstimationService) WithSchemaRegistry(registry *engines.Registry) *EstimationService {
+	es.registry = registry
+	return es
+}
+
+// WithOpaValidator sets the validator whose remediation catalog gives the remediation effort of
+// the migration issues, see calculators.ParamRemediationHours.
+func (es *EstimationService) WithOpaValidator(validator *opa.Validator) *EstimationService {
+	es.opaValidator = validator
+	return es
+}
+
+// ListEstimationSchemas returns the estimation schemas that can be requested, in registration order.
+func (es *EstimationService) ListEstimationSchemas() []engines.SchemaDefinition {
+	return es.registry.Schemas()
+}
+
+// CalculateMigrationEstimation calculates migration time estimation for a given assessment and cluster.
+// When snapshotID is nil the latest snapshot is used.
 func (es *EstimationService) CalculateMigrationEstimation(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	snapshotID *uint,
 	schemas []engines.Schema,
 	userParams []estimation.Param,
 ) (map[engines.Schema]*MigrationAssessmentResult, error) {
@@ -73,6 +108,7 @@ func (es *EstimationService) CalculateMigrationEstimation(
 	tracer := logger.Operation("calculate_migration_estimation").
 		WithUUID("assessment_id", assessmentID).
 		WithString("cluster_id", clusterID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
 		Build()
 
 	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
@@ -85,41 +121,20 @@ func (es *EstimationService) CalculateMigrationEstimation(
 		return nil, fmt.Errorf("failed to get assessment: %w", err)
 	}
 
-	if len(assessment.Snapshots) == 0 {
-		err := fmt.Errorf("assessment has no snapshots")
-		tracer.Error(err).Log()
-		return nil, err
-	}
-
-	// assuming each assessment has one snapshot at most
-	latestSnapshot := assessment.Snapshots[0]
-	if len(latestSnapshot.Inventory) == 0 {
-		err := fmt.Errorf("latest snapshot has empty inventory")
-		tracer.Error(err).Log()
-		return nil, err
-	}
-
-	var inventory api.Inventory
-	if err := json.Unmarshal(latestSnapshot.Inventory, &inventory); err != nil {
-		tracer.Error(err).Log()
-		return nil, fmt.Errorf("failed to parse inventory: %w", err)
-	}
-
-	if len(inventory.Clusters) == 0 {
-		err := fmt.Errorf("inventory has no clusters")
+	// Waived issues are not remediated
+	if err := applyConcernWaivers(ctx, es.store, assessmentID, assessment.Snapshots); err != nil {
 		tracer.Error(err).Log()
 		return nil, err
 	}
 
-	clusterInventory, exists := inventory.Clusters[clusterID]
-	if !exists {
-		err := NewErrClusterNotFound(clusterID, assessmentID)
+	clusterInventory, err := clusterInventoryFromAssessment(assessment, snapshotID, clusterID)
+	if err != nil {
 		tracer.Error(err).Log()
 		return nil, err
 	}
 
+	// Defaults are not merged in: each calculator falls back to the defaults of its schema.
 	params := mergeParams(
-		defaultParams(),
 		es.mapClusterToParams(clusterInventory),
 		userParams,
 	)
@@ -139,16 +154,20 @@ func (es *EstimationService) CalculateMigrationEstimation(
 }
 
 // CalculateMigrationComplexity calculates OS and disk complexity breakdowns
-// for the given cluster within the assessment's inventory.
+// for the given cluster within the assessment's inventory. The breakdowns are scored with the
+// given version of the complexity tables, or with the version the assessment was scored with.
 func (es *EstimationService) CalculateMigrationComplexity(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	snapshotID *uint,
+	complexityTableVersion *string,
 ) (*MigrationComplexityResult, error) {
 	logger := es.logger.WithContext(ctx)
 	tracer := logger.Operation("calculate_migration_complexity").
 		WithUUID("assessment_id", assessmentID).
 		WithString("cluster_id", clusterID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
 		Build()
 
 	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
@@ -161,50 +180,30 @@ func (es *EstimationService) CalculateMigrationComplexity(
 		return nil, fmt.Errorf("failed to get assessment: %w", err)
 	}
 
-	if len(assessment.Snapshots) == 0 {
-		err := fmt.Errorf("assessment has no snapshots")
-		tracer.Error(err).Log()
-		return nil, err
-	}
-
-	latestSnapshot := assessment.Snapshots[0]
-	if len(latestSnapshot.Inventory) == 0 {
-		err := fmt.Errorf("latest snapshot has empty inventory")
-		tracer.Error(err).Log()
-		return nil, err
-	}
-
-	var inventory api.Inventory
-	if err := json.Unmarshal(latestSnapshot.Inventory, &inventory); err != nil {
-		tracer.Error(err).Log()
-		return nil, fmt.Errorf("failed to parse inventory: %w", err)
-	}
-
-	if len(inventory.Clusters) == 0 {
-		err := fmt.Errorf("inventory has no clusters")
+	clusterInventory, err := clusterInventoryFromAssessment(assessment, snapshotID, clusterID)
+	if err != nil {
 		tracer.Error(err).Log()
 		return nil, err
 	}
 
-	clusterInventory, exists := inventory.Clusters[clusterID]
-	if !exists {
-		err := NewErrClusterNotFound(clusterID, assessmentID)
+	table, err := complexityTableForAssessment(ctx, es.store, assessment, complexityTableVersion)
+	if err != nil {
 		tracer.Error(err).Log()
 		return nil, err
 	}
 
-	result, err := es.buildComplexityResult(clusterInventory)
+	result, err := es.buildComplexityResult(clusterInventory, table)
 	if err != nil {
 		tracer.Error(err).Log()
 		return nil, err
 	}
 
-	tracer.Success().Log()
+	tracer.Success().WithString("complexity_table_version", table.Version).Log()
 	return result, nil
 }
 
-// buildComplexityResult converts cluster inventory data into complexity breakdowns.
-func (es *EstimationService) buildComplexityResult(clusterInventory api.InventoryData) (*MigrationComplexityResult, error) {
+// buildComplexityResult converts cluster inventory data into complexity breakdowns scored with the table.
+func (es *EstimationService) buildComplexityResult(clusterInventory api.InventoryData, table complexity.Table) (*MigrationComplexityResult, error) {
 	if clusterInventory.Vms.OsInfo == nil {
 		return nil, fmt.Errorf("inventory has no osInfo data")
 	}
@@ -232,12 +231,23 @@ func (es *EstimationService) buildComplexityResult(clusterInventory api.Inventor
 		osEntries = append(osEntries, complexity.VMOsEntry{Name: osName, Count: info.Count})
 	}
 
+	// Inventories built before application detection, or from sources without guest
+	// applications, have no applications.
+	var appEntries []complexity.VMApplicationEntry
+	if clusterInventory.Vms.Applications != nil {
+		for id, count := range *clusterInventory.Vms.Applications {
+			appEntries = append(appEntries, complexity.VMApplicationEntry{ID: id, Count: count})
+		}
+	}
+
 	return &MigrationComplexityResult{
-		ComplexityByOS:     complexity.OSBreakdown(osEntries),
-		ComplexityByOSName: complexity.OSNameBreakdown(osEntries),
-		ComplexityByDisk:   complexity.DiskBreakdown(diskEntries),
-		DiskSizeRatings:    complexity.DiskSizeRangeRatings(),
-		OSRatings:          complexity.OSRatings(osEntries),
+		ComplexityByOS:          table.OSBreakdown(osEntries),
+		ComplexityByOSName:      table.OSNameBreakdown(osEntries),
+		ComplexityByDisk:        table.DiskBreakdown(diskEntries),
+		ComplexityByApplication: complexity.ApplicationBreakdown(appEntries),
+		DiskSizeRatings:         table.DiskSizeRangeRatings(),
+		OSRatings:               table.OSRatings(osEntries),
+		ComplexityTableVersion:  table.Version,
 	}, nil
 }
 
@@ -279,7 +289,7 @@ type ParamDefinition struct {
 	Min         *float64         // inclusive lower bound, nil if unbounded
 	Max         *float64         // inclusive upper bound, nil if unbounded
 	Default     any              // value used when neither inventory nor user supplies this key
-	Schemas     []engines.Schema // schemas that use this parameter; nil means all schemas
+	Schemas     []engines.Schema // built-in schemas that use this parameter; nil means all of them
 }
 
 // estimationParamDefs is the authoritative list of user-overridable calculator parameters.
@@ -289,6 +299,8 @@ var estimationParamDefs = func() []ParamDefinition {
 	minHours := 0.5
 	minMins := 1.0
 	minEngineers := 1.0
+	minVMsPerWindow := 1.0
+	minRemediationHours := 0.0
 	return []ParamDefinition{
 		{
 			Key:         calculators.ParamTransferRateMbps,
@@ -323,6 +335,42 @@ var estimationParamDefs = func() []ParamDefinition {
 			Min:         &minEngineers,
 			Default:     calculators.DefaultEngineerCount,
 		},
+		{
+			Key:         calculators.ParamVMsPerChangeWindow,
+			DisplayName: "VMs per Change Window",
+			Type:        "integer",
+			Unit:        "",
+			Min:         &minVMsPerWindow,
+			Default:     calculators.DefaultVMsPerChangeWindow,
+			Schemas:     []engines.Schema{},
+		},
+		{
+			Key:         calculators.ParamChangeWindowHours,
+			DisplayName: "Change Window Length",
+			Type:        "number",
+			Unit:        "hours",
+			Min:         &minHours,
+			Default:     calculators.DefaultChangeWindowHours,
+			Schemas:     []engines.Schema{},
+		},
+		{
+			Key:         calculators.ParamRemediationHours,
+			DisplayName: "Issue Remediation Effort",
+			Type:        "number",
+			Unit:        "hours",
+			Min:         &minRemediationHours,
+			Default:     0.0,
+			Schemas:     []engines.Schema{},
+		},
+		{
+			Key:         calculators.ParamRemediationEngineers,
+			DisplayName: "Remediation Engineers",
+			Type:        "integer",
+			Unit:        "",
+			Min:         &minEngineers,
+			Default:     calculators.DefaultRemediationEngineers,
+			Schemas:     []engines.Schema{},
+		},
 	}
 }()
 
@@ -353,28 +401,62 @@ func mergeParams(layers ...[]estimation.Param) []estimation.Param {
 
 // mapClusterToParams converts cluster inventory data to estimation parameters
 func (es *EstimationService) mapClusterToParams(clusterInventory api.InventoryData) []estimation.Param {
-	return []estimation.Param{
+	params := []estimation.Param{
 		{Key: calculators.ParamTotalDiskGB, Value: float64(clusterInventory.Vms.DiskGB.Total)},
 		{Key: calculators.ParamVMCount, Value: clusterInventory.Vms.Total},
 	}
+	if es.opaValidator != nil {
+		params = append(params, estimation.Param{
+			Key:   calculators.ParamRemediationHours,
+			Value: remediationHours(clusterInventory.Vms, es.opaValidator.Remediations()),
+		})
+	}
+	return params
+}
+
+// remediationHours returns the effort to resolve the migration issues of the VMs: the effort per
+// VM of the remediation of each issue times the number of VMs having it. Issues without a
+// remediation in the catalog take no effort.
+func remediationHours(vms api.VMs, catalog *opa.RemediationCatalog) float64 {
+	var hours float64
+	for _, issues := range [][]api.MigrationIssue{vms.NotMigratableReasons, vms.MigrationWarnings} {
+		for _, issue := range issues {
+			if issue.Id == nil {
+				continue
+			}
+			if r, found := catalog.Lookup(*issue.Id); found {
+				hours += r.EffortHoursPerVM * float64(issue.Count)
+			}
+		}
+	}
+	return hours
 }
 
 // OsDiskComplexityResult holds the OsDisk complexity buckets for one cluster.
 type OsDiskComplexityResult struct {
-	Buckets []complexity.OSDiskEntry
+	Buckets                []complexity.OSDiskEntry
+	ComplexityTableVersion string
 }
 
 // CalculateOsDiskComplexity fetches the cluster inventory and returns the
 // combined OS+Disk complexity distribution. Used by the by-complexity handler.
+//
+// The distribution of the inventory is scored when the inventory is built. Scoring it with another
+// version of the complexity tables recomputes it from the VMs of the snapshot, so only snapshots
+// with VM records can be rescored. Without VM records, the recorded version of the assessment falls
+// back to the distribution of the inventory, and the result reports the version it was scored with.
 func (es *EstimationService) CalculateOsDiskComplexity(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	snapshotID *uint,
+	complexityTableVersion *string,
 ) (*OsDiskComplexityResult, error) {
 	logger := es.logger.WithContext(ctx)
 	tracer := logger.Operation("calculate_osdisk_complexity").
 		WithUUID("assessment_id", assessmentID).
 		WithString("cluster_id", clusterID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
 		Build()
 
 	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
@@ -386,29 +468,79 @@ func (es *EstimationService) CalculateOsDiskComplexity(
 		tracer.Error(err).Log()
 		return nil, fmt.Errorf("failed to get assessment: %w", err)
 	}
-	if len(assessment.Snapshots) == 0 {
-		return nil, fmt.Errorf("assessment has no snapshots")
+	clusterInventory, err := clusterInventoryFromAssessment(assessment, snapshotID, clusterID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
 	}
-	latestSnapshot := assessment.Snapshots[0]
-	if len(latestSnapshot.Inventory) == 0 {
-		return nil, fmt.Errorf("latest snapshot has empty inventory")
+
+	table, err := complexityTableForAssessment(ctx, es.store, assessment, complexityTableVersion)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
 	}
 
-	var inventory api.Inventory
-	if err := json.Unmarshal(latestSnapshot.Inventory, &inventory); err != nil {
-		return nil, fmt.Errorf("failed to parse inventory: %w", err)
+	// Only RVTools inventories are built by the planner, with the tables of the organization
+	scoredWith := complexity.DefaultTableVersion
+	if assessment.SourceType == SourceTypeRvtools && assessment.ComplexityTableVersion != "" {
+		scoredWith = assessment.ComplexityTableVersion
 	}
-	if len(inventory.Clusters) == 0 {
-		return nil, fmt.Errorf("inventory has no clusters")
+
+	var buckets []complexity.OSDiskEntry
+	if table.Version != scoredWith {
+		snapshot, err := selectSnapshot(assessment, snapshotID)
+		if err != nil {
+			tracer.Error(err).Log()
+			return nil, err
+		}
+		buckets, err = es.scoreOsDiskComplexity(ctx, snapshot.ID, clusterID, table)
+		if err != nil {
+			tracer.Error(err).Log()
+			return nil, err
+		}
+		if buckets == nil && complexityTableVersion != nil {
+			err := NewErrInvalidRequest(fmt.Sprintf(
+				"complexity table %s: the snapshot has no VM records to rescore, only the tables it was scored with can be used", table.Version))
+			tracer.Error(err).Log()
+			return nil, err
+		}
 	}
-	clusterInventory, exists := inventory.Clusters[clusterID]
-	if !exists {
-		return nil, NewErrClusterNotFound(clusterID, assessmentID)
+	if buckets == nil {
+		buckets = buildComplexityByOsDisk(clusterInventory.Vms.ComplexityDistribution)
+		table.Version = scoredWith
 	}
 
-	buckets := buildComplexityByOsDisk(clusterInventory.Vms.ComplexityDistribution)
-	tracer.Success().Log()
-	return &OsDiskComplexityResult{Buckets: buckets}, nil
+	tracer.Success().WithString("complexity_table_version", table.Version).Log()
+	return &OsDiskComplexityResult{Buckets: buckets, ComplexityTableVersion: table.Version}, nil
+}
+
+// scoreOsDiskComplexity scores the VMs of the cluster recorded for the snapshot with the table and
+// returns the combined OS+Disk distribution in canonical score order (0–4), or nil if no VM of the
+// cluster is recorded. Like the ingestion, VMs excluded from the migration are not scored.
+func (es *EstimationService) scoreOsDiskComplexity(ctx context.Context, snapshotID uint, clusterID string, table complexity.Table) ([]complexity.OSDiskEntry, error) {
+	filter := store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByCluster(clusterID)
+	vms, err := es.store.AssessmentVM().List(ctx, filter, store.NewAssessmentVMQueryOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VMs: %w", err)
+	}
+	if len(vms) == 0 {
+		return nil, nil
+	}
+
+	result := make([]complexity.OSDiskEntry, len(complexity.OSScores))
+	for i, s := range complexity.OSScores {
+		result[i].Score = s
+	}
+	for _, vm := range vms {
+		if vm.MigrationExcluded {
+			continue
+		}
+		diskTB := float64(vm.DiskGB) / 1024
+		score := table.ScoreVM(vm.OS, diskTB)
+		result[score].VMCount++
+		result[score].TotalSizeTB += diskTB
+	}
+	return result, nil
 }
 
 // EstimationContext carries the parameters used to compute per-bucket estimates.
@@ -418,15 +550,16 @@ type EstimationContext struct {
 }
 
 // BuildBaseParams returns the merged base params (defaults + user overrides),
-// without any per-bucket inventory values.
+// without any per-bucket inventory values. The result describes the estimation context;
+// estimations run on the user params only, so that schema defaults apply.
 func (es *EstimationService) BuildBaseParams(userParams []estimation.Param) []estimation.Param {
 	return mergeParams(defaultParams(), userParams)
 }
 
-// BuildBucketParams returns params for a single complexity bucket.
+// BuildBucketParams returns params for a single complexity bucket, on top of the given user params.
 // diskGB = bucket.TotalSizeTB * 1024 (TiB → GiB; matches DiskGB.Total unit in the regular path).
-func (es *EstimationService) BuildBucketParams(baseParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param {
-	return mergeParams(baseParams, []estimation.Param{
+func (es *EstimationService) BuildBucketParams(userParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param {
+	return mergeParams(userParams, []estimation.Param{
 		{Key: calculators.ParamVMCount, Value: vmCount},
 		{Key: calculators.ParamTotalDiskGB, Value: diskGB},
 	})
@@ -483,9 +616,9 @@ func toFloat64(v any) (float64, error) {
 
 // RunEstimation builds engines from schemas and runs them with the provided
 // fully-merged params. Performs no store access.
-// When schemas is empty, engines.BuildEngines uses all known schemas.
+// When schemas is empty, all registered schemas are used.
 func (es *EstimationService) RunEstimation(schemas []engines.Schema, params []estimation.Param) (map[engines.Schema]*MigrationAssessmentResult, error) {
-	engineMap, err := engines.BuildEngines(schemas)
+	engineMap, err := es.registry.BuildEngines(schemas)
 	if err != nil {
 		return nil, &ErrInvalidSchema{Msg: err.Error()}
 	}
@@ -506,7 +639,40 @@ func (es *EstimationService) RunEstimation(schemas []engines.Schema, params []es
 			MinTotalDuration: minTotal,
 			MaxTotalDuration: maxTotal,
 			Breakdown:        breakdown,
+			Phases:           engine.Names(),
+			StreamedPhases:   engine.StreamedNames(),
 		}
 	}
 	return results, nil
 }
+
+// ProjectTimelines places the phases of each schema result on the calendar. When the calendar does not
+// set the work hours per day, the work_hours_per_day param applies, as for post-migration checks.
+// Invalid calendars return ErrInvalidRequest.
+func (es *EstimationService) ProjectTimelines(
+	results map[engines.Schema]*MigrationAssessmentResult,
+	userParams []estimation.Param,
+	cal timeline.Calendar,
+) (map[engines.Schema]*timeline.Timeline, error) {
+	if cal.WorkHoursPerDay == 0 {
+		cal.WorkHoursPerDay = calculators.DefaultWorkHoursPerDay
+		for _, p := range userParams {
+			if p.Key != calculators.ParamWorkHoursPerDay {
+				continue
+			}
+			if v, err := toFloat64(p.Value); err == nil {
+				cal.WorkHoursPerDay = v
+			}
+		}
+	}
+
+	timelines := make(map[engines.Schema]*timeline.Timeline, len(results))
+	for schema, result := range results {
+		tl, err := timeline.Project(cal, result.Phases, result.StreamedPhases, result.Breakdown)
+		if err != nil {
+			return nil, NewErrInvalidRequest(err.Error())
+		}
+		timelines[schema] = tl
+	}
+	return timelines, nil
+}
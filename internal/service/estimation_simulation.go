@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// DefaultSimulationRuns is the number of runs of a simulation when none is requested.
+	DefaultSimulationRuns = 1000
+	// MaxSimulationRuns bounds the runs of a simulation, which are computed synchronously.
+	MaxSimulationRuns = 100000
+)
+
+// SimulationSettings configures a Monte Carlo estimation. Distributions are keyed by param
+// key and take precedence over the value of the same param, if any.
+type SimulationSettings struct {
+	Runs          int    // DefaultSimulationRuns when zero
+	Seed          uint64 // the same seed always yields the same result
+	Distributions map[string]estimation.Distribution
+}
+
+func (s SimulationSettings) validate() error {
+	if s.Runs < 0 || s.Runs > MaxSimulationRuns {
+		return NewErrInvalidRequest(fmt.Sprintf("simulation runs must be between 1 and %d", MaxSimulationRuns))
+	}
+	defs := make(map[string]ParamDefinition, len(estimationParamDefs))
+	for _, d := range estimationParamDefs {
+		defs[d.Key] = d
+	}
+	for key, dist := range s.Distributions {
+		def, ok := defs[key]
+		if !ok {
+			return &ErrInvalidEstimationParam{Msg: fmt.Sprintf("unknown param key %q", key)}
+		}
+		if def.Type != "number" {
+			return &ErrInvalidEstimationParam{Msg: fmt.Sprintf("param %q: distributions are only supported for number params", key)}
+		}
+		if err := dist.Validate(); err != nil {
+			return &ErrInvalidEstimationParam{Msg: fmt.Sprintf("param %q: %v", key, err)}
+		}
+		if def.Min != nil && dist.Lower() < *def.Min {
+			return &ErrInvalidEstimationParam{Msg: fmt.Sprintf("param %q: distribution lower bound %v is below minimum %v", key, dist.Lower(), *def.Min)}
+		}
+	}
+	return nil
+}
+
+// SimulateMigrationEstimation runs a Monte Carlo estimation for a given assessment and cluster:
+// each schema is run settings.Runs times, sampling the params that have a distribution.
+// When snapshotID is nil the latest snapshot is used.
+func (es *EstimationService) SimulateMigrationEstimation(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	clusterID string,
+	snapshotID *uint,
+	schemas []engines.Schema,
+	userParams []estimation.Param,
+	settings SimulationSettings,
+) (map[engines.Schema]*estimation.SimulationResult, error) {
+	logger := es.logger.WithContext(ctx)
+	tracer := logger.Operation("simulate_migration_estimation").
+		WithUUID("assessment_id", assessmentID).
+		WithString("cluster_id", clusterID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
+		Build()
+
+	if err := settings.validate(); err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+	if settings.Runs == 0 {
+		settings.Runs = DefaultSimulationRuns
+	}
+
+	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			tracer.Error(err).Log()
+			return nil, NewErrAssessmentNotFound(assessmentID)
+		}
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	// Waived issues are not remediated
+	if err := applyConcernWaivers(ctx, es.store, assessmentID, assessment.Snapshots); err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+
+	clusterInventory, err := clusterInventoryFromAssessment(assessment, snapshotID, clusterID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+
+	engineMap, err := es.registry.BuildEngines(schemas)
+	if err != nil {
+		return nil, &ErrInvalidSchema{Msg: err.Error()}
+	}
+
+	params := mergeParams(es.mapClusterToParams(clusterInventory), userParams)
+	results := make(map[engines.Schema]*estimation.SimulationResult, len(engineMap))
+	for schema, engine := range engineMap {
+		result, err := engine.Simulate(params, settings.Distributions, settings.Runs, settings.Seed)
+		if err != nil {
+			tracer.Error(err).Log()
+			return nil, NewErrInvalidRequest(err.Error())
+		}
+		results[schema] = result
+	}
+
+	tracer.Success().
+		WithInt("schema_count", len(results)).
+		WithInt("runs", settings.Runs).
+		Log()
+
+	return results, nil
+}
@@ -11,9 +11,13 @@ import (
 	"github.com/kubev2v/migration-planner/internal/service/eventwrap"
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 	"github.com/kubev2v/migration-planner/pkg/events/kafka"
+	"github.com/kubev2v/migration-planner/pkg/opa"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -241,7 +245,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result).NotTo(BeNil())
@@ -257,7 +261,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				// score 0: no unknown entries
@@ -285,7 +289,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, diskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				// DiskComplexityTier is set by createTestInventoryForComplexity with a fixed
 				// single entry: "0-10TiB" → score 1, VmCount 125, TotalSizeTB 8.5
@@ -303,7 +307,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				for i, entry := range result.ComplexityByOS {
@@ -316,7 +320,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				for i, entry := range result.ComplexityByDisk {
@@ -331,7 +335,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				// defaultOsInfo has 3 distinct OS names
@@ -343,7 +347,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				byName := map[string]int{}
@@ -360,7 +364,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				byName := map[string]int{}
@@ -380,7 +384,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(result.DiskSizeRatings).To(HaveLen(4))
@@ -395,7 +399,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(err).To(BeNil())
 				// defaultOsInfo has 3 distinct OS names
@@ -428,7 +432,7 @@ var _ = Describe("EstimationService", func() {
 				data, err := json.Marshal(inv)
 				Expect(err).ToNot(HaveOccurred())
 				mockStore.assessments[assessmentID] = createTestAssessmentFromRawInventory(assessmentID, testUsername, testOrgID, data)
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 				Expect(err).To(BeNil())
 				Expect(result.ComplexityByDisk).To(HaveLen(4))
 				// "Easy (0-10TB)" maps to score 1 — verify VmCount and TotalSizeTB flowed through
@@ -436,11 +440,43 @@ var _ = Describe("EstimationService", func() {
 				Expect(result.ComplexityByDisk[0].VMCount).To(Equal(50))
 				Expect(result.ComplexityByDisk[0].TotalSizeTB).To(Equal(3.0))
 			})
+
+			It("reports the business applications detected in the cluster", func() {
+				var inv api.Inventory
+				Expect(json.Unmarshal(createTestInventoryForComplexity(clusterID, defaultOsInfo, defaultDiskTier), &inv)).To(Succeed())
+				clusterData := inv.Clusters[clusterID]
+				clusterData.Vms.Applications = &map[string]int{"oracle-db": 2, "mssql": 5, "exchange-eos": 1}
+				inv.Clusters[clusterID] = clusterData
+				data, err := json.Marshal(inv)
+				Expect(err).ToNot(HaveOccurred())
+				mockStore.assessments[assessmentID] = createTestAssessmentFromRawInventory(assessmentID, testUsername, testOrgID, data)
+
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.ComplexityByApplication).To(Equal([]complexity.ApplicationEntry{
+					{ID: "mssql", Name: "Microsoft SQL Server", Score: 3, Approach: complexity.ApproachCoordinatedCutover, VMCount: 5},
+					{ID: "exchange-eos", Name: "Microsoft Exchange Server (end of support)", Score: 4, Approach: complexity.ApproachReplatform, VMCount: 1},
+					{ID: "oracle-db", Name: "Oracle Database", Score: 4, Approach: complexity.ApproachVendorValidated, VMCount: 2},
+				}))
+			})
+
+			It("returns an empty application breakdown when the inventory has no applications", func() {
+				mockStore.assessments[assessmentID] = createTestAssessmentForComplexity(
+					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
+				)
+
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
+
+				Expect(err).To(BeNil())
+				Expect(result.ComplexityByApplication).NotTo(BeNil())
+				Expect(result.ComplexityByApplication).To(BeEmpty())
+			})
 		})
 
 		Context("assessment not found", func() {
 			It("returns ErrResourceNotFound when assessment does not exist", func() {
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, uuid.New(), clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, uuid.New(), clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -451,7 +487,7 @@ var _ = Describe("EstimationService", func() {
 			It("returns error when store returns error", func() {
 				mockStore.getError = store.ErrRecordNotFound
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -467,7 +503,7 @@ var _ = Describe("EstimationService", func() {
 					Snapshots: []model.Snapshot{},
 				}
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -484,7 +520,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -498,7 +534,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, "other-cluster", defaultOsInfo, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, "non-existent-cluster")
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, "non-existent-cluster", nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -513,7 +549,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, nil, defaultDiskTier,
 				)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -540,7 +576,7 @@ var _ = Describe("EstimationService", func() {
 				Expect(err).ToNot(HaveOccurred())
 				mockStore.assessments[assessmentID] = createTestAssessmentFromRawInventory(assessmentID, testUsername, testOrgID, data)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -570,7 +606,7 @@ var _ = Describe("EstimationService", func() {
 				Expect(err).ToNot(HaveOccurred())
 				mockStore.assessments[assessmentID] = createTestAssessmentFromRawInventory(assessmentID, testUsername, testOrgID, data)
 
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 				Expect(result).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -587,7 +623,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(results).NotTo(BeNil())
@@ -606,7 +642,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 20, 2000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(results[engines.SchemaNetworkBased].Breakdown).To(HaveKey("Storage Migration"))
@@ -618,7 +654,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 
@@ -644,7 +680,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 15, 750,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 				for _, result := range results {
@@ -659,7 +695,7 @@ var _ = Describe("EstimationService", func() {
 			It("returns ErrResourceNotFound when assessment does not exist", func() {
 				nonExistentID := uuid.New()
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, nonExistentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, nonExistentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -670,7 +706,7 @@ var _ = Describe("EstimationService", func() {
 			It("returns error when store returns error", func() {
 				mockStore.getError = store.ErrRecordNotFound
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -688,7 +724,7 @@ var _ = Describe("EstimationService", func() {
 					Snapshots: []model.Snapshot{}, // Empty snapshots
 				}
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -713,7 +749,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -739,7 +775,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -768,7 +804,7 @@ var _ = Describe("EstimationService", func() {
 					},
 				}
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -782,7 +818,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, "different-cluster", 10, 1000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, "non-existent-cluster", nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, "non-existent-cluster", nil, nil, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -791,13 +827,64 @@ var _ = Describe("EstimationService", func() {
 			})
 		})
 
+		Context("snapshot selection", func() {
+			BeforeEach(func() {
+				assessment := createTestAssessmentForEstimation(
+					assessmentID, testUsername, testOrgID, clusterID, 100, 10000,
+				)
+				// Older snapshot goes last: the store returns snapshots newest first
+				assessment.Snapshots[0].ID = 2
+				assessment.Snapshots = append(assessment.Snapshots, model.Snapshot{
+					ID:           1,
+					CreatedAt:    time.Now().Add(-24 * time.Hour),
+					Inventory:    createTestInventoryForEstimation(clusterID, 10, 1000),
+					AssessmentID: assessmentID,
+					Version:      2,
+				})
+				mockStore.assessments[assessmentID] = assessment
+			})
+
+			It("uses the latest snapshot when snapshotID is nil", func() {
+				latest, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, []engines.Schema{engines.SchemaNetworkBased}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				snapshotID := uint(2)
+				explicit, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, &snapshotID, []engines.Schema{engines.SchemaNetworkBased}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(latest[engines.SchemaNetworkBased].MaxTotalDuration).To(Equal(explicit[engines.SchemaNetworkBased].MaxTotalDuration))
+			})
+
+			It("uses the requested snapshot when snapshotID is set", func() {
+				latest, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, []engines.Schema{engines.SchemaNetworkBased}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				snapshotID := uint(1)
+				older, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, &snapshotID, []engines.Schema{engines.SchemaNetworkBased}, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(older[engines.SchemaNetworkBased].MaxTotalDuration).To(BeNumerically("<", latest[engines.SchemaNetworkBased].MaxTotalDuration))
+			})
+
+			It("returns ErrResourceNotFound when the snapshot does not exist", func() {
+				snapshotID := uint(42)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, &snapshotID, nil, nil)
+
+				Expect(results).To(BeNil())
+				Expect(err).To(HaveOccurred())
+				_, ok := err.(*service.ErrResourceNotFound)
+				Expect(ok).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("snapshot 42"))
+			})
+		})
+
 		Context("invalid schema", func() {
 			It("returns ErrInvalidSchema when an unknown schema name is provided", func() {
 				mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
 					assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, []engines.Schema{"unknown-schema"}, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, []engines.Schema{"unknown-schema"}, nil)
 
 				Expect(results).To(BeNil())
 				Expect(err).NotTo(BeNil())
@@ -812,7 +899,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 0, 0,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(results).NotTo(BeNil())
@@ -827,7 +914,7 @@ var _ = Describe("EstimationService", func() {
 					assessmentID, testUsername, testOrgID, clusterID, 10000, 500000,
 				)
 
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
 
 				Expect(err).To(BeNil())
 				Expect(results).NotTo(BeNil())
@@ -843,14 +930,14 @@ var _ = Describe("EstimationService", func() {
 
 				// Run with default params (nil userParams)
 				defaultResults, err := estimationSrv.CalculateMigrationEstimation(
-					ctx, assessmentID, clusterID, []engines.Schema{engines.SchemaNetworkBased}, nil,
+					ctx, assessmentID, clusterID, nil, []engines.Schema{engines.SchemaNetworkBased}, nil,
 				)
 				Expect(err).NotTo(HaveOccurred())
 				defaultDuration := *defaultResults[engines.SchemaNetworkBased].Breakdown["Storage Migration"].Duration
 
 				// Run with a much faster transfer rate — duration must be shorter
 				fastResults, err := estimationSrv.CalculateMigrationEstimation(
-					ctx, assessmentID, clusterID, []engines.Schema{engines.SchemaNetworkBased},
+					ctx, assessmentID, clusterID, nil, []engines.Schema{engines.SchemaNetworkBased},
 					[]estimation.Param{{Key: "transfer_rate_mbps", Value: 10000.0}},
 				)
 				Expect(err).NotTo(HaveOccurred())
@@ -859,6 +946,70 @@ var _ = Describe("EstimationService", func() {
 				Expect(fastDuration).To(BeNumerically("<", defaultDuration))
 			})
 		})
+
+		Context("remediation hours", func() {
+			var remediationSrv service.EstimationServicer
+
+			BeforeEach(func() {
+				validator, err := opa.NewValidator(map[string]string{
+					"test.rego": "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns contains flag if {\n\tfalse\n\tflag := {}\n}\n",
+					opa.RemediationCatalogFile: `{"remediations": [
+						{"concernId": "test.cbt", "steps": ["Enable CBT."], "effortHoursPerVM": 0.5},
+						{"concernId": "test.rdm", "steps": ["Convert the RDM disks."], "effortHoursPerVM": 2}
+					]}`,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				registry, err := engines.NewRegistry(engines.SchemaDefinition{
+					Name: "remediate",
+					Calculators: []engines.CalculatorDefinition{
+						{Type: engines.CalculatorRemediation, Params: map[string]float64{"remediation_engineers": 1}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				remediationSrv = service.NewEstimationService(mockStore).WithSchemaRegistry(registry).WithOpaValidator(validator)
+
+				inventory := api.Inventory{
+					Clusters: map[string]api.InventoryData{
+						clusterID: {
+							Vms: api.VMs{
+								Total:                10,
+								DiskGB:               api.VMResourceBreakdown{Total: 100},
+								MigrationWarnings:    []api.MigrationIssue{{Id: util.Ptr("test.cbt"), Label: "CBT", Count: 4}, {Id: util.Ptr("test.other"), Label: "Other", Count: 3}},
+								NotMigratableReasons: []api.MigrationIssue{{Id: util.Ptr("test.rdm"), Label: "RDM", Count: 1}},
+							},
+						},
+					},
+				}
+				data, err := json.Marshal(inventory)
+				Expect(err).NotTo(HaveOccurred())
+				assessment := createTestAssessmentForEstimation(assessmentID, testUsername, testOrgID, clusterID, 10, 100)
+				assessment.Snapshots[0].Inventory = data
+				mockStore.assessments[assessmentID] = assessment
+			})
+
+			It("derives the remediation hours from the remediation catalog", func() {
+				results, err := remediationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				// 4 VMs * 0.5 h + 1 VM * 2 h, by 1 engineer
+				Expect(*results["remediate"].Breakdown["Issue Remediation"].Duration).To(Equal(4 * time.Hour))
+			})
+
+			It("does not count the waived issues", func() {
+				mockStore.waivers = model.ConcernWaiverList{{ID: uuid.New(), AssessmentID: assessmentID, ConcernID: "test.cbt", Justification: "cold migration"}}
+
+				results, err := remediationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*results["remediate"].Breakdown["Issue Remediation"].Duration).To(Equal(2 * time.Hour))
+			})
+
+			It("uses the remediation hours supplied by the user", func() {
+				results, err := remediationSrv.CalculateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil,
+					[]estimation.Param{{Key: "remediation_hours", Value: 10.0}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*results["remediate"].Breakdown["Issue Remediation"].Duration).To(Equal(10 * time.Hour))
+			})
+		})
 	})
 
 	Describe("CalculateOsDiskComplexity", func() {
@@ -883,7 +1034,7 @@ var _ = Describe("EstimationService", func() {
 				assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier, dist,
 			)
 
-			result, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID)
+			result, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, nil, nil)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Buckets).To(HaveLen(5))
@@ -894,7 +1045,7 @@ var _ = Describe("EstimationService", func() {
 		})
 
 		It("returns error for unknown assessment", func() {
-			_, err := estimationSrv.CalculateOsDiskComplexity(ctx, uuid.New(), clusterID)
+			_, err := estimationSrv.CalculateOsDiskComplexity(ctx, uuid.New(), clusterID, nil, nil)
 			Expect(err).To(HaveOccurred())
 		})
 
@@ -902,9 +1053,192 @@ var _ = Describe("EstimationService", func() {
 			mockStore.assessments[assessmentID] = createTestAssessmentForComplexity(
 				assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
 			)
-			_, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, "wrong-cluster")
+			_, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, "wrong-cluster", nil, nil)
 			Expect(err).To(HaveOccurred())
 		})
+
+		Context("with the complexity tables of the organization", func() {
+			var orgVersion string
+
+			BeforeEach(func() {
+				table := complexity.DefaultTable()
+				table.OSDifficultyScores["Red Hat Enterprise Linux 9"] = 4
+				created, err := mockStore.ComplexityTable().Create(ctx, model.ComplexityTable{OrgID: testOrgID, Tables: *model.MakeJSONField(table)})
+				Expect(err).To(BeNil())
+				orgVersion = created.Version
+
+				dist := buildComplexityDistribution(map[string]api.DiskSizeTierSummary{
+					"1": {VmCount: 2, TotalSizeTB: 1.0},
+				})
+				mockStore.assessments[assessmentID] = createTestAssessmentWithComplexityDistribution(
+					assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier, dist,
+				)
+			})
+
+			It("rescores the VMs of the snapshot", func() {
+				mockStore.vms = model.AssessmentVMList{
+					{SnapshotID: 1, VMID: "vm-1", ClusterID: clusterID, OS: "Red Hat Enterprise Linux 9 (64-bit)", DiskGB: 512},
+					{SnapshotID: 1, VMID: "vm-2", ClusterID: clusterID, OS: "Red Hat Enterprise Linux 9 (64-bit)", DiskGB: 512},
+					{SnapshotID: 1, VMID: "vm-3", ClusterID: clusterID, OS: "Red Hat Enterprise Linux 9 (64-bit)", DiskGB: 512, MigrationExcluded: true},
+				}
+
+				result, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, nil, &orgVersion)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ComplexityTableVersion).To(Equal(orgVersion))
+				Expect(result.Buckets[1].VMCount).To(Equal(0))
+				Expect(result.Buckets[4].VMCount).To(Equal(2))
+				Expect(result.Buckets[4].TotalSizeTB).To(Equal(1.0))
+			})
+
+			It("rejects a requested version when the snapshot has no VM records", func() {
+				_, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, nil, &orgVersion)
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+			})
+
+			It("falls back to the distribution of the inventory for the recorded version", func() {
+				mockStore.assessments[assessmentID].ComplexityTableVersion = orgVersion
+
+				result, err := estimationSrv.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, nil, nil)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ComplexityTableVersion).To(Equal(complexity.DefaultTableVersion))
+				Expect(result.Buckets[1].VMCount).To(Equal(2))
+			})
+
+			It("scores the OS breakdown with the requested version", func() {
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, &orgVersion)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.ComplexityTableVersion).To(Equal(orgVersion))
+				Expect(result.OSRatings["Red Hat Enterprise Linux 9 (64-bit)"]).To(Equal(4))
+			})
+
+			It("returns not found for an unknown version", func() {
+				unknown := "org-42"
+				_, err := estimationSrv.CalculateMigrationComplexity(ctx, assessmentID, clusterID, nil, &unknown)
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+			})
+		})
+	})
+
+	Describe("PlanMigrationWaves", func() {
+		var defaultOsInfo *map[string]api.OsInfo
+		var defaultDiskTier *map[string]api.DiskSizeTierSummary
+
+		BeforeEach(func() {
+			defaultOsInfo = buildOsInfo(map[string]int{
+				"Red Hat Enterprise Linux 9 (64-bit)": 45,
+			})
+			defaultDiskTier = buildDiskSizeTier(map[string]api.DiskSizeTierSummary{
+				"0-100GiB": {VmCount: 45, TotalSizeTB: 24.0},
+			})
+			// bucket 1: 30 VMs of 102.4 GB on average
+			dist := buildComplexityDistribution(map[string]api.DiskSizeTierSummary{
+				"0": {VmCount: 5, TotalSizeTB: 1.0},
+				"1": {VmCount: 30, TotalSizeTB: 3.0},
+				"3": {VmCount: 10, TotalSizeTB: 20.0},
+			})
+			mockStore.assessments[assessmentID] = createTestAssessmentWithComplexityDistribution(
+				assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier, dist,
+			)
+		})
+
+		waveCounts := func(plan *service.WavePlan) []int {
+			counts := make([]int, 0, len(plan.Waves))
+			for _, w := range plan.Waves {
+				counts = append(counts, w.VMCount)
+			}
+			return counts
+		}
+
+		It("plans one wave per complexity score, easiest first and unknown last, without constraints", func() {
+			plan, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil, service.WaveConstraints{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Schema).To(Equal(engines.SchemaNetworkBased))
+			Expect(plan.Waves).To(HaveLen(3))
+			Expect([]int{plan.Waves[0].ComplexityScore, plan.Waves[1].ComplexityScore, plan.Waves[2].ComplexityScore}).To(Equal([]int{1, 3, 0}))
+			Expect(waveCounts(plan)).To(Equal([]int{30, 10, 5}))
+			Expect(plan.Waves[0].Number).To(Equal(1))
+			Expect(plan.Waves[0].TotalDiskGB).To(Equal(3072.0))
+
+			var minTotal, maxTotal time.Duration
+			for _, w := range plan.Waves {
+				Expect(w.Estimation.Breakdown).To(HaveKey("Storage Migration"))
+				minTotal += w.Estimation.MinTotalDuration
+				maxTotal += w.Estimation.MaxTotalDuration
+			}
+			Expect(plan.MinTotalDuration).To(Equal(minTotal))
+			Expect(plan.MaxTotalDuration).To(Equal(maxTotal))
+		})
+
+		It("bounds waves by the maximum number of VMs", func() {
+			plan, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil,
+				service.WaveConstraints{MaxVMsPerWave: 12})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(waveCounts(plan)).To(Equal([]int{12, 12, 6, 10, 5}))
+		})
+
+		It("bounds waves by the maximum disk GB", func() {
+			plan, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil,
+				service.WaveConstraints{MaxDiskGBPerWave: 500, MaxVMsPerWave: 100})
+
+			Expect(err).ToNot(HaveOccurred())
+			for _, w := range plan.Waves {
+				if w.ComplexityScore == 1 {
+					Expect(w.VMCount).To(BeNumerically("<=", 4))
+					Expect(w.TotalDiskGB).To(BeNumerically("<=", 500))
+					Expect(w.ExceedsConstraints).To(BeFalse())
+				}
+			}
+			// VMs of bucket 3 are 2 TiB each: planned one per wave and flagged
+			bucket3 := 0
+			for _, w := range plan.Waves {
+				if w.ComplexityScore == 3 {
+					bucket3++
+					Expect(w.VMCount).To(Equal(1))
+					Expect(w.ExceedsConstraints).To(BeTrue())
+				}
+			}
+			Expect(bucket3).To(Equal(10))
+		})
+
+		It("bounds waves by the maximum estimated duration", func() {
+			plan, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil,
+				service.WaveConstraints{MaxWaveDuration: 2 * time.Hour})
+
+			Expect(err).ToNot(HaveOccurred())
+			total := 0
+			for _, w := range plan.Waves {
+				total += w.VMCount
+				if !w.ExceedsConstraints {
+					Expect(w.Estimation.MaxTotalDuration).To(BeNumerically("<=", 2*time.Hour))
+				}
+			}
+			Expect(total).To(Equal(45))
+			// ~28.5 min per VM of bucket 1: 4 VMs fit in 2 hours
+			Expect(plan.Waves[0].VMCount).To(Equal(4))
+		})
+
+		It("returns ErrInvalidSchema for an unknown schema", func() {
+			_, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "bogus", nil, service.WaveConstraints{})
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidSchema{}))
+		})
+
+		It("returns ErrInvalidRequest for negative constraints", func() {
+			_, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil, service.WaveConstraints{MaxVMsPerWave: -1})
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+
+		It("returns ErrInvalidClusterInventory when the inventory has no complexity distribution", func() {
+			mockStore.assessments[assessmentID] = createTestAssessmentForComplexity(
+				assessmentID, testUsername, testOrgID, clusterID, defaultOsInfo, defaultDiskTier,
+			)
+			_, err := estimationSrv.PlanMigrationWaves(ctx, assessmentID, clusterID, nil, "", nil, service.WaveConstraints{})
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidClusterInventory{}))
+		})
 	})
 
 	Describe("RunEstimation", func() {
@@ -934,6 +1268,123 @@ var _ = Describe("EstimationService", func() {
 		})
 	})
 
+	Describe("ProjectTimelines", func() {
+		It("places the phases in calculator order, with the work hours of the params", func() {
+			params := []estimation.Param{
+				{Key: "vm_count", Value: 10},
+				{Key: "total_disk_gb", Value: 1000.0},
+			}
+			results, err := estimationSrv.RunEstimation([]engines.Schema{engines.SchemaNetworkBased}, params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results[engines.SchemaNetworkBased].Phases).To(Equal([]string{"Storage Migration", "Post-Migration Checks"}))
+			Expect(results[engines.SchemaNetworkBased].StreamedPhases).To(Equal([]string{"Storage Migration"}))
+
+			// ~3.7h of transfer then 1h of checks, 2 work hours a day from Monday 2026-01-05
+			start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+			timelines, err := estimationSrv.ProjectTimelines(results,
+				[]estimation.Param{{Key: "work_hours_per_day", Value: 2.0}},
+				timeline.Calendar{Start: start})
+
+			Expect(err).ToNot(HaveOccurred())
+			tl := timelines[engines.SchemaNetworkBased]
+			Expect(tl.Phases).To(HaveLen(2))
+			Expect(tl.Phases[0].Name).To(Equal("Storage Migration"))
+			Expect(tl.Phases[0].End).To(Equal(start.AddDate(0, 0, 1)))
+			Expect(tl.Phases[1].Start).To(Equal(start.AddDate(0, 0, 1)))
+			Expect(tl.End).To(Equal(start.AddDate(0, 0, 2)))
+		})
+
+		It("splits only the storage migration across parallel streams", func() {
+			params := []estimation.Param{
+				{Key: "vm_count", Value: 10},
+				{Key: "total_disk_gb", Value: 1000.0},
+			}
+			results, err := estimationSrv.RunEstimation([]engines.Schema{engines.SchemaNetworkBased}, params)
+			Expect(err).ToNot(HaveOccurred())
+
+			// ~1.9h of transfer per stream then 1h of checks, 2 work hours a day from Monday 2026-01-05
+			start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+			timelines, err := estimationSrv.ProjectTimelines(results,
+				[]estimation.Param{{Key: "work_hours_per_day", Value: 2.0}},
+				timeline.Calendar{Start: start, Streams: 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			tl := timelines[engines.SchemaNetworkBased]
+			Expect(tl.Phases[0].End).To(Equal(start))
+			// the checks are not split and end on Tuesday
+			Expect(tl.Phases[1].Start).To(Equal(start))
+			Expect(tl.End).To(Equal(start.AddDate(0, 0, 1)))
+		})
+
+		It("returns ErrInvalidRequest for an invalid calendar", func() {
+			results, err := estimationSrv.RunEstimation([]engines.Schema{engines.SchemaNetworkBased},
+				[]estimation.Param{{Key: "vm_count", Value: 1}, {Key: "total_disk_gb", Value: 1.0}})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = estimationSrv.ProjectTimelines(results, nil, timeline.Calendar{})
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
+
+	Describe("SimulateMigrationEstimation", func() {
+		BeforeEach(func() {
+			mockStore.assessments[assessmentID] = createTestAssessmentForEstimation(
+				assessmentID, testUsername, testOrgID, clusterID, 10, 1000,
+			)
+		})
+
+		It("returns ordered percentiles per calculator, reproducible for a seed", func() {
+			settings := service.SimulationSettings{
+				Runs: 200,
+				Seed: 42,
+				Distributions: map[string]estimation.Distribution{
+					"transfer_rate_mbps":       estimation.Triangular{Min: 200, Mode: 580, Max: 900},
+					"troubleshoot_mins_per_vm": estimation.Uniform{Min: 30, Max: 90},
+				},
+			}
+			schemas := []engines.Schema{engines.SchemaNetworkBased}
+
+			results, err := estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, nil, schemas, nil, settings)
+			Expect(err).ToNot(HaveOccurred())
+			result := results[engines.SchemaNetworkBased]
+			Expect(result.Runs).To(Equal(200))
+			Expect(result.Breakdown).To(HaveKey("Storage Migration"))
+			Expect(result.Breakdown).To(HaveKey("Post-Migration Checks"))
+			Expect(result.Total.P50).To(BeNumerically(">", 0))
+			Expect(result.Total.P80).To(BeNumerically(">=", result.Total.P50))
+			Expect(result.Total.P95).To(BeNumerically(">=", result.Total.P80))
+
+			again, err := estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, nil, schemas, nil, settings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again[engines.SchemaNetworkBased]).To(Equal(result))
+		})
+
+		It("uses the default number of runs", func() {
+			results, err := estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, nil,
+				[]engines.Schema{engines.SchemaStorageOffload}, nil, service.SimulationSettings{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results[engines.SchemaStorageOffload].Runs).To(Equal(service.DefaultSimulationRuns))
+		})
+
+		It("rejects distributions of unknown, integer or out of range params", func() {
+			for key, dist := range map[string]estimation.Distribution{
+				"bogus":                    estimation.Uniform{Min: 1, Max: 2},
+				"post_migration_engineers": estimation.Uniform{Min: 1, Max: 4},
+				"transfer_rate_mbps":       estimation.Normal{Mean: 100, StdDev: 50},
+			} {
+				_, err := estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil,
+					service.SimulationSettings{Distributions: map[string]estimation.Distribution{key: dist}})
+				Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidEstimationParam{}), key)
+			}
+		})
+
+		It("rejects too many runs", func() {
+			_, err := estimationSrv.SimulateMigrationEstimation(ctx, assessmentID, clusterID, nil, nil, nil,
+				service.SimulationSettings{Runs: service.MaxSimulationRuns + 1})
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+		})
+	})
+
 	Describe("BuildBaseParams", func() {
 		It("returns defaults when no user params supplied", func() {
 			params := estimationSrv.BuildBaseParams(nil)
@@ -969,7 +1420,7 @@ var _ = Describe("EstimationService", func() {
 	Describe("EventEstimationService event publishing", func() {
 		Context("CalculateMigrationComplexity", func() {
 			It("does not publish an event when the inner service fails", func() {
-				result, err := estimationSrv.CalculateMigrationComplexity(ctx, uuid.New(), clusterID)
+				result, err := estimationSrv.CalculateMigrationComplexity(ctx, uuid.New(), clusterID, nil, nil)
 
 				Expect(err).NotTo(BeNil())
 				Expect(result).To(BeNil())
@@ -979,7 +1430,7 @@ var _ = Describe("EstimationService", func() {
 
 		Context("CalculateMigrationEstimation", func() {
 			It("does not publish an event when the inner service fails", func() {
-				results, err := estimationSrv.CalculateMigrationEstimation(ctx, uuid.New(), clusterID, nil, nil)
+				results, err := estimationSrv.CalculateMigrationEstimation(ctx, uuid.New(), clusterID, nil, nil, nil)
 
 				Expect(err).NotTo(BeNil())
 				Expect(results).To(BeNil())
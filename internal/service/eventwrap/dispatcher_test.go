@@ -82,16 +82,23 @@ func (m *mockStore) NewTransactionContext(ctx context.Context) (context.Context,
 }
 
 func (m *mockStore) Outbox() store.Outbox                                       { return m.outbox }
+func (m *mockStore) AuditLog() store.AuditLog                                   { return nil }
 func (m *mockStore) Agent() store.Agent                                         { return nil }
 func (m *mockStore) Authz() store.Authz                                         { return nil }
 func (m *mockStore) Source() store.Source                                       { return nil }
 func (m *mockStore) SourceSubsetInventory() store.SourceSubsetInventory         { return nil }
+func (m *mockStore) SourceVM() store.SourceVM                                   { return nil }
 func (m *mockStore) AssessmentSubsetInventory() store.AssessmentSubsetInventory { return nil }
+func (m *mockStore) AssessmentVM() store.AssessmentVM                           { return nil }
 func (m *mockStore) ImageInfra() store.ImageInfra                               { return nil }
 func (m *mockStore) PrivateKey() store.PrivateKey                               { return nil }
 func (m *mockStore) Label() store.Label                                         { return nil }
 func (m *mockStore) Assessment() store.Assessment                               { return nil }
 func (m *mockStore) ClusterSizingInput() store.ClusterSizingInput               { return nil }
+func (m *mockStore) HardwareSKU() store.HardwareSKU                             { return nil }
+func (m *mockStore) ComplexityTable() store.ComplexityTable                     { return nil }
+func (m *mockStore) PolicyBundle() store.PolicyBundle                           { return nil }
+func (m *mockStore) ConcernWaiver() store.ConcernWaiver                         { return nil }
 func (m *mockStore) AssessmentEnhancementData() store.AssessmentEnhancementData { return nil }
 func (m *mockStore) Job() store.Job                                             { return nil }
 func (m *mockStore) Accounts() store.Accounts                                   { return nil }
@@ -2,6 +2,7 @@ package eventwrap
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -110,8 +111,8 @@ func (e *EventAssessmentService) CreateAssessment(ctx context.Context, createFor
 	return assessment, nil
 }
 
-func (e *EventAssessmentService) UpdateAssessment(ctx context.Context, id uuid.UUID, name *string) (*model.Assessment, error) {
-	return e.inner.UpdateAssessment(ctx, id, name)
+func (e *EventAssessmentService) UpdateAssessment(ctx context.Context, id uuid.UUID, name *string, inventory []byte) (*model.Assessment, error) {
+	return e.inner.UpdateAssessment(ctx, id, name, inventory)
 }
 
 func (e *EventAssessmentService) DeleteAssessment(ctx context.Context, id uuid.UUID) error {
@@ -249,3 +250,150 @@ func (e *EventAssessmentService) UnshareAssessment(ctx context.Context, id uuid.
 
 	return nil
 }
+
+func (e *EventAssessmentService) ShareAssessmentWith(ctx context.Context, id uuid.UUID, subjects []model.Subject, relation model.Relation) error {
+	user := auth.MustHaveUser(ctx)
+
+	ctx, err := e.store.NewTransactionContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	if err := e.inner.ShareAssessmentWith(ctx, id, subjects, relation); err != nil {
+		return err
+	}
+
+	payload := kafka.NewShareAssessmentWithPayload(user.Username, id.String(), subjectsToStrings(subjects), string(relation))
+	ceBytes, err := kafka.BuildCloudEvent(kafka.ShareAssessmentEventType, payload)
+	if err != nil {
+		return err
+	}
+	if err := e.outbox.Insert(ctx, kafka.ShareAssessmentEventType, ceBytes); err != nil {
+		return err
+	}
+
+	// Notify the users and the members of the groups the assessment is shared with
+	var notifiedUsers []string
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case model.UserSubject:
+			notifiedUsers = append(notifiedUsers, subject.ID)
+		case model.OrgSubject:
+			groupID, err := uuid.Parse(subject.ID)
+			if err != nil {
+				return err
+			}
+			group, err := e.store.Accounts().GetGroup(ctx, groupID)
+			if err != nil {
+				return err
+			}
+			for _, m := range group.Members {
+				if m.Username != user.Username {
+					notifiedUsers = append(notifiedUsers, m.Username)
+				}
+			}
+		}
+	}
+	slices.Sort(notifiedUsers)
+	notifiedUsers = slices.Compact(notifiedUsers)
+
+	if len(notifiedUsers) > 0 {
+		notificationBytes, err := notification.Build(
+			notification.AssessmentSharedEventType,
+			user.Organization,
+			notification.SeverityImportant,
+			map[string]string{"assessment_id": id.String()},
+			notification.Recipient{IgnoreUserPreferences: true, Users: notifiedUsers},
+		)
+		if err != nil {
+			return err
+		}
+		if err := e.outbox.Insert(ctx, notification.AssessmentSharedEventType, notificationBytes); err != nil {
+			return err
+		}
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *EventAssessmentService) UnshareAssessmentWith(ctx context.Context, id uuid.UUID, subjects []model.Subject) error {
+	user := auth.MustHaveUser(ctx)
+
+	ctx, err := e.store.NewTransactionContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	if err := e.inner.UnshareAssessmentWith(ctx, id, subjects); err != nil {
+		return err
+	}
+
+	payload := kafka.NewUnshareAssessmentWithPayload(user.Username, id.String(), subjectsToStrings(subjects))
+	ceBytes, err := kafka.BuildCloudEvent(kafka.UnshareAssessmentEventType, payload)
+	if err != nil {
+		return err
+	}
+	if err := e.outbox.Insert(ctx, kafka.UnshareAssessmentEventType, ceBytes); err != nil {
+		return err
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *EventAssessmentService) TransferOwnership(ctx context.Context, id uuid.UUID, username string) (*model.Assessment, error) {
+	return e.inner.TransferOwnership(ctx, id, username)
+}
+
+func (e *EventAssessmentService) AuthorizeEdit(ctx context.Context, id uuid.UUID) error {
+	return e.inner.AuthorizeEdit(ctx, id)
+}
+
+func subjectsToStrings(subjects []model.Subject) []string {
+	result := make([]string, 0, len(subjects))
+	for _, s := range subjects {
+		result = append(result, s.String())
+	}
+	return result
+}
+
+func (e *EventAssessmentService) ListSnapshots(ctx context.Context, id uuid.UUID) ([]model.Snapshot, error) {
+	return e.inner.ListSnapshots(ctx, id)
+}
+
+func (e *EventAssessmentService) GetSnapshot(ctx context.Context, id uuid.UUID, snapshotID uint) (*model.Snapshot, error) {
+	return e.inner.GetSnapshot(ctx, id, snapshotID)
+}
+
+func (e *EventAssessmentService) DiffSnapshots(ctx context.Context, id uuid.UUID, fromSnapshotID, toSnapshotID uint) (*service.SnapshotDiff, error) {
+	return e.inner.DiffSnapshots(ctx, id, fromSnapshotID, toSnapshotID)
+}
+
+func (e *EventAssessmentService) ListVMs(ctx context.Context, id uuid.UUID, filter *service.AssessmentVMFilter) (*service.AssessmentVMPage, error) {
+	return e.inner.ListVMs(ctx, id, filter)
+}
+
+func (e *EventAssessmentService) ListConcernWaivers(ctx context.Context, id uuid.UUID) (model.ConcernWaiverList, error) {
+	return e.inner.ListConcernWaivers(ctx, id)
+}
+
+func (e *EventAssessmentService) CreateConcernWaiver(ctx context.Context, id uuid.UUID, form service.ConcernWaiverForm) (*model.ConcernWaiver, error) {
+	return e.inner.CreateConcernWaiver(ctx, id, form)
+}
+
+func (e *EventAssessmentService) DeleteConcernWaiver(ctx context.Context, id uuid.UUID, waiverID uuid.UUID) error {
+	return e.inner.DeleteConcernWaiver(ctx, id, waiverID)
+}
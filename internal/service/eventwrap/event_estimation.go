@@ -9,6 +9,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/store"
 	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/timeline"
 	"github.com/kubev2v/migration-planner/pkg/events/kafka"
 )
 
@@ -26,10 +27,11 @@ func (e *EventEstimationService) CalculateMigrationEstimation(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	snapshotID *uint,
 	schemas []engines.Schema,
 	userParams []estimation.Param,
 ) (map[engines.Schema]*service.MigrationAssessmentResult, error) {
-	results, err := e.inner.CalculateMigrationEstimation(ctx, assessmentID, clusterID, schemas, userParams)
+	results, err := e.inner.CalculateMigrationEstimation(ctx, assessmentID, clusterID, snapshotID, schemas, userParams)
 	if err != nil {
 		return nil, err
 	}
@@ -44,8 +46,10 @@ func (e *EventEstimationService) CalculateMigrationComplexity(
 	ctx context.Context,
 	assessmentID uuid.UUID,
 	clusterID string,
+	snapshotID *uint,
+	complexityTableVersion *string,
 ) (*service.MigrationComplexityResult, error) {
-	result, err := e.inner.CalculateMigrationComplexity(ctx, assessmentID, clusterID)
+	result, err := e.inner.CalculateMigrationComplexity(ctx, assessmentID, clusterID, snapshotID, complexityTableVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -56,8 +60,8 @@ func (e *EventEstimationService) CalculateMigrationComplexity(
 	return result, nil
 }
 
-func (e *EventEstimationService) CalculateOsDiskComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string) (*service.OsDiskComplexityResult, error) {
-	return e.inner.CalculateOsDiskComplexity(ctx, assessmentID, clusterID)
+func (e *EventEstimationService) CalculateOsDiskComplexity(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, complexityTableVersion *string) (*service.OsDiskComplexityResult, error) {
+	return e.inner.CalculateOsDiskComplexity(ctx, assessmentID, clusterID, snapshotID, complexityTableVersion)
 }
 
 func (e *EventEstimationService) ValidateParams(userParams []estimation.Param) error {
@@ -68,14 +72,30 @@ func (e *EventEstimationService) BuildBaseParams(userParams []estimation.Param)
 	return e.inner.BuildBaseParams(userParams)
 }
 
-func (e *EventEstimationService) BuildBucketParams(baseParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param {
-	return e.inner.BuildBucketParams(baseParams, vmCount, diskGB)
+func (e *EventEstimationService) BuildBucketParams(userParams []estimation.Param, vmCount int, diskGB float64) []estimation.Param {
+	return e.inner.BuildBucketParams(userParams, vmCount, diskGB)
 }
 
 func (e *EventEstimationService) RunEstimation(schemas []engines.Schema, params []estimation.Param) (map[engines.Schema]*service.MigrationAssessmentResult, error) {
 	return e.inner.RunEstimation(schemas, params)
 }
 
+func (e *EventEstimationService) ListEstimationSchemas() []engines.SchemaDefinition {
+	return e.inner.ListEstimationSchemas()
+}
+
+func (e *EventEstimationService) ProjectTimelines(results map[engines.Schema]*service.MigrationAssessmentResult, userParams []estimation.Param, cal timeline.Calendar) (map[engines.Schema]*timeline.Timeline, error) {
+	return e.inner.ProjectTimelines(results, userParams, cal)
+}
+
+func (e *EventEstimationService) PlanMigrationWaves(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, schema engines.Schema, userParams []estimation.Param, constraints service.WaveConstraints) (*service.WavePlan, error) {
+	return e.inner.PlanMigrationWaves(ctx, assessmentID, clusterID, snapshotID, schema, userParams, constraints)
+}
+
+func (e *EventEstimationService) SimulateMigrationEstimation(ctx context.Context, assessmentID uuid.UUID, clusterID string, snapshotID *uint, schemas []engines.Schema, userParams []estimation.Param, settings service.SimulationSettings) (map[engines.Schema]*estimation.SimulationResult, error) {
+	return e.inner.SimulateMigrationEstimation(ctx, assessmentID, clusterID, snapshotID, schemas, userParams, settings)
+}
+
 func (e *EventEstimationService) publishUserAction(ctx context.Context, assessmentID uuid.UUID, eventType string) error {
 	assessment, err := e.store.Assessment().Get(ctx, assessmentID)
 	if err != nil {
@@ -31,23 +31,62 @@ func (e *EventSizerService) CalculateClusterRequirements(
 		return nil, err
 	}
 
-	assessment, err := e.store.Assessment().Get(ctx, assessmentID)
+	if err := e.insertSizingEvent(ctx, assessmentID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (e *EventSizerService) CalculateTopologySizing(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	req *mappers.TopologySizingRequestForm,
+) (*api.TopologySizingResponse, error) {
+	result, err := e.inner.CalculateTopologySizing(ctx, assessmentID, req)
 	if err != nil {
 		return nil, err
 	}
 
-	payload := kafka.NewSizingPayload(assessment.Username, assessmentID.String())
-	ceBytes, err := kafka.BuildCloudEvent(kafka.SizingEventType, payload)
+	if err := e.insertSizingEvent(ctx, assessmentID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (e *EventSizerService) CalculateHardwareOptions(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	req *mappers.HardwareOptionsRequestForm,
+	catalog []service.HardwareSKU,
+) (*api.HardwareOptionsResponse, error) {
+	result, err := e.inner.CalculateHardwareOptions(ctx, assessmentID, req, catalog)
 	if err != nil {
 		return nil, err
 	}
-	if err := e.outbox.Insert(ctx, kafka.SizingEventType, ceBytes); err != nil {
+
+	if err := e.insertSizingEvent(ctx, assessmentID); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
 
+func (e *EventSizerService) insertSizingEvent(ctx context.Context, assessmentID uuid.UUID) error {
+	assessment, err := e.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		return err
+	}
+
+	payload := kafka.NewSizingPayload(assessment.Username, assessmentID.String())
+	ceBytes, err := kafka.BuildCloudEvent(kafka.SizingEventType, payload)
+	if err != nil {
+		return err
+	}
+	return e.outbox.Insert(ctx, kafka.SizingEventType, ceBytes)
+}
+
 func (e *EventSizerService) CalculateStandaloneClusterRequirements(
 	ctx context.Context,
 	req *mappers.StandaloneClusterRequirementsRequestForm,
@@ -59,6 +98,10 @@ func (e *EventSizerService) GetClusterRequirementsInput(ctx context.Context, ass
 	return e.inner.GetClusterRequirementsInput(ctx, assessmentID, clusterID)
 }
 
+func (e *EventSizerService) GetRightSizing(ctx context.Context, assessmentID uuid.UUID, snapshotID *uint, clusterID string) (*api.RightSizingReport, error) {
+	return e.inner.GetRightSizing(ctx, assessmentID, snapshotID, clusterID)
+}
+
 func (e *EventSizerService) Health(ctx context.Context) error {
 	return e.inner.Health(ctx)
 }
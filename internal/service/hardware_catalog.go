@@ -0,0 +1,248 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// Bounds of a hardware SKU; must match api/v1alpha1/openapi.yaml (HardwareSkuCreate).
+const (
+	hardwareSKUNameMaxLength = 100
+	hardwareSKUCoresMin      = 2
+	hardwareSKUCoresMax      = 384
+	hardwareSKUThreadsMax    = 2000
+	hardwareSKUMemoryMin     = 4
+	hardwareSKUMemoryMax     = 4096
+)
+
+type HardwareCatalogServicer interface {
+	ListSKUs(ctx context.Context) ([]HardwareSKU, error)
+	GetSKU(ctx context.Context, name string) (HardwareSKU, error)
+	CreateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error)
+	UpdateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error)
+	DeleteSKU(ctx context.Context, name string) (HardwareSKU, error)
+}
+
+type HardwareSKUSource string
+
+const (
+	// HardwareSKUSourceFile SKUs are loaded from the catalog file at startup and are read-only.
+	HardwareSKUSourceFile HardwareSKUSource = "file"
+	// HardwareSKUSourceAPI SKUs are managed by admins through the API and stored in the database.
+	HardwareSKUSourceAPI HardwareSKUSource = "api"
+)
+
+// HardwareSKU is a server model usable as a worker node, with its price and footprint.
+type HardwareSKU struct {
+	Name       string            `json:"name"`
+	Cores      int               `json:"cores"`
+	Threads    *int              `json:"threads,omitempty"`
+	MemoryGB   int               `json:"memoryGB"`
+	Price      float64           `json:"price"`
+	RackUnits  int               `json:"rackUnits"`
+	PowerWatts int               `json:"powerWatts"`
+	Source     HardwareSKUSource `json:"-"`
+}
+
+// hardwareCatalogFile is the layout of a hardware catalog file.
+type hardwareCatalogFile struct {
+	SKUs []HardwareSKU `json:"skus"`
+}
+
+// ParseHardwareCatalogFile reads the SKUs of a YAML or JSON hardware catalog file.
+func ParseHardwareCatalogFile(path string) ([]HardwareSKU, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading hardware catalog file: %w", err)
+	}
+
+	var catalog hardwareCatalogFile
+	if err := yaml.UnmarshalStrict(data, &catalog); err != nil {
+		return nil, fmt.Errorf("parsing hardware catalog file: %w", err)
+	}
+
+	names := make(map[string]bool, len(catalog.SKUs))
+	for i := range catalog.SKUs {
+		sku := &catalog.SKUs[i]
+		sku.Name = strings.TrimSpace(sku.Name)
+		sku.Source = HardwareSKUSourceFile
+		if err := validateHardwareSKU(*sku); err != nil {
+			return nil, fmt.Errorf("hardware catalog file: sku[%d]: %w", i, err)
+		}
+		if names[sku.Name] {
+			return nil, fmt.Errorf("hardware catalog file: duplicate sku %q", sku.Name)
+		}
+		names[sku.Name] = true
+	}
+	return catalog.SKUs, nil
+}
+
+// validateHardwareSKU checks the SKU can size worker nodes like the cluster requirements request.
+func validateHardwareSKU(sku HardwareSKU) error {
+	switch {
+	case sku.Name == "":
+		return NewErrInvalidRequest("sku name is required")
+	case len(sku.Name) > hardwareSKUNameMaxLength:
+		return NewErrInvalidRequest(fmt.Sprintf("sku name must be at most %d characters", hardwareSKUNameMaxLength))
+	case sku.Cores < hardwareSKUCoresMin || sku.Cores > hardwareSKUCoresMax:
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: cores must be between %d and %d, got: %d", sku.Name, hardwareSKUCoresMin, hardwareSKUCoresMax, sku.Cores))
+	case sku.Threads != nil && (*sku.Threads < sku.Cores || *sku.Threads > hardwareSKUThreadsMax):
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: threads must be between cores (%d) and %d, got: %d", sku.Name, sku.Cores, hardwareSKUThreadsMax, *sku.Threads))
+	case sku.MemoryGB < hardwareSKUMemoryMin || sku.MemoryGB > hardwareSKUMemoryMax:
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: memoryGB must be between %d and %d, got: %d", sku.Name, hardwareSKUMemoryMin, hardwareSKUMemoryMax, sku.MemoryGB))
+	case sku.Price < 0:
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: price must not be negative, got: %g", sku.Name, sku.Price))
+	case sku.RackUnits < 1:
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: rackUnits must be at least 1, got: %d", sku.Name, sku.RackUnits))
+	case sku.PowerWatts < 0:
+		return NewErrInvalidRequest(fmt.Sprintf("sku %s: powerWatts must not be negative, got: %d", sku.Name, sku.PowerWatts))
+	}
+	return nil
+}
+
+// HardwareCatalogService serves the SKUs of the catalog file together with the SKUs stored in the
+// database. A stored SKU cannot take the name of a file SKU, and file SKUs cannot be modified.
+type HardwareCatalogService struct {
+	store    store.Store
+	fileSKUs []HardwareSKU
+}
+
+func NewHardwareCatalogService(store store.Store) *HardwareCatalogService {
+	return &HardwareCatalogService{store: store}
+}
+
+// WithFileSKUs sets the read-only SKUs loaded from the catalog file.
+func (s *HardwareCatalogService) WithFileSKUs(skus []HardwareSKU) *HardwareCatalogService {
+	s.fileSKUs = skus
+	return s
+}
+
+func (s *HardwareCatalogService) ListSKUs(ctx context.Context) ([]HardwareSKU, error) {
+	stored, err := s.store.HardwareSKU().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hardware skus: %w", err)
+	}
+	skus := slices.Clone(s.fileSKUs)
+	for _, sku := range stored {
+		skus = append(skus, hardwareSKUFromModel(sku))
+	}
+	slices.SortFunc(skus, func(a, b HardwareSKU) int { return cmp.Compare(a.Name, b.Name) })
+	return skus, nil
+}
+
+func (s *HardwareCatalogService) GetSKU(ctx context.Context, name string) (HardwareSKU, error) {
+	if sku, ok := s.fileSKU(name); ok {
+		return sku, nil
+	}
+	stored, err := s.store.HardwareSKU().Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return HardwareSKU{}, NewErrResourceNotFoundByStr(name, "hardware sku")
+		}
+		return HardwareSKU{}, fmt.Errorf("failed to get hardware sku: %w", err)
+	}
+	return hardwareSKUFromModel(*stored), nil
+}
+
+func (s *HardwareCatalogService) CreateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error) {
+	sku.Name = strings.TrimSpace(sku.Name)
+	if err := validateHardwareSKU(sku); err != nil {
+		return HardwareSKU{}, err
+	}
+	if _, ok := s.fileSKU(sku.Name); ok {
+		return HardwareSKU{}, NewErrDuplicateKey("hardware sku", sku.Name)
+	}
+	created, err := s.store.HardwareSKU().Create(ctx, hardwareSKUToModel(sku))
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return HardwareSKU{}, NewErrDuplicateKey("hardware sku", sku.Name)
+		}
+		return HardwareSKU{}, fmt.Errorf("failed to create hardware sku: %w", err)
+	}
+	return hardwareSKUFromModel(*created), nil
+}
+
+func (s *HardwareCatalogService) UpdateSKU(ctx context.Context, sku HardwareSKU) (HardwareSKU, error) {
+	if err := s.requireStoredSKU(sku.Name); err != nil {
+		return HardwareSKU{}, err
+	}
+	if err := validateHardwareSKU(sku); err != nil {
+		return HardwareSKU{}, err
+	}
+	updated, err := s.store.HardwareSKU().Update(ctx, hardwareSKUToModel(sku))
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return HardwareSKU{}, NewErrResourceNotFoundByStr(sku.Name, "hardware sku")
+		}
+		return HardwareSKU{}, fmt.Errorf("failed to update hardware sku: %w", err)
+	}
+	return hardwareSKUFromModel(*updated), nil
+}
+
+func (s *HardwareCatalogService) DeleteSKU(ctx context.Context, name string) (HardwareSKU, error) {
+	if err := s.requireStoredSKU(name); err != nil {
+		return HardwareSKU{}, err
+	}
+	sku, err := s.GetSKU(ctx, name)
+	if err != nil {
+		return HardwareSKU{}, err
+	}
+	if err := s.store.HardwareSKU().Delete(ctx, name); err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return HardwareSKU{}, NewErrResourceNotFoundByStr(name, "hardware sku")
+		}
+		return HardwareSKU{}, fmt.Errorf("failed to delete hardware sku: %w", err)
+	}
+	return sku, nil
+}
+
+func (s *HardwareCatalogService) fileSKU(name string) (HardwareSKU, bool) {
+	i := slices.IndexFunc(s.fileSKUs, func(sku HardwareSKU) bool { return sku.Name == name })
+	if i < 0 {
+		return HardwareSKU{}, false
+	}
+	return s.fileSKUs[i], true
+}
+
+// requireStoredSKU rejects the modification of a SKU of the catalog file.
+func (s *HardwareCatalogService) requireStoredSKU(name string) error {
+	if _, ok := s.fileSKU(name); ok {
+		return NewErrInvalidRequest(fmt.Sprintf("hardware sku %s is defined in the catalog file and is read-only", name))
+	}
+	return nil
+}
+
+func hardwareSKUFromModel(sku model.HardwareSKU) HardwareSKU {
+	return HardwareSKU{
+		Name:       sku.Name,
+		Cores:      sku.Cores,
+		Threads:    sku.Threads,
+		MemoryGB:   sku.MemoryGB,
+		Price:      sku.Price,
+		RackUnits:  sku.RackUnits,
+		PowerWatts: sku.PowerWatts,
+		Source:     HardwareSKUSourceAPI,
+	}
+}
+
+func hardwareSKUToModel(sku HardwareSKU) model.HardwareSKU {
+	return model.HardwareSKU{
+		Name:       sku.Name,
+		Cores:      sku.Cores,
+		Threads:    sku.Threads,
+		MemoryGB:   sku.MemoryGB,
+		Price:      sku.Price,
+		RackUnits:  sku.RackUnits,
+		PowerWatts: sku.PowerWatts,
+	}
+}
@@ -0,0 +1,223 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/client"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hardware catalog", func() {
+	var (
+		ctx        context.Context
+		mockStore  *MockStore
+		catalogSvc *service.HardwareCatalogService
+		fileSKUs   []service.HardwareSKU
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		mockStore = NewMockStore()
+		fileSKUs = []service.HardwareSKU{
+			{Name: "std-32c-256g", Cores: 32, MemoryGB: 256, Price: 12000, RackUnits: 1, PowerWatts: 600, Source: service.HardwareSKUSourceFile},
+			{Name: "dense-64c-512g", Cores: 64, Threads: util.IntPtr(128), MemoryGB: 512, Price: 30000, RackUnits: 2, PowerWatts: 1100, Source: service.HardwareSKUSourceFile},
+		}
+		catalogSvc = service.NewHardwareCatalogService(mockStore).WithFileSKUs(fileSKUs)
+	})
+
+	Context("ParseHardwareCatalogFile", func() {
+		writeTempYAML := func(content string) string {
+			f, err := os.CreateTemp("", "hardware-catalog-*.yaml")
+			Expect(err).To(BeNil())
+			_, err = f.WriteString(content)
+			Expect(err).To(BeNil())
+			Expect(f.Close()).To(BeNil())
+			DeferCleanup(func() { _ = os.Remove(f.Name()) })
+			return f.Name()
+		}
+
+		It("parses a valid file", func() {
+			path := writeTempYAML("skus:\n  - name: std-32c-256g\n    cores: 32\n    threads: 64\n    memoryGB: 256\n    price: 12000\n    rackUnits: 1\n    powerWatts: 600\n")
+			skus, err := service.ParseHardwareCatalogFile(path)
+			Expect(err).To(BeNil())
+			Expect(skus).To(HaveLen(1))
+			Expect(skus[0].Name).To(Equal("std-32c-256g"))
+			Expect(*skus[0].Threads).To(Equal(64))
+			Expect(skus[0].Source).To(Equal(service.HardwareSKUSourceFile))
+		})
+
+		DescribeTable("returns error for invalid input",
+			func(content string, expectedErr string) {
+				path := writeTempYAML(content)
+				_, err := service.ParseHardwareCatalogFile(path)
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring(expectedErr))
+			},
+			Entry("duplicate sku", "skus:\n  - {name: a, cores: 8, memoryGB: 32, price: 1, rackUnits: 1, powerWatts: 1}\n  - {name: a, cores: 8, memoryGB: 32, price: 1, rackUnits: 1, powerWatts: 1}\n", `duplicate sku "a"`),
+			Entry("threads below cores", "skus:\n  - {name: a, cores: 8, threads: 4, memoryGB: 32, price: 1, rackUnits: 1, powerWatts: 1}\n", "threads must be between cores (8)"),
+			Entry("missing rack units", "skus:\n  - {name: a, cores: 8, memoryGB: 32, price: 1, powerWatts: 1}\n", "rackUnits must be at least 1"),
+			Entry("unknown field", "skus:\n  - {name: a, cores: 8, memoryGB: 32, price: 1, rackUnits: 1, powerWatts: 1, gpus: 2}\n", "unknown field"),
+		)
+	})
+
+	Context("SKU management", func() {
+		It("lists the SKUs of the file and the database by name", func() {
+			_, err := catalogSvc.CreateSKU(ctx, service.HardwareSKU{Name: "edge-16c-128g", Cores: 16, MemoryGB: 128, Price: 6000, RackUnits: 1, PowerWatts: 350})
+			Expect(err).To(BeNil())
+
+			skus, err := catalogSvc.ListSKUs(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(skus).To(HaveLen(3))
+			Expect(skus[0].Name).To(Equal("dense-64c-512g"))
+			Expect(skus[1].Name).To(Equal("edge-16c-128g"))
+			Expect(skus[1].Source).To(Equal(service.HardwareSKUSourceAPI))
+			Expect(skus[2].Source).To(Equal(service.HardwareSKUSourceFile))
+		})
+
+		It("rejects a SKU named like a SKU of the file", func() {
+			_, err := catalogSvc.CreateSKU(ctx, service.HardwareSKU{Name: "std-32c-256g", Cores: 16, MemoryGB: 128, Price: 6000, RackUnits: 1})
+
+			var duplicate *service.ErrDuplicateKey
+			Expect(errors.As(err, &duplicate)).To(BeTrue())
+		})
+
+		It("does not modify the SKUs of the file", func() {
+			_, err := catalogSvc.UpdateSKU(ctx, service.HardwareSKU{Name: "std-32c-256g", Cores: 32, MemoryGB: 256, Price: 1, RackUnits: 1})
+			var invalidReq *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalidReq)).To(BeTrue())
+
+			_, err = catalogSvc.DeleteSKU(ctx, "std-32c-256g")
+			Expect(errors.As(err, &invalidReq)).To(BeTrue())
+		})
+
+		It("updates and deletes a SKU of the database", func() {
+			_, err := catalogSvc.CreateSKU(ctx, service.HardwareSKU{Name: "edge-16c-128g", Cores: 16, MemoryGB: 128, Price: 6000, RackUnits: 1, PowerWatts: 350})
+			Expect(err).To(BeNil())
+
+			updated, err := catalogSvc.UpdateSKU(ctx, service.HardwareSKU{Name: "edge-16c-128g", Cores: 16, MemoryGB: 128, Price: 5500, RackUnits: 1, PowerWatts: 350})
+			Expect(err).To(BeNil())
+			Expect(updated.Price).To(Equal(5500.0))
+
+			deleted, err := catalogSvc.DeleteSKU(ctx, "edge-16c-128g")
+			Expect(err).To(BeNil())
+			Expect(deleted.Name).To(Equal("edge-16c-128g"))
+
+			_, err = catalogSvc.GetSKU(ctx, "edge-16c-128g")
+			var notFound *service.ErrResourceNotFound
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+	})
+
+	Context("CalculateHardwareOptions", func() {
+		var (
+			sizerService *service.SizerService
+			assessmentID uuid.UUID
+			request      *mappers.HardwareOptionsRequestForm
+		)
+
+		BeforeEach(func() {
+			sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+			assessmentID = uuid.New()
+			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, "domain-c1", 200, 800, 1600)
+			request = &mappers.HardwareOptionsRequestForm{
+				Sizing: mappers.ClusterRequirementsRequestForm{
+					ClusterID:             "domain-c1",
+					CpuOverCommitRatio:    "1:4",
+					MemoryOverCommitRatio: "1:2",
+					WorkerNodeCPU:         16,
+					WorkerNodeMemory:      64,
+				},
+			}
+		})
+
+		It("ranks the SKUs by total cost and compares them to the worker node of the request", func() {
+			catalog := append(slices.Clone(fileSKUs), service.HardwareSKU{Name: "tiny-2c-4g", Cores: 2, MemoryGB: 4, Price: 100, RackUnits: 1, PowerWatts: 50, Source: service.HardwareSKUSourceAPI})
+
+			result, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, catalog)
+
+			Expect(err).To(BeNil())
+			Expect(result.Baseline.Sku).To(BeNil())
+			Expect(result.Baseline.WorkerNodeCPU).To(Equal(16))
+			Expect(result.InventoryTotals.TotalVMs).To(Equal(200))
+			Expect(result.Options).To(HaveLen(2))
+			Expect(result.UnsuitableSkus).To(HaveLen(1))
+			Expect(result.UnsuitableSkus[0].Name).To(Equal("tiny-2c-4g"))
+			for i, option := range result.Options {
+				Expect(option.Rank).To(Equal(i + 1))
+				Expect(option.NodeCount).To(Equal(option.ClusterSizing.TotalNodes))
+				Expect(option.TotalCost).To(Equal(float64(option.NodeCount) * option.Sku.Price))
+				Expect(option.TotalRackUnits).To(Equal(option.NodeCount * option.Sku.RackUnits))
+				Expect(option.TotalPowerWatts).To(Equal(option.NodeCount * option.Sku.PowerWatts))
+				Expect(option.Savings).NotTo(BeNil())
+				Expect(option.Savings.NodesSaved).To(Equal(result.Baseline.ClusterSizing.TotalNodes - option.ClusterSizing.TotalNodes))
+				Expect(option.CostSavings).To(BeNil())
+			}
+			Expect(result.Options[0].TotalCost).To(BeNumerically("<=", result.Options[1].TotalCost))
+		})
+
+		It("counts the on-prem control plane nodes in the totals", func() {
+			request.SKUs = []string{"std-32c-256g"}
+
+			result, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, fileSKUs)
+
+			Expect(err).To(BeNil())
+			option := result.Options[0]
+			Expect(option.ClusterSizing.ControlPlaneNodes).To(Equal(3))
+			Expect(option.NodeCount).To(Equal(option.ClusterSizing.ControlPlaneNodes + option.ClusterSizing.WorkerNodes))
+			Expect(option.TotalCost).To(Equal(float64(option.NodeCount) * option.Sku.Price))
+
+			request.Sizing.HostedControlPlane = util.BoolPtr(true)
+
+			hosted, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, fileSKUs)
+
+			Expect(err).To(BeNil())
+			Expect(hosted.Options[0].ClusterSizing.ControlPlaneNodes).To(Equal(0))
+			Expect(hosted.Options[0].NodeCount).To(Equal(hosted.Options[0].ClusterSizing.WorkerNodes))
+		})
+
+		It("compares the options to a baseline SKU", func() {
+			request.Sizing.WorkerNodeCPU, request.Sizing.WorkerNodeMemory = 0, 0
+			request.BaselineSKU = "dense-64c-512g"
+			request.SKUs = []string{"std-32c-256g"}
+
+			result, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, fileSKUs)
+
+			Expect(err).To(BeNil())
+			Expect(*result.Baseline.Sku).To(Equal("dense-64c-512g"))
+			Expect(result.Baseline.WorkerNodeCPU).To(Equal(64))
+			Expect(result.Options).To(HaveLen(1))
+			option := result.Options[0]
+			Expect(option.Sku.Name).To(Equal("std-32c-256g"))
+			Expect(option.Sku.Source).To(Equal(api.HardwareSkuSourceFile))
+			Expect(*option.CostSavings).To(Equal(*result.Baseline.TotalCost - option.TotalCost))
+		})
+
+		It("returns not found for an unknown SKU", func() {
+			request.SKUs = []string{"std-32c-256g", "unknown"}
+
+			result, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, fileSKUs)
+
+			Expect(result).To(BeNil())
+			var notFound *service.ErrResourceNotFound
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+
+		It("rejects an empty catalog", func() {
+			result, err := sizerService.CalculateHardwareOptions(ctx, assessmentID, request, nil)
+
+			Expect(result).To(BeNil())
+			var invalidReq *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalidReq)).To(BeTrue())
+		})
+	})
+})
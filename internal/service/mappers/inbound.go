@@ -1,6 +1,9 @@
 package mappers
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/kubev2v/migration-planner/api/v1alpha1"
 	"github.com/kubev2v/migration-planner/internal/store/model"
@@ -84,12 +87,14 @@ type InventoryUpdateForm struct {
 	AgentID   uuid.UUID
 	VCenterID string
 	Inventory []byte
+	VMs       []model.SourceVM // per-VM records of the inventory, nil when not reported
 }
 
 type SourceInventoryUpdateForm struct {
 	SourceID  uuid.UUID
 	VCenterID string
 	Inventory []byte
+	VMs       []model.SourceVM // per-VM records of the inventory, nil when not reported
 }
 
 type SourceSubsetUpdateForm struct {
@@ -284,6 +289,44 @@ type ClusterRequirementsRequestForm struct {
 	ControlPlaneMemory      *int
 	HostedControlPlane      *bool
 	CompactMode             *bool
+	SnapshotID              *uint
+	// SizingMode is "batched" or "perVm", empty meaning "batched".
+	SizingMode string
+	// RightSized sizes the cluster with the VMs right-sized to their own utilization.
+	RightSized bool
+	Storage    *StorageSizingForm
+	// ReadOnly calculates the requirements without saving the inputs as the stored input of the
+	// cluster, for the users who cannot edit the assessment.
+	ReadOnly bool
+}
+
+// TopologySizingRequestForm maps source clusters of an assessment to target clusters, all sized
+// with the node configuration of Sizing. Sizing.ClusterID is not used.
+type TopologySizingRequestForm struct {
+	Sizing         ClusterRequirementsRequestForm
+	TargetClusters []TargetClusterForm
+}
+
+// HardwareOptionsRequestForm sizes a cluster with SKUs of the hardware catalog. The worker node of
+// Sizing is the baseline, unless BaselineSKU is set. An empty SKUs tries every SKU of the catalog.
+type HardwareOptionsRequestForm struct {
+	Sizing      ClusterRequirementsRequestForm
+	SKUs        []string
+	BaselineSKU string
+}
+
+type TargetClusterForm struct {
+	Name             string
+	SourceClusterIDs []string
+}
+
+// StorageSizingForm is the target storage backend to size the cluster VM disks for.
+type StorageSizingForm struct {
+	Backend               string
+	DataChunks            *int
+	CodingChunks          *int
+	ThinProvisioningRatio *float64
+	OSDDiskSizeGB         *int
 }
 
 type ClusterRequirementsInputForm struct {
@@ -383,18 +426,8 @@ func (m *Mapper) ToClusterRequirementsResponse(
 	totalMemory int,
 	optimizationStatus v1alpha1.OptimizationStatus,
 ) *v1alpha1.ClusterRequirementsResponse {
-	baselineControlPlaneNodes := baselineResult.TotalNodes - baselineResult.WorkerNodes
-	baselineTotalWorkers := baselineResult.WorkerNodes + baselineFailoverNodes
-	baselineTotalNodes := baselineControlPlaneNodes + baselineTotalWorkers
-
-	baseline := v1alpha1.ClusterSizing{
-		TotalNodes:        baselineTotalNodes,
-		WorkerNodes:       baselineTotalWorkers,
-		ControlPlaneNodes: baselineControlPlaneNodes,
-		FailoverNodes:     baselineFailoverNodes,
-		TotalCPU:          baselineResult.TotalCPU,
-		TotalMemory:       baselineResult.TotalMemory,
-	}
+	baseline := toClusterSizing(baselineResult, baselineFailoverNodes)
+	baselineTotalNodes := baseline.TotalNodes
 
 	var optimized *v1alpha1.ClusterSizing
 	var savings *v1alpha1.Savings
@@ -430,41 +463,265 @@ func (m *Mapper) ToClusterRequirementsResponse(
 		}
 	}
 
-	resourceConsumption := v1alpha1.SizingResourceConsumption{
-		Limits:          &v1alpha1.SizingResourceLimits{},
-		OverCommitRatio: &v1alpha1.SizingOverCommitRatio{},
-	}
-	if baselineResult.ResourceConsumption != nil {
-		resourceConsumption.Cpu = baselineResult.ResourceConsumption.CPU
-		resourceConsumption.Memory = baselineResult.ResourceConsumption.Memory
-
-		if baselineResult.ResourceConsumption.Limits != nil {
-			resourceConsumption.Limits = &v1alpha1.SizingResourceLimits{
-				Cpu:    baselineResult.ResourceConsumption.Limits.CPU,
-				Memory: baselineResult.ResourceConsumption.Limits.Memory,
-			}
-		}
-
-		if baselineResult.ResourceConsumption.OverCommitRatio != nil {
-			resourceConsumption.OverCommitRatio = &v1alpha1.SizingOverCommitRatio{
-				Cpu:    baselineResult.ResourceConsumption.OverCommitRatio.CPU,
-				Memory: baselineResult.ResourceConsumption.OverCommitRatio.Memory,
-			}
-		}
-	}
-
 	return &v1alpha1.ClusterRequirementsResponse{
 		ClusterSizing:       baseline,
 		OptimizedSizing:     optimized,
 		Savings:             savings,
 		OptimizationStatus:  &optimizationStatus,
-		ResourceConsumption: resourceConsumption,
+		ResourceConsumption: toSizingResourceConsumption(baselineResult.ResourceConsumption),
+		InventoryTotals: v1alpha1.InventoryTotals{
+			TotalVMs:    totalVMs,
+			TotalCPU:    totalCPU,
+			TotalMemory: totalMemory,
+		},
+		VmPacking: toAPIVMPacking(baselineResult.VMPacking),
+	}
+}
+
+// ToTopologySizingResponse maps the baseline sizing of every target cluster, and sums them up.
+func (m *Mapper) ToTopologySizingResponse(targets []TargetClusterSizing) *v1alpha1.TopologySizingResponse {
+	response := &v1alpha1.TopologySizingResponse{
+		TargetClusters: make([]v1alpha1.TargetClusterSizing, 0, len(targets)),
+	}
+	for _, target := range targets {
+		sizing := toClusterSizing(target.Result, target.FailoverNodes)
+		response.TargetClusters = append(response.TargetClusters, v1alpha1.TargetClusterSizing{
+			Name:                target.Name,
+			SourceClusterIds:    target.SourceClusterIDs,
+			ClusterSizing:       sizing,
+			ResourceConsumption: toSizingResourceConsumption(target.Result.ResourceConsumption),
+			InventoryTotals: v1alpha1.InventoryTotals{
+				TotalVMs:    target.TotalVMs,
+				TotalCPU:    target.TotalCPU,
+				TotalMemory: target.TotalMemory,
+			},
+		})
+		response.Totals.TotalNodes += sizing.TotalNodes
+		response.Totals.WorkerNodes += sizing.WorkerNodes
+		response.Totals.ControlPlaneNodes += sizing.ControlPlaneNodes
+		response.Totals.FailoverNodes += sizing.FailoverNodes
+		response.Totals.TotalCPU += sizing.TotalCPU
+		response.Totals.TotalMemory += sizing.TotalMemory
+	}
+	return response
+}
+
+// ToHardwareOptionsResponse maps the sizing of the cluster with every suitable SKU, in the order
+// of options, against the baseline. Cost savings are only known when the baseline is a SKU.
+func (m *Mapper) ToHardwareOptionsResponse(
+	baseline HardwareSizing,
+	options []HardwareSizing,
+	unsuitable []UnsuitableHardwareSKU,
+	totalVMs int,
+	totalCPU int,
+	totalMemory int,
+) *v1alpha1.HardwareOptionsResponse {
+	baselineSizing := toClusterSizing(baseline.Result, baseline.FailoverNodes)
+	response := &v1alpha1.HardwareOptionsResponse{
+		Baseline: v1alpha1.HardwareBaseline{
+			WorkerNodeCPU:    baseline.WorkerNodeCPU,
+			WorkerNodeMemory: baseline.WorkerNodeMemory,
+			ClusterSizing:    baselineSizing,
+		},
+		Options:        make([]v1alpha1.HardwareOption, 0, len(options)),
+		UnsuitableSkus: make([]v1alpha1.UnsuitableHardwareSku, 0, len(unsuitable)),
 		InventoryTotals: v1alpha1.InventoryTotals{
 			TotalVMs:    totalVMs,
 			TotalCPU:    totalCPU,
 			TotalMemory: totalMemory,
 		},
 	}
+	description := fmt.Sprintf("Compared to worker nodes of %d CPU / %d GB", baseline.WorkerNodeCPU, baseline.WorkerNodeMemory)
+	if baseline.SKU != nil {
+		response.Baseline.Sku = &baseline.SKU.Name
+		response.Baseline.TotalCost = &baseline.TotalCost
+		description = fmt.Sprintf("Compared to SKU %s", baseline.SKU.Name)
+	}
+
+	for i, option := range options {
+		sizing := toClusterSizing(option.Result, option.FailoverNodes)
+		apiOption := v1alpha1.HardwareOption{
+			Rank:            i + 1,
+			Sku:             toAPIHardwareSKU(*option.SKU),
+			ClusterSizing:   sizing,
+			NodeCount:       option.NodeCount,
+			TotalCost:       option.TotalCost,
+			TotalRackUnits:  option.TotalRackUnits,
+			TotalPowerWatts: option.TotalPowerWatts,
+		}
+		if nodesSaved := baselineSizing.TotalNodes - sizing.TotalNodes; nodesSaved > 0 {
+			apiOption.Savings = &v1alpha1.Savings{
+				NodesSaved:          nodesSaved,
+				PercentageReduction: (float64(nodesSaved) / float64(baselineSizing.TotalNodes)) * 100,
+				Description:         description,
+			}
+		}
+		if baseline.SKU != nil {
+			costSavings := baseline.TotalCost - option.TotalCost
+			apiOption.CostSavings = &costSavings
+		}
+		response.Options = append(response.Options, apiOption)
+	}
+
+	for _, sku := range unsuitable {
+		response.UnsuitableSkus = append(response.UnsuitableSkus, v1alpha1.UnsuitableHardwareSku{
+			Name:   sku.Name,
+			Reason: sku.Reason,
+		})
+	}
+	return response
+}
+
+func toAPIHardwareSKU(sku HardwareSKUForm) v1alpha1.HardwareSku {
+	return v1alpha1.HardwareSku{
+		Name:       sku.Name,
+		Cores:      sku.Cores,
+		Threads:    sku.Threads,
+		MemoryGB:   sku.MemoryGB,
+		Price:      sku.Price,
+		RackUnits:  sku.RackUnits,
+		PowerWatts: sku.PowerWatts,
+		Source:     v1alpha1.HardwareSkuSource(sku.Source),
+	}
+}
+
+// toClusterSizing counts the failover nodes in the worker and total nodes of a sizing result.
+func toClusterSizing(result SizingResult, failoverNodes int) v1alpha1.ClusterSizing {
+	controlPlaneNodes := result.TotalNodes - result.WorkerNodes
+	totalWorkers := result.WorkerNodes + failoverNodes
+	return v1alpha1.ClusterSizing{
+		TotalNodes:        controlPlaneNodes + totalWorkers,
+		WorkerNodes:       totalWorkers,
+		ControlPlaneNodes: controlPlaneNodes,
+		FailoverNodes:     failoverNodes,
+		TotalCPU:          result.TotalCPU,
+		TotalMemory:       result.TotalMemory,
+	}
+}
+
+func toSizingResourceConsumption(rc *ResourceConsumption) v1alpha1.SizingResourceConsumption {
+	resourceConsumption := v1alpha1.SizingResourceConsumption{
+		Limits:          &v1alpha1.SizingResourceLimits{},
+		OverCommitRatio: &v1alpha1.SizingOverCommitRatio{},
+	}
+	if rc == nil {
+		return resourceConsumption
+	}
+	resourceConsumption.Cpu = rc.CPU
+	resourceConsumption.Memory = rc.Memory
+
+	if rc.Limits != nil {
+		resourceConsumption.Limits = &v1alpha1.SizingResourceLimits{
+			Cpu:    rc.Limits.CPU,
+			Memory: rc.Limits.Memory,
+		}
+	}
+
+	if rc.OverCommitRatio != nil {
+		resourceConsumption.OverCommitRatio = &v1alpha1.SizingOverCommitRatio{
+			Cpu:    rc.OverCommitRatio.CPU,
+			Memory: rc.OverCommitRatio.Memory,
+		}
+	}
+	return resourceConsumption
+}
+
+func (m *Mapper) ToStorageSizing(sizing StorageSizing) *v1alpha1.StorageSizing {
+	return &v1alpha1.StorageSizing{
+		Backend:         v1alpha1.StorageBackend(sizing.Backend),
+		ProvisionedGB:   sizing.ProvisionedGB,
+		DatastoreUsedGB: sizing.DatastoreUsedGB,
+		DiskTypes:       sizing.DiskTypes,
+		DataGB:          sizing.DataGB,
+		UsableGB:        sizing.UsableGB,
+		RawGB:           sizing.RawGB,
+		StorageNodes:    sizing.StorageNodes,
+		OsdDisksPerNode: sizing.OSDDisksPerNode,
+		TotalOsdDisks:   sizing.TotalOSDDisks,
+	}
+}
+
+func toAPIVMPacking(packing *VMPacking) *v1alpha1.VmPacking {
+	if packing == nil {
+		return nil
+	}
+	result := &v1alpha1.VmPacking{
+		PackedVms:           packing.PackedVMs,
+		UnplaceableVms:      make([]v1alpha1.UnplaceableVm, 0, len(packing.Unplaceable)),
+		LargestVms:          make([]v1alpha1.PackedVm, 0, len(packing.LargestVMs)),
+		LargestVmsNodeCount: packing.LargestVMsNodeCount,
+	}
+	for _, vm := range packing.Unplaceable {
+		result.UnplaceableVms = append(result.UnplaceableVms, v1alpha1.UnplaceableVm{
+			Id:       vm.ID,
+			Name:     vm.Name,
+			Cpu:      vm.CPU,
+			MemoryGb: vm.MemoryGB,
+			Reason:   vm.Reason,
+		})
+	}
+	for _, vm := range packing.LargestVMs {
+		result.LargestVms = append(result.LargestVms, v1alpha1.PackedVm{
+			Id:       vm.ID,
+			Name:     vm.Name,
+			Cpu:      vm.CPU,
+			MemoryGb: vm.MemoryGB,
+		})
+	}
+	return result
+}
+
+// ToRightSizingReport maps the right-sizing suggestions of the VMs of a snapshot.
+func (m *Mapper) ToRightSizingReport(snapshotID uint, totals RightSizingTotals, suggestions []VMRightSizing) *v1alpha1.RightSizingReport {
+	report := &v1alpha1.RightSizingReport{
+		SnapshotId:  int(snapshotID),
+		Totals:      *m.ToRightSizingTotals(totals),
+		Suggestions: make([]v1alpha1.RightSizingSuggestion, 0, len(suggestions)),
+	}
+	for _, vm := range suggestions {
+		report.Suggestions = append(report.Suggestions, v1alpha1.RightSizingSuggestion{
+			Id:                 vm.ID,
+			Name:               vm.Name,
+			ClusterId:          vm.ClusterID,
+			CurrentCPU:         vm.CurrentCPU,
+			SuggestedCPU:       vm.SuggestedCPU,
+			CurrentMemoryGB:    vm.CurrentMemoryGB,
+			SuggestedMemoryGB:  vm.SuggestedMemoryGB,
+			CpuUsagePercent:    vm.CpuUsagePercent,
+			MemoryUsagePercent: vm.MemoryUsagePercent,
+			Description:        rightSizingDescription(vm),
+		})
+	}
+	return report
+}
+
+func (m *Mapper) ToRightSizingTotals(totals RightSizingTotals) *v1alpha1.RightSizingTotals {
+	// without any utilization every VM keeps its allocation
+	basis := v1alpha1.Allocation
+	if totals.VMsWithUtilization > 0 {
+		basis = v1alpha1.Utilization
+	}
+	return &v1alpha1.RightSizingTotals{
+		SizingBasis:        basis,
+		VmsWithUtilization: totals.VMsWithUtilization,
+		VmsRightSized:      totals.VMsRightSized,
+		CurrentCPU:         totals.CurrentCPU,
+		SuggestedCPU:       totals.SuggestedCPU,
+		CurrentMemoryGB:    totals.CurrentMemoryGB,
+		SuggestedMemoryGB:  totals.SuggestedMemoryGB,
+	}
+}
+
+// rightSizingDescription describes the reduced resources of a VM, e.g. "vCPU 16 → 4, RAM 64 GB → 24 GB".
+func rightSizingDescription(vm VMRightSizing) string {
+	var parts []string
+	if vm.SuggestedCPU < vm.CurrentCPU {
+		parts = append(parts, fmt.Sprintf("vCPU %d → %d", vm.CurrentCPU, vm.SuggestedCPU))
+	}
+	if vm.SuggestedMemoryGB < vm.CurrentMemoryGB {
+		parts = append(parts, fmt.Sprintf("RAM %g GB → %g GB", vm.CurrentMemoryGB, vm.SuggestedMemoryGB))
+	}
+	return strings.Join(parts, ", ")
 }
 
 type SizingResult struct {
@@ -475,6 +732,110 @@ type SizingResult struct {
 	EffectiveCPU        float64
 	EffectiveMemory     float64
 	ResourceConsumption *ResourceConsumption
+	VMPacking           *VMPacking
+}
+
+// VMPacking is the outcome of packing the VMs of a cluster onto worker nodes.
+type VMPacking struct {
+	PackedVMs           int
+	Unplaceable         []UnplaceableVM
+	LargestVMs          []PackedVM
+	LargestVMsNodeCount int
+}
+
+type PackedVM struct {
+	ID       string
+	Name     string
+	CPU      float64
+	MemoryGB float64
+}
+
+type UnplaceableVM struct {
+	PackedVM
+	Reason string
+}
+
+// TargetClusterSizing is the baseline sizing of a target cluster of a topology, from the
+// aggregated inventory totals of its source clusters.
+type TargetClusterSizing struct {
+	Name             string
+	SourceClusterIDs []string
+	TotalVMs         int
+	TotalCPU         int
+	TotalMemory      int
+	Result           SizingResult
+	FailoverNodes    int
+}
+
+// HardwareSKUForm is a server model of the hardware catalog.
+type HardwareSKUForm struct {
+	Name       string
+	Cores      int
+	Threads    *int
+	MemoryGB   int
+	Price      float64
+	RackUnits  int
+	PowerWatts int
+	Source     string
+}
+
+// HardwareSizing is the baseline sizing of a cluster with the worker nodes of a SKU, or with the
+// worker node of the request when SKU is nil. NodeCount and the totals cover the worker nodes,
+// failover nodes included.
+type HardwareSizing struct {
+	SKU              *HardwareSKUForm
+	WorkerNodeCPU    int
+	WorkerNodeMemory int
+	Result           SizingResult
+	FailoverNodes    int
+	NodeCount        int
+	TotalCost        float64
+	TotalRackUnits   int
+	TotalPowerWatts  int
+}
+
+// VMRightSizing is the allocation of a VM before and after right-sizing it to its utilization.
+// The usage percentages are nil when the source doesn't report them.
+type VMRightSizing struct {
+	ID                 string
+	Name               string
+	ClusterID          string
+	CurrentCPU         int
+	SuggestedCPU       int
+	CurrentMemoryGB    float64
+	SuggestedMemoryGB  float64
+	CpuUsagePercent    *float64
+	MemoryUsagePercent *float64
+}
+
+// RightSizingTotals sums up the allocations of VMs before and after right-sizing.
+type RightSizingTotals struct {
+	VMsWithUtilization int
+	VMsRightSized      int
+	CurrentCPU         int
+	SuggestedCPU       int
+	CurrentMemoryGB    float64
+	SuggestedMemoryGB  float64
+}
+
+type UnsuitableHardwareSKU struct {
+	Name   string
+	Reason string
+}
+
+// StorageSizing is the storage capacity of the VM disks of a cluster on a target backend.
+// The OSD fields are only set for ODF backends.
+type StorageSizing struct {
+	Backend         string
+	ProvisionedGB   float64
+	DatastoreUsedGB float64
+	DiskTypes       map[string]float64
+	DataGB          float64
+	UsableGB        float64
+	RawGB           float64
+	StorageNodes    *int
+	OSDDisksPerNode *int
+	TotalOSDDisks   *int
 }
 
 type ResourceConsumption struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,7 +13,9 @@ import (
 	"github.com/kubev2v/migration-planner/internal/store/model"
 )
 
-func revokeSharedAssessments(ctx context.Context, s store.Store, username, partnerID string) error {
+// revokeSharedAssessments removes the relations granted to the partner on the assessments of the
+// user, and records each revocation in the audit log on behalf of actor.
+func revokeSharedAssessments(ctx context.Context, s store.Store, actor, username, partnerID string) error {
 	assessments, err := s.Assessment().List(ctx, store.NewAssessmentQueryFilter().WithUsername(username))
 	if err != nil {
 		return fmt.Errorf("failed to list assessments for user %s: %w", username, err)
@@ -20,13 +23,46 @@ func revokeSharedAssessments(ctx context.Context, s store.Store, username, partn
 	if len(assessments) == 0 {
 		return nil
 	}
+
+	ids := make([]string, 0, len(assessments))
+	for _, a := range assessments {
+		ids = append(ids, a.ID.String())
+	}
+	relsByID, err := s.Authz().ListBulkRelationship(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list relationships of the assessments of user %s: %w", username, err)
+	}
+
+	partner := model.NewOrgSubject(partnerID)
 	builder := store.NewRelationshipBuilder()
 	for _, a := range assessments {
-		builder.Without(model.NewAssessmentResource(a.ID.String()), model.ViewerRelation, model.NewOrgSubject(partnerID))
+		for _, rel := range relsByID[a.ID.String()] {
+			if rel.Subject != partner || !slices.Contains(sharingRelations, rel.Relation) {
+				continue
+			}
+			builder.Without(model.NewAssessmentResource(a.ID.String()), rel.Relation, partner)
+			if err := auditSharing(ctx, s, actor, a.ID, partner, rel.Relation, ""); err != nil {
+				return err
+			}
+		}
 	}
 	return s.Authz().WriteRelationships(ctx, builder.Build())
 }
 
+// auditPartnerRequest records a change of status of a partner request.
+func auditPartnerRequest(ctx context.Context, s store.Store, actor string, action model.AuditAction, pc model.PartnerCustomer, after model.AuditState) error {
+	after["username"] = pc.Username
+	return recordAudit(ctx, s, model.AuditEntry{
+		Actor:        actor,
+		Action:       action,
+		ResourceType: model.AuditPartnerRequestResource,
+		ResourceID:   pc.ID.String(),
+		Subject:      model.NewOrgSubject(pc.PartnerID).String(),
+		Before:       auditState(model.AuditState{"username": pc.Username, "status": pc.RequestStatus}),
+		After:        auditState(after),
+	})
+}
+
 type PartnerServicer interface {
 	// Regular user
 	ListPartners(ctx context.Context) (model.GroupList, error)
@@ -106,6 +142,14 @@ func (s *PartnerService) CreateRequest(ctx context.Context, user auth.User, part
 		return nil, NewErrResourceNotFoundByStr(partnerID, "partner")
 	}
 
+	ctx, err = s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
 	pc.ID = uuid.New()
 	pc.Username = user.Username
 	pc.PartnerID = partnerID
@@ -117,6 +161,21 @@ func (s *PartnerService) CreateRequest(ctx context.Context, user auth.User, part
 		}
 		return nil, err
 	}
+
+	if err := recordAudit(ctx, s.store, model.AuditEntry{
+		Actor:        user.Username,
+		Action:       model.AuditPartnerRequestCreated,
+		ResourceType: model.AuditPartnerRequestResource,
+		ResourceID:   created.ID.String(),
+		Subject:      model.NewOrgSubject(partnerID).String(),
+		After:        auditState(model.AuditState{"username": user.Username, "status": created.RequestStatus}),
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return created, nil
 }
 
@@ -135,12 +194,30 @@ func (s *PartnerService) CancelRequest(ctx context.Context, user auth.User, requ
 	if pc.RequestStatus != model.RequestStatusPending {
 		return NewErrInvalidRequest("only pending requests can be cancelled")
 	}
+
+	ctx, err = s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
 	now := time.Now()
-	_, err = s.store.PartnerCustomer().Update(ctx, model.PartnerCustomer{
+	if _, err = s.store.PartnerCustomer().Update(ctx, model.PartnerCustomer{
 		ID:            requestID,
 		RequestStatus: model.RequestStatusCancelled,
 		TerminatedAt:  &now,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if err := auditPartnerRequest(ctx, s.store, user.Username, model.AuditPartnerRequestCancelled, *pc,
+		model.AuditState{"status": model.RequestStatusCancelled}); err != nil {
+		return err
+	}
+
+	_, err = store.Commit(ctx)
 	return err
 }
 
@@ -195,7 +272,12 @@ func (s *PartnerService) LeavePartner(ctx context.Context, user auth.User, partn
 		return err
 	}
 
-	if err = revokeSharedAssessments(ctx, s.store, user.Username, partnerID); err != nil {
+	if err := auditPartnerRequest(ctx, s.store, user.Username, model.AuditPartnerLeft, *pc,
+		model.AuditState{"status": model.RequestStatusCancelled}); err != nil {
+		return err
+	}
+
+	if err = revokeSharedAssessments(ctx, s.store, user.Username, user.Username, partnerID); err != nil {
 		return err
 	}
 
@@ -240,11 +322,38 @@ func (s *PartnerService) UpdateRequest(ctx context.Context, user auth.User, requ
 		RequestStatus: req.Status,
 		Reason:        reason,
 	}
+	action := model.AuditPartnerRequestRejected
 	if req.Status == model.RequestStatusAccepted {
 		now := time.Now()
 		update.AcceptedAt = &now
+		action = model.AuditPartnerRequestAccepted
+	}
+
+	ctx, err = s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return s.store.PartnerCustomer().Update(ctx, update)
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	updated, err := s.store.PartnerCustomer().Update(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+
+	after := model.AuditState{"status": req.Status}
+	if reason != nil {
+		after["reason"] = *reason
+	}
+	if err := auditPartnerRequest(ctx, s.store, user.Username, action, *pc, after); err != nil {
+		return nil, err
+	}
+
+	if _, err := store.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
 // RemoveCustomer removes a customer from the partner's group.
@@ -277,7 +386,12 @@ func (s *PartnerService) RemoveCustomer(ctx context.Context, user auth.User, use
 		return err
 	}
 
-	if err = revokeSharedAssessments(ctx, s.store, username, pc.PartnerID); err != nil {
+	if err := auditPartnerRequest(ctx, s.store, user.Username, model.AuditPartnerCustomerRemoved, *pc,
+		model.AuditState{"status": model.RequestStatusCancelled}); err != nil {
+		return err
+	}
+
+	if err = revokeSharedAssessments(ctx, s.store, user.Username, username, pc.PartnerID); err != nil {
 		return err
 	}
 
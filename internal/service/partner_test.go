@@ -13,6 +13,7 @@ import (
 	"github.com/kubev2v/migration-planner/internal/store/model"
 	"github.com/kubev2v/migration-planner/pkg/events/kafka"
 	"github.com/kubev2v/migration-planner/pkg/events/notification"
+	"github.com/kubev2v/migration-planner/pkg/requestid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gorm.io/gorm"
@@ -592,4 +593,91 @@ var _ = Describe("partner service", Ordered, func() {
 			gormdb.Exec("DELETE FROM groups;")
 		})
 	})
+	Context("audit log", func() {
+		var partnerGroupID uuid.UUID
+
+		BeforeEach(func() {
+			// the entries of the other specs are kept, the log is append-only
+			tx := gormdb.Exec("TRUNCATE audit_log;")
+			Expect(tx.Error).To(BeNil())
+			partnerGroupID = uuid.New()
+			tx = gormdb.Exec(fmt.Sprintf(insertPartnerGroupStm, partnerGroupID, "Partner Org", "desc", "partner", "icon", "Acme", "NULL"))
+			Expect(tx.Error).To(BeNil())
+		})
+
+		It("records the accepted request", func() {
+			requestID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertPartnerCustomerStm, requestID, "user1", partnerGroupID, "pending", "Name1", "Contact1", "555-0001", "user1@example.com", "Location1"))
+			Expect(tx.Error).To(BeNil())
+
+			_, err := srv.UpdateRequest(requestid.ToContext(context.TODO(), "req-1"), auth.User{Username: "partneruser"}, requestID, model.Request{
+				Status: model.RequestStatusAccepted,
+			})
+			Expect(err).To(BeNil())
+
+			entries, err := s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByResourceID(requestID.String()), nil)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Action).To(Equal(model.AuditPartnerRequestAccepted))
+			Expect(entries[0].Actor).To(Equal("partneruser"))
+			Expect(entries[0].RequestID).To(Equal("req-1"))
+			Expect(entries[0].Subject).To(Equal("org:" + partnerGroupID.String()))
+			Expect(entries[0].Before.Data).To(HaveKeyWithValue("status", "pending"))
+			Expect(entries[0].After.Data).To(HaveKeyWithValue("status", "accepted"))
+		})
+
+		It("records the assessments revoked when the customer leaves", func() {
+			tx := gormdb.Exec(fmt.Sprintf(insertPartnerCustomerStm, uuid.New(), "user1", partnerGroupID, "accepted", "Name1", "Contact1", "555-0001", "user1@example.com", "Location1"))
+			Expect(tx.Error).To(BeNil())
+			shared, notShared := uuid.New(), uuid.New()
+			for _, id := range []uuid.UUID{shared, notShared} {
+				tx = gormdb.Exec(fmt.Sprintf(insertAssessmentStm, id, "Assessment "+id.String(), "org1", "user1", "John", "Doe", service.SourceTypeInventory, "NULL"))
+				Expect(tx.Error).To(BeNil())
+			}
+			tx = gormdb.Exec(fmt.Sprintf(insertRelationStm, "assessment", shared, "viewer", "org", partnerGroupID))
+			Expect(tx.Error).To(BeNil())
+
+			err := srv.LeavePartner(context.TODO(), auth.User{Username: "user1"}, partnerGroupID.String())
+			Expect(err).To(BeNil())
+
+			entries, err := s.AuditLog().List(context.TODO(), store.NewAuditQueryFilter().ByActor("user1"), nil)
+			Expect(err).To(BeNil())
+			Expect(entries).To(ConsistOf(
+				And(HaveField("Action", model.AuditPartnerLeft), HaveField("ResourceType", model.AuditPartnerRequestResource)),
+				And(HaveField("Action", model.AuditAssessmentUnshared), HaveField("ResourceID", shared.String())),
+			))
+		})
+
+		It("does not record anything when the change fails", func() {
+			requestID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertPartnerCustomerStm, requestID, "user1", partnerGroupID, "accepted", "Name1", "Contact1", "555-0001", "user1@example.com", "Location1"))
+			Expect(tx.Error).To(BeNil())
+
+			err := srv.CancelRequest(context.TODO(), auth.User{Username: "user1"}, requestID)
+			Expect(err).ToNot(BeNil())
+
+			entries, err := s.AuditLog().List(context.TODO(), nil, nil)
+			Expect(err).To(BeNil())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("rejects updates of the entries", func() {
+			requestID := uuid.New()
+			tx := gormdb.Exec(fmt.Sprintf(insertPartnerCustomerStm, requestID, "user1", partnerGroupID, "pending", "Name1", "Contact1", "555-0001", "user1@example.com", "Location1"))
+			Expect(tx.Error).To(BeNil())
+			Expect(srv.CancelRequest(context.TODO(), auth.User{Username: "user1"}, requestID)).To(Succeed())
+
+			tx = gormdb.Exec("UPDATE audit_log SET actor = 'someone';")
+			Expect(tx.Error).ToNot(BeNil())
+			tx = gormdb.Exec("DELETE FROM audit_log;")
+			Expect(tx.Error).ToNot(BeNil())
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM relations;")
+			gormdb.Exec("DELETE FROM assessments;")
+			gormdb.Exec("DELETE FROM partners_customers;")
+			gormdb.Exec("DELETE FROM groups;")
+		})
+	})
 })
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/opa"
+)
+
+const (
+	maxPolicyBundleModules   = 50
+	maxPolicyBundleSize      = 1 << 20 // 1 MiB of Rego source
+	maxPolicyBundleTestVMs   = 100
+	maxPolicyBundleNameChars = 100
+)
+
+var policyModuleNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*\.rego$`)
+
+type PolicyBundleServicer interface {
+	ListBundles(ctx context.Context, orgID string) (model.PolicyBundleList, error)
+	GetBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error)
+	GetActiveBundle(ctx context.Context, orgID string) (*model.PolicyBundle, error)
+	CreateBundle(ctx context.Context, orgID string, form PolicyBundleForm) (*model.PolicyBundle, error)
+	ActivateBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error)
+	DeactivateBundle(ctx context.Context, orgID string) error
+	TestBundle(ctx context.Context, orgID string, revision int, vms []models.VM) ([]PolicyTestResult, error)
+}
+
+// PolicyBundleForm is an uploaded bundle of Rego policies.
+type PolicyBundleForm struct {
+	Name        string
+	Description string
+	// Modules maps the file names of the policies to their Rego source.
+	Modules map[string]string
+}
+
+// PolicyTestResult holds the concerns the global and the bundle policies raise for a VM.
+type PolicyTestResult struct {
+	VM       string
+	Concerns []models.Concern
+}
+
+// PolicyBundleService manages the OPA policies of the organizations. Their policies are evaluated
+// with the global policies, so they must compile with them: they can add concerns but not change
+// the global ones.
+type PolicyBundleService struct {
+	store     store.Store
+	validator *opa.Validator
+}
+
+func NewPolicyBundleService(store store.Store, validator *opa.Validator) *PolicyBundleService {
+	return &PolicyBundleService{store: store, validator: validator}
+}
+
+// ListBundles returns the revisions of the policies of the organization, the latest first.
+func (s *PolicyBundleService) ListBundles(ctx context.Context, orgID string) (model.PolicyBundleList, error) {
+	bundles, err := s.store.PolicyBundle().List(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy bundles: %w", err)
+	}
+	return bundles, nil
+}
+
+func (s *PolicyBundleService) GetBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	bundle, err := s.store.PolicyBundle().Get(ctx, orgID, revision)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrResourceNotFoundByStr(strconv.Itoa(revision), "policy bundle")
+		}
+		return nil, fmt.Errorf("failed to get policy bundle: %w", err)
+	}
+	return bundle, nil
+}
+
+// GetActiveBundle returns the policies evaluated with the global ones for the organization.
+func (s *PolicyBundleService) GetActiveBundle(ctx context.Context, orgID string) (*model.PolicyBundle, error) {
+	bundle, err := s.store.PolicyBundle().GetActive(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrResourceNotFoundByStr(orgID, "active policy bundle of organization")
+		}
+		return nil, fmt.Errorf("failed to get active policy bundle: %w", err)
+	}
+	return bundle, nil
+}
+
+// CreateBundle checks that the policies compile with the global ones and stores them as a new,
+// inactive revision of the organization.
+func (s *PolicyBundleService) CreateBundle(ctx context.Context, orgID string, form PolicyBundleForm) (*model.PolicyBundle, error) {
+	if err := validatePolicyBundleForm(form); err != nil {
+		return nil, err
+	}
+	bundle := model.PolicyBundle{
+		OrgID:       orgID,
+		Name:        strings.TrimSpace(form.Name),
+		Description: form.Description,
+		Modules:     *model.MakeJSONField(form.Modules),
+		CreatedBy:   auth.MustHaveUser(ctx).Username,
+	}
+	if _, err := s.compile(bundle); err != nil {
+		return nil, err
+	}
+
+	ctx, err := s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	created, err := s.store.PolicyBundle().Create(ctx, bundle)
+	if err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, NewErrDuplicateKey("policy bundle", orgID)
+		}
+		return nil, fmt.Errorf("failed to create policy bundle: %w", err)
+	}
+	if _, err := store.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ActivateBundle evaluates the revision with the global policies for the VMs the organization
+// ingests from now on. The revision is compiled again, in case the global policies changed.
+func (s *PolicyBundleService) ActivateBundle(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	bundle, err := s.GetBundle(ctx, orgID, revision)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.compile(*bundle); err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.store.NewTransactionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = store.Rollback(ctx)
+	}()
+
+	if err := s.store.PolicyBundle().Deactivate(ctx, orgID); err != nil {
+		return nil, fmt.Errorf("failed to deactivate policy bundle: %w", err)
+	}
+	if err := s.store.PolicyBundle().Activate(ctx, orgID, revision); err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrResourceNotFoundByStr(strconv.Itoa(revision), "policy bundle")
+		}
+		return nil, fmt.Errorf("failed to activate policy bundle: %w", err)
+	}
+	if _, err := store.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	bundle.Active = true
+	return bundle, nil
+}
+
+// DeactivateBundle reverts the organization to the global policies. Its revisions are kept.
+func (s *PolicyBundleService) DeactivateBundle(ctx context.Context, orgID string) error {
+	if err := s.store.PolicyBundle().Deactivate(ctx, orgID); err != nil {
+		return fmt.Errorf("failed to deactivate policy bundle: %w", err)
+	}
+	return nil
+}
+
+// TestBundle evaluates the global policies and the revision for sample VMs.
+func (s *PolicyBundleService) TestBundle(ctx context.Context, orgID string, revision int, vms []models.VM) ([]PolicyTestResult, error) {
+	if len(vms) == 0 || len(vms) > maxPolicyBundleTestVMs {
+		return nil, NewErrInvalidRequest(fmt.Sprintf("between 1 and %d VMs can be tested", maxPolicyBundleTestVMs))
+	}
+	bundle, err := s.GetBundle(ctx, orgID, revision)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := s.compile(*bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]PolicyTestResult, 0, len(vms))
+	for _, vm := range vms {
+		concerns, err := validator.Validate(ctx, vm)
+		if err != nil {
+			return nil, NewErrInvalidRequest(err.Error())
+		}
+		name := vm.Name
+		if name == "" {
+			name = vm.ID
+		}
+		results = append(results, PolicyTestResult{VM: name, Concerns: concerns})
+	}
+	return results, nil
+}
+
+func (s *PolicyBundleService) compile(bundle model.PolicyBundle) (*opa.Validator, error) {
+	if s.validator == nil {
+		return nil, NewErrInvalidRequest("OPA policies are not configured")
+	}
+	validator, err := s.validator.WithPolicies(bundle.Policies())
+	if err != nil {
+		return nil, NewErrInvalidRequest(err.Error())
+	}
+	return validator, nil
+}
+
+func validatePolicyBundleForm(form PolicyBundleForm) error {
+	name := strings.TrimSpace(form.Name)
+	if name == "" || len(name) > maxPolicyBundleNameChars {
+		return NewErrInvalidRequest(fmt.Sprintf("name must be between 1 and %d characters", maxPolicyBundleNameChars))
+	}
+	if len(form.Modules) == 0 || len(form.Modules) > maxPolicyBundleModules {
+		return NewErrInvalidRequest(fmt.Sprintf("a bundle has between 1 and %d policies", maxPolicyBundleModules))
+	}
+	size := 0
+	for filename, content := range form.Modules {
+		if filename == opa.RemediationCatalogFile {
+			size += len(content)
+			continue
+		}
+		if !policyModuleNameRegexp.MatchString(filename) || strings.HasSuffix(filename, "_test.rego") {
+			return NewErrInvalidRequest(fmt.Sprintf("invalid policy file name %q: expected <name>.rego or %s, test files are not supported", filename, opa.RemediationCatalogFile))
+		}
+		size += len(content)
+	}
+	if size > maxPolicyBundleSize {
+		return NewErrInvalidRequest(fmt.Sprintf("policies must not exceed %d bytes", maxPolicyBundleSize))
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/kubev2v/migration-planner/internal/auth"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const globalTestPolicy = `package io.konveyor.forklift.vmware
+
+import rego.v1
+
+concerns contains flag if {
+	input.cpuHotAddEnabled
+	flag := {
+		"id": "vmware.cpu_memory.hotplug.enabled",
+		"category": "Warning",
+		"label": "CPU hot add enabled",
+		"assessment": "CPU hot add is not supported.",
+	}
+}
+`
+
+const pciTestPolicy = `package io.konveyor.forklift.vmware
+
+import rego.v1
+
+concerns contains flag if {
+	"pci" in input.labels
+	flag := {
+		"id": "org.pci.wave1",
+		"category": "Critical",
+		"label": "PCI VM",
+		"assessment": "PCI VMs are not migratable in wave 1.",
+	}
+}
+`
+
+var _ = Describe("policy bundles", func() {
+	const orgID = "org-a"
+
+	var (
+		ctx       context.Context
+		mockStore *MockStore
+		bundleSvc *service.PolicyBundleService
+	)
+
+	BeforeEach(func() {
+		ctx = auth.NewTokenContext(context.TODO(), auth.User{Username: "admin", Organization: "admin"})
+		mockStore = NewMockStore()
+		validator, err := opa.NewValidator(map[string]string{"concerns.rego": globalTestPolicy})
+		Expect(err).To(BeNil())
+		bundleSvc = service.NewPolicyBundleService(mockStore, validator)
+	})
+
+	pciBundle := func() service.PolicyBundleForm {
+		return service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"pci.rego": pciTestPolicy}}
+	}
+
+	It("stores uploaded bundles as inactive revisions", func() {
+		first, err := bundleSvc.CreateBundle(ctx, orgID, pciBundle())
+		Expect(err).To(BeNil())
+		Expect(first.Revision).To(Equal(1))
+		Expect(first.Active).To(BeFalse())
+		Expect(first.CreatedBy).To(Equal("admin"))
+
+		second, err := bundleSvc.CreateBundle(ctx, orgID, pciBundle())
+		Expect(err).To(BeNil())
+		Expect(second.Revision).To(Equal(2))
+
+		bundles, err := bundleSvc.ListBundles(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(bundles).To(HaveLen(2))
+		Expect(bundles[0].Revision).To(Equal(2))
+
+		_, err = bundleSvc.GetActiveBundle(ctx, orgID)
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+	})
+
+	It("activates one revision at a time", func() {
+		_, err := bundleSvc.CreateBundle(ctx, orgID, pciBundle())
+		Expect(err).To(BeNil())
+		_, err = bundleSvc.CreateBundle(ctx, orgID, pciBundle())
+		Expect(err).To(BeNil())
+
+		_, err = bundleSvc.ActivateBundle(ctx, orgID, 1)
+		Expect(err).To(BeNil())
+		activated, err := bundleSvc.ActivateBundle(ctx, orgID, 2)
+		Expect(err).To(BeNil())
+		Expect(activated.Active).To(BeTrue())
+
+		active, err := bundleSvc.GetActiveBundle(ctx, orgID)
+		Expect(err).To(BeNil())
+		Expect(active.Revision).To(Equal(2))
+		first, err := bundleSvc.GetBundle(ctx, orgID, 1)
+		Expect(err).To(BeNil())
+		Expect(first.Active).To(BeFalse())
+
+		Expect(bundleSvc.DeactivateBundle(ctx, orgID)).To(Succeed())
+		_, err = bundleSvc.GetActiveBundle(ctx, orgID)
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+	})
+
+	It("returns not found for an unknown revision", func() {
+		_, err := bundleSvc.ActivateBundle(ctx, orgID, 7)
+		Expect(err).To(BeAssignableToTypeOf(&service.ErrResourceNotFound{}))
+	})
+
+	It("evaluates the global and the bundle policies for sample VMs", func() {
+		_, err := bundleSvc.CreateBundle(ctx, orgID, pciBundle())
+		Expect(err).To(BeNil())
+
+		results, err := bundleSvc.TestBundle(ctx, orgID, 1, []models.VM{
+			{Name: "pci-vm", Labels: models.Labels{"pci"}, CpuHotAddEnabled: true},
+			{ID: "vm-2"},
+		})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].VM).To(Equal("pci-vm"))
+		Expect(results[0].Concerns).To(ConsistOf(
+			HaveField("Id", "vmware.cpu_memory.hotplug.enabled"),
+			HaveField("Id", "org.pci.wave1"),
+		))
+		Expect(results[1].VM).To(Equal("vm-2"))
+		Expect(results[1].Concerns).To(BeEmpty())
+	})
+
+	It("adds the remediation catalog of the bundle to the global one", func() {
+		form := pciBundle()
+		form.Modules["remediations.json"] = `{"osUpgrades": {"fedora 30": "Red Hat Enterprise Linux 9"}}`
+		_, err := bundleSvc.CreateBundle(ctx, orgID, form)
+		Expect(err).To(BeNil())
+
+		results, err := bundleSvc.TestBundle(ctx, orgID, 1, []models.VM{{Name: "fedora", GuestName: "Fedora 30"}})
+		Expect(err).To(BeNil())
+		Expect(results[0].Concerns).To(ContainElement(HaveField("Id", "vmware.os.upgrade.recommendation")))
+	})
+
+	DescribeTable("rejects invalid bundles",
+		func(form service.PolicyBundleForm) {
+			_, err := bundleSvc.CreateBundle(ctx, orgID, form)
+			Expect(err).To(BeAssignableToTypeOf(&service.ErrInvalidRequest{}))
+			Expect(mockStore.policyBundles).To(BeEmpty())
+		},
+		Entry("without name", service.PolicyBundleForm{Modules: map[string]string{"pci.rego": pciTestPolicy}}),
+		Entry("without policies", service.PolicyBundleForm{Name: "empty"}),
+		Entry("with a file name that is not a policy", service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"../pci.rego": pciTestPolicy}}),
+		Entry("with a test file", service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"pci_test.rego": pciTestPolicy}}),
+		Entry("with an invalid remediation catalog", service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"pci.rego": pciTestPolicy, "remediations.json": "{"}}),
+		Entry("with a policy outside the concerns package", service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"pci.rego": "package acme\n\nallow := true\n"}}),
+		Entry("with a policy redefining the concerns", service.PolicyBundleForm{Name: "pci", Modules: map[string]string{"pci.rego": "package io.konveyor.forklift.vmware\n\nconcerns := []\n"}}),
+	)
+})
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -81,6 +80,9 @@ type SizerServicer interface {
 	CalculateClusterRequirements(ctx context.Context, assessmentID uuid.UUID, req *mappers.ClusterRequirementsRequestForm) (*api.ClusterRequirementsResponse, error)
 	CalculateStandaloneClusterRequirements(ctx context.Context, req *mappers.StandaloneClusterRequirementsRequestForm) (*mappers.StandaloneClusterRequirementsResponseForm, error)
 	GetClusterRequirementsInput(ctx context.Context, assessmentID uuid.UUID, clusterID string) (*mappers.ClusterRequirementsInputForm, error)
+	CalculateTopologySizing(ctx context.Context, assessmentID uuid.UUID, req *mappers.TopologySizingRequestForm) (*api.TopologySizingResponse, error)
+	CalculateHardwareOptions(ctx context.Context, assessmentID uuid.UUID, req *mappers.HardwareOptionsRequestForm, catalog []HardwareSKU) (*api.HardwareOptionsResponse, error)
+	GetRightSizing(ctx context.Context, assessmentID uuid.UUID, snapshotID *uint, clusterID string) (*api.RightSizingReport, error)
 	Health(ctx context.Context) error
 }
 
@@ -102,6 +104,7 @@ type SizingResult struct {
 	EffectiveMemory     float64
 	Services            []BatchedService
 	ResourceConsumption *client.ResourceConsumption
+	VMPacking           *mappers.VMPacking // set by the per-VM sizing mode
 }
 
 // ToMapperSizingResult converts service.SizingResult to mappers.SizingResult
@@ -114,12 +117,13 @@ func (s SizingResult) ToMapperSizingResult() mappers.SizingResult {
 		EffectiveCPU:        s.EffectiveCPU,
 		EffectiveMemory:     s.EffectiveMemory,
 		ResourceConsumption: convertResourceConsumption(s.ResourceConsumption),
+		VMPacking:           s.VMPacking,
 	}
 }
 
 // SizerService handles cluster sizing calculations
 type SizerService struct {
-	sizerClient *client.SizerClient
+	sizerClient client.Sizer
 	store       store.Store
 	logger      *log.StructuredLogger
 }
@@ -168,6 +172,8 @@ type clusterRequirementsParams struct {
 	ControlPlaneNodeCount   int
 	HostedControlPlane      bool
 	CompactMode             bool
+	// VMs, when set, are packed one by one instead of batching the totals
+	VMs []vmShape
 }
 
 var cpuOverCommitMultipliers = map[string]float64{
@@ -184,7 +190,9 @@ var memoryOverCommitMultipliers = map[string]float64{
 	"1:4": 4.0,
 }
 
-func NewSizerService(sizerClient *client.SizerClient, store store.Store) *SizerService {
+// NewSizerService creates a SizerService computing node counts with the given sizer, either the
+// remote sizer service or the in-process one.
+func NewSizerService(sizerClient client.Sizer, store store.Store) *SizerService {
 	return &SizerService{
 		sizerClient: sizerClient,
 		store:       store,
@@ -353,18 +361,40 @@ func (s *SizerService) calculateSizingWithMultipliers(
 		return SizingResult{}, err
 	}
 
-	services, err := s.aggregateVMsIntoServices(
-		effectiveTotalCPU,
-		effectiveTotalMemory,
-		totalVMs,
-		batchingNodeCPU,
-		batchingNodeMemory,
-		params.CpuOverCommitRatio,
-		params.MemoryOverCommitRatio,
-		CapacityMultiplier,
-	)
-	if err != nil {
-		return SizingResult{}, fmt.Errorf("aggregating services: %w", err)
+	var services []BatchedService
+	var vmPacking *mappers.VMPacking
+	if params.VMs != nil {
+		services, vmPacking, err = s.packVMsIntoServices(
+			params.VMs,
+			batchingNodeCPU,
+			batchingNodeMemory,
+			params.CpuOverCommitRatio,
+			params.MemoryOverCommitRatio,
+			CapacityMultiplier,
+			cpuMultiplier,
+			memoryMultiplier,
+		)
+		if err != nil {
+			var invalidReq *ErrInvalidRequest
+			if errors.As(err, &invalidReq) {
+				return SizingResult{}, err
+			}
+			return SizingResult{}, fmt.Errorf("packing VMs: %w", err)
+		}
+	} else {
+		services, err = s.aggregateVMsIntoServices(
+			effectiveTotalCPU,
+			effectiveTotalMemory,
+			totalVMs,
+			batchingNodeCPU,
+			batchingNodeMemory,
+			params.CpuOverCommitRatio,
+			params.MemoryOverCommitRatio,
+			CapacityMultiplier,
+		)
+		if err != nil {
+			return SizingResult{}, fmt.Errorf("aggregating services: %w", err)
+		}
 	}
 
 	includeControlPlane := !params.HostedControlPlane
@@ -491,6 +521,7 @@ func (s *SizerService) calculateSizingWithMultipliers(
 		EffectiveMemory:     effectiveTotalMemory,
 		Services:            services,
 		ResourceConsumption: &sizerResponse.Data.ResourceConsumption,
+		VMPacking:           vmPacking,
 	}, nil
 }
 
@@ -557,18 +588,14 @@ func (s *SizerService) CalculateClusterRequirements(
 
 	calcReq := applyDefaults(req)
 
-	if len(assessment.Snapshots) == 0 {
-		return nil, fmt.Errorf("assessment has no snapshots")
-	}
-
-	latestSnapshot := assessment.Snapshots[0]
-	if len(latestSnapshot.Inventory) == 0 {
-		return nil, fmt.Errorf("latest snapshot has empty inventory")
+	snapshot, err := selectSnapshot(assessment, calcReq.SnapshotID)
+	if err != nil {
+		return nil, err
 	}
 
-	var inventory api.Inventory
-	if err := json.Unmarshal(latestSnapshot.Inventory, &inventory); err != nil {
-		return nil, fmt.Errorf("failed to parse inventory: %w", err)
+	inventory, err := parseSnapshotInventory(snapshot)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(inventory.Clusters) == 0 {
@@ -577,7 +604,7 @@ func (s *SizerService) CalculateClusterRequirements(
 
 	clusterInventory, exists := inventory.Clusters[calcReq.ClusterID]
 	if !exists {
-		return nil, fmt.Errorf("cluster %s not found in assessment %s", calcReq.ClusterID, assessmentID)
+		return nil, NewErrClusterNotFound(calcReq.ClusterID, assessmentID)
 	}
 
 	totalVMs := clusterInventory.Vms.Total
@@ -605,52 +632,57 @@ func (s *SizerService) CalculateClusterRequirements(
 		CompactMode:             calcReq.CompactMode != nil && *calcReq.CompactMode,
 	}
 
-	singleNode := params.ControlPlaneNodeCount == 1
-	compactMode := params.CompactMode
-	controlPlaneSchedulable := extractControlPlaneSchedulable(&params)
-	workerNodeThreads := extractWorkerNodeThreads(&params)
-	effectiveCPU := CalculateEffectiveCPU(params.WorkerNodeCPU, workerNodeThreads)
-	smtMultiplier := 1.0
-	if params.WorkerNodeCPU > 0 && workerNodeThreads > 0 && workerNodeThreads > params.WorkerNodeCPU {
-		smtMultiplier = effectiveCPU / float64(params.WorkerNodeCPU)
+	var rightSizing *mappers.RightSizingTotals
+	var rightSizedVMs []mappers.VMRightSizing
+	if calcReq.RightSized {
+		vms, err := s.listClusterVMs(ctx, snapshot.ID, calcReq.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+		if len(vms) == 0 {
+			return nil, NewErrInvalidRequest(fmt.Sprintf(
+				"right-sizing requires the VMs of cluster %s, none is recorded for the snapshot", calcReq.ClusterID))
+		}
+		sized, totals := rightSizeVMs(vms)
+		// the inventory memory is in whole GB, only whole GB saved are taken off
+		params.TotalCPU = max(totalCPU-(totals.CurrentCPU-totals.SuggestedCPU), 1)
+		params.TotalMemory = max(totalMemory-int(totals.CurrentMemoryGB-totals.SuggestedMemoryGB), 1)
+		rightSizing = &totals
+		rightSizedVMs = sized
+	}
+
+	if isPerVMSizing(calcReq) {
+		var vms []vmShape
+		if rightSizedVMs != nil {
+			vms = rightSizedVMShapes(rightSizedVMs)
+		} else {
+			vms, err = s.listClusterVMShapes(ctx, snapshot.ID, calcReq.ClusterID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(vms) == 0 {
+			return nil, NewErrInvalidRequest(fmt.Sprintf(
+				"per-VM sizing requires the VMs of cluster %s, none is recorded for the snapshot", calcReq.ClusterID))
+		}
+		params.VMs = vms
 	}
 
-	if singleNode && !controlPlaneSchedulable {
-		return nil, NewErrInvalidRequest(
-			"single-node clusters require schedulable control planes. " +
-				"Set ControlPlaneSchedulable to true or use multiple control plane nodes")
+	var storageParams *storageSizingParams
+	if calcReq.Storage != nil {
+		sp, err := newStorageSizingParams(calcReq.Storage)
+		if err != nil {
+			return nil, err
+		}
+		storageParams = &sp
 	}
 
-	if err := validateCompactModeParams(params); err != nil {
+	baselineResult, err := s.calculateBaselineSizing(ctx, params)
+	if err != nil {
 		return nil, err
 	}
 
-	if !singleNode && !compactMode {
-		targetCPU := effectiveCPU * CapacityMultiplier
-		targetMemory := float64(params.WorkerNodeMemory) * CapacityMultiplier
-
-		minNodeCPUForMaxBatches, minNodeMemoryForMaxBatches := s.calculateMinimumNodeSize(
-			params.TotalCPU,
-			params.TotalMemory,
-			MaxBatches,
-			CapacityMultiplier,
-			smtMultiplier,
-		)
-
-		estimatedBatchesCPU := int(math.Ceil(float64(params.TotalCPU) / targetCPU))
-		estimatedBatchesMemory := int(math.Ceil(float64(params.TotalMemory) / targetMemory))
-		estimatedBatches := max(estimatedBatchesCPU, estimatedBatchesMemory)
-
-		if estimatedBatches > MaxBatches {
-			return nil, s.formatNodeSizeError(
-				params.WorkerNodeCPU, params.WorkerNodeMemory,
-				params.TotalCPU, params.TotalMemory,
-				minNodeCPUForMaxBatches, minNodeMemoryForMaxBatches,
-			)
-		}
-	}
-
-	utilizationContext := s.extractUtilizationFromInventory(&inventory, calcReq.ClusterID)
+	utilizationContext := s.extractUtilizationFromInventory(inventory, calcReq.ClusterID)
 
 	// Track optimization attempt status
 	optimizationStatus := api.OptimizationStatus{
@@ -658,49 +690,6 @@ func (s *SizerService) CalculateClusterRequirements(
 		Reason:    api.NoUtilizationData,
 	}
 
-	baselineResult, err := s.calculateSizingWithMultipliers(
-		ctx,
-		totalCPU,
-		totalMemory,
-		totalVMs,
-		params,
-		1.0,
-		1.0,
-	)
-	if err != nil {
-		var invalidReq *ErrInvalidRequest
-		if errors.As(err, &invalidReq) {
-			return nil, err
-		}
-		return nil, fmt.Errorf("calculating baseline sizing: %w", err)
-	}
-
-	if !singleNode && baselineResult.TotalNodes > MaxNodeCount {
-		minNodeCPU, minNodeMemory := s.calculateMinimumNodeSize(
-			params.TotalCPU,
-			params.TotalMemory,
-			MaxNodeCount,
-			CapacityMultiplier,
-			smtMultiplier,
-		)
-		return nil, s.formatNodeSizeError(
-			params.WorkerNodeCPU, params.WorkerNodeMemory,
-			params.TotalCPU, params.TotalMemory,
-			minNodeCPU, minNodeMemory,
-		)
-	}
-
-	if singleNode {
-		controlPlaneCPU := extractControlPlaneCPU(&params)
-		controlPlaneMemory := extractControlPlaneMemory(&params)
-		if err := s.validateSingleNodeFit(baselineResult.TotalNodes, totalCPU, totalMemory, smtMultiplier, params.CpuOverCommitRatio, params.MemoryOverCommitRatio, controlPlaneCPU, controlPlaneMemory); err != nil {
-			return nil, err
-		}
-	}
-
-	// Note: compact mode validation is now done inside calculateSizingWithMultipliers
-	// using the raw sizer response before transformation, so it's removed from here
-
 	logger.Operation("calculate_baseline").
 		WithString("cluster_id", calcReq.ClusterID).
 		WithInt("total_nodes", baselineResult.TotalNodes).
@@ -708,7 +697,10 @@ func (s *SizerService) CalculateClusterRequirements(
 		Build()
 
 	var optimizedResult *SizingResult
-	if !utilizationContext.HasData {
+	if rightSizing != nil && rightSizing.VMsWithUtilization > 0 {
+		// the VMs are already sized to their own utilization, the cluster utilization would count it twice
+		optimizationStatus.Reason = api.RightSized
+	} else if !utilizationContext.HasData {
 		// Check why optimization is not being attempted
 		if utilizationContext.Confidence > 0 && utilizationContext.Confidence < MinConfidenceThreshold {
 			optimizationStatus.Reason = api.LowConfidence
@@ -750,9 +742,11 @@ func (s *SizerService) CalculateClusterRequirements(
 		}
 	}
 
-	if err := s.persistClusterSizingInput(ctx, assessmentID, req); err != nil {
-		logger.Operation("persist_cluster_sizing_input").Build().Error(err).Log()
-		return nil, err
+	if !req.ReadOnly {
+		if err := s.persistClusterSizingInput(ctx, assessmentID, req); err != nil {
+			logger.Operation("persist_cluster_sizing_input").Build().Error(err).Log()
+			return nil, err
+		}
 	}
 
 	baselineFailoverNodes := calculateFailoverNodes(baselineResult.WorkerNodes)
@@ -780,7 +774,7 @@ func (s *SizerService) CalculateClusterRequirements(
 		HasData:          utilizationContext.HasData,
 	}
 
-	return mapper.ToClusterRequirementsResponse(
+	response := mapper.ToClusterRequirementsResponse(
 		baselineForMapper,
 		optimizedForMapper,
 		utilizationForMapper,
@@ -790,7 +784,115 @@ func (s *SizerService) CalculateClusterRequirements(
 		totalCPU,
 		totalMemory,
 		optimizationStatus,
-	), nil
+	)
+
+	if storageParams != nil {
+		// ODF runs on the workers, or on the control plane nodes of clusters without workers
+		storageNodes := response.ClusterSizing.WorkerNodes
+		if storageNodes == 0 {
+			storageNodes = response.ClusterSizing.TotalNodes
+		}
+		response.StorageSizing = mapper.ToStorageSizing(calculateStorageSizing(clusterInventory, *storageParams, storageNodes))
+	}
+	if rightSizing != nil {
+		response.RightSizing = mapper.ToRightSizingTotals(*rightSizing)
+	}
+
+	return response, nil
+}
+
+// calculateBaselineSizing validates the cluster parameters and sizes the cluster at 100% resource
+// allocation, recommending larger nodes when the batch or node limits are exceeded.
+func (s *SizerService) calculateBaselineSizing(ctx context.Context, params clusterRequirementsParams) (SizingResult, error) {
+	singleNode := params.ControlPlaneNodeCount == 1
+	compactMode := params.CompactMode
+	controlPlaneSchedulable := extractControlPlaneSchedulable(&params)
+	workerNodeThreads := extractWorkerNodeThreads(&params)
+	effectiveCPU := CalculateEffectiveCPU(params.WorkerNodeCPU, workerNodeThreads)
+	smtMultiplier := 1.0
+	if params.WorkerNodeCPU > 0 && workerNodeThreads > 0 && workerNodeThreads > params.WorkerNodeCPU {
+		smtMultiplier = effectiveCPU / float64(params.WorkerNodeCPU)
+	}
+
+	if singleNode && !controlPlaneSchedulable {
+		return SizingResult{}, NewErrInvalidRequest(
+			"single-node clusters require schedulable control planes. " +
+				"Set ControlPlaneSchedulable to true or use multiple control plane nodes")
+	}
+
+	if err := validateCompactModeParams(params); err != nil {
+		return SizingResult{}, err
+	}
+
+	if !singleNode && !compactMode {
+		targetCPU := effectiveCPU * CapacityMultiplier
+		targetMemory := float64(params.WorkerNodeMemory) * CapacityMultiplier
+
+		minNodeCPUForMaxBatches, minNodeMemoryForMaxBatches := s.calculateMinimumNodeSize(
+			params.TotalCPU,
+			params.TotalMemory,
+			MaxBatches,
+			CapacityMultiplier,
+			smtMultiplier,
+		)
+
+		estimatedBatchesCPU := int(math.Ceil(float64(params.TotalCPU) / targetCPU))
+		estimatedBatchesMemory := int(math.Ceil(float64(params.TotalMemory) / targetMemory))
+		estimatedBatches := max(estimatedBatchesCPU, estimatedBatchesMemory)
+
+		if estimatedBatches > MaxBatches {
+			return SizingResult{}, s.formatNodeSizeError(
+				params.WorkerNodeCPU, params.WorkerNodeMemory,
+				params.TotalCPU, params.TotalMemory,
+				minNodeCPUForMaxBatches, minNodeMemoryForMaxBatches,
+			)
+		}
+	}
+
+	baselineResult, err := s.calculateSizingWithMultipliers(
+		ctx,
+		params.TotalCPU,
+		params.TotalMemory,
+		params.TotalVMs,
+		params,
+		1.0,
+		1.0,
+	)
+	if err != nil {
+		var invalidReq *ErrInvalidRequest
+		if errors.As(err, &invalidReq) {
+			return SizingResult{}, err
+		}
+		return SizingResult{}, fmt.Errorf("calculating baseline sizing: %w", err)
+	}
+
+	if !singleNode && baselineResult.TotalNodes > MaxNodeCount {
+		minNodeCPU, minNodeMemory := s.calculateMinimumNodeSize(
+			params.TotalCPU,
+			params.TotalMemory,
+			MaxNodeCount,
+			CapacityMultiplier,
+			smtMultiplier,
+		)
+		return SizingResult{}, s.formatNodeSizeError(
+			params.WorkerNodeCPU, params.WorkerNodeMemory,
+			params.TotalCPU, params.TotalMemory,
+			minNodeCPU, minNodeMemory,
+		)
+	}
+
+	if singleNode {
+		controlPlaneCPU := extractControlPlaneCPU(&params)
+		controlPlaneMemory := extractControlPlaneMemory(&params)
+		if err := s.validateSingleNodeFit(baselineResult.TotalNodes, params.TotalCPU, params.TotalMemory, smtMultiplier, params.CpuOverCommitRatio, params.MemoryOverCommitRatio, controlPlaneCPU, controlPlaneMemory); err != nil {
+			return SizingResult{}, err
+		}
+	}
+
+	// Note: compact mode validation is now done inside calculateSizingWithMultipliers
+	// using the raw sizer response before transformation, so it's removed from here
+
+	return baselineResult, nil
 }
 
 // CalculateStandaloneClusterRequirements calculates cluster sizing for inline inventory.
@@ -1174,6 +1276,10 @@ func applyDefaults(req *mappers.ClusterRequirementsRequestForm) *mappers.Cluster
 		ControlPlaneCPU:         opts.ControlPlaneCPU,
 		ControlPlaneMemory:      opts.ControlPlaneMemory,
 		CompactMode:             req.CompactMode,
+		SnapshotID:              req.SnapshotID,
+		SizingMode:              req.SizingMode,
+		RightSized:              req.RightSized,
+		Storage:                 req.Storage,
 	}
 }
 
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/util"
+)
+
+// CalculateHardwareOptions sizes a cluster of an assessment with the worker nodes of every SKU of the
+// catalog, or of the SKUs named by the request, and ranks the SKUs by the total cost of the nodes:
+// the on-prem control plane nodes, the worker nodes and the failover nodes.
+//
+// The options are compared to a baseline: the SKU named by the request, or the worker node of the
+// request. SKUs the cluster cannot be sized with are reported with the reason.
+func (s *SizerService) CalculateHardwareOptions(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	req *mappers.HardwareOptionsRequestForm,
+	catalog []HardwareSKU,
+) (*api.HardwareOptionsResponse, error) {
+	if s.sizerClient == nil {
+		return nil, fmt.Errorf("sizer client is not configured")
+	}
+
+	tracer := s.logger.WithContext(ctx).Operation("calculate_hardware_options").
+		WithUUID("assessment_id", assessmentID).
+		WithString("cluster_id", req.Sizing.ClusterID).
+		Build()
+
+	skus, err := selectHardwareSKUs(catalog, req.SKUs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the zero SKU keeps the worker node of the request
+	var baselineSKU HardwareSKU
+	if req.BaselineSKU != "" {
+		i := slices.IndexFunc(catalog, func(sku HardwareSKU) bool { return sku.Name == req.BaselineSKU })
+		if i < 0 {
+			return nil, NewErrResourceNotFoundByStr(req.BaselineSKU, "hardware sku")
+		}
+		baselineSKU = catalog[i]
+	} else if req.Sizing.WorkerNodeCPU <= 0 || req.Sizing.WorkerNodeMemory <= 0 {
+		return nil, NewErrInvalidRequest("either baselineSku or workerNodeCPU and workerNodeMemory is required")
+	}
+
+	assessment, err := s.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAssessmentNotFound(assessmentID)
+		}
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	calcReq := applyDefaults(&req.Sizing)
+
+	snapshot, err := selectSnapshot(assessment, calcReq.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := parseSnapshotInventory(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInventory, exists := inventory.Clusters[calcReq.ClusterID]
+	if !exists {
+		return nil, NewErrClusterNotFound(calcReq.ClusterID, assessmentID)
+	}
+
+	totalVMs := clusterInventory.Vms.Total
+	totalCPU := clusterInventory.Vms.CpuCores.Total
+	totalMemory := clusterInventory.Vms.RamGB.Total
+
+	if totalVMs == 0 || totalCPU == 0 || totalMemory == 0 {
+		return nil, NewErrInvalidClusterInventory(calcReq.ClusterID, "cluster has no VMs or no CPU/Memory resources and cannot be used for migration planning")
+	}
+
+	params := clusterRequirementsParams{
+		TotalVMs:                totalVMs,
+		TotalCPU:                totalCPU,
+		TotalMemory:             totalMemory,
+		WorkerNodeCPU:           calcReq.WorkerNodeCPU,
+		WorkerNodeMemory:        calcReq.WorkerNodeMemory,
+		WorkerNodeThreads:       calcReq.WorkerNodeThreads,
+		CpuOverCommitRatio:      calcReq.CpuOverCommitRatio,
+		MemoryOverCommitRatio:   calcReq.MemoryOverCommitRatio,
+		ControlPlaneSchedulable: calcReq.ControlPlaneSchedulable,
+		ControlPlaneCPU:         calcReq.ControlPlaneCPU,
+		ControlPlaneMemory:      calcReq.ControlPlaneMemory,
+		ControlPlaneNodeCount:   effectiveControlPlaneNodeCount(calcReq),
+		HostedControlPlane:      calcReq.HostedControlPlane != nil && *calcReq.HostedControlPlane,
+	}
+
+	if isPerVMSizing(calcReq) {
+		vms, err := s.listClusterVMShapes(ctx, snapshot.ID, calcReq.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+		if len(vms) == 0 {
+			return nil, NewErrInvalidRequest(fmt.Sprintf(
+				"per-VM sizing requires the VMs of cluster %s, none is recorded for the snapshot", calcReq.ClusterID))
+		}
+		params.VMs = vms
+	}
+
+	baseline, err := s.calculateHardwareSizing(ctx, params, baselineSKU)
+	if err != nil {
+		tracer.Error(err).Log()
+		var invalidReq *ErrInvalidRequest
+		if errors.As(err, &invalidReq) {
+			return nil, NewErrInvalidRequest(fmt.Sprintf("baseline: %s", err))
+		}
+		return nil, fmt.Errorf("sizing baseline: %w", err)
+	}
+
+	options := make([]mappers.HardwareSizing, 0, len(skus))
+	unsuitable := []mappers.UnsuitableHardwareSKU{}
+	for _, sku := range skus {
+		option, err := s.calculateHardwareSizing(ctx, params, sku)
+		if err != nil {
+			var invalidReq *ErrInvalidRequest
+			if !errors.As(err, &invalidReq) {
+				tracer.Error(err).WithString("sku", sku.Name).Log()
+				return nil, fmt.Errorf("sizing sku %s: %w", sku.Name, err)
+			}
+			unsuitable = append(unsuitable, mappers.UnsuitableHardwareSKU{Name: sku.Name, Reason: err.Error()})
+			continue
+		}
+		options = append(options, option)
+	}
+
+	// cheapest first; on equal cost, the fewest nodes, then the smallest footprint
+	slices.SortStableFunc(options, func(a, b mappers.HardwareSizing) int {
+		return cmp.Or(
+			cmp.Compare(a.TotalCost, b.TotalCost),
+			cmp.Compare(a.NodeCount, b.NodeCount),
+			cmp.Compare(a.TotalRackUnits, b.TotalRackUnits),
+			cmp.Compare(a.SKU.Name, b.SKU.Name),
+		)
+	})
+
+	tracer.Success().
+		WithInt("options", len(options)).
+		WithInt("unsuitable_skus", len(unsuitable)).
+		Log()
+
+	mapper := &mappers.Mapper{}
+	return mapper.ToHardwareOptionsResponse(baseline, options, unsuitable, totalVMs, totalCPU, totalMemory), nil
+}
+
+// calculateHardwareSizing sizes the cluster with the worker nodes of a SKU. A SKU without name
+// keeps the worker node of params.
+func (s *SizerService) calculateHardwareSizing(ctx context.Context, params clusterRequirementsParams, sku HardwareSKU) (mappers.HardwareSizing, error) {
+	var form *mappers.HardwareSKUForm
+	if sku.Name != "" {
+		params.WorkerNodeCPU = sku.Cores
+		params.WorkerNodeMemory = sku.MemoryGB
+		params.WorkerNodeThreads = sku.Threads
+		if params.WorkerNodeThreads == nil {
+			params.WorkerNodeThreads = util.IntPtr(defaultWorkerNodeThreads)
+		}
+		form = toHardwareSKUForm(sku)
+	}
+
+	result, err := s.calculateBaselineSizing(ctx, params)
+	if err != nil {
+		return mappers.HardwareSizing{}, err
+	}
+	// The on-prem control plane nodes run on the SKU too; a hosted control plane has none.
+	failoverNodes := calculateFailoverNodes(result.WorkerNodes)
+	nodeCount := result.TotalNodes + failoverNodes
+	return mappers.HardwareSizing{
+		SKU:              form,
+		WorkerNodeCPU:    params.WorkerNodeCPU,
+		WorkerNodeMemory: params.WorkerNodeMemory,
+		Result:           result.ToMapperSizingResult(),
+		FailoverNodes:    failoverNodes,
+		NodeCount:        nodeCount,
+		TotalCost:        float64(nodeCount) * sku.Price,
+		TotalRackUnits:   nodeCount * sku.RackUnits,
+		TotalPowerWatts:  nodeCount * sku.PowerWatts,
+	}, nil
+}
+
+// selectHardwareSKUs returns the SKUs of the catalog named, or the whole catalog if names is empty.
+func selectHardwareSKUs(catalog []HardwareSKU, names []string) ([]HardwareSKU, error) {
+	if len(names) == 0 {
+		if len(catalog) == 0 {
+			return nil, NewErrInvalidRequest("the hardware catalog has no SKU")
+		}
+		return catalog, nil
+	}
+	skus := make([]HardwareSKU, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(catalog, func(sku HardwareSKU) bool { return sku.Name == name })
+		if i < 0 {
+			return nil, NewErrResourceNotFoundByStr(name, "hardware sku")
+		}
+		if !slices.ContainsFunc(skus, func(sku HardwareSKU) bool { return sku.Name == name }) {
+			skus = append(skus, catalog[i])
+		}
+	}
+	return skus, nil
+}
+
+func toHardwareSKUForm(sku HardwareSKU) *mappers.HardwareSKUForm {
+	return &mappers.HardwareSKUForm{
+		Name:       sku.Name,
+		Cores:      sku.Cores,
+		Threads:    sku.Threads,
+		MemoryGB:   sku.MemoryGB,
+		Price:      sku.Price,
+		RackUnits:  sku.RackUnits,
+		PowerWatts: sku.PowerWatts,
+		Source:     string(sku.Source),
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+const (
+	// RightSizingTargetUtilization is the utilization of a right-sized VM at its measured usage. The
+	// headroom covers the peaks missed by the single sample of RVTools exports.
+	RightSizingTargetUtilization = 0.7
+
+	// MinRightSizedMemoryGB is the smallest memory suggested for a VM
+	MinRightSizedMemoryGB = 1
+
+	// rightSizingTolerance keeps float rounding errors from adding a vCPU or a GB
+	rightSizingTolerance = 1e-9
+
+	poweredOnState = "poweredOn"
+)
+
+// GetRightSizing suggests smaller vCPU and memory allocations for the VMs of an assessment
+// snapshot, optionally of a single cluster, from the utilization recorded for each VM.
+func (s *SizerService) GetRightSizing(ctx context.Context, assessmentID uuid.UUID, snapshotID *uint, clusterID string) (*api.RightSizingReport, error) {
+	tracer := s.logger.WithContext(ctx).Operation("get_right_sizing").
+		WithUUID("assessment_id", assessmentID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
+		WithString("cluster_id", clusterID).
+		Build()
+
+	assessment, err := s.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAssessmentNotFound(assessmentID)
+		}
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	snapshot, err := selectSnapshot(assessment, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := store.NewAssessmentVMQueryFilter().BySnapshotID(snapshot.ID)
+	if clusterID != "" {
+		inventory, err := parseSnapshotInventory(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := inventory.Clusters[clusterID]; !ok {
+			return nil, NewErrClusterNotFound(clusterID, assessmentID)
+		}
+		filter = filter.ByCluster(clusterID)
+	}
+
+	vms, err := s.store.AssessmentVM().List(ctx, filter, store.NewAssessmentVMQueryOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VMs: %w", err)
+	}
+	vms = slices.DeleteFunc(vms, func(vm model.AssessmentVM) bool { return vm.IsTemplate })
+
+	sized, totals := rightSizeVMs(vms)
+	suggestions := slices.DeleteFunc(sized, func(vm mappers.VMRightSizing) bool {
+		return vm.SuggestedCPU == vm.CurrentCPU && vm.SuggestedMemoryGB == vm.CurrentMemoryGB
+	})
+	// largest reduction first
+	slices.SortStableFunc(suggestions, func(a, b mappers.VMRightSizing) int {
+		return cmp.Or(
+			cmp.Compare(b.CurrentCPU-b.SuggestedCPU, a.CurrentCPU-a.SuggestedCPU),
+			cmp.Compare(b.CurrentMemoryGB-b.SuggestedMemoryGB, a.CurrentMemoryGB-a.SuggestedMemoryGB),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	tracer.Success().
+		WithInt("snapshot_id", int(snapshot.ID)).
+		WithInt("vms_with_utilization", totals.VMsWithUtilization).
+		WithInt("vms_right_sized", totals.VMsRightSized).
+		Log()
+
+	mapper := &mappers.Mapper{}
+	return mapper.ToRightSizingReport(snapshot.ID, totals, suggestions), nil
+}
+
+// rightSizeVMs right-sizes every VM and sums up the allocations. VMs without utilization keep
+// their allocation.
+func rightSizeVMs(vms []model.AssessmentVM) ([]mappers.VMRightSizing, mappers.RightSizingTotals) {
+	sized := make([]mappers.VMRightSizing, 0, len(vms))
+	var totals mappers.RightSizingTotals
+	for _, vm := range vms {
+		result, hasUtilization := rightSizeVM(vm)
+		if hasUtilization {
+			totals.VMsWithUtilization++
+		}
+		if result.SuggestedCPU < result.CurrentCPU || result.SuggestedMemoryGB < result.CurrentMemoryGB {
+			totals.VMsRightSized++
+		}
+		totals.CurrentCPU += result.CurrentCPU
+		totals.SuggestedCPU += result.SuggestedCPU
+		totals.CurrentMemoryGB += result.CurrentMemoryGB
+		totals.SuggestedMemoryGB += result.SuggestedMemoryGB
+		sized = append(sized, result)
+	}
+	return sized, totals
+}
+
+// rightSizeVM sizes the vCPU and memory of a VM so that its measured usage runs at
+// RightSizingTargetUtilization, never above the current allocation. vCPUs and memory are rounded
+// up to whole vCPUs and GB. Only powered on VMs have a meaningful usage; the others, and the
+// resources without utilization, keep their allocation.
+func rightSizeVM(vm model.AssessmentVM) (mappers.VMRightSizing, bool) {
+	result := mappers.VMRightSizing{
+		ID:                 vm.VMID,
+		Name:               vm.Name,
+		ClusterID:          vm.ClusterID,
+		CurrentCPU:         vm.CpuCount,
+		SuggestedCPU:       vm.CpuCount,
+		CurrentMemoryGB:    float64(vm.MemoryMB) / 1024,
+		SuggestedMemoryGB:  float64(vm.MemoryMB) / 1024,
+		CpuUsagePercent:    vm.CpuUsagePercent,
+		MemoryUsagePercent: vm.MemoryUsagePercent,
+	}
+	if vm.PowerState != poweredOnState || (vm.CpuUsagePercent == nil && vm.MemoryUsagePercent == nil) {
+		return result, false
+	}
+
+	if vm.CpuUsagePercent != nil {
+		used := float64(vm.CpuCount) * *vm.CpuUsagePercent / 100
+		suggested := max(int(math.Ceil(used/RightSizingTargetUtilization-rightSizingTolerance)), 1)
+		result.SuggestedCPU = min(suggested, result.CurrentCPU)
+	}
+	if vm.MemoryUsagePercent != nil {
+		used := result.CurrentMemoryGB * *vm.MemoryUsagePercent / 100
+		suggested := max(math.Ceil(used/RightSizingTargetUtilization-rightSizingTolerance), MinRightSizedMemoryGB)
+		result.SuggestedMemoryGB = min(suggested, result.CurrentMemoryGB)
+	}
+	return result, true
+}
+
+// rightSizedVMShapes returns the right-sized CPU and memory of the VMs to pack onto worker nodes.
+func rightSizedVMShapes(vms []mappers.VMRightSizing) []vmShape {
+	shapes := make([]vmShape, 0, len(vms))
+	for _, vm := range vms {
+		shapes = append(shapes, vmShape{
+			id:       vm.ID,
+			name:     vm.Name,
+			cpu:      float64(vm.SuggestedCPU),
+			memoryGB: vm.SuggestedMemoryGB,
+		})
+	}
+	return shapes
+}
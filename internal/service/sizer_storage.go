@@ -0,0 +1,140 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+)
+
+const (
+	// StorageTargetUtilization keeps the storage backend 25% free, below the ODF near-full alerts
+	StorageTargetUtilization = 0.75
+
+	// ODFReplicaCount is the number of copies of every block in an ODF replica-3 pool
+	ODFReplicaCount = 3
+
+	// Storage defaults; must match api/v1alpha1/openapi.yaml.
+	defaultErasureCodingDataChunks   = 4
+	defaultErasureCodingCodingChunks = 2
+	defaultThinProvisioningRatio     = 1.0
+	defaultOSDDiskSizeGB             = 4096
+
+	gbPerTB = 1024
+)
+
+// storageSizingParams is a StorageSizingForm with defaults applied and validated.
+type storageSizingParams struct {
+	Backend               api.StorageBackend
+	DataChunks            int
+	CodingChunks          int
+	ThinProvisioningRatio float64
+	OSDDiskSizeGB         int
+}
+
+// newStorageSizingParams applies the defaults to the storage request and validates it.
+func newStorageSizingParams(form *mappers.StorageSizingForm) (storageSizingParams, error) {
+	params := storageSizingParams{
+		Backend:               api.StorageBackend(form.Backend),
+		DataChunks:            defaultErasureCodingDataChunks,
+		CodingChunks:          defaultErasureCodingCodingChunks,
+		ThinProvisioningRatio: defaultThinProvisioningRatio,
+		OSDDiskSizeGB:         defaultOSDDiskSizeGB,
+	}
+	if form.DataChunks != nil {
+		params.DataChunks = *form.DataChunks
+	}
+	if form.CodingChunks != nil {
+		params.CodingChunks = *form.CodingChunks
+	}
+	if form.ThinProvisioningRatio != nil {
+		params.ThinProvisioningRatio = *form.ThinProvisioningRatio
+	}
+	if form.OSDDiskSizeGB != nil {
+		params.OSDDiskSizeGB = *form.OSDDiskSizeGB
+	}
+
+	switch params.Backend {
+	case api.StorageBackendOdfReplica3, api.StorageBackendOdfErasureCoding, api.StorageBackendExternalSan:
+	default:
+		return storageSizingParams{}, NewErrInvalidRequest(fmt.Sprintf(
+			"invalid storage backend: %s. Valid values are: odfReplica3, odfErasureCoding, externalSan", form.Backend))
+	}
+	switch {
+	case params.DataChunks < 2:
+		return storageSizingParams{}, NewErrInvalidRequest(fmt.Sprintf("erasureCodingDataChunks must be at least 2, got: %d", params.DataChunks))
+	case params.CodingChunks < 1:
+		return storageSizingParams{}, NewErrInvalidRequest(fmt.Sprintf("erasureCodingCodingChunks must be at least 1, got: %d", params.CodingChunks))
+	case params.ThinProvisioningRatio < 1:
+		return storageSizingParams{}, NewErrInvalidRequest(fmt.Sprintf("thinProvisioningRatio must be at least 1, got: %g", params.ThinProvisioningRatio))
+	case params.OSDDiskSizeGB <= 0:
+		return storageSizingParams{}, NewErrInvalidRequest(fmt.Sprintf("osdDiskSizeGB must be positive, got: %d", params.OSDDiskSizeGB))
+	}
+	return params, nil
+}
+
+// isODF reports whether the backend is OpenShift Data Foundation, whose OSD disks are added to the cluster nodes.
+func (p storageSizingParams) isODF() bool {
+	return p.Backend == api.StorageBackendOdfReplica3 || p.Backend == api.StorageBackendOdfErasureCoding
+}
+
+// overhead returns the raw capacity stored per unit of data.
+func (p storageSizingParams) overhead() float64 {
+	switch p.Backend {
+	case api.StorageBackendOdfReplica3:
+		return ODFReplicaCount
+	case api.StorageBackendOdfErasureCoding:
+		return float64(p.DataChunks+p.CodingChunks) / float64(p.DataChunks)
+	}
+	// RAID protection of a SAN array is part of its usable capacity
+	return 1
+}
+
+// failureDomains returns the minimum number of nodes the pool spreads its copies or chunks over.
+func (p storageSizingParams) failureDomains() int {
+	if p.Backend == api.StorageBackendOdfErasureCoding {
+		return p.DataChunks + p.CodingChunks
+	}
+	return ODFReplicaCount
+}
+
+// calculateStorageSizing sizes the capacity of the VM disks of a cluster on the target backend.
+//
+// The data stored is the provisioned disk capacity divided by the thin provisioning ratio, and
+// never less than what the datastores of the cluster already use. The usable capacity keeps the
+// backend at StorageTargetUtilization, and the raw capacity adds the replication or erasure
+// coding overhead.
+//
+// For ODF, the raw capacity is spread evenly as OSD disks over the storage nodes: the nodes of
+// the cluster, but at least as many as the failure domains of the pool.
+func calculateStorageSizing(cluster api.InventoryData, params storageSizingParams, clusterNodes int) mappers.StorageSizing {
+	result := mappers.StorageSizing{
+		Backend:       string(params.Backend),
+		ProvisionedGB: float64(cluster.Vms.DiskGB.Total),
+		DiskTypes:     make(map[string]float64),
+	}
+	if cluster.Vms.DiskTypes != nil {
+		for diskType, summary := range *cluster.Vms.DiskTypes {
+			result.DiskTypes[diskType] = summary.TotalSizeTB * gbPerTB
+		}
+	}
+	for _, ds := range cluster.Infra.Datastores {
+		result.DatastoreUsedGB += float64(max(ds.TotalCapacityGB-ds.FreeCapacityGB, 0))
+	}
+
+	result.DataGB = max(result.ProvisionedGB/params.ThinProvisioningRatio, result.DatastoreUsedGB)
+	result.UsableGB = result.DataGB / StorageTargetUtilization
+	result.RawGB = result.UsableGB * params.overhead()
+
+	if params.isODF() {
+		storageNodes := max(clusterNodes, params.failureDomains())
+		osdDisks := int(math.Ceil(result.RawGB / float64(params.OSDDiskSizeGB)))
+		perNode := int(math.Ceil(float64(osdDisks) / float64(storageNodes)))
+		total := perNode * storageNodes
+		result.StorageNodes = &storageNodes
+		result.OSDDisksPerNode = &perNode
+		result.TotalOSDDisks = &total
+	}
+	return result
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,17 +25,23 @@ import (
 
 // MockStore is a mock implementation of store.Store
 type MockStore struct {
-	assessments     map[uuid.UUID]*model.Assessment
-	clusterInputs   map[string]*model.AssessmentClusterSizingInput
-	enhancementData map[uuid.UUID]*model.AssessmentEnhancementData
-	getError        error
-	outboxEvents    []model.OutboxEvent
+	assessments      map[uuid.UUID]*model.Assessment
+	clusterInputs    map[string]*model.AssessmentClusterSizingInput
+	hardwareSKUs     map[string]*model.HardwareSKU
+	complexityTables model.ComplexityTableList
+	policyBundles    model.PolicyBundleList
+	waivers          model.ConcernWaiverList
+	enhancementData  map[uuid.UUID]*model.AssessmentEnhancementData
+	getError         error
+	outboxEvents     []model.OutboxEvent
+	vms              model.AssessmentVMList
 }
 
 func NewMockStore() *MockStore {
 	return &MockStore{
 		assessments:     make(map[uuid.UUID]*model.Assessment),
 		clusterInputs:   make(map[string]*model.AssessmentClusterSizingInput),
+		hardwareSKUs:    make(map[string]*model.HardwareSKU),
 		enhancementData: make(map[uuid.UUID]*model.AssessmentEnhancementData),
 	}
 }
@@ -54,10 +62,18 @@ func (m *MockStore) SourceSubsetInventory() store.SourceSubsetInventory {
 	panic("MockStore.SourceSubsetInventory() called unexpectedly - not implemented for this test")
 }
 
+func (m *MockStore) SourceVM() store.SourceVM {
+	panic("MockStore.SourceVM() called unexpectedly - not implemented for this test")
+}
+
 func (m *MockStore) AssessmentSubsetInventory() store.AssessmentSubsetInventory {
 	panic("MockStore.AssessmentSubsetInventory() called unexpectedly - not implemented for this test")
 }
 
+func (m *MockStore) AssessmentVM() store.AssessmentVM {
+	return &MockAssessmentVMStore{store: m}
+}
+
 func (m *MockStore) Agent() store.Agent {
 	panic("MockStore.Agent() called unexpectedly - not implemented for this test")
 }
@@ -86,6 +102,22 @@ func (m *MockStore) ClusterSizingInput() store.ClusterSizingInput {
 	return &MockClusterSizingInputStore{store: m}
 }
 
+func (m *MockStore) HardwareSKU() store.HardwareSKU {
+	return &MockHardwareSKUStore{store: m}
+}
+
+func (m *MockStore) ComplexityTable() store.ComplexityTable {
+	return &MockComplexityTableStore{store: m}
+}
+
+func (m *MockStore) PolicyBundle() store.PolicyBundle {
+	return &MockPolicyBundleStore{store: m}
+}
+
+func (m *MockStore) ConcernWaiver() store.ConcernWaiver {
+	return &MockConcernWaiverStore{store: m}
+}
+
 func (m *MockStore) AssessmentEnhancementData() store.AssessmentEnhancementData {
 	return &MockAssessmentEnhancementDataStore{store: m}
 }
@@ -108,6 +140,10 @@ func (m *MockStore) Outbox() store.Outbox {
 	return &MockOutboxStore{store: m}
 }
 
+func (m *MockStore) AuditLog() store.AuditLog {
+	return nil
+}
+
 func (m *MockStore) Close() error {
 	return nil
 }
@@ -120,6 +156,229 @@ type MockClusterSizingInputStore struct {
 	store *MockStore
 }
 
+type MockHardwareSKUStore struct {
+	store *MockStore
+}
+
+func (m *MockHardwareSKUStore) List(ctx context.Context) (model.HardwareSKUList, error) {
+	skus := make(model.HardwareSKUList, 0, len(m.store.hardwareSKUs))
+	for _, sku := range m.store.hardwareSKUs {
+		skus = append(skus, *sku)
+	}
+	slices.SortFunc(skus, func(a, b model.HardwareSKU) int { return strings.Compare(a.Name, b.Name) })
+	return skus, nil
+}
+
+func (m *MockHardwareSKUStore) Get(ctx context.Context, name string) (*model.HardwareSKU, error) {
+	sku, ok := m.store.hardwareSKUs[name]
+	if !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	return sku, nil
+}
+
+func (m *MockHardwareSKUStore) Create(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	if _, ok := m.store.hardwareSKUs[sku.Name]; ok {
+		return nil, store.ErrDuplicateKey
+	}
+	m.store.hardwareSKUs[sku.Name] = &sku
+	return &sku, nil
+}
+
+func (m *MockHardwareSKUStore) Update(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	if _, ok := m.store.hardwareSKUs[sku.Name]; !ok {
+		return nil, store.ErrRecordNotFound
+	}
+	m.store.hardwareSKUs[sku.Name] = &sku
+	return &sku, nil
+}
+
+func (m *MockHardwareSKUStore) Delete(ctx context.Context, name string) error {
+	if _, ok := m.store.hardwareSKUs[name]; !ok {
+		return store.ErrRecordNotFound
+	}
+	delete(m.store.hardwareSKUs, name)
+	return nil
+}
+
+type MockComplexityTableStore struct {
+	store *MockStore
+}
+
+func (m *MockComplexityTableStore) List(ctx context.Context, orgID string) (model.ComplexityTableList, error) {
+	var tables model.ComplexityTableList
+	for _, table := range slices.Backward(m.store.complexityTables) {
+		if table.OrgID == orgID {
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
+
+func (m *MockComplexityTableStore) Get(ctx context.Context, orgID, version string) (*model.ComplexityTable, error) {
+	for _, table := range m.store.complexityTables {
+		if table.OrgID == orgID && table.Version == version {
+			return &table, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockComplexityTableStore) GetActive(ctx context.Context, orgID string) (*model.ComplexityTable, error) {
+	for _, table := range m.store.complexityTables {
+		if table.OrgID == orgID && table.Active {
+			return &table, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockComplexityTableStore) Create(ctx context.Context, table model.ComplexityTable) (*model.ComplexityTable, error) {
+	_ = m.Deactivate(ctx, table.OrgID)
+	table.Revision = 1
+	for _, t := range m.store.complexityTables {
+		if t.OrgID == table.OrgID {
+			table.Revision = t.Revision + 1
+		}
+	}
+	table.Version = model.ComplexityTableVersion(table.Revision)
+	table.Tables.Data.Version = table.Version
+	table.Active = true
+	table.CreatedAt = time.Now()
+	m.store.complexityTables = append(m.store.complexityTables, table)
+	return &table, nil
+}
+
+func (m *MockComplexityTableStore) Deactivate(ctx context.Context, orgID string) error {
+	for i := range m.store.complexityTables {
+		if m.store.complexityTables[i].OrgID == orgID {
+			m.store.complexityTables[i].Active = false
+		}
+	}
+	return nil
+}
+
+type MockConcernWaiverStore struct {
+	store *MockStore
+}
+
+func (m *MockConcernWaiverStore) List(ctx context.Context, assessmentID uuid.UUID) (model.ConcernWaiverList, error) {
+	var waivers model.ConcernWaiverList
+	for _, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID {
+			waivers = append(waivers, waiver)
+		}
+	}
+	return waivers, nil
+}
+
+func (m *MockConcernWaiverStore) Get(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) (*model.ConcernWaiver, error) {
+	for _, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID && waiver.ID == id {
+			return &waiver, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockConcernWaiverStore) Create(ctx context.Context, waiver model.ConcernWaiver) (*model.ConcernWaiver, error) {
+	waiver.CreatedAt = time.Now()
+	m.store.waivers = append(m.store.waivers, waiver)
+	return &waiver, nil
+}
+
+func (m *MockConcernWaiverStore) Delete(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) error {
+	for i, waiver := range m.store.waivers {
+		if waiver.AssessmentID == assessmentID && waiver.ID == id {
+			m.store.waivers = slices.Delete(m.store.waivers, i, i+1)
+			return nil
+		}
+	}
+	return store.ErrRecordNotFound
+}
+
+type MockPolicyBundleStore struct {
+	store *MockStore
+}
+
+func (m *MockPolicyBundleStore) List(ctx context.Context, orgID string) (model.PolicyBundleList, error) {
+	var bundles model.PolicyBundleList
+	for _, bundle := range slices.Backward(m.store.policyBundles) {
+		if bundle.OrgID == orgID {
+			bundles = append(bundles, bundle)
+		}
+	}
+	return bundles, nil
+}
+
+func (m *MockPolicyBundleStore) Get(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	for _, bundle := range m.store.policyBundles {
+		if bundle.OrgID == orgID && bundle.Revision == revision {
+			return &bundle, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) GetActive(ctx context.Context, orgID string) (*model.PolicyBundle, error) {
+	for _, bundle := range m.store.policyBundles {
+		if bundle.OrgID == orgID && bundle.Active {
+			return &bundle, nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) Create(ctx context.Context, bundle model.PolicyBundle) (*model.PolicyBundle, error) {
+	bundle.Revision = 1
+	for _, b := range m.store.policyBundles {
+		if b.OrgID == bundle.OrgID {
+			bundle.Revision = b.Revision + 1
+		}
+	}
+	bundle.Active = false
+	bundle.CreatedAt = time.Now()
+	m.store.policyBundles = append(m.store.policyBundles, bundle)
+	return &bundle, nil
+}
+
+func (m *MockPolicyBundleStore) Activate(ctx context.Context, orgID string, revision int) error {
+	for i := range m.store.policyBundles {
+		if m.store.policyBundles[i].OrgID == orgID && m.store.policyBundles[i].Revision == revision {
+			m.store.policyBundles[i].Active = true
+			return nil
+		}
+	}
+	return store.ErrRecordNotFound
+}
+
+func (m *MockPolicyBundleStore) Deactivate(ctx context.Context, orgID string) error {
+	for i := range m.store.policyBundles {
+		if m.store.policyBundles[i].OrgID == orgID {
+			m.store.policyBundles[i].Active = false
+		}
+	}
+	return nil
+}
+
+// MockAssessmentVMStore ignores query filters, they are evaluated by the database.
+type MockAssessmentVMStore struct {
+	store *MockStore
+}
+
+func (m *MockAssessmentVMStore) CreateBatch(ctx context.Context, vms []model.AssessmentVM) error {
+	m.store.vms = append(m.store.vms, vms...)
+	return nil
+}
+
+func (m *MockAssessmentVMStore) List(ctx context.Context, filter *store.AssessmentVMQueryFilter, options *store.AssessmentVMQueryOptions) (model.AssessmentVMList, error) {
+	return m.store.vms, nil
+}
+
+func (m *MockAssessmentVMStore) Count(ctx context.Context, filter *store.AssessmentVMQueryFilter) (int64, error) {
+	return int64(len(m.store.vms)), nil
+}
+
 func (m *MockAssessmentStore) Get(ctx context.Context, id uuid.UUID) (*model.Assessment, error) {
 	if m.store.getError != nil {
 		return nil, m.store.getError
@@ -143,10 +402,35 @@ func (m *MockAssessmentStore) Update(ctx context.Context, assessmentID uuid.UUID
 	return nil, nil
 }
 
+func (m *MockAssessmentStore) UpdateOwner(ctx context.Context, assessmentID uuid.UUID, username string) (*model.Assessment, error) {
+	return nil, nil
+}
+
 func (m *MockAssessmentStore) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (m *MockAssessmentStore) ListSnapshots(ctx context.Context, assessmentID uuid.UUID) ([]model.Snapshot, error) {
+	assessment, exists := m.store.assessments[assessmentID]
+	if !exists {
+		return nil, nil
+	}
+	return assessment.Snapshots, nil
+}
+
+func (m *MockAssessmentStore) GetSnapshot(ctx context.Context, assessmentID uuid.UUID, snapshotID uint) (*model.Snapshot, error) {
+	assessment, exists := m.store.assessments[assessmentID]
+	if !exists {
+		return nil, store.ErrRecordNotFound
+	}
+	for i := range assessment.Snapshots {
+		if assessment.Snapshots[i].ID == snapshotID {
+			return &assessment.Snapshots[i], nil
+		}
+	}
+	return nil, store.ErrRecordNotFound
+}
+
 func (m *MockClusterSizingInputStore) Upsert(ctx context.Context, input model.AssessmentClusterSizingInput) (*model.AssessmentClusterSizingInput, error) {
 	key := fmt.Sprintf("%s/%s", input.AssessmentID, input.ExternalClusterID)
 	copied := input
@@ -392,6 +676,21 @@ var _ = Describe("sizer service", func() {
 				Expect(result.ResourceConsumption.Memory).To(Equal(200.0))
 			})
 
+			It("calculates cluster requirements with the in-process sizer", func() {
+				assessment := createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+				mockStore.assessments[assessmentID] = assessment
+				sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.ClusterSizing.ControlPlaneNodes).To(Equal(3))
+				Expect(result.ClusterSizing.WorkerNodes).To(BeNumerically(">", result.ClusterSizing.FailoverNodes))
+				// 40 CPU at 1:4 and 80 GB at 1:2 are requested, next to the control plane services
+				Expect(result.ResourceConsumption.Cpu).To(BeNumerically("~", 10+3*3.5, 0.01))
+				Expect(result.ResourceConsumption.Memory).To(BeNumerically("~", 40+3*13.39, 0.01))
+			})
+
 			It("successfully handles control plane schedulable enabled", func() {
 				request.ControlPlaneSchedulable = util.BoolPtr(true)
 				assessment := createTestAssessment(assessmentID, clusterID, 10, 40, 80)
@@ -1118,6 +1417,281 @@ var _ = Describe("sizer service", func() {
 			})
 		})
 
+		Context("per-VM sizing", func() {
+			BeforeEach(func() {
+				request.SizingMode = "perVm"
+				sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+			})
+
+			addVMs := func(count, cpu, memoryMB int) {
+				for range count {
+					mockStore.vms = append(mockStore.vms, model.AssessmentVM{
+						SnapshotID: 1,
+						VMID:       fmt.Sprintf("vm-%d", len(mockStore.vms)+1),
+						Name:       fmt.Sprintf("vm-%d", len(mockStore.vms)+1),
+						ClusterID:  clusterID,
+						CpuCount:   cpu,
+						MemoryMB:   memoryMB,
+					})
+				}
+			}
+
+			It("packs the VMs and reports the ones larger than a worker node", func() {
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 10, 34, 68)
+				addVMs(9, 2, 4096)
+				addVMs(1, 16, 32768)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.VmPacking).NotTo(BeNil())
+				Expect(result.VmPacking.PackedVms).To(Equal(9))
+				Expect(result.VmPacking.UnplaceableVms).To(HaveLen(1))
+				Expect(result.VmPacking.UnplaceableVms[0].Id).To(Equal("vm-10"))
+				Expect(result.VmPacking.UnplaceableVms[0].Reason).To(ContainSubstring("requires 16.00 CPU"))
+				Expect(result.VmPacking.LargestVms).To(HaveLen(9))
+				// 9 VMs requesting 0.5 CPU / 2 GB each fit on two 8 CPU / 16 GB nodes
+				Expect(result.VmPacking.LargestVmsNodeCount).To(Equal(2))
+				// bins of 3 VMs (6 CPU / 12 GB) stay within 80% of a worker node
+				Expect(result.ResourceConsumption.Cpu).To(BeNumerically("~", 18.0/4+3*3.5, 0.01))
+				Expect(result.ResourceConsumption.Memory).To(BeNumerically("~", 36.0/2+3*13.39, 0.01))
+			})
+
+			It("skips templates", func() {
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 2, 4, 8)
+				addVMs(2, 2, 4096)
+				mockStore.vms[1].IsTemplate = true
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.VmPacking.PackedVms).To(Equal(1))
+			})
+
+			It("returns invalid request when no VM is recorded", func() {
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(result).To(BeNil())
+				var invalidReq *service.ErrInvalidRequest
+				Expect(errors.As(err, &invalidReq)).To(BeTrue())
+			})
+
+			It("returns invalid request when no VM fits on a worker node", func() {
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 2, 32, 64)
+				addVMs(2, 16, 32768)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(result).To(BeNil())
+				var invalidReq *service.ErrInvalidRequest
+				Expect(errors.As(err, &invalidReq)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("none of the 2 VMs"))
+			})
+
+			It("does not report packing in batched mode", func() {
+				request.SizingMode = ""
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.VmPacking).To(BeNil())
+			})
+		})
+
+		Context("right-sizing", func() {
+			BeforeEach(func() {
+				request.RightSized = true
+				sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+			})
+
+			addVM := func(cpu, memoryMB int, cpuUsage, memoryUsage *float64) {
+				mockStore.vms = append(mockStore.vms, model.AssessmentVM{
+					SnapshotID:         1,
+					VMID:               fmt.Sprintf("vm-%d", len(mockStore.vms)+1),
+					Name:               fmt.Sprintf("vm-%d", len(mockStore.vms)+1),
+					ClusterID:          clusterID,
+					PowerState:         "poweredOn",
+					CpuCount:           cpu,
+					MemoryMB:           memoryMB,
+					CpuUsagePercent:    cpuUsage,
+					MemoryUsagePercent: memoryUsage,
+				})
+			}
+
+			It("sizes the cluster with the right-sized VMs instead of the cluster utilization", func() {
+				inventory := createInventoryWithUtilization(clusterID, 40, 50, 90)
+				mockStore.assessments[assessmentID] = createAssessmentWithInventory(assessmentID, inventory)
+				for range 5 {
+					addVM(16, 32768, util.FloatPtr(10), util.FloatPtr(20))
+				}
+				addVM(20, 40960, nil, nil)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.RightSizing).NotTo(BeNil())
+				Expect(result.RightSizing.VmsWithUtilization).To(Equal(5))
+				Expect(result.RightSizing.VmsRightSized).To(Equal(5))
+				Expect(result.RightSizing.CurrentCPU).To(Equal(100))
+				// 1.6 vCPU used, 3 vCPU at 70%; 6.4 GB used, 10 GB at 70%
+				Expect(result.RightSizing.SuggestedCPU).To(Equal(5*3 + 20))
+				Expect(result.RightSizing.SuggestedMemoryGB).To(BeNumerically("~", 5*10+40, 0.001))
+				Expect(result.OptimizedSizing).To(BeNil())
+				Expect(result.OptimizationStatus.Attempted).To(BeFalse())
+				Expect(result.OptimizationStatus.Reason).To(Equal(api.RightSized))
+				Expect(result.RightSizing.SizingBasis).To(Equal(api.Utilization))
+				Expect(result.InventoryTotals.TotalCPU).To(Equal(100))
+
+				request.RightSized = false
+				baseline, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+				Expect(err).To(BeNil())
+				Expect(baseline.RightSizing).To(BeNil())
+				Expect(result.ResourceConsumption.Cpu).To(BeNumerically("<", baseline.ResourceConsumption.Cpu))
+				Expect(result.ResourceConsumption.Memory).To(BeNumerically("<", baseline.ResourceConsumption.Memory))
+			})
+
+			It("packs the right-sized VMs in per-VM mode", func() {
+				request.SizingMode = "perVm"
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 2, 20, 40)
+				// larger than a worker node of 8 CPU / 16 GB, until right-sized to 4 vCPU / 8 GB
+				addVM(16, 32768, util.FloatPtr(17.5), util.FloatPtr(17.5))
+				addVM(4, 8192, nil, nil)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.VmPacking.PackedVms).To(Equal(2))
+				Expect(result.VmPacking.UnplaceableVms).To(BeEmpty())
+				Expect(result.RightSizing.SuggestedCPU).To(Equal(8))
+			})
+
+			It("falls back to the cluster utilization when no VM records its utilization", func() {
+				inventory := createInventoryWithUtilization(clusterID, 40, 50, 90)
+				mockStore.assessments[assessmentID] = createAssessmentWithInventory(assessmentID, inventory)
+				for range 5 {
+					addVM(8, 16384, nil, nil)
+				}
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.RightSizing.SizingBasis).To(Equal(api.Allocation))
+				Expect(result.RightSizing.VmsWithUtilization).To(BeZero())
+				Expect(result.RightSizing.SuggestedCPU).To(Equal(result.RightSizing.CurrentCPU))
+				Expect(result.OptimizationStatus.Attempted).To(BeTrue())
+				Expect(result.OptimizationStatus.Reason).NotTo(Equal(api.RightSized))
+			})
+
+			It("returns invalid request when no VM is recorded", func() {
+				mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(result).To(BeNil())
+				var invalidReq *service.ErrInvalidRequest
+				Expect(errors.As(err, &invalidReq)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("right-sizing requires the VMs"))
+			})
+		})
+
+		Context("storage sizing", func() {
+			BeforeEach(func() {
+				sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+
+				assessment := createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+				var inventory api.Inventory
+				Expect(json.Unmarshal(assessment.Snapshots[0].Inventory, &inventory)).To(Succeed())
+				cluster := inventory.Clusters[clusterID]
+				cluster.Vms.DiskGB = api.VMResourceBreakdown{Total: 3000}
+				cluster.Vms.DiskTypes = &map[string]api.DiskTypeSummary{
+					"VMFS": {TotalSizeTB: 2, VmCount: 6},
+					"NFS":  {TotalSizeTB: 0.5, VmCount: 4},
+				}
+				cluster.Infra.Datastores = []api.Datastore{
+					{TotalCapacityGB: 4000, FreeCapacityGB: 2500},
+				}
+				inventory.Clusters[clusterID] = cluster
+				data, err := json.Marshal(inventory)
+				Expect(err).ToNot(HaveOccurred())
+				assessment.Snapshots[0].Inventory = data
+				mockStore.assessments[assessmentID] = assessment
+			})
+
+			It("sizes ODF replica-3 capacity and OSD disks", func() {
+				request.Storage = &mappers.StorageSizingForm{Backend: "odfReplica3"}
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				storage := result.StorageSizing
+				Expect(storage).NotTo(BeNil())
+				Expect(storage.ProvisionedGB).To(Equal(3000.0))
+				Expect(storage.DatastoreUsedGB).To(Equal(1500.0))
+				Expect(storage.DiskTypes).To(HaveKeyWithValue("VMFS", 2048.0))
+				Expect(storage.DiskTypes).To(HaveKeyWithValue("NFS", 512.0))
+				Expect(storage.DataGB).To(Equal(3000.0))
+				Expect(storage.UsableGB).To(BeNumerically("~", 4000, 0.01))
+				Expect(storage.RawGB).To(BeNumerically("~", 12000, 0.01))
+				// 12000 GB raw takes 3 disks of 4096 GB, spread over every worker node
+				Expect(*storage.StorageNodes).To(Equal(max(result.ClusterSizing.WorkerNodes, 3)))
+				Expect(*storage.OsdDisksPerNode).To(Equal(1))
+				Expect(*storage.TotalOsdDisks).To(Equal(*storage.StorageNodes))
+			})
+
+			It("sizes ODF erasure coding over at least as many nodes as chunks", func() {
+				request.Storage = &mappers.StorageSizingForm{
+					Backend:       "odfErasureCoding",
+					DataChunks:    util.IntPtr(8),
+					CodingChunks:  util.IntPtr(3),
+					OSDDiskSizeGB: util.IntPtr(512),
+				}
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				storage := result.StorageSizing
+				Expect(storage.RawGB).To(BeNumerically("~", 4000*11.0/8, 0.01))
+				Expect(*storage.StorageNodes).To(BeNumerically(">=", 11))
+				// 5500 GB raw takes 11 disks of 512 GB
+				Expect(*storage.OsdDisksPerNode * *storage.StorageNodes).To(BeNumerically(">=", 11))
+			})
+
+			It("sizes external SAN capacity with thin provisioning, floored at the datastore usage", func() {
+				ratio := 3.0
+				request.Storage = &mappers.StorageSizingForm{Backend: "externalSan", ThinProvisioningRatio: &ratio}
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				storage := result.StorageSizing
+				Expect(storage.DataGB).To(Equal(1500.0))
+				Expect(storage.RawGB).To(BeNumerically("~", 2000, 0.01))
+				Expect(storage.StorageNodes).To(BeNil())
+				Expect(storage.OsdDisksPerNode).To(BeNil())
+			})
+
+			It("returns invalid request for an unknown backend", func() {
+				request.Storage = &mappers.StorageSizingForm{Backend: "tape"}
+
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(result).To(BeNil())
+				var invalidReq *service.ErrInvalidRequest
+				Expect(errors.As(err, &invalidReq)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("invalid storage backend: tape"))
+			})
+
+			It("does not size storage unless requested", func() {
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+
+				Expect(err).To(BeNil())
+				Expect(result.StorageSizing).To(BeNil())
+			})
+		})
+
 		Context("request persistence", func() {
 			It("does not persist sizing input when calculation fails", func() {
 				assessment := createTestAssessment(assessmentID, clusterID, 10, 40, 80)
@@ -1153,6 +1727,20 @@ var _ = Describe("sizer service", func() {
 				Expect(storedInput.WorkerNodeCPU).ToNot(BeNil())
 				Expect(*storedInput.WorkerNodeCPU).To(Equal(8))
 			})
+
+			It("does not persist sizing input of read-only requests", func() {
+				assessment := createTestAssessment(assessmentID, clusterID, 10, 40, 80)
+				mockStore.assessments[assessmentID] = assessment
+				testServer = createTestSizerServer(createTestSizerResponse(5, 2, 3, 40, 80), http.StatusOK, false)
+				sizerClient = client.NewSizerClient(testServer.URL, 5*time.Second)
+				sizerService = service.NewSizerService(sizerClient, mockStore)
+
+				request.ReadOnly = true
+				result, err := sizerService.CalculateClusterRequirements(ctx, assessmentID, request)
+				Expect(err).To(BeNil())
+				Expect(result).NotTo(BeNil())
+				Expect(mockStore.clusterInputs).To(BeEmpty())
+			})
 		})
 	})
 
@@ -1198,6 +1786,181 @@ var _ = Describe("sizer service", func() {
 	// calculateMinimumNodeSize, formatNodeSizeError, buildSizerPayload, transformSizerResponse)
 	// are tested indirectly through CalculateClusterRequirements above.
 
+	Describe("CalculateTopologySizing", func() {
+		var (
+			assessmentID uuid.UUID
+			request      *mappers.TopologySizingRequestForm
+		)
+
+		BeforeEach(func() {
+			assessmentID = uuid.New()
+			sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+
+			inventory := api.Inventory{Clusters: map[string]api.InventoryData{}}
+			for i, totals := range [][3]int{{10, 40, 80}, {20, 80, 160}, {5, 20, 40}, {0, 0, 0}} {
+				inventory.Clusters[fmt.Sprintf("domain-c%d", i+1)] = api.InventoryData{
+					Vms: api.VMs{
+						Total:    totals[0],
+						CpuCores: api.VMResourceBreakdown{Total: totals[1]},
+						RamGB:    api.VMResourceBreakdown{Total: totals[2]},
+					},
+				}
+			}
+			data, err := json.Marshal(inventory)
+			Expect(err).ToNot(HaveOccurred())
+			assessment := createTestAssessment(assessmentID, "domain-c1", 0, 0, 0)
+			assessment.Snapshots[0].Inventory = data
+			mockStore.assessments[assessmentID] = assessment
+
+			request = &mappers.TopologySizingRequestForm{
+				Sizing: mappers.ClusterRequirementsRequestForm{
+					CpuOverCommitRatio:    "1:4",
+					MemoryOverCommitRatio: "1:2",
+					WorkerNodeCPU:         8,
+					WorkerNodeMemory:      16,
+				},
+				TargetClusters: []mappers.TargetClusterForm{
+					{Name: "ocp-east", SourceClusterIDs: []string{"domain-c1", "domain-c2"}},
+					{Name: "ocp-west", SourceClusterIDs: []string{"domain-c3"}},
+				},
+			}
+		})
+
+		It("sizes every target cluster from the aggregated source clusters", func() {
+			result, err := sizerService.CalculateTopologySizing(ctx, assessmentID, request)
+
+			Expect(err).To(BeNil())
+			Expect(result.TargetClusters).To(HaveLen(2))
+			east, west := result.TargetClusters[0], result.TargetClusters[1]
+			Expect(east.Name).To(Equal("ocp-east"))
+			Expect(east.InventoryTotals).To(Equal(api.InventoryTotals{TotalVMs: 30, TotalCPU: 120, TotalMemory: 240}))
+			Expect(west.InventoryTotals).To(Equal(api.InventoryTotals{TotalVMs: 5, TotalCPU: 20, TotalMemory: 40}))
+			Expect(east.ClusterSizing.ControlPlaneNodes).To(Equal(3))
+			Expect(west.ClusterSizing.ControlPlaneNodes).To(Equal(3))
+			Expect(east.ClusterSizing.WorkerNodes).To(BeNumerically(">", west.ClusterSizing.WorkerNodes))
+			// 120 CPU at 1:4 are requested next to the control plane services
+			Expect(east.ResourceConsumption.Cpu).To(BeNumerically("~", 30+3*3.5, 0.01))
+			Expect(result.Totals.TotalNodes).To(Equal(east.ClusterSizing.TotalNodes + west.ClusterSizing.TotalNodes))
+			Expect(result.Totals.ControlPlaneNodes).To(Equal(6))
+		})
+
+		It("returns not found for an unknown source cluster", func() {
+			request.TargetClusters[1].SourceClusterIDs = []string{"domain-c9"}
+
+			result, err := sizerService.CalculateTopologySizing(ctx, assessmentID, request)
+
+			Expect(result).To(BeNil())
+			var notFound *service.ErrResourceNotFound
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+
+		It("rejects a source cluster mapped to two target clusters", func() {
+			request.TargetClusters[1].SourceClusterIDs = []string{"domain-c3", "domain-c1"}
+
+			result, err := sizerService.CalculateTopologySizing(ctx, assessmentID, request)
+
+			Expect(result).To(BeNil())
+			var invalidReq *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalidReq)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("source cluster domain-c1 is mapped to both target clusters ocp-east and ocp-west"))
+		})
+
+		It("rejects duplicate target cluster names", func() {
+			request.TargetClusters[1].Name = "ocp-east"
+
+			_, err := sizerService.CalculateTopologySizing(ctx, assessmentID, request)
+
+			var invalidReq *service.ErrInvalidRequest
+			Expect(errors.As(err, &invalidReq)).To(BeTrue())
+		})
+
+		It("rejects a target cluster without VMs", func() {
+			request.TargetClusters[1].SourceClusterIDs = []string{"domain-c4"}
+
+			_, err := sizerService.CalculateTopologySizing(ctx, assessmentID, request)
+
+			var invalidInventory *service.ErrInvalidClusterInventory
+			Expect(errors.As(err, &invalidInventory)).To(BeTrue())
+		})
+	})
+
+	Describe("GetRightSizing", func() {
+		var (
+			assessmentID uuid.UUID
+			clusterID    string
+		)
+
+		BeforeEach(func() {
+			assessmentID = uuid.New()
+			clusterID = "domain-c1"
+			sizerService = service.NewSizerService(client.NewLocalSizer(), mockStore)
+			mockStore.assessments[assessmentID] = createTestAssessment(assessmentID, clusterID, 5, 60, 200)
+			mockStore.vms = []model.AssessmentVM{
+				{VMID: "vm-1", Name: "db", ClusterID: clusterID, PowerState: "poweredOn", CpuCount: 16, MemoryMB: 65536,
+					CpuUsagePercent: util.FloatPtr(17.5), MemoryUsagePercent: util.FloatPtr(26.25)},
+				{VMID: "vm-2", Name: "web", ClusterID: clusterID, PowerState: "poweredOn", CpuCount: 4, MemoryMB: 8192,
+					CpuUsagePercent: util.FloatPtr(90), MemoryUsagePercent: util.FloatPtr(25)},
+				{VMID: "vm-3", Name: "busy", ClusterID: clusterID, PowerState: "poweredOn", CpuCount: 8, MemoryMB: 16384,
+					CpuUsagePercent: util.FloatPtr(95), MemoryUsagePercent: util.FloatPtr(99)},
+				{VMID: "vm-4", Name: "stopped", ClusterID: clusterID, PowerState: "poweredOff", CpuCount: 8, MemoryMB: 16384,
+					CpuUsagePercent: util.FloatPtr(0), MemoryUsagePercent: util.FloatPtr(0)},
+				{VMID: "vm-5", Name: "template", ClusterID: clusterID, PowerState: "poweredOff", CpuCount: 8, MemoryMB: 16384, IsTemplate: true},
+			}
+		})
+
+		It("suggests right-sized allocations, largest reduction first", func() {
+			report, err := sizerService.GetRightSizing(ctx, assessmentID, nil, "")
+
+			Expect(err).To(BeNil())
+			Expect(report.SnapshotId).To(Equal(1))
+			Expect(report.Suggestions).To(HaveLen(2))
+			Expect(report.Suggestions[0].Id).To(Equal("vm-1"))
+			Expect(report.Suggestions[0].SuggestedCPU).To(Equal(4))
+			Expect(report.Suggestions[0].SuggestedMemoryGB).To(Equal(24.0))
+			Expect(report.Suggestions[0].Description).To(Equal("vCPU 16 → 4, RAM 64 GB → 24 GB"))
+			// CPU is used at 90%, only the memory is reduced
+			Expect(report.Suggestions[1].Id).To(Equal("vm-2"))
+			Expect(report.Suggestions[1].SuggestedCPU).To(Equal(4))
+			Expect(report.Suggestions[1].Description).To(Equal("RAM 8 GB → 3 GB"))
+
+			Expect(report.Totals.VmsWithUtilization).To(Equal(3))
+			Expect(report.Totals.VmsRightSized).To(Equal(2))
+			Expect(report.Totals.CurrentCPU).To(Equal(36))
+			Expect(report.Totals.SuggestedCPU).To(Equal(24))
+			Expect(report.Totals.CurrentMemoryGB).To(Equal(104.0))
+			Expect(report.Totals.SuggestedMemoryGB).To(Equal(59.0))
+			Expect(report.Totals.SizingBasis).To(Equal(api.Utilization))
+		})
+
+		It("reports allocation-based sizing when no VM records its utilization", func() {
+			for i := range mockStore.vms {
+				mockStore.vms[i].CpuUsagePercent = nil
+				mockStore.vms[i].MemoryUsagePercent = nil
+			}
+
+			report, err := sizerService.GetRightSizing(ctx, assessmentID, nil, "")
+
+			Expect(err).To(BeNil())
+			Expect(report.Suggestions).To(BeEmpty())
+			Expect(report.Totals.SizingBasis).To(Equal(api.Allocation))
+			Expect(report.Totals.SuggestedCPU).To(Equal(report.Totals.CurrentCPU))
+		})
+
+		It("returns not found for an unknown cluster", func() {
+			_, err := sizerService.GetRightSizing(ctx, assessmentID, nil, "domain-c9")
+
+			var notFound *service.ErrResourceNotFound
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+
+		It("returns not found for an unknown assessment", func() {
+			_, err := sizerService.GetRightSizing(ctx, uuid.New(), nil, "")
+
+			var notFound *service.ErrResourceNotFound
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+		})
+	})
+
 	Describe("CalculateStandaloneClusterRequirements", func() {
 		var request *mappers.StandaloneClusterRequirementsRequestForm
 
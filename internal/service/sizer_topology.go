@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store"
+)
+
+// CalculateTopologySizing sizes several target clusters, each consolidating the VMs of some source
+// clusters of an assessment. The inventory totals of the source clusters of a target are summed up
+// and the target is sized like a single cluster, with the node configuration of the request.
+// A source cluster can only be consolidated into one target cluster.
+func (s *SizerService) CalculateTopologySizing(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	req *mappers.TopologySizingRequestForm,
+) (*api.TopologySizingResponse, error) {
+	if s.sizerClient == nil {
+		return nil, fmt.Errorf("sizer client is not configured")
+	}
+
+	tracer := s.logger.WithContext(ctx).Operation("calculate_topology_sizing").
+		WithUUID("assessment_id", assessmentID).
+		WithInt("target_clusters", len(req.TargetClusters)).
+		Build()
+
+	assessment, err := s.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			return nil, NewErrAssessmentNotFound(assessmentID)
+		}
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	calcReq := applyDefaults(&req.Sizing)
+
+	snapshot, err := selectSnapshot(assessment, calcReq.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := parseSnapshotInventory(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTopology(req.TargetClusters, inventory, assessmentID); err != nil {
+		return nil, err
+	}
+
+	targets := make([]mappers.TargetClusterSizing, 0, len(req.TargetClusters))
+	for _, target := range req.TargetClusters {
+		sizing := mappers.TargetClusterSizing{
+			Name:             target.Name,
+			SourceClusterIDs: target.SourceClusterIDs,
+		}
+		for _, clusterID := range target.SourceClusterIDs {
+			vms := inventory.Clusters[clusterID].Vms
+			sizing.TotalVMs += vms.Total
+			sizing.TotalCPU += vms.CpuCores.Total
+			sizing.TotalMemory += vms.RamGB.Total
+		}
+		if sizing.TotalVMs == 0 || sizing.TotalCPU == 0 || sizing.TotalMemory == 0 {
+			return nil, NewErrInvalidClusterInventory(target.Name, "source clusters have no VMs or no CPU/Memory resources and cannot be used for migration planning")
+		}
+
+		params := clusterRequirementsParams{
+			TotalVMs:                sizing.TotalVMs,
+			TotalCPU:                sizing.TotalCPU,
+			TotalMemory:             sizing.TotalMemory,
+			WorkerNodeCPU:           calcReq.WorkerNodeCPU,
+			WorkerNodeMemory:        calcReq.WorkerNodeMemory,
+			WorkerNodeThreads:       calcReq.WorkerNodeThreads,
+			CpuOverCommitRatio:      calcReq.CpuOverCommitRatio,
+			MemoryOverCommitRatio:   calcReq.MemoryOverCommitRatio,
+			ControlPlaneSchedulable: calcReq.ControlPlaneSchedulable,
+			ControlPlaneCPU:         calcReq.ControlPlaneCPU,
+			ControlPlaneMemory:      calcReq.ControlPlaneMemory,
+			ControlPlaneNodeCount:   effectiveControlPlaneNodeCount(calcReq),
+			HostedControlPlane:      calcReq.HostedControlPlane != nil && *calcReq.HostedControlPlane,
+			CompactMode:             calcReq.CompactMode != nil && *calcReq.CompactMode,
+		}
+
+		result, err := s.calculateBaselineSizing(ctx, params)
+		if err != nil {
+			tracer.Error(err).WithString("target_cluster", target.Name).Log()
+			var invalidReq *ErrInvalidRequest
+			if errors.As(err, &invalidReq) {
+				return nil, NewErrInvalidRequest(fmt.Sprintf("target cluster %s: %s", target.Name, err))
+			}
+			return nil, fmt.Errorf("sizing target cluster %s: %w", target.Name, err)
+		}
+		sizing.Result = result.ToMapperSizingResult()
+		sizing.FailoverNodes = calculateFailoverNodes(result.WorkerNodes)
+		targets = append(targets, sizing)
+
+		tracer.Step("target_cluster_sized").
+			WithString("target_cluster", target.Name).
+			WithInt("total_nodes", result.TotalNodes).
+			WithInt("worker_nodes", result.WorkerNodes).
+			Log()
+	}
+
+	tracer.Success().Log()
+
+	mapper := &mappers.Mapper{}
+	return mapper.ToTopologySizingResponse(targets), nil
+}
+
+// validateTopology checks that the target clusters have distinct names and that every source
+// cluster exists in the inventory and is consolidated into a single target cluster.
+func validateTopology(targets []mappers.TargetClusterForm, inventory *api.Inventory, assessmentID uuid.UUID) error {
+	if len(targets) == 0 {
+		return NewErrInvalidRequest("at least one target cluster is required")
+	}
+	names := make(map[string]bool, len(targets))
+	sources := make(map[string]string)
+	for _, target := range targets {
+		if target.Name == "" {
+			return NewErrInvalidRequest("target cluster name is required")
+		}
+		if names[target.Name] {
+			return NewErrInvalidRequest(fmt.Sprintf("duplicate target cluster %s", target.Name))
+		}
+		names[target.Name] = true
+		if len(target.SourceClusterIDs) == 0 {
+			return NewErrInvalidRequest(fmt.Sprintf("target cluster %s has no source clusters", target.Name))
+		}
+		for _, clusterID := range target.SourceClusterIDs {
+			if _, ok := inventory.Clusters[clusterID]; !ok {
+				return NewErrClusterNotFound(clusterID, assessmentID)
+			}
+			if other, ok := sources[clusterID]; ok {
+				return NewErrInvalidRequest(fmt.Sprintf(
+					"source cluster %s is mapped to both target clusters %s and %s", clusterID, other, target.Name))
+			}
+			sources[clusterID] = target.Name
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service/mappers"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// MaxLargestVMs is the number of largest VMs reported by the per-VM sizing mode.
+const MaxLargestVMs = 10
+
+// vmShape is the CPU and memory of a single VM to pack onto worker nodes.
+type vmShape struct {
+	id       string
+	name     string
+	cpu      float64
+	memoryGB float64
+}
+
+// vmBin is a set of VMs packed together, within the target capacity of a worker node.
+type vmBin struct {
+	vms    []vmShape
+	cpu    float64
+	memory float64
+}
+
+// isPerVMSizing reports whether the request sizes the cluster VM by VM.
+func isPerVMSizing(req *mappers.ClusterRequirementsRequestForm) bool {
+	return req.SizingMode == string(api.SizingModePerVm)
+}
+
+// listClusterVMs returns the VMs of a cluster, templates excluded.
+func (s *SizerService) listClusterVMs(ctx context.Context, snapshotID uint, clusterID string) ([]model.AssessmentVM, error) {
+	vms, err := s.store.AssessmentVM().List(ctx,
+		store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByCluster(clusterID),
+		store.NewAssessmentVMQueryOptions(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster VMs: %w", err)
+	}
+	return slices.DeleteFunc(vms, func(vm model.AssessmentVM) bool { return vm.IsTemplate }), nil
+}
+
+// listClusterVMShapes returns the CPU and memory of the VMs of a cluster, templates excluded.
+func (s *SizerService) listClusterVMShapes(ctx context.Context, snapshotID uint, clusterID string) ([]vmShape, error) {
+	vms, err := s.listClusterVMs(ctx, snapshotID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	shapes := make([]vmShape, 0, len(vms))
+	for _, vm := range vms {
+		shapes = append(shapes, vmShape{
+			id:       vm.VMID,
+			name:     vm.Name,
+			cpu:      float64(vm.CpuCount),
+			memoryGB: float64(vm.MemoryMB) / 1024,
+		})
+	}
+	return shapes, nil
+}
+
+// packVMsIntoServices is the per-VM counterpart of aggregateVMsIntoServices. Instead of spreading
+// the cluster totals evenly, it packs the actual VMs first-fit decreasing into bins holding at most
+// the target capacity of a worker node (capacityMultiplier of its CPU and memory) and
+// MaxVMsPerWorkerNode VMs. Each bin becomes a BatchedService, with the over-commit ratios applied
+// to its requests like for the batched services.
+//
+// VMs larger than a worker node are left out of the services and reported as unplaceable. The
+// report also lists the largest VMs, relative to the node size, and the number of worker nodes
+// their requests need when packed alone.
+//
+// cpuMultiplier and memoryMultiplier scale the size of every VM, as the utilization multipliers
+// scale the cluster totals in the batched mode.
+func (s *SizerService) packVMsIntoServices(
+	vms []vmShape,
+	effectiveWorkerNodeCPU float64,
+	workerNodeMemory int,
+	cpuOverCommitRatio string,
+	memoryOverCommitRatio string,
+	capacityMultiplier float64,
+	cpuMultiplier float64,
+	memoryMultiplier float64,
+) ([]BatchedService, *mappers.VMPacking, error) {
+	if effectiveWorkerNodeCPU <= 0 || workerNodeMemory <= 0 {
+		return nil, nil, fmt.Errorf("worker node size must be greater than zero: CPU=%.2f, Memory=%d", effectiveWorkerNodeCPU, workerNodeMemory)
+	}
+	cpuOverCommitMultiplier, err := s.getCpuOverCommitMultiplier(cpuOverCommitRatio)
+	if err != nil {
+		return nil, nil, err
+	}
+	memoryOverCommitMultiplier, err := s.getMemoryOverCommitMultiplier(memoryOverCommitRatio)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeMemory := float64(workerNodeMemory)
+	targetCPU := effectiveWorkerNodeCPU * capacityMultiplier
+	targetMemory := nodeMemory * capacityMultiplier
+
+	report := &mappers.VMPacking{Unplaceable: []mappers.UnplaceableVM{}}
+	placeable := make([]vmShape, 0, len(vms))
+	for _, vm := range vms {
+		vm.cpu *= cpuMultiplier
+		vm.memoryGB *= memoryMultiplier
+		var reason string
+		switch {
+		case vm.cpu > effectiveWorkerNodeCPU:
+			reason = fmt.Sprintf("requires %.2f CPU, worker nodes have %.2f", vm.cpu, effectiveWorkerNodeCPU)
+		case vm.memoryGB > nodeMemory:
+			reason = fmt.Sprintf("requires %.2f GB memory, worker nodes have %d GB", vm.memoryGB, workerNodeMemory)
+		default:
+			placeable = append(placeable, vm)
+			continue
+		}
+		report.Unplaceable = append(report.Unplaceable, mappers.UnplaceableVM{
+			PackedVM: toPackedVM(vm),
+			Reason:   reason,
+		})
+	}
+	if len(placeable) == 0 {
+		return nil, nil, NewErrInvalidRequest(fmt.Sprintf(
+			"none of the %d VMs of the cluster fits on a worker node of %.2f CPU / %d GB",
+			len(vms), effectiveWorkerNodeCPU, workerNodeMemory))
+	}
+
+	// largest first, relative to the node capacity; stable so that equal VMs keep their order
+	share := func(vm vmShape) float64 {
+		return max(vm.cpu/effectiveWorkerNodeCPU, vm.memoryGB/nodeMemory)
+	}
+	slices.SortStableFunc(placeable, func(a, b vmShape) int {
+		return cmp.Compare(share(b), share(a))
+	})
+
+	bins := firstFitDecreasing(placeable, targetCPU, targetMemory, MaxVMsPerWorkerNode)
+	if len(bins) > MaxBatches {
+		return nil, nil, fmt.Errorf("cluster has too many VMs (%d) to size within constraints (max %d VMs per node, max %d batches). "+
+			"This inventory exceeds the sizing limits",
+			len(placeable), MaxVMsPerWorkerNode, MaxBatches)
+	}
+
+	services := make([]BatchedService, 0, len(bins))
+	for i, bin := range bins {
+		services = append(services, BatchedService{
+			Name:           fmt.Sprintf("vms-bin-%d-services", i+1),
+			RequiredCPU:    bin.cpu / cpuOverCommitMultiplier,
+			RequiredMemory: bin.memory / memoryOverCommitMultiplier,
+			LimitCPU:       bin.cpu,
+			LimitMemory:    bin.memory,
+			VMCount:        len(bin.vms),
+		})
+	}
+
+	largest := placeable[:min(MaxLargestVMs, len(placeable))]
+	requests := make([]vmShape, 0, len(largest))
+	report.LargestVMs = make([]mappers.PackedVM, 0, len(largest))
+	for _, vm := range largest {
+		report.LargestVMs = append(report.LargestVMs, toPackedVM(vm))
+		vm.cpu /= cpuOverCommitMultiplier
+		vm.memoryGB /= memoryOverCommitMultiplier
+		requests = append(requests, vm)
+	}
+	report.LargestVMsNodeCount = len(firstFitDecreasing(requests, effectiveWorkerNodeCPU, nodeMemory, MaxVMsPerWorkerNode))
+	report.PackedVMs = len(placeable)
+
+	return services, report, nil
+}
+
+// firstFitDecreasing packs the VMs, sorted largest first, into bins of the given capacity holding
+// at most maxVMs VMs. A VM larger than the capacity gets a bin of its own.
+func firstFitDecreasing(vms []vmShape, capacityCPU, capacityMemory float64, maxVMs int) []*vmBin {
+	var bins []*vmBin
+	for _, vm := range vms {
+		var target *vmBin
+		for _, bin := range bins {
+			if len(bin.vms) < maxVMs && bin.cpu+vm.cpu <= capacityCPU && bin.memory+vm.memoryGB <= capacityMemory {
+				target = bin
+				break
+			}
+		}
+		if target == nil {
+			target = &vmBin{}
+			bins = append(bins, target)
+		}
+		target.vms = append(target.vms, vm)
+		target.cpu += vm.cpu
+		target.memory += vm.memoryGB
+	}
+	return bins
+}
+
+func toPackedVM(vm vmShape) mappers.PackedVM {
+	return mappers.PackedVM{ID: vm.id, Name: vm.name, CPU: vm.cpu, MemoryGB: vm.memoryGB}
+}
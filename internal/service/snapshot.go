@@ -0,0 +1,86 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// selectSnapshot returns the snapshot identified by snapshotID, or the latest
+// snapshot when snapshotID is nil. Snapshots are expected to be ordered newest first,
+// which is how the assessment store loads them.
+func selectSnapshot(assessment *model.Assessment, snapshotID *uint) (*model.Snapshot, error) {
+	if len(assessment.Snapshots) == 0 {
+		return nil, fmt.Errorf("assessment has no snapshots")
+	}
+
+	if snapshotID == nil {
+		return &assessment.Snapshots[0], nil
+	}
+
+	for i := range assessment.Snapshots {
+		if assessment.Snapshots[i].ID == *snapshotID {
+			return &assessment.Snapshots[i], nil
+		}
+	}
+
+	return nil, NewErrSnapshotNotFound(*snapshotID, assessment.ID)
+}
+
+// parseSnapshotInventory unmarshals the snapshot inventory into the v2 inventory format.
+func parseSnapshotInventory(snapshot *model.Snapshot) (*api.Inventory, error) {
+	if len(snapshot.Inventory) == 0 {
+		return nil, fmt.Errorf("snapshot %d has empty inventory", snapshot.ID)
+	}
+
+	var inventory api.Inventory
+	if err := json.Unmarshal(snapshot.Inventory, &inventory); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory: %w", err)
+	}
+
+	return &inventory, nil
+}
+
+// snapshotInventoryByID returns the parsed inventory of the given snapshot.
+func snapshotInventoryByID(assessment *model.Assessment, snapshotID uint) (*api.Inventory, error) {
+	snapshot, err := selectSnapshot(assessment, &snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshotInventory(snapshot)
+}
+
+// clusterInventoryFromAssessment resolves the snapshot (latest when snapshotID is nil)
+// and returns the inventory of the requested cluster.
+func clusterInventoryFromAssessment(assessment *model.Assessment, snapshotID *uint, clusterID string) (api.InventoryData, error) {
+	snapshot, err := selectSnapshot(assessment, snapshotID)
+	if err != nil {
+		return api.InventoryData{}, err
+	}
+
+	inventory, err := parseSnapshotInventory(snapshot)
+	if err != nil {
+		return api.InventoryData{}, err
+	}
+
+	if len(inventory.Clusters) == 0 {
+		return api.InventoryData{}, fmt.Errorf("inventory has no clusters")
+	}
+
+	clusterInventory, exists := inventory.Clusters[clusterID]
+	if !exists {
+		return api.InventoryData{}, NewErrClusterNotFound(clusterID, assessment.ID)
+	}
+
+	return clusterInventory, nil
+}
+
+// snapshotIDString formats an optional snapshot ID for logging.
+func snapshotIDString(snapshotID *uint) string {
+	if snapshotID == nil {
+		return "latest"
+	}
+	return fmt.Sprintf("%d", *snapshotID)
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ClusterDiffStatus string
+
+const (
+	ClusterDiffStatusAdded     ClusterDiffStatus = "added"
+	ClusterDiffStatusRemoved   ClusterDiffStatus = "removed"
+	ClusterDiffStatusChanged   ClusterDiffStatus = "changed"
+	ClusterDiffStatusUnchanged ClusterDiffStatus = "unchanged"
+)
+
+// SnapshotDiff holds the per-cluster comparison between two snapshots of an assessment.
+type SnapshotDiff struct {
+	FromSnapshotID uint
+	ToSnapshotID   uint
+	Clusters       []ClusterSnapshotDiff // sorted by cluster ID
+}
+
+type ClusterSnapshotDiff struct {
+	ClusterID            string
+	Status               ClusterDiffStatus
+	VMsTotal             CountDiff
+	VMsTotalMigratable   CountDiff
+	DiskGB               CountDiff
+	RamGB                CountDiff
+	OSInfo               []OSCountDiff // only operating systems whose count changed
+	MigrationWarnings    IssuesDiff
+	NotMigratableReasons IssuesDiff
+	Hosts                HostsDiff
+	Datastores           DatastoresDiff
+	// VMsAdded and VMsRemoved are the VMs found in only one of the snapshots. They are nil when
+	// one of the snapshots has no per-VM records, and only the VM counts can be compared.
+	VMsAdded   []SnapshotVM
+	VMsRemoved []SnapshotVM
+}
+
+// SnapshotVM is a VM added to or removed from a cluster between two snapshots.
+type SnapshotVM struct {
+	ID   string
+	Name string
+}
+
+type CountDiff struct {
+	From int
+	To   int
+}
+
+func (c CountDiff) Delta() int {
+	return c.To - c.From
+}
+
+type OSCountDiff struct {
+	Name string
+	CountDiff
+}
+
+type IssuesDiff struct {
+	New      []api.MigrationIssue
+	Resolved []api.MigrationIssue
+	Changed  []IssueCountDiff
+}
+
+type IssueCountDiff struct {
+	ID    *string
+	Label string
+	CountDiff
+}
+
+type HostsDiff struct {
+	Total   CountDiff
+	Added   []api.Host
+	Removed []api.Host
+}
+
+type DatastoresDiff struct {
+	Total   CountDiff
+	Added   []api.Datastore
+	Removed []api.Datastore
+}
+
+// diffInventories compares two inventories cluster by cluster.
+// Clusters missing from one side are compared against an empty cluster.
+// The VMs of the clusters are compared when both snapshots have per-VM records.
+func diffInventories(from, to *api.Inventory, fromVMs, toVMs model.AssessmentVMList) []ClusterSnapshotDiff {
+	clusterIDs := make(map[string]struct{}, len(from.Clusters)+len(to.Clusters))
+	for id := range from.Clusters {
+		clusterIDs[id] = struct{}{}
+	}
+	for id := range to.Clusters {
+		clusterIDs[id] = struct{}{}
+	}
+
+	ids := make([]string, 0, len(clusterIDs))
+	for id := range clusterIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	diffs := make([]ClusterSnapshotDiff, 0, len(ids))
+	for _, id := range ids {
+		fromCluster, inFrom := from.Clusters[id]
+		toCluster, inTo := to.Clusters[id]
+
+		diff := diffCluster(id, fromCluster, toCluster)
+		if len(fromVMs) > 0 && len(toVMs) > 0 {
+			diff.VMsAdded, diff.VMsRemoved = diffVMs(clusterVMs(fromVMs, id), clusterVMs(toVMs, id))
+		}
+		switch {
+		case !inFrom:
+			diff.Status = ClusterDiffStatusAdded
+		case !inTo:
+			diff.Status = ClusterDiffStatusRemoved
+		case diff.hasChanges():
+			diff.Status = ClusterDiffStatusChanged
+		default:
+			diff.Status = ClusterDiffStatusUnchanged
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+func diffCluster(clusterID string, from, to api.InventoryData) ClusterSnapshotDiff {
+	return ClusterSnapshotDiff{
+		ClusterID:            clusterID,
+		VMsTotal:             CountDiff{From: from.Vms.Total, To: to.Vms.Total},
+		VMsTotalMigratable:   CountDiff{From: from.Vms.TotalMigratable, To: to.Vms.TotalMigratable},
+		DiskGB:               CountDiff{From: from.Vms.DiskGB.Total, To: to.Vms.DiskGB.Total},
+		RamGB:                CountDiff{From: from.Vms.RamGB.Total, To: to.Vms.RamGB.Total},
+		OSInfo:               diffOSInfo(from.Vms.OsInfo, to.Vms.OsInfo),
+		MigrationWarnings:    diffIssues(from.Vms.MigrationWarnings, to.Vms.MigrationWarnings),
+		NotMigratableReasons: diffIssues(from.Vms.NotMigratableReasons, to.Vms.NotMigratableReasons),
+		Hosts:                diffHosts(from.Infra, to.Infra),
+		Datastores:           diffDatastores(from.Infra.Datastores, to.Infra.Datastores),
+	}
+}
+
+func (d ClusterSnapshotDiff) hasChanges() bool {
+	for _, c := range []CountDiff{d.VMsTotal, d.VMsTotalMigratable, d.DiskGB, d.RamGB, d.Hosts.Total, d.Datastores.Total} {
+		if c.Delta() != 0 {
+			return true
+		}
+	}
+	return len(d.OSInfo) > 0 ||
+		d.MigrationWarnings.hasChanges() ||
+		d.NotMigratableReasons.hasChanges() ||
+		len(d.Hosts.Added) > 0 || len(d.Hosts.Removed) > 0 ||
+		len(d.Datastores.Added) > 0 || len(d.Datastores.Removed) > 0 ||
+		len(d.VMsAdded) > 0 || len(d.VMsRemoved) > 0
+}
+
+func clusterVMs(vms model.AssessmentVMList, clusterID string) model.AssessmentVMList {
+	var result model.AssessmentVMList
+	for _, vm := range vms {
+		if vm.ClusterID == clusterID {
+			result = append(result, vm)
+		}
+	}
+	return result
+}
+
+// diffVMs returns the VMs found in only one of the lists. VMs are matched by ID, then the
+// remaining ones by name, so that a VM keeps its identity when it is re-registered with a new ID.
+func diffVMs(from, to model.AssessmentVMList) (added, removed []SnapshotVM) {
+	fromIDs := make(map[string]bool, len(from))
+	for _, vm := range from {
+		fromIDs[vm.VMID] = true
+	}
+	toIDs := make(map[string]bool, len(to))
+	for _, vm := range to {
+		toIDs[vm.VMID] = true
+	}
+
+	// Count the names of the VMs whose ID disappeared, each of them matches one new VM
+	removedNames := make(map[string]int)
+	for _, vm := range from {
+		if !toIDs[vm.VMID] {
+			removedNames[vm.Name]++
+		}
+	}
+	addedNames := make(map[string]int)
+	added = []SnapshotVM{}
+	for _, vm := range to {
+		if fromIDs[vm.VMID] {
+			continue
+		}
+		if removedNames[vm.Name] > 0 {
+			removedNames[vm.Name]--
+			addedNames[vm.Name]++
+			continue
+		}
+		added = append(added, SnapshotVM{ID: vm.VMID, Name: vm.Name})
+	}
+
+	removed = []SnapshotVM{}
+	for _, vm := range from {
+		if toIDs[vm.VMID] {
+			continue
+		}
+		if addedNames[vm.Name] > 0 {
+			addedNames[vm.Name]--
+			continue
+		}
+		removed = append(removed, SnapshotVM{ID: vm.VMID, Name: vm.Name})
+	}
+	return added, removed
+}
+
+func (d IssuesDiff) hasChanges() bool {
+	return len(d.New) > 0 || len(d.Resolved) > 0 || len(d.Changed) > 0
+}
+
+func diffOSInfo(from, to *map[string]api.OsInfo) []OSCountDiff {
+	counts := make(map[string]*CountDiff)
+	if from != nil {
+		for name, info := range *from {
+			counts[name] = &CountDiff{From: info.Count}
+		}
+	}
+	if to != nil {
+		for name, info := range *to {
+			if c, ok := counts[name]; ok {
+				c.To = info.Count
+				continue
+			}
+			counts[name] = &CountDiff{To: info.Count}
+		}
+	}
+
+	diffs := []OSCountDiff{}
+	for name, c := range counts {
+		if c.Delta() != 0 {
+			diffs = append(diffs, OSCountDiff{Name: name, CountDiff: *c})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+	return diffs
+}
+
+// issueKey identifies a migration issue across snapshots. Issues produced by the
+// current collectors carry a stable ID, older inventories only have the label.
+func issueKey(issue api.MigrationIssue) string {
+	if issue.Id != nil && *issue.Id != "" {
+		return *issue.Id
+	}
+	return issue.Label
+}
+
+func diffIssues(from, to []api.MigrationIssue) IssuesDiff {
+	fromByKey := make(map[string]api.MigrationIssue, len(from))
+	for _, issue := range from {
+		fromByKey[issueKey(issue)] = issue
+	}
+
+	diff := IssuesDiff{
+		New:      []api.MigrationIssue{},
+		Resolved: []api.MigrationIssue{},
+		Changed:  []IssueCountDiff{},
+	}
+
+	toKeys := make(map[string]struct{}, len(to))
+	for _, issue := range to {
+		key := issueKey(issue)
+		toKeys[key] = struct{}{}
+
+		previous, ok := fromByKey[key]
+		if !ok {
+			diff.New = append(diff.New, issue)
+			continue
+		}
+		if previous.Count != issue.Count {
+			diff.Changed = append(diff.Changed, IssueCountDiff{
+				ID:        issue.Id,
+				Label:     issue.Label,
+				CountDiff: CountDiff{From: previous.Count, To: issue.Count},
+			})
+		}
+	}
+
+	for _, issue := range from {
+		if _, ok := toKeys[issueKey(issue)]; !ok {
+			diff.Resolved = append(diff.Resolved, issue)
+		}
+	}
+
+	return diff
+}
+
+// hostKey identifies a host across snapshots. Hosts without an ID are matched
+// on their hardware description.
+func hostKey(host api.Host) string {
+	if host.Id != nil && *host.Id != "" {
+		return *host.Id
+	}
+
+	var cores, sockets int
+	var memory int64
+	if host.CpuCores != nil {
+		cores = *host.CpuCores
+	}
+	if host.CpuSockets != nil {
+		sockets = *host.CpuSockets
+	}
+	if host.MemoryMB != nil {
+		memory = *host.MemoryMB
+	}
+	return fmt.Sprintf("%s/%s/%d/%d/%d", host.Vendor, host.Model, sockets, cores, memory)
+}
+
+func diffHosts(from, to api.Infra) HostsDiff {
+	var fromHosts, toHosts []api.Host
+	if from.Hosts != nil {
+		fromHosts = *from.Hosts
+	}
+	if to.Hosts != nil {
+		toHosts = *to.Hosts
+	}
+
+	added, removed := diffByKey(fromHosts, toHosts, hostKey)
+
+	return HostsDiff{
+		Total:   CountDiff{From: from.TotalHosts, To: to.TotalHosts},
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+// datastoreKey identifies a datastore across snapshots. Free capacity is left out
+// on purpose so that usage changes are not reported as a replaced datastore.
+func datastoreKey(ds api.Datastore) string {
+	hostID := ""
+	if ds.HostId != nil {
+		hostID = *ds.HostId
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%d", ds.DiskId, hostID, ds.Type, ds.Vendor, ds.Model, ds.ProtocolType, ds.TotalCapacityGB)
+}
+
+func diffDatastores(from, to []api.Datastore) DatastoresDiff {
+	added, removed := diffByKey(from, to, datastoreKey)
+
+	return DatastoresDiff{
+		Total:   CountDiff{From: len(from), To: len(to)},
+		Added:   added,
+		Removed: removed,
+	}
+}
+
+// diffByKey returns the items of to missing from from (added) and the items of from
+// missing from to (removed). Items sharing a key are matched one to one.
+func diffByKey[T any](from, to []T, key func(T) string) (added, removed []T) {
+	remaining := make(map[string]int, len(from))
+	for _, item := range from {
+		remaining[key(item)]++
+	}
+
+	added = []T{}
+	for _, item := range to {
+		k := key(item)
+		if remaining[k] > 0 {
+			remaining[k]--
+			continue
+		}
+		added = append(added, item)
+	}
+
+	removed = []T{}
+	for _, item := range from {
+		k := key(item)
+		if remaining[k] > 0 {
+			remaining[k]--
+			removed = append(removed, item)
+		}
+	}
+
+	return added, removed
+}
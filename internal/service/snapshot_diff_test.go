@@ -0,0 +1,261 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	api "github.com/kubev2v/migration-planner/api/v1alpha1"
+	"github.com/kubev2v/migration-planner/internal/service"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"github.com/kubev2v/migration-planner/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func buildDiffCluster(total, migratable, diskGB, ramGB int, osCounts map[string]int, warnings []api.MigrationIssue, hostIDs []string, datastoreIDs []string) api.InventoryData {
+	hosts := make([]api.Host, len(hostIDs))
+	for i, id := range hostIDs {
+		hosts[i] = api.Host{Id: util.ToStrPtr(id), Vendor: "Dell", Model: "R740"}
+	}
+
+	datastores := make([]api.Datastore, len(datastoreIDs))
+	for i, id := range datastoreIDs {
+		datastores[i] = api.Datastore{DiskId: id, Type: "VMFS", TotalCapacityGB: 1000, FreeCapacityGB: 100 * (i + 1)}
+	}
+
+	return api.InventoryData{
+		Vms: api.VMs{
+			Total:                total,
+			TotalMigratable:      migratable,
+			DiskGB:               api.VMResourceBreakdown{Total: diskGB},
+			RamGB:                api.VMResourceBreakdown{Total: ramGB},
+			OsInfo:               buildOsInfo(osCounts),
+			MigrationWarnings:    warnings,
+			NotMigratableReasons: []api.MigrationIssue{},
+		},
+		Infra: api.Infra{
+			TotalHosts: len(hosts),
+			Hosts:      &hosts,
+			Datastores: datastores,
+		},
+	}
+}
+
+func buildDiffSnapshot(id uint, clusters map[string]api.InventoryData) model.Snapshot {
+	data, err := json.Marshal(api.Inventory{VcenterId: "vcenter-1", Clusters: clusters})
+	Expect(err).To(BeNil())
+	return model.Snapshot{ID: id, Inventory: data}
+}
+
+var _ = Describe("snapshot diff", func() {
+	var (
+		mockStore     *MockStore
+		assessmentSrv *service.AssessmentService
+		ctx           context.Context
+		assessmentID  uuid.UUID
+	)
+
+	BeforeEach(func() {
+		mockStore = NewMockStore()
+		assessmentSrv = service.NewAssessmentService(mockStore, nil, nil)
+		ctx = context.Background()
+		assessmentID = uuid.New()
+
+		older := buildDiffSnapshot(1, map[string]api.InventoryData{
+			"cluster-a": buildDiffCluster(10, 8, 1000, 64, map[string]int{"RHEL 8": 6, "Windows 2012": 4},
+				[]api.MigrationIssue{
+					{Id: util.ToStrPtr("cbt"), Label: "CBT disabled", Count: 5},
+					{Id: util.ToStrPtr("usb"), Label: "USB device", Count: 1},
+				},
+				[]string{"host-1", "host-2"}, []string{"ds-1", "ds-2"}),
+			"cluster-b": buildDiffCluster(3, 3, 300, 12, map[string]int{"RHEL 9": 3}, []api.MigrationIssue{}, []string{"host-3"}, []string{"ds-3"}),
+			"cluster-c": buildDiffCluster(1, 1, 50, 4, map[string]int{"RHEL 9": 1}, []api.MigrationIssue{}, []string{"host-4"}, []string{"ds-4"}),
+		})
+		newer := buildDiffSnapshot(2, map[string]api.InventoryData{
+			"cluster-a": buildDiffCluster(12, 11, 1200, 80, map[string]int{"RHEL 8": 6, "RHEL 9": 4, "Windows 2012": 2},
+				[]api.MigrationIssue{
+					{Id: util.ToStrPtr("cbt"), Label: "CBT disabled", Count: 2},
+					{Id: util.ToStrPtr("rdm"), Label: "RDM disk", Count: 1},
+				},
+				[]string{"host-1", "host-5"}, []string{"ds-1", "ds-2"}),
+			"cluster-b": buildDiffCluster(3, 3, 300, 12, map[string]int{"RHEL 9": 3}, []api.MigrationIssue{}, []string{"host-3"}, []string{"ds-3"}),
+			"cluster-d": buildDiffCluster(2, 2, 100, 8, map[string]int{"RHEL 9": 2}, []api.MigrationIssue{}, []string{"host-6"}, []string{"ds-5"}),
+		})
+
+		mockStore.assessments[assessmentID] = &model.Assessment{
+			ID:        assessmentID,
+			Snapshots: []model.Snapshot{newer, older},
+		}
+	})
+
+	clusterByID := func(diff *service.SnapshotDiff, id string) service.ClusterSnapshotDiff {
+		for _, c := range diff.Clusters {
+			if c.ClusterID == id {
+				return c
+			}
+		}
+		Fail("cluster " + id + " not found in diff")
+		return service.ClusterSnapshotDiff{}
+	}
+
+	It("reports every cluster with its status, sorted by ID", func() {
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+		Expect(diff.FromSnapshotID).To(Equal(uint(1)))
+		Expect(diff.ToSnapshotID).To(Equal(uint(2)))
+		Expect(diff.Clusters).To(HaveLen(4))
+
+		statuses := []service.ClusterDiffStatus{}
+		for _, c := range diff.Clusters {
+			statuses = append(statuses, c.Status)
+		}
+		Expect(statuses).To(Equal([]service.ClusterDiffStatus{
+			service.ClusterDiffStatusChanged,
+			service.ClusterDiffStatusUnchanged,
+			service.ClusterDiffStatusRemoved,
+			service.ClusterDiffStatusAdded,
+		}))
+	})
+
+	It("computes VM totals and OS count changes", func() {
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		a := clusterByID(diff, "cluster-a")
+		Expect(a.VMsTotal).To(Equal(service.CountDiff{From: 10, To: 12}))
+		Expect(a.VMsTotal.Delta()).To(Equal(2))
+		Expect(a.VMsTotalMigratable.Delta()).To(Equal(3))
+		Expect(a.DiskGB.Delta()).To(Equal(200))
+		Expect(a.RamGB.Delta()).To(Equal(16))
+
+		Expect(a.OSInfo).To(HaveLen(2))
+		Expect(a.OSInfo[0].Name).To(Equal("RHEL 9"))
+		Expect(a.OSInfo[0].Delta()).To(Equal(4))
+		Expect(a.OSInfo[1].Name).To(Equal("Windows 2012"))
+		Expect(a.OSInfo[1].Delta()).To(Equal(-2))
+
+		d := clusterByID(diff, "cluster-d")
+		Expect(d.VMsTotal).To(Equal(service.CountDiff{From: 0, To: 2}))
+	})
+
+	It("separates new, resolved and changed migration issues", func() {
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		warnings := clusterByID(diff, "cluster-a").MigrationWarnings
+		Expect(warnings.New).To(HaveLen(1))
+		Expect(warnings.New[0].Label).To(Equal("RDM disk"))
+		Expect(warnings.Resolved).To(HaveLen(1))
+		Expect(warnings.Resolved[0].Label).To(Equal("USB device"))
+		Expect(warnings.Changed).To(HaveLen(1))
+		Expect(warnings.Changed[0].Label).To(Equal("CBT disabled"))
+		Expect(warnings.Changed[0].Delta()).To(Equal(-3))
+	})
+
+	It("diffs the migration issues left after the concern waivers", func() {
+		for i := range mockStore.assessments[assessmentID].Snapshots {
+			mockStore.assessments[assessmentID].Snapshots[i].Version = model.SnapshotVersionV2
+		}
+		mockStore.waivers = model.ConcernWaiverList{
+			{ID: uuid.New(), AssessmentID: assessmentID, ConcernID: "rdm", Justification: "RDM disks are converted"},
+		}
+
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		warnings := clusterByID(diff, "cluster-a").MigrationWarnings
+		Expect(warnings.New).To(BeEmpty())
+		Expect(warnings.Resolved).To(HaveLen(1))
+		Expect(warnings.Resolved[0].Label).To(Equal("USB device"))
+		Expect(warnings.Changed).To(HaveLen(1))
+		Expect(warnings.Changed[0].Label).To(Equal("CBT disabled"))
+	})
+
+	It("reports added and removed hosts without flagging datastore usage changes", func() {
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		a := clusterByID(diff, "cluster-a")
+		Expect(a.Hosts.Added).To(HaveLen(1))
+		Expect(*a.Hosts.Added[0].Id).To(Equal("host-5"))
+		Expect(a.Hosts.Removed).To(HaveLen(1))
+		Expect(*a.Hosts.Removed[0].Id).To(Equal("host-2"))
+		Expect(a.Datastores.Added).To(BeEmpty())
+		Expect(a.Datastores.Removed).To(BeEmpty())
+
+		c := clusterByID(diff, "cluster-c")
+		Expect(c.Hosts.Removed).To(HaveLen(1))
+		Expect(c.Datastores.Removed).To(HaveLen(1))
+		Expect(c.Datastores.Total).To(Equal(service.CountDiff{From: 1, To: 0}))
+	})
+
+	It("reports the VMs added and removed when both snapshots have VM records", func() {
+		vm := func(snapshotID uint, clusterID, id, name string) model.AssessmentVM {
+			return model.AssessmentVM{SnapshotID: snapshotID, AssessmentID: assessmentID, ClusterID: clusterID, VMID: id, Name: name}
+		}
+		mockStore.vms = model.AssessmentVMList{
+			vm(1, "cluster-a", "vm-1", "web-1"),
+			vm(1, "cluster-a", "vm-2", "web-2"),
+			vm(1, "cluster-a", "vm-3", "db-1"),
+			vm(1, "cluster-b", "vm-4", "app-1"),
+			vm(2, "cluster-a", "vm-1", "web-1"),
+			vm(2, "cluster-a", "vm-9", "db-1"),
+			vm(2, "cluster-a", "vm-5", "web-3"),
+			vm(2, "cluster-b", "vm-4", "app-1"),
+		}
+
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		a := clusterByID(diff, "cluster-a")
+		Expect(a.VMsAdded).To(Equal([]service.SnapshotVM{{ID: "vm-5", Name: "web-3"}}))
+		Expect(a.VMsRemoved).To(Equal([]service.SnapshotVM{{ID: "vm-2", Name: "web-2"}}))
+
+		b := clusterByID(diff, "cluster-b")
+		Expect(b.VMsAdded).To(BeEmpty())
+		Expect(b.VMsRemoved).To(BeEmpty())
+		Expect(b.Status).To(Equal(service.ClusterDiffStatusUnchanged))
+	})
+
+	It("flags a cluster whose VMs were replaced as changed", func() {
+		mockStore.vms = model.AssessmentVMList{
+			{SnapshotID: 1, ClusterID: "cluster-b", VMID: "vm-4", Name: "app-1"},
+			{SnapshotID: 2, ClusterID: "cluster-b", VMID: "vm-7", Name: "app-2"},
+		}
+
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		b := clusterByID(diff, "cluster-b")
+		Expect(b.VMsTotal.Delta()).To(BeZero())
+		Expect(b.Status).To(Equal(service.ClusterDiffStatusChanged))
+	})
+
+	It("does not compare VMs when a snapshot has no VM records", func() {
+		mockStore.vms = model.AssessmentVMList{{SnapshotID: 2, ClusterID: "cluster-b", VMID: "vm-7", Name: "app-2"}}
+
+		diff, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 2)
+		Expect(err).To(BeNil())
+
+		b := clusterByID(diff, "cluster-b")
+		Expect(b.VMsAdded).To(BeNil())
+		Expect(b.VMsRemoved).To(BeNil())
+		Expect(b.Status).To(Equal(service.ClusterDiffStatusUnchanged))
+	})
+
+	It("returns not found for an unknown snapshot", func() {
+		_, err := assessmentSrv.DiffSnapshots(ctx, assessmentID, 1, 42)
+		Expect(err).NotTo(BeNil())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("snapshot 42"))
+	})
+
+	It("returns not found for an unknown assessment", func() {
+		_, err := assessmentSrv.DiffSnapshots(ctx, uuid.New(), 1, 2)
+		Expect(err).NotTo(BeNil())
+		_, ok := err.(*service.ErrResourceNotFound)
+		Expect(ok).To(BeTrue())
+	})
+})
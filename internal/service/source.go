@@ -267,6 +267,11 @@ func (s *SourceService) UpdateInventory(ctx context.Context, form mappers.Invent
 		return model.Source{}, err
 	}
 
+	if err := s.store.SourceVM().Replace(ctx, source.ID, form.VMs); err != nil {
+		_, _ = store.Rollback(ctx)
+		return model.Source{}, err
+	}
+
 	if _, err := store.Commit(ctx); err != nil {
 		return model.Source{}, err
 	}
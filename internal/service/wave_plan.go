@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// waveScoreOrder is the order in which complexity buckets are planned: easiest first.
+// Score 0 (unknown OS) can not be assessed and is planned last.
+var waveScoreOrder = []complexity.Score{1, 2, 3, 4, 0}
+
+// WaveConstraints bounds the size of each migration wave. Zero values leave the
+// corresponding dimension unbounded.
+type WaveConstraints struct {
+	MaxWaveDuration  time.Duration // upper bound of the estimated wave duration (max of the range)
+	MaxVMsPerWave    int           // maximum number of VMs migrated concurrently
+	MaxDiskGBPerWave float64       // maximum disk GB transferred per change window
+}
+
+func (c WaveConstraints) validate() error {
+	if c.MaxWaveDuration < 0 {
+		return NewErrInvalidRequest("maximum wave duration must be positive")
+	}
+	if c.MaxVMsPerWave < 0 {
+		return NewErrInvalidRequest("maximum VMs per wave must be positive")
+	}
+	if c.MaxDiskGBPerWave < 0 {
+		return NewErrInvalidRequest("maximum disk GB per wave must be positive")
+	}
+	return nil
+}
+
+// MigrationWave is one wave of a WavePlan. All VMs of a wave share the same combined
+// OS/disk complexity score.
+type MigrationWave struct {
+	Number          int
+	ComplexityScore complexity.Score
+	VMCount         int
+	TotalDiskGB     float64
+	Estimation      *MigrationAssessmentResult
+	// ExceedsConstraints is set when a single VM of the bucket already exceeds the constraints;
+	// such VMs are planned one per wave.
+	ExceedsConstraints bool
+}
+
+// WavePlan splits the VMs of a cluster into sequential migration waves.
+type WavePlan struct {
+	Schema           engines.Schema
+	Waves            []MigrationWave
+	MinTotalDuration time.Duration
+	MaxTotalDuration time.Duration
+}
+
+// PlanMigrationWaves splits the VMs of the cluster into migration waves bounded by the constraints.
+// VMs are grouped by their combined OS/disk complexity score, easiest first; VMs of a bucket are
+// assumed to share the average disk size of the bucket. Each wave is estimated by its own engine
+// run of the schema (network-based when empty). When snapshotID is nil the latest snapshot is used.
+func (es *EstimationService) PlanMigrationWaves(
+	ctx context.Context,
+	assessmentID uuid.UUID,
+	clusterID string,
+	snapshotID *uint,
+	schema engines.Schema,
+	userParams []estimation.Param,
+	constraints WaveConstraints,
+) (*WavePlan, error) {
+	logger := es.logger.WithContext(ctx)
+	tracer := logger.Operation("plan_migration_waves").
+		WithUUID("assessment_id", assessmentID).
+		WithString("cluster_id", clusterID).
+		WithString("snapshot_id", snapshotIDString(snapshotID)).
+		Build()
+
+	if err := constraints.validate(); err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+	if schema == "" {
+		schema = engines.SchemaNetworkBased
+	}
+
+	assessment, err := es.store.Assessment().Get(ctx, assessmentID)
+	if err != nil {
+		if errors.Is(err, store.ErrRecordNotFound) {
+			tracer.Error(err).Log()
+			return nil, NewErrAssessmentNotFound(assessmentID)
+		}
+		tracer.Error(err).Log()
+		return nil, fmt.Errorf("failed to get assessment: %w", err)
+	}
+
+	clusterInventory, err := clusterInventoryFromAssessment(assessment, snapshotID, clusterID)
+	if err != nil {
+		tracer.Error(err).Log()
+		return nil, err
+	}
+	if clusterInventory.Vms.ComplexityDistribution == nil {
+		err := NewErrInvalidClusterInventory(clusterID, "no OS/disk complexity distribution")
+		tracer.Error(err).Log()
+		return nil, err
+	}
+
+	buckets := make(map[complexity.Score]complexity.OSDiskEntry)
+	for _, b := range buildComplexityByOsDisk(clusterInventory.Vms.ComplexityDistribution) {
+		buckets[b.Score] = b
+	}
+
+	estimate := func(vmCount int, diskGB float64) (*MigrationAssessmentResult, error) {
+		results, err := es.RunEstimation([]engines.Schema{schema}, es.BuildBucketParams(userParams, vmCount, diskGB))
+		if err != nil {
+			return nil, err
+		}
+		return results[schema], nil
+	}
+
+	plan := &WavePlan{Schema: schema}
+	for _, score := range waveScoreOrder {
+		bucket := buckets[score]
+		if bucket.VMCount == 0 {
+			continue
+		}
+		avgDiskGB := bucket.TotalSizeTB * 1024 / float64(bucket.VMCount)
+
+		waveSize, oversized, err := maxWaveSize(bucket.VMCount, avgDiskGB, constraints, estimate)
+		if err != nil {
+			tracer.Error(err).Log()
+			return nil, err
+		}
+
+		for remaining := bucket.VMCount; remaining > 0; remaining -= waveSize {
+			vmCount := min(waveSize, remaining)
+			diskGB := math.Round(float64(vmCount)*avgDiskGB*100) / 100
+			result, err := estimate(vmCount, diskGB)
+			if err != nil {
+				tracer.Error(err).Log()
+				return nil, err
+			}
+			plan.Waves = append(plan.Waves, MigrationWave{
+				Number:             len(plan.Waves) + 1,
+				ComplexityScore:    score,
+				VMCount:            vmCount,
+				TotalDiskGB:        diskGB,
+				Estimation:         result,
+				ExceedsConstraints: oversized,
+			})
+			plan.MinTotalDuration += result.MinTotalDuration
+			plan.MaxTotalDuration += result.MaxTotalDuration
+		}
+	}
+
+	tracer.Success().
+		WithString("schema", string(schema)).
+		WithInt("wave_count", len(plan.Waves)).
+		Log()
+
+	return plan, nil
+}
+
+// maxWaveSize returns the largest number of VMs of avgDiskGB each that fits in one wave, up to vmCount.
+// When a single VM already exceeds the constraints it returns 1 and reports the wave as oversized.
+// The duration bound is found by binary search, as the estimated duration grows with the VM count.
+func maxWaveSize(
+	vmCount int,
+	avgDiskGB float64,
+	constraints WaveConstraints,
+	estimate func(vmCount int, diskGB float64) (*MigrationAssessmentResult, error),
+) (int, bool, error) {
+	limit := vmCount
+	if constraints.MaxVMsPerWave > 0 {
+		limit = min(limit, constraints.MaxVMsPerWave)
+	}
+	if constraints.MaxDiskGBPerWave > 0 && avgDiskGB > 0 {
+		limit = min(limit, int(math.Floor(constraints.MaxDiskGBPerWave/avgDiskGB)))
+	}
+	if limit < 1 {
+		return 1, true, nil
+	}
+	if constraints.MaxWaveDuration == 0 {
+		return limit, false, nil
+	}
+
+	fits := func(n int) (bool, error) {
+		result, err := estimate(n, float64(n)*avgDiskGB)
+		if err != nil {
+			return false, err
+		}
+		return result.MaxTotalDuration <= constraints.MaxWaveDuration, nil
+	}
+
+	ok, err := fits(1)
+	if err != nil {
+		return 0, false, err
+	}
+	if !ok {
+		return 1, true, nil
+	}
+
+	// invariant: lo fits, every size above hi does not
+	lo, hi := 1, limit
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		ok, err := fits(mid)
+		if err != nil {
+			return 0, false, err
+		}
+		if ok {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, false, nil
+}
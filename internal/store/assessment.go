@@ -19,7 +19,10 @@ type Assessment interface {
 	Get(ctx context.Context, id uuid.UUID) (*model.Assessment, error)
 	Create(ctx context.Context, assessment model.Assessment, inventory []byte, subsetInventories []model.AssessmentSubsetInventory) (*model.Assessment, error)
 	Update(ctx context.Context, assessmentID uuid.UUID, name *string, inventory []byte) (*model.Assessment, error)
+	UpdateOwner(ctx context.Context, assessmentID uuid.UUID, username string) (*model.Assessment, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	ListSnapshots(ctx context.Context, assessmentID uuid.UUID) ([]model.Snapshot, error)
+	GetSnapshot(ctx context.Context, assessmentID uuid.UUID, snapshotID uint) (*model.Snapshot, error)
 }
 
 type AssessmentStore struct {
@@ -36,7 +39,7 @@ func NewAssessmentStore(db *gorm.DB) Assessment {
 func (a *AssessmentStore) List(ctx context.Context, filter *AssessmentQueryFilter) (model.AssessmentList, error) {
 	var assessments model.AssessmentList
 	tx := a.getDB(ctx).Model(&assessments).Order("created_at DESC").Preload("Snapshots", func(db *gorm.DB) *gorm.DB {
-		return db.Order("snapshots.created_at DESC")
+		return db.Order("snapshots.created_at DESC, snapshots.id DESC")
 	})
 
 	if filter != nil {
@@ -56,7 +59,7 @@ func (a *AssessmentStore) Get(ctx context.Context, id uuid.UUID) (*model.Assessm
 	var assessment model.Assessment
 	result := a.getDB(ctx).
 		Preload("Snapshots", func(db *gorm.DB) *gorm.DB {
-			return db.Order("snapshots.created_at DESC")
+			return db.Order("snapshots.created_at DESC, snapshots.id DESC")
 		}).
 		Preload("Snapshots.SubsetInventories", func(db *gorm.DB) *gorm.DB {
 			return db.Order("name ASC, id ASC")
@@ -140,8 +143,31 @@ func (a *AssessmentStore) Update(ctx context.Context, assessmentID uuid.UUID, na
 		return nil, err
 	}
 
-	// Return the updated assessment with snapshots
-	return &assessment, nil
+	// Return the updated assessment with the full snapshot history loaded
+	return a.Get(ctx, assessmentID)
+}
+
+// UpdateOwner sets the owner of the assessment. The name of the new owner is unknown, it is cleared.
+func (a *AssessmentStore) UpdateOwner(ctx context.Context, assessmentID uuid.UUID, username string) (*model.Assessment, error) {
+	result := a.getDB(ctx).Model(&model.Assessment{}).
+		Where("id = ?", assessmentID).
+		Updates(map[string]any{
+			"username":         username,
+			"owner_first_name": nil,
+			"owner_last_name":  nil,
+			"updated_at":       time.Now(),
+		})
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrRecordNotFound
+	}
+
+	return a.Get(ctx, assessmentID)
 }
 
 func (a *AssessmentStore) Delete(ctx context.Context, id uuid.UUID) error {
@@ -152,6 +178,39 @@ func (a *AssessmentStore) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ListSnapshots returns every snapshot of the assessment, newest first.
+func (a *AssessmentStore) ListSnapshots(ctx context.Context, assessmentID uuid.UUID) ([]model.Snapshot, error) {
+	var snapshots []model.Snapshot
+	result := a.getDB(ctx).
+		Preload("SubsetInventories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC, id ASC")
+		}).
+		Where("assessment_id = ?", assessmentID).
+		Order("created_at DESC, id DESC").
+		Find(&snapshots)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return snapshots, nil
+}
+
+// GetSnapshot returns a single snapshot. The snapshot must belong to the given assessment.
+func (a *AssessmentStore) GetSnapshot(ctx context.Context, assessmentID uuid.UUID, snapshotID uint) (*model.Snapshot, error) {
+	var snapshot model.Snapshot
+	result := a.getDB(ctx).
+		Preload("SubsetInventories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC, id ASC")
+		}).
+		First(&snapshot, "id = ? AND assessment_id = ?", snapshotID, assessmentID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, result.Error
+	}
+	return &snapshot, nil
+}
+
 func (a *AssessmentStore) getDB(ctx context.Context) *gorm.DB {
 	tx := FromContext(ctx)
 	if tx != nil {
@@ -511,6 +511,8 @@ var _ = Describe("assessment store", Ordered, func() {
 			updated, err := s.Assessment().Update(context.TODO(), assessmentID, nil, inventory2JSON)
 			Expect(err).To(BeNil())
 			Expect(updated).ToNot(BeNil())
+			Expect(updated.Snapshots).To(HaveLen(2))
+			Expect(string(updated.Snapshots[0].Inventory)).To(ContainSubstring("test-vcenter-2"))
 
 			// Verify new snapshot was added
 			var count int
@@ -566,6 +568,68 @@ var _ = Describe("assessment store", Ordered, func() {
 		})
 	})
 
+	Context("snapshots", func() {
+		It("lists all snapshots newest first", func() {
+			assessmentID := uuid.New()
+			assessment := model.Assessment{
+				ID:         assessmentID,
+				Name:       "test-assessment",
+				OrgID:      "org1",
+				SourceType: "agent",
+			}
+
+			_, err := s.Assessment().Create(context.TODO(), assessment, []byte(`{"vcenter":{"id":"test-vcenter-1"}}`), nil)
+			Expect(err).To(BeNil())
+			_, err = s.Assessment().Update(context.TODO(), assessmentID, nil, []byte(`{"vcenter":{"id":"test-vcenter-2"}}`))
+			Expect(err).To(BeNil())
+
+			snapshots, err := s.Assessment().ListSnapshots(context.TODO(), assessmentID)
+			Expect(err).To(BeNil())
+			Expect(snapshots).To(HaveLen(2))
+			Expect(string(snapshots[0].Inventory)).To(ContainSubstring("test-vcenter-2"))
+			Expect(string(snapshots[1].Inventory)).To(ContainSubstring("test-vcenter-1"))
+		})
+
+		It("gets a single snapshot", func() {
+			assessmentID := uuid.New()
+			assessment := model.Assessment{
+				ID:         assessmentID,
+				Name:       "test-assessment",
+				OrgID:      "org1",
+				SourceType: "agent",
+			}
+
+			created, err := s.Assessment().Create(context.TODO(), assessment, []byte(`{"vcenter":{"id":"test-vcenter-1"}}`), nil)
+			Expect(err).To(BeNil())
+
+			snapshot, err := s.Assessment().GetSnapshot(context.TODO(), assessmentID, created.Snapshots[0].ID)
+			Expect(err).To(BeNil())
+			Expect(snapshot.ID).To(Equal(created.Snapshots[0].ID))
+			Expect(snapshot.AssessmentID).To(Equal(assessmentID))
+		})
+
+		It("does not return a snapshot of another assessment", func() {
+			assessmentID := uuid.New()
+			assessment := model.Assessment{
+				ID:         assessmentID,
+				Name:       "test-assessment",
+				OrgID:      "org1",
+				SourceType: "agent",
+			}
+
+			created, err := s.Assessment().Create(context.TODO(), assessment, []byte(`{"vcenter":{"id":"test-vcenter-1"}}`), nil)
+			Expect(err).To(BeNil())
+
+			_, err = s.Assessment().GetSnapshot(context.TODO(), uuid.New(), created.Snapshots[0].ID)
+			Expect(err).To(Equal(store.ErrRecordNotFound))
+		})
+
+		AfterEach(func() {
+			gormdb.Exec("DELETE FROM snapshots;")
+			gormdb.Exec("DELETE FROM assessments;")
+		})
+	})
+
 	Context("delete", func() {
 		It("successfully deletes an assessment", func() {
 			assessmentID := uuid.New()
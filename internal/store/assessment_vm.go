@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"gorm.io/gorm"
+)
+
+const assessmentVMBatchSize = 500
+
+type AssessmentVM interface {
+	CreateBatch(ctx context.Context, vms []model.AssessmentVM) error
+	List(ctx context.Context, filter *AssessmentVMQueryFilter, options *AssessmentVMQueryOptions) (model.AssessmentVMList, error)
+	Count(ctx context.Context, filter *AssessmentVMQueryFilter) (int64, error)
+	// Note: No Update or Delete methods - VM records are immutable like the snapshot they belong to
+	// Delete happens via CASCADE when the snapshot or assessment is deleted
+}
+
+type AssessmentVMStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to AssessmentVM interface
+var _ AssessmentVM = (*AssessmentVMStore)(nil)
+
+func NewAssessmentVMStore(db *gorm.DB) AssessmentVM {
+	return &AssessmentVMStore{db: db}
+}
+
+func (s *AssessmentVMStore) CreateBatch(ctx context.Context, vms []model.AssessmentVM) error {
+	if len(vms) == 0 {
+		return nil
+	}
+
+	if result := s.getDB(ctx).CreateInBatches(&vms, assessmentVMBatchSize); result.Error != nil {
+		return fmt.Errorf("create assessment vms: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (s *AssessmentVMStore) List(ctx context.Context, filter *AssessmentVMQueryFilter, options *AssessmentVMQueryOptions) (model.AssessmentVMList, error) {
+	var vms model.AssessmentVMList
+	tx := s.getDB(ctx).Model(&vms)
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	if options != nil {
+		for _, fn := range options.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	// Apply deterministic tiebreaker after sort options
+	tx = tx.Order("vm_id")
+
+	if result := tx.Find(&vms); result.Error != nil {
+		return nil, fmt.Errorf("list assessment vms: %w", result.Error)
+	}
+	return vms, nil
+}
+
+func (s *AssessmentVMStore) Count(ctx context.Context, filter *AssessmentVMQueryFilter) (int64, error) {
+	var count int64
+	tx := s.getDB(ctx).Model(&model.AssessmentVM{})
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	if result := tx.Count(&count); result.Error != nil {
+		return 0, fmt.Errorf("count assessment vms: %w", result.Error)
+	}
+	return count, nil
+}
+
+func (s *AssessmentVMStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
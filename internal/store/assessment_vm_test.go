@@ -0,0 +1,129 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/config"
+	"github.com/kubev2v/migration-planner/internal/store"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("assessment vm store", Ordered, func() {
+	var (
+		s            store.Store
+		gormdb       *gorm.DB
+		assessmentID uuid.UUID
+		snapshotID   uint
+	)
+
+	BeforeAll(func() {
+		cfg, err := config.New()
+		Expect(err).To(BeNil())
+		db, err := store.InitDB(cfg)
+		Expect(err).To(BeNil())
+
+		s = store.NewStore(db)
+		gormdb = db
+	})
+
+	AfterAll(func() {
+		_ = s.Close()
+	})
+
+	BeforeEach(func() {
+		assessmentID = uuid.New()
+		tx := gormdb.Exec(fmt.Sprintf(insertAssessmentStm, assessmentID, "assessment-vms", "org1", "user1", "John", "Doe", "rvtools", "NULL"))
+		Expect(tx.Error).To(BeNil())
+		tx = gormdb.Exec(fmt.Sprintf(insertSnapshotStm, assessmentID, `{"vcenter": {"id": "test"}}`))
+		Expect(tx.Error).To(BeNil())
+		Expect(gormdb.Raw("SELECT id FROM snapshots WHERE assessment_id = ?", assessmentID).Scan(&snapshotID).Error).To(BeNil())
+
+		concerns := func(c ...model.VMConcern) model.JSONField[[]model.VMConcern] {
+			return *model.MakeJSONField(append([]model.VMConcern{}, c...))
+		}
+		cbt := model.VMConcern{ID: "vmware.changed_block_tracking.disabled", Label: "CBT disabled", Category: "Warning"}
+		rdm := model.VMConcern{ID: "vmware.disk.rdm.detected", Label: "RDM disk", Category: "Critical"}
+
+		err := s.AssessmentVM().CreateBatch(context.TODO(), []model.AssessmentVM{
+			{SnapshotID: snapshotID, VMID: "vm-1", AssessmentID: assessmentID, Name: "db-01", ClusterID: "domain-c1", ClusterName: "prod", OS: "RHEL 9", PowerState: "poweredOn", CpuCount: 8, Concerns: concerns(cbt, rdm)},
+			{SnapshotID: snapshotID, VMID: "vm-2", AssessmentID: assessmentID, Name: "web-01", ClusterID: "domain-c1", ClusterName: "prod", OS: "RHEL 8", PowerState: "poweredOff", CpuCount: 2, Concerns: concerns(cbt)},
+			{SnapshotID: snapshotID, VMID: "vm-3", AssessmentID: assessmentID, Name: "app-01", ClusterID: "domain-c2", ClusterName: "dev", OS: "RHEL 9", PowerState: "poweredOn", CpuCount: 4, Concerns: concerns()},
+		})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		gormdb.Exec("DELETE FROM assessment_vms;")
+		gormdb.Exec("DELETE FROM snapshots;")
+		gormdb.Exec("DELETE FROM assessments;")
+	})
+
+	names := func(vms model.AssessmentVMList) []string {
+		result := make([]string, 0, len(vms))
+		for _, vm := range vms {
+			result = append(result, vm.Name)
+		}
+		return result
+	}
+
+	It("lists the vms of a snapshot with their concerns", func() {
+		vms, err := s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID), nil)
+		Expect(err).To(BeNil())
+		Expect(vms).To(HaveLen(3))
+		Expect(vms[0].VMID).To(Equal("vm-1"))
+		Expect(vms[0].Concerns.Data).To(HaveLen(2))
+	})
+
+	It("filters by cluster id or name, os and power state", func() {
+		byID, err := s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByCluster("domain-c1"), nil)
+		Expect(err).To(BeNil())
+		Expect(byID).To(HaveLen(2))
+
+		byName, err := s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByCluster("dev"), nil)
+		Expect(err).To(BeNil())
+		Expect(names(byName)).To(Equal([]string{"app-01"}))
+
+		vms, err := s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByOS("RHEL 9").ByPowerState("poweredOn"), nil)
+		Expect(err).To(BeNil())
+		Expect(vms).To(HaveLen(2))
+	})
+
+	It("filters by concern id and category", func() {
+		vms, err := s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByConcernID("vmware.changed_block_tracking.disabled"), nil)
+		Expect(err).To(BeNil())
+		Expect(vms).To(HaveLen(2))
+
+		vms, err = s.AssessmentVM().List(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID).ByConcernCategory("Critical"), nil)
+		Expect(err).To(BeNil())
+		Expect(names(vms)).To(Equal([]string{"db-01"}))
+	})
+
+	It("sorts, paginates and counts", func() {
+		filter := store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID)
+
+		vms, err := s.AssessmentVM().List(context.TODO(), filter, store.NewAssessmentVMQueryOptions().WithSort("cpuCount", true).WithLimit(2))
+		Expect(err).To(BeNil())
+		Expect(names(vms)).To(Equal([]string{"db-01", "app-01"}))
+
+		vms, err = s.AssessmentVM().List(context.TODO(), filter, store.NewAssessmentVMQueryOptions().WithSort("concernCount", false).WithOffset(1))
+		Expect(err).To(BeNil())
+		Expect(names(vms)).To(Equal([]string{"web-01", "db-01"}))
+
+		count, err := s.AssessmentVM().Count(context.TODO(), filter)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(3)))
+	})
+
+	It("deletes the vms with the assessment", func() {
+		Expect(s.Assessment().Delete(context.TODO(), assessmentID)).To(BeNil())
+
+		count, err := s.AssessmentVM().Count(context.TODO(), store.NewAssessmentVMQueryFilter().BySnapshotID(snapshotID))
+		Expect(err).To(BeNil())
+		Expect(count).To(BeZero())
+	})
+})
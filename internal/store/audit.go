@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+// AuditLog is the append-only log of the access changes. Entries are appended in the transaction
+// of the change they record, so they are committed or rolled back with it.
+type AuditLog interface {
+	Append(ctx context.Context, entry model.AuditEntry) (*model.AuditEntry, error)
+	List(ctx context.Context, filter *AuditQueryFilter, options *AuditQueryOptions) (model.AuditEntryList, error)
+}
+
+type AuditLogStore struct {
+	db *gorm.DB
+}
+
+var _ AuditLog = (*AuditLogStore)(nil)
+
+func NewAuditLogStore(db *gorm.DB) AuditLog {
+	return &AuditLogStore{db: db}
+}
+
+func (s *AuditLogStore) Append(ctx context.Context, entry model.AuditEntry) (*model.AuditEntry, error) {
+	entry.ID = 0
+	if err := s.getDB(ctx).Clauses(clause.Returning{}).Create(&entry).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// List returns the entries, the most recent first.
+func (s *AuditLogStore) List(ctx context.Context, filter *AuditQueryFilter, options *AuditQueryOptions) (model.AuditEntryList, error) {
+	var entries model.AuditEntryList
+	tx := s.getDB(ctx).Model(&entries)
+
+	if filter != nil {
+		for _, fn := range filter.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	if options != nil {
+		for _, fn := range options.QueryFn {
+			tx = fn(tx)
+		}
+	}
+
+	if err := tx.Order("created_at DESC, id DESC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *AuditLogStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
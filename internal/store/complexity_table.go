@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ComplexityTable interface {
+	List(ctx context.Context, orgID string) (model.ComplexityTableList, error)
+	Get(ctx context.Context, orgID, version string) (*model.ComplexityTable, error)
+	GetActive(ctx context.Context, orgID string) (*model.ComplexityTable, error)
+	Create(ctx context.Context, table model.ComplexityTable) (*model.ComplexityTable, error)
+	Deactivate(ctx context.Context, orgID string) error
+}
+
+type ComplexityTableStore struct {
+	db *gorm.DB
+}
+
+var _ ComplexityTable = (*ComplexityTableStore)(nil)
+
+func NewComplexityTableStore(db *gorm.DB) ComplexityTable {
+	return &ComplexityTableStore{db: db}
+}
+
+// List returns the versions of the tables of an organization, the latest first.
+func (s *ComplexityTableStore) List(ctx context.Context, orgID string) (model.ComplexityTableList, error) {
+	var tables model.ComplexityTableList
+	if err := s.getDB(ctx).Where("org_id = ?", orgID).Order("revision DESC").Find(&tables).Error; err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func (s *ComplexityTableStore) Get(ctx context.Context, orgID, version string) (*model.ComplexityTable, error) {
+	var table model.ComplexityTable
+	if err := s.getDB(ctx).First(&table, "org_id = ? AND version = ?", orgID, version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+// GetActive returns the active version of the tables of an organization, or ErrRecordNotFound
+// when the organization scores with the built-in tables.
+func (s *ComplexityTableStore) GetActive(ctx context.Context, orgID string) (*model.ComplexityTable, error) {
+	var table model.ComplexityTable
+	if err := s.getDB(ctx).First(&table, "org_id = ? AND active", orgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+// Create stores the tables as the next revision of the organization and activates them. The
+// caller should run it in a transaction, so that concurrent versions are not interleaved.
+func (s *ComplexityTableStore) Create(ctx context.Context, table model.ComplexityTable) (*model.ComplexityTable, error) {
+	db := s.getDB(ctx)
+
+	var last int
+	if err := db.Model(&model.ComplexityTable{}).Where("org_id = ?", table.OrgID).
+		Select("COALESCE(MAX(revision), 0)").Scan(&last).Error; err != nil {
+		return nil, err
+	}
+	if err := s.Deactivate(ctx, table.OrgID); err != nil {
+		return nil, err
+	}
+
+	table.Revision = last + 1
+	table.Version = model.ComplexityTableVersion(table.Revision)
+	table.Tables.Data.Version = table.Version
+	table.Active = true
+	if err := db.Clauses(clause.Returning{}).Create(&table).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, err
+	}
+	return &table, nil
+}
+
+// Deactivate reverts an organization to the built-in tables. Its versions are kept, so that
+// the assessments scored with them can still be recomputed.
+func (s *ComplexityTableStore) Deactivate(ctx context.Context, orgID string) error {
+	return s.getDB(ctx).Model(&model.ComplexityTable{}).
+		Where("org_id = ? AND active", orgID).
+		Update("active", false).Error
+}
+
+func (s *ComplexityTableStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type ConcernWaiver interface {
+	List(ctx context.Context, assessmentID uuid.UUID) (model.ConcernWaiverList, error)
+	Get(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) (*model.ConcernWaiver, error)
+	Create(ctx context.Context, waiver model.ConcernWaiver) (*model.ConcernWaiver, error)
+	Delete(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) error
+}
+
+type ConcernWaiverStore struct {
+	db *gorm.DB
+}
+
+var _ ConcernWaiver = (*ConcernWaiverStore)(nil)
+
+func NewConcernWaiverStore(db *gorm.DB) ConcernWaiver {
+	return &ConcernWaiverStore{db: db}
+}
+
+// List returns the waivers of an assessment, expired ones included, the oldest first.
+func (s *ConcernWaiverStore) List(ctx context.Context, assessmentID uuid.UUID) (model.ConcernWaiverList, error) {
+	var waivers model.ConcernWaiverList
+	if err := s.getDB(ctx).Where("assessment_id = ?", assessmentID).Order("created_at, id").Find(&waivers).Error; err != nil {
+		return nil, err
+	}
+	return waivers, nil
+}
+
+func (s *ConcernWaiverStore) Get(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) (*model.ConcernWaiver, error) {
+	var waiver model.ConcernWaiver
+	if err := s.getDB(ctx).First(&waiver, "assessment_id = ? AND id = ?", assessmentID, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &waiver, nil
+}
+
+func (s *ConcernWaiverStore) Create(ctx context.Context, waiver model.ConcernWaiver) (*model.ConcernWaiver, error) {
+	if waiver.VMIDs == nil {
+		waiver.VMIDs = model.StringArray{}
+	}
+	if err := s.getDB(ctx).Clauses(clause.Returning{}).Create(&waiver).Error; err != nil {
+		return nil, err
+	}
+	return &waiver, nil
+}
+
+func (s *ConcernWaiverStore) Delete(ctx context.Context, assessmentID uuid.UUID, id uuid.UUID) error {
+	result := s.getDB(ctx).Where("assessment_id = ? AND id = ?", assessmentID, id).Delete(&model.ConcernWaiver{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *ConcernWaiverStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
@@ -22,7 +22,7 @@
 //	relations (
 //	    resource           — resource type (e.g. "assessment", "org")
 //	    resource_id        — resource instance ID
-//	    relation           — relationship name: owner, viewer, member
+//	    relation           — relationship name: owner, editor, viewer, member
 //	    subject_namespace  — subject type: user, org
 //	    subject_id         — subject instance ID
 //	)
@@ -34,6 +34,7 @@
 // ### Relation types
 //
 //   - owner  — full control over a resource (read, edit, share, delete)
+//   - editor — read and edit access to a resource; cannot share or delete it
 //   - viewer — read-only access to a resource
 //   - member — org membership (resource=org, subject_namespace=user)
 //
@@ -51,6 +52,7 @@
 // Permissions are derived from relations in Go code (not stored):
 //
 //   - owner  → read, edit, share, delete
+//   - editor → read, edit
 //   - viewer → read
 //
 // Both Relation.Permissions() and Permission.Relations() encode these rules
@@ -58,7 +60,7 @@
 //
 // ListResources and GetPermissions resolve access through two paths:
 //
-//  1. Direct: the user is an owner or viewer of the resource
+//  1. Direct: the user is an owner, editor or viewer of the resource
 //  2. Indirect: the resource is shared with an org the user is a member of
 //
 // Both paths are combined in a single SQL UNION query. The returned Resource
@@ -98,7 +100,7 @@
 //	err := s.Authz().WriteRelationships(ctx, updates)
 //	ctx, _ = store.Commit(ctx)
 //
-// ## Sharing with a partner org
+// ## Sharing with a group (partner org or accounts group)
 //
 //	updates := store.NewRelationshipBuilder().
 //	    With(model.NewAssessmentResource(assessmentID), model.ViewerRelation, model.NewOrgSubject(partnerOrgID)).
@@ -147,4 +149,23 @@
 //	rels, err := s.Authz().ListRelationships(ctx, model.NewAssessmentResource(assessmentID))
 //	ctx, _ = store.Commit(ctx)
 //	// rels[0].String() => "assessment:assess1#owner@user:jane"
+//
+// ## Auditing access changes
+//
+// Changes of access (sharing, ownership, group membership, partner requests)
+// are recorded in the append-only audit_log table, in the transaction of the
+// change so the entry is rolled back with it. A trigger rejects the updates
+// and deletes of the entries.
+//
+//	ctx, _ = s.NewTransactionContext(ctx)
+//	err := s.Authz().WriteRelationships(ctx, updates)
+//	_, err = s.AuditLog().Append(ctx, model.AuditEntry{
+//	    Actor:        "jane",
+//	    Action:       model.AuditAssessmentShared,
+//	    ResourceType: string(model.AssessmentResource),
+//	    ResourceID:   assessmentID,
+//	    Subject:      model.NewOrgSubject(partnerOrgID).String(),
+//	    After:        model.MakeJSONField(model.AuditState{"relation": model.ViewerRelation}),
+//	})
+//	ctx, _ = store.Commit(ctx)
 package store
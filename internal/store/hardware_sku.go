@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type HardwareSKU interface {
+	List(ctx context.Context) (model.HardwareSKUList, error)
+	Get(ctx context.Context, name string) (*model.HardwareSKU, error)
+	Create(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error)
+	Update(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error)
+	Delete(ctx context.Context, name string) error
+}
+
+type HardwareSKUStore struct {
+	db *gorm.DB
+}
+
+var _ HardwareSKU = (*HardwareSKUStore)(nil)
+
+func NewHardwareSKUStore(db *gorm.DB) HardwareSKU {
+	return &HardwareSKUStore{db: db}
+}
+
+func (s *HardwareSKUStore) List(ctx context.Context) (model.HardwareSKUList, error) {
+	var skus model.HardwareSKUList
+	if err := s.getDB(ctx).Order("name").Find(&skus).Error; err != nil {
+		return nil, err
+	}
+	return skus, nil
+}
+
+func (s *HardwareSKUStore) Get(ctx context.Context, name string) (*model.HardwareSKU, error) {
+	var sku model.HardwareSKU
+	if err := s.getDB(ctx).First(&sku, "name = ?", name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &sku, nil
+}
+
+func (s *HardwareSKUStore) Create(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	if err := s.getDB(ctx).Clauses(clause.Returning{}).Create(&sku).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, err
+	}
+	return &sku, nil
+}
+
+func (s *HardwareSKUStore) Update(ctx context.Context, sku model.HardwareSKU) (*model.HardwareSKU, error) {
+	now := time.Now()
+	sku.UpdatedAt = &now
+	result := s.getDB(ctx).Model(&sku).Clauses(clause.Returning{}).
+		Select("cores", "threads", "memory_gb", "price", "rack_units", "power_watts", "updated_at").
+		Updates(&sku)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrRecordNotFound
+	}
+	return &sku, nil
+}
+
+func (s *HardwareSKUStore) Delete(ctx context.Context, name string) error {
+	result := s.getDB(ctx).Delete(&model.HardwareSKU{}, "name = ?", name)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *HardwareSKUStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
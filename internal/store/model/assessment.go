@@ -26,6 +26,9 @@ type Assessment struct {
 	Snapshots      []Snapshot   `gorm:"foreignKey:AssessmentID;references:ID;constraint:OnDelete:CASCADE;"`
 	Permissions    []Permission `gorm:"-"`
 	Sharing        *Sharing     `gorm:"-"`
+
+	// ComplexityTableVersion is the version of the complexity tables the assessment was scored with.
+	ComplexityTableVersion string `gorm:"type:VARCHAR(100);not null;default:'builtin-1'"`
 }
 
 type Snapshot struct {
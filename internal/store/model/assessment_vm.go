@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// AssessmentVM is the per-VM record of an assessment snapshot. Only snapshots built
+// from a per-VM source (RVTools, or a source whose agent reported its VMs) have VM
+// records; the inventory JSON of the snapshot keeps the aggregates.
+type AssessmentVM struct {
+	SnapshotID        uint                   `gorm:"primaryKey;column:snapshot_id"`
+	VMID              string                 `gorm:"primaryKey;column:vm_id;type:TEXT"`
+	AssessmentID      uuid.UUID              `gorm:"column:assessment_id;type:VARCHAR(255);not null;index"`
+	Name              string                 `gorm:"column:name;type:TEXT;not null"`
+	ClusterID         string                 `gorm:"column:cluster_id;type:TEXT"`
+	ClusterName       string                 `gorm:"column:cluster_name;type:TEXT"`
+	VCenterID         string                 `gorm:"column:vcenter_id;type:TEXT"`
+	Datacenter        string                 `gorm:"column:datacenter;type:TEXT"`
+	Host              string                 `gorm:"column:host;type:TEXT"`
+	OS                string                 `gorm:"column:os;type:TEXT"`
+	PowerState        string                 `gorm:"column:power_state;type:TEXT"`
+	CpuCount          int                    `gorm:"column:cpu_count"`
+	MemoryMB          int                    `gorm:"column:memory_mb"`
+	DiskGB            int                    `gorm:"column:disk_gb"`
+	IsTemplate        bool                   `gorm:"column:is_template"`
+	MigrationExcluded bool                   `gorm:"column:migration_excluded"`
+	Concerns          JSONField[[]VMConcern] `gorm:"column:concerns;type:jsonb;not null"`
+
+	// CpuUsagePercent and MemoryUsagePercent are the CPU and memory (consumed or guest active)
+	// used by the VM when the source was collected, nil when the source doesn't report them.
+	CpuUsagePercent    *float64 `gorm:"column:cpu_usage_percent"`
+	MemoryUsagePercent *float64 `gorm:"column:memory_usage_percent"`
+}
+
+func (AssessmentVM) TableName() string {
+	return "assessment_vms"
+}
+
+type AssessmentVMList []AssessmentVM
+
+// VMConcern is a validation concern raised for a single VM.
+type VMConcern struct {
+	ID         string `json:"id"`
+	Label      string `json:"label"`
+	Category   string `json:"category"`
+	Assessment string `json:"assessment"`
+}
@@ -0,0 +1,53 @@
+package model
+
+import "time"
+
+// AuditAction is a change of access recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditPartnerRequestCreated   AuditAction = "partner_request.created"
+	AuditPartnerRequestCancelled AuditAction = "partner_request.cancelled"
+	AuditPartnerRequestAccepted  AuditAction = "partner_request.accepted"
+	AuditPartnerRequestRejected  AuditAction = "partner_request.rejected"
+	AuditPartnerLeft             AuditAction = "partner.left"
+	AuditPartnerCustomerRemoved  AuditAction = "partner.customer_removed"
+	AuditGroupMemberAdded        AuditAction = "group_member.added"
+	AuditGroupMemberUpdated      AuditAction = "group_member.updated"
+	AuditGroupMemberRemoved      AuditAction = "group_member.removed"
+	AuditAssessmentShared        AuditAction = "assessment.shared"
+	AuditAssessmentUnshared      AuditAction = "assessment.unshared"
+	AuditAssessmentTransferred   AuditAction = "assessment.ownership_transferred"
+)
+
+// Audited resource types, besides the authz ones (assessment, org).
+const (
+	AuditPartnerRequestResource = "partner_request"
+	AuditGroupResource          = "group"
+)
+
+// AuditState is the state of the audited resource before or after the change, e.g. the status
+// of a partner request or the relation granted on an assessment.
+type AuditState map[string]any
+
+// AuditEntry records who changed the access to a resource, and when. Entries are never updated
+// nor deleted.
+type AuditEntry struct {
+	ID           int64       `gorm:"primaryKey;autoIncrement"`
+	CreatedAt    time.Time   `gorm:"not null;default:now();type:TIMESTAMPTZ"`
+	RequestID    string      `gorm:"column:request_id;type:VARCHAR(255);not null"`
+	Actor        string      `gorm:"column:actor;type:VARCHAR(255);not null"`
+	Action       AuditAction `gorm:"column:action;type:VARCHAR(255);not null"`
+	ResourceType string      `gorm:"column:resource_type;type:VARCHAR(255);not null"`
+	ResourceID   string      `gorm:"column:resource_id;type:VARCHAR(255);not null"`
+	// Subject is the user or group gaining or losing the access, as type:id (e.g. org:<groupID>).
+	Subject string                 `gorm:"column:subject;type:VARCHAR(255);not null"`
+	Before  *JSONField[AuditState] `gorm:"column:before;type:jsonb"`
+	After   *JSONField[AuditState] `gorm:"column:after;type:jsonb"`
+}
+
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+type AuditEntryList []AuditEntry
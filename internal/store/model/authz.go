@@ -26,6 +26,7 @@ type Relation string
 
 const (
 	OwnerRelation  Relation = "owner"
+	EditorRelation Relation = "editor"
 	ViewerRelation Relation = "viewer"
 	MemberRelation Relation = "member"
 )
@@ -54,6 +55,8 @@ func (r Relation) Permissions() []Permission {
 	switch r {
 	case OwnerRelation:
 		return []Permission{ReadPermission, EditPermission, SharePermission, DeletePermission}
+	case EditorRelation:
+		return []Permission{ReadPermission, EditPermission}
 	case ViewerRelation:
 		return []Permission{ReadPermission}
 	default:
@@ -65,8 +68,10 @@ func (r Relation) Permissions() []Permission {
 func (p Permission) Relations() []string {
 	switch p {
 	case ReadPermission:
-		return []string{string(OwnerRelation), string(ViewerRelation)}
-	case EditPermission, SharePermission, DeletePermission:
+		return []string{string(OwnerRelation), string(EditorRelation), string(ViewerRelation)}
+	case EditPermission:
+		return []string{string(OwnerRelation), string(EditorRelation)}
+	case SharePermission, DeletePermission:
 		return []string{string(OwnerRelation)}
 	default:
 		return nil
@@ -122,11 +127,18 @@ func NewOrgResource(id string) Resource        { return Resource{Type: OrgResour
 func NewUserSubject(id string) Subject { return Subject{Kind: UserSubject, ID: id} }
 func NewOrgSubject(id string) Subject  { return Subject{Kind: OrgSubject, ID: id} }
 
+// String returns the subject as type:id.
+func (s Subject) String() string {
+	return fmt.Sprintf("%s:%s", s.Kind, s.ID)
+}
+
 // SharingSubject identifies who an assessment is shared with or by.
+// Relation is the relation granted to the subject, it is empty for the sharer.
 type SharingSubject struct {
-	Type string
-	ID   string
-	Name string
+	Type     string
+	ID       string
+	Name     string
+	Relation Relation
 }
 
 // Sharing captures the sharing state of an assessment for a given viewer.
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+)
+
+// ComplexityTable is a version of the complexity scoring tables of an organization. The
+// built-in tables are not stored. At most one version per organization is active; without an
+// active version the organization scores with the built-in tables.
+type ComplexityTable struct {
+	OrgID     string                      `gorm:"primaryKey;column:org_id;type:VARCHAR(255);"`
+	Revision  int                         `gorm:"primaryKey;column:revision"`
+	Version   string                      `gorm:"column:version;type:VARCHAR(100);not null"`
+	Active    bool                        `gorm:"column:active;not null"`
+	Tables    JSONField[complexity.Table] `gorm:"column:tables;type:jsonb;not null"`
+	CreatedBy string                      `gorm:"column:created_by;type:VARCHAR(255);not null"`
+	CreatedAt time.Time                   `gorm:"not null;default:now();type:TIMESTAMPTZ"`
+}
+
+func (ComplexityTable) TableName() string {
+	return "complexity_tables"
+}
+
+type ComplexityTableList []ComplexityTable
+
+// ComplexityTableVersion returns the version of the revision of the tables of an organization.
+func ComplexityTableVersion(revision int) string {
+	return fmt.Sprintf("org-%d", revision)
+}
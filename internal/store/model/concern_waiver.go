@@ -0,0 +1,63 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConcernWaiver accepts the risk of a migration concern for the VMs of an assessment. A waived
+// concern is reported apart from the migration issues and no longer counts against the VMs.
+type ConcernWaiver struct {
+	ID           uuid.UUID `gorm:"primaryKey;column:id;type:VARCHAR(255);"`
+	AssessmentID uuid.UUID `gorm:"column:assessment_id;type:VARCHAR(255);not null;index"`
+	ConcernID    string    `gorm:"column:concern_id;type:TEXT;not null"`
+	// VMIDs restricts the waiver to some VMs; an empty list waives the concern for all the VMs.
+	VMIDs         StringArray `gorm:"column:vm_ids;type:TEXT[];not null"`
+	Justification string      `gorm:"column:justification;type:TEXT;not null"`
+	CreatedBy     string      `gorm:"column:created_by;type:VARCHAR(255);not null"`
+	CreatedAt     time.Time   `gorm:"not null;default:now();type:TIMESTAMPTZ"`
+	ExpiresAt     *time.Time  `gorm:"column:expires_at;type:TIMESTAMPTZ"`
+}
+
+func (ConcernWaiver) TableName() string {
+	return "concern_waivers"
+}
+
+type ConcernWaiverList []ConcernWaiver
+
+// AllVMs reports whether the waiver applies to all the VMs of the assessment.
+func (w ConcernWaiver) AllVMs() bool {
+	return len(w.VMIDs) == 0
+}
+
+// Expired reports whether the waiver no longer applies at t.
+func (w ConcernWaiver) Expired(t time.Time) bool {
+	return w.ExpiresAt != nil && !t.Before(*w.ExpiresAt)
+}
+
+// Waives reports whether the waiver applies to the concern of the VM.
+func (w ConcernWaiver) Waives(concernID, vmID string) bool {
+	return w.ConcernID == concernID && (w.AllVMs() || slices.Contains(w.VMIDs, vmID))
+}
+
+// Active returns the waivers not expired at t.
+func (l ConcernWaiverList) Active(t time.Time) ConcernWaiverList {
+	return slices.DeleteFunc(slices.Clone(l), func(w ConcernWaiver) bool { return w.Expired(t) })
+}
+
+// Waives reports whether one of the waivers applies to the concern of the VM.
+func (l ConcernWaiverList) Waives(concernID, vmID string) bool {
+	return slices.ContainsFunc(l, func(w ConcernWaiver) bool { return w.Waives(concernID, vmID) })
+}
+
+// ConcernIDs returns the IDs of the waived concerns, sorted and without duplicates.
+func (l ConcernWaiverList) ConcernIDs() []string {
+	ids := make([]string, 0, len(l))
+	for _, w := range l {
+		ids = append(ids, w.ConcernID)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// HardwareSKU is a server model of the hardware catalog managed through the API. The SKUs of the
+// catalog file are not stored.
+type HardwareSKU struct {
+	Name       string     `gorm:"primaryKey;column:name;type:VARCHAR(100);"`
+	Cores      int        `gorm:"not null"`
+	Threads    *int       `gorm:"column:threads"`
+	MemoryGB   int        `gorm:"column:memory_gb;not null"`
+	Price      float64    `gorm:"not null"`
+	RackUnits  int        `gorm:"not null"`
+	PowerWatts int        `gorm:"not null"`
+	CreatedAt  time.Time  `gorm:"not null;default:now();type:TIMESTAMPTZ"`
+	UpdatedAt  *time.Time `gorm:"type:TIMESTAMPTZ"`
+}
+
+type HardwareSKUList []HardwareSKU
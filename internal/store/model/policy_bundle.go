@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+)
+
+// PolicyBundle is a revision of the OPA policies of an organization, evaluated with the global
+// policies when its VMs are ingested. At most one revision per organization is active; without an
+// active revision only the global policies are evaluated.
+type PolicyBundle struct {
+	OrgID       string `gorm:"primaryKey;column:org_id;type:VARCHAR(255);"`
+	Revision    int    `gorm:"primaryKey;column:revision"`
+	Name        string `gorm:"column:name;type:VARCHAR(100);not null"`
+	Description string `gorm:"column:description;type:TEXT;not null"`
+	// Modules maps the file names of the policies to their Rego source.
+	Modules   JSONField[map[string]string] `gorm:"column:modules;type:jsonb;not null"`
+	Active    bool                         `gorm:"column:active;not null"`
+	CreatedBy string                       `gorm:"column:created_by;type:VARCHAR(255);not null"`
+	CreatedAt time.Time                    `gorm:"not null;default:now();type:TIMESTAMPTZ"`
+}
+
+func (PolicyBundle) TableName() string {
+	return "policy_bundles"
+}
+
+type PolicyBundleList []PolicyBundle
+
+// Policies returns the modules of the bundle keyed by "org/<file name>", so that they cannot be
+// mistaken for the global policies.
+func (b PolicyBundle) Policies() map[string]string {
+	policies := make(map[string]string, len(b.Modules.Data))
+	for name, content := range b.Modules.Data {
+		policies["org/"+name] = content
+	}
+	return policies
+}
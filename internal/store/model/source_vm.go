@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/google/uuid"
+)
+
+// SourceVM is the per-VM record of the inventory of a source, as last reported by its agent.
+// Assessments created from the source copy the records into their snapshots.
+type SourceVM struct {
+	SourceID          uuid.UUID              `gorm:"primaryKey;column:source_id;type:TEXT"`
+	VMID              string                 `gorm:"primaryKey;column:vm_id;type:TEXT"`
+	Name              string                 `gorm:"column:name;type:TEXT;not null"`
+	ClusterID         string                 `gorm:"column:cluster_id;type:TEXT"`
+	ClusterName       string                 `gorm:"column:cluster_name;type:TEXT"`
+	VCenterID         string                 `gorm:"column:vcenter_id;type:TEXT"`
+	Datacenter        string                 `gorm:"column:datacenter;type:TEXT"`
+	Host              string                 `gorm:"column:host;type:TEXT"`
+	OS                string                 `gorm:"column:os;type:TEXT"`
+	PowerState        string                 `gorm:"column:power_state;type:TEXT"`
+	CpuCount          int                    `gorm:"column:cpu_count"`
+	MemoryMB          int                    `gorm:"column:memory_mb"`
+	DiskGB            int                    `gorm:"column:disk_gb"`
+	IsTemplate        bool                   `gorm:"column:is_template"`
+	MigrationExcluded bool                   `gorm:"column:migration_excluded"`
+	Concerns          JSONField[[]VMConcern] `gorm:"column:concerns;type:jsonb;not null"`
+
+	CpuUsagePercent    *float64 `gorm:"column:cpu_usage_percent"`
+	MemoryUsagePercent *float64 `gorm:"column:memory_usage_percent"`
+}
+
+func (SourceVM) TableName() string {
+	return "source_vms"
+}
+
+// ToAssessmentVM returns the record of the VM in the given assessment snapshot.
+func (vm SourceVM) ToAssessmentVM(assessmentID uuid.UUID, snapshotID uint) AssessmentVM {
+	return AssessmentVM{
+		SnapshotID:         snapshotID,
+		VMID:               vm.VMID,
+		AssessmentID:       assessmentID,
+		Name:               vm.Name,
+		ClusterID:          vm.ClusterID,
+		ClusterName:        vm.ClusterName,
+		VCenterID:          vm.VCenterID,
+		Datacenter:         vm.Datacenter,
+		Host:               vm.Host,
+		OS:                 vm.OS,
+		PowerState:         vm.PowerState,
+		CpuCount:           vm.CpuCount,
+		MemoryMB:           vm.MemoryMB,
+		DiskGB:             vm.DiskGB,
+		IsTemplate:         vm.IsTemplate,
+		MigrationExcluded:  vm.MigrationExcluded,
+		Concerns:           vm.Concerns,
+		CpuUsagePercent:    vm.CpuUsagePercent,
+		MemoryUsagePercent: vm.MemoryUsagePercent,
+	}
+}
+
+type SourceVMList []SourceVM
@@ -1,6 +1,9 @@
 package store
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/kubev2v/migration-planner/internal/store/model"
 	"gorm.io/gorm"
@@ -138,6 +141,133 @@ func (f *AssessmentSubsetInventoryQueryFilter) OrderByCreatedAt(asc bool) *Asses
 	return f
 }
 
+type AssessmentVMQueryFilter BaseQuerier
+
+func NewAssessmentVMQueryFilter() *AssessmentVMQueryFilter {
+	return &AssessmentVMQueryFilter{QueryFn: make([]func(tx *gorm.DB) *gorm.DB, 0)}
+}
+
+func (f *AssessmentVMQueryFilter) BySnapshotID(snapshotID uint) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("snapshot_id = ?", snapshotID)
+	})
+	return f
+}
+
+func (f *AssessmentVMQueryFilter) BySnapshotIDs(snapshotIDs ...uint) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("snapshot_id IN ?", snapshotIDs)
+	})
+	return f
+}
+
+// ByCluster matches either the cluster ID used as key of the inventory clusters or the cluster name.
+func (f *AssessmentVMQueryFilter) ByCluster(cluster string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("cluster_id = ? OR cluster_name = ?", cluster, cluster)
+	})
+	return f
+}
+
+func (f *AssessmentVMQueryFilter) ByOS(os string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("os = ?", os)
+	})
+	return f
+}
+
+func (f *AssessmentVMQueryFilter) ByPowerState(powerState string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("power_state = ?", powerState)
+	})
+	return f
+}
+
+func (f *AssessmentVMQueryFilter) ByConcernID(concernID string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("concerns @> ?::jsonb", concernContainment("id", concernID))
+	})
+	return f
+}
+
+func (f *AssessmentVMQueryFilter) ByConcernCategory(category string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("concerns @> ?::jsonb", concernContainment("category", category))
+	})
+	return f
+}
+
+// ByAnyConcernID matches the VMs having a concern with one of the IDs.
+func (f *AssessmentVMQueryFilter) ByAnyConcernID(concernIDs []string) *AssessmentVMQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		conditions := tx.Session(&gorm.Session{NewDB: true})
+		for _, id := range concernIDs {
+			conditions = conditions.Or("concerns @> ?::jsonb", concernContainment("id", id))
+		}
+		return tx.Where(conditions)
+	})
+	return f
+}
+
+// concernContainment builds the jsonb document matching VMs having a concern with key set to value.
+func concernContainment(key, value string) string {
+	doc, _ := json.Marshal([]map[string]string{{key: value}})
+	return string(doc)
+}
+
+type AssessmentVMQueryOptions BaseQuerier
+
+func NewAssessmentVMQueryOptions() *AssessmentVMQueryOptions {
+	return &AssessmentVMQueryOptions{QueryFn: make([]func(tx *gorm.DB) *gorm.DB, 0)}
+}
+
+// assessmentVMSortColumns maps the sortable fields to their column expression.
+var assessmentVMSortColumns = map[string]string{
+	"name":         "name",
+	"cluster":      "cluster_name",
+	"os":           "os",
+	"powerState":   "power_state",
+	"cpuCount":     "cpu_count",
+	"memoryMB":     "memory_mb",
+	"diskGB":       "disk_gb",
+	"concernCount": "jsonb_array_length(concerns)",
+}
+
+// IsValidAssessmentVMSortField reports whether field can be passed to WithSort.
+func IsValidAssessmentVMSortField(field string) bool {
+	_, ok := assessmentVMSortColumns[field]
+	return ok
+}
+
+// WithSort orders the results by field. Unknown fields are ignored.
+func (o *AssessmentVMQueryOptions) WithSort(field string, desc bool) *AssessmentVMQueryOptions {
+	column, ok := assessmentVMSortColumns[field]
+	if !ok {
+		return o
+	}
+	o.QueryFn = append(o.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		if desc {
+			return tx.Order(column + " DESC")
+		}
+		return tx.Order(column + " ASC")
+	})
+	return o
+}
+
+func (o *AssessmentVMQueryOptions) WithLimit(limit int) *AssessmentVMQueryOptions {
+	o.QueryFn = append(o.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit)
+	})
+	return o
+}
+
+func (o *AssessmentVMQueryOptions) WithOffset(offset int) *AssessmentVMQueryOptions {
+	o.QueryFn = append(o.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Offset(offset)
+	})
+	return o
+}
+
 type PartnerQueryFilter BaseQuerier
 
 func NewPartnerQueryFilter() *PartnerQueryFilter {
@@ -316,3 +446,89 @@ func (f *MemberQueryFilter) ByGroupID(id uuid.UUID) *MemberQueryFilter {
 	})
 	return f
 }
+
+// Audit log filters
+
+type AuditQueryFilter BaseQuerier
+
+func NewAuditQueryFilter() *AuditQueryFilter {
+	return &AuditQueryFilter{QueryFn: make([]func(tx *gorm.DB) *gorm.DB, 0)}
+}
+
+func (f *AuditQueryFilter) ByActor(actor string) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("actor = ?", actor)
+	})
+	return f
+}
+
+func (f *AuditQueryFilter) ByAction(action model.AuditAction) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("action = ?", action)
+	})
+	return f
+}
+
+func (f *AuditQueryFilter) ByResourceType(resourceType string) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("resource_type = ?", resourceType)
+	})
+	return f
+}
+
+func (f *AuditQueryFilter) ByResourceID(resourceID string) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("resource_id = ?", resourceID)
+	})
+	return f
+}
+
+func (f *AuditQueryFilter) BySubject(subject string) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("subject = ?", subject)
+	})
+	return f
+}
+
+func (f *AuditQueryFilter) ByRequestID(requestID string) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("request_id = ?", requestID)
+	})
+	return f
+}
+
+// Since keeps the entries recorded at or after t.
+func (f *AuditQueryFilter) Since(t time.Time) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("created_at >= ?", t)
+	})
+	return f
+}
+
+// Until keeps the entries recorded before t.
+func (f *AuditQueryFilter) Until(t time.Time) *AuditQueryFilter {
+	f.QueryFn = append(f.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("created_at < ?", t)
+	})
+	return f
+}
+
+type AuditQueryOptions BaseQuerier
+
+func NewAuditQueryOptions() *AuditQueryOptions {
+	return &AuditQueryOptions{QueryFn: make([]func(tx *gorm.DB) *gorm.DB, 0)}
+}
+
+func (o *AuditQueryOptions) WithLimit(limit int) *AuditQueryOptions {
+	o.QueryFn = append(o.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Limit(limit)
+	})
+	return o
+}
+
+func (o *AuditQueryOptions) WithOffset(offset int) *AuditQueryOptions {
+	o.QueryFn = append(o.QueryFn, func(tx *gorm.DB) *gorm.DB {
+		return tx.Offset(offset)
+	})
+	return o
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/kubev2v/migration-planner/internal/store/model"
+)
+
+type PolicyBundle interface {
+	List(ctx context.Context, orgID string) (model.PolicyBundleList, error)
+	Get(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error)
+	GetActive(ctx context.Context, orgID string) (*model.PolicyBundle, error)
+	Create(ctx context.Context, bundle model.PolicyBundle) (*model.PolicyBundle, error)
+	Activate(ctx context.Context, orgID string, revision int) error
+	Deactivate(ctx context.Context, orgID string) error
+}
+
+type PolicyBundleStore struct {
+	db *gorm.DB
+}
+
+var _ PolicyBundle = (*PolicyBundleStore)(nil)
+
+func NewPolicyBundleStore(db *gorm.DB) PolicyBundle {
+	return &PolicyBundleStore{db: db}
+}
+
+// List returns the revisions of the policies of an organization, the latest first.
+func (s *PolicyBundleStore) List(ctx context.Context, orgID string) (model.PolicyBundleList, error) {
+	var bundles model.PolicyBundleList
+	if err := s.getDB(ctx).Where("org_id = ?", orgID).Order("revision DESC").Find(&bundles).Error; err != nil {
+		return nil, err
+	}
+	return bundles, nil
+}
+
+func (s *PolicyBundleStore) Get(ctx context.Context, orgID string, revision int) (*model.PolicyBundle, error) {
+	var bundle model.PolicyBundle
+	if err := s.getDB(ctx).First(&bundle, "org_id = ? AND revision = ?", orgID, revision).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// GetActive returns the active revision of the policies of an organization, or ErrRecordNotFound
+// when only the global policies are evaluated.
+func (s *PolicyBundleStore) GetActive(ctx context.Context, orgID string) (*model.PolicyBundle, error) {
+	var bundle model.PolicyBundle
+	if err := s.getDB(ctx).First(&bundle, "org_id = ? AND active", orgID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// Create stores the policies as the next, inactive revision of the organization. The caller
+// should run it in a transaction, so that concurrent revisions are not interleaved.
+func (s *PolicyBundleStore) Create(ctx context.Context, bundle model.PolicyBundle) (*model.PolicyBundle, error) {
+	db := s.getDB(ctx)
+
+	var last int
+	if err := db.Model(&model.PolicyBundle{}).Where("org_id = ?", bundle.OrgID).
+		Select("COALESCE(MAX(revision), 0)").Scan(&last).Error; err != nil {
+		return nil, err
+	}
+
+	bundle.Revision = last + 1
+	bundle.Active = false
+	if err := db.Clauses(clause.Returning{}).Create(&bundle).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, ErrDuplicateKey
+		}
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// Activate makes a revision the active one of the organization. The caller should run it in a
+// transaction with Deactivate.
+func (s *PolicyBundleStore) Activate(ctx context.Context, orgID string, revision int) error {
+	result := s.getDB(ctx).Model(&model.PolicyBundle{}).
+		Where("org_id = ? AND revision = ?", orgID, revision).
+		Update("active", true)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+// Deactivate reverts an organization to the global policies. Its revisions are kept.
+func (s *PolicyBundleStore) Deactivate(ctx context.Context, orgID string) error {
+	return s.getDB(ctx).Model(&model.PolicyBundle{}).
+		Where("org_id = ? AND active", orgID).
+		Update("active", false).Error
+}
+
+func (s *PolicyBundleStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
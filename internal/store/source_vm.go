@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kubev2v/migration-planner/internal/store/model"
+	"gorm.io/gorm"
+)
+
+type SourceVM interface {
+	// Replace replaces the VM records of the source with vms.
+	Replace(ctx context.Context, sourceID uuid.UUID, vms []model.SourceVM) error
+	List(ctx context.Context, sourceID uuid.UUID) (model.SourceVMList, error)
+}
+
+type SourceVMStore struct {
+	db *gorm.DB
+}
+
+// Make sure we conform to SourceVM interface
+var _ SourceVM = (*SourceVMStore)(nil)
+
+func NewSourceVMStore(db *gorm.DB) SourceVM {
+	return &SourceVMStore{db: db}
+}
+
+func (s *SourceVMStore) Replace(ctx context.Context, sourceID uuid.UUID, vms []model.SourceVM) error {
+	if result := s.getDB(ctx).Where("source_id = ?", sourceID).Delete(&model.SourceVM{}); result.Error != nil {
+		return fmt.Errorf("delete source vms: %w", result.Error)
+	}
+
+	if len(vms) == 0 {
+		return nil
+	}
+
+	for i := range vms {
+		vms[i].SourceID = sourceID
+	}
+	if result := s.getDB(ctx).CreateInBatches(&vms, assessmentVMBatchSize); result.Error != nil {
+		return fmt.Errorf("create source vms: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (s *SourceVMStore) List(ctx context.Context, sourceID uuid.UUID) (model.SourceVMList, error) {
+	var vms model.SourceVMList
+	if result := s.getDB(ctx).Where("source_id = ?", sourceID).Order("vm_id").Find(&vms); result.Error != nil {
+		return nil, fmt.Errorf("list source vms: %w", result.Error)
+	}
+	return vms, nil
+}
+
+func (s *SourceVMStore) getDB(ctx context.Context) *gorm.DB {
+	tx := FromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+	return s.db
+}
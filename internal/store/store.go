@@ -13,17 +13,24 @@ type Store interface {
 	Authz() Authz
 	Source() Source
 	SourceSubsetInventory() SourceSubsetInventory
+	SourceVM() SourceVM
 	ImageInfra() ImageInfra
 	PrivateKey() PrivateKey
 	Label() Label
 	Assessment() Assessment
 	AssessmentSubsetInventory() AssessmentSubsetInventory
+	AssessmentVM() AssessmentVM
 	ClusterSizingInput() ClusterSizingInput
+	HardwareSKU() HardwareSKU
+	ComplexityTable() ComplexityTable
+	PolicyBundle() PolicyBundle
+	ConcernWaiver() ConcernWaiver
 	AssessmentEnhancementData() AssessmentEnhancementData
 	Job() Job
 	Accounts() Accounts
 	PartnerCustomer() PartnerCustomer
 	Outbox() Outbox
+	AuditLog() AuditLog
 	Statistics(ctx context.Context) (model.InventoryStats, error)
 	Close() error
 	RequestMetricsCacheRefresh()
@@ -35,17 +42,24 @@ type DataStore struct {
 	db                        *gorm.DB
 	source                    Source
 	sourceInventory           SourceSubsetInventory
+	sourceVM                  SourceVM
 	imageInfra                ImageInfra
 	privateKey                PrivateKey
 	label                     Label
 	assessment                Assessment
 	assessmentSubsetInventory AssessmentSubsetInventory
+	assessmentVM              AssessmentVM
 	cluster                   ClusterSizingInput
+	hardwareSKU               HardwareSKU
+	complexityTable           ComplexityTable
+	policyBundle              PolicyBundle
+	concernWaiver             ConcernWaiver
 	assessmentEnhancementData AssessmentEnhancementData
 	job                       Job
 	accounts                  Accounts
 	partnerCustomer           PartnerCustomer
 	outbox                    Outbox
+	auditLog                  AuditLog
 	metricCache               *MetricsCache
 }
 
@@ -56,18 +70,25 @@ func NewStore(db *gorm.DB) Store {
 		agent:                     NewAgentSource(db),
 		source:                    NewSource(db),
 		sourceInventory:           NewSourceSubsetInventory(db),
+		sourceVM:                  NewSourceVMStore(db),
 		imageInfra:                NewImageInfraStore(db),
 		privateKey:                NewCacheKeyStore(NewPrivateKey(db)),
 		label:                     NewLabelStore(db),
 		assessment:                assessment,
 		assessmentSubsetInventory: NewAssessmentSubsetInventory(db),
+		assessmentVM:              NewAssessmentVMStore(db),
 		cluster:                   NewClusterSizingInputStore(db),
+		hardwareSKU:               NewHardwareSKUStore(db),
+		complexityTable:           NewComplexityTableStore(db),
+		policyBundle:              NewPolicyBundleStore(db),
+		concernWaiver:             NewConcernWaiverStore(db),
 		assessmentEnhancementData: NewAssessmentEnhancementDataStore(db),
 		job:                       NewJobStore(db),
 		authz:                     NewAuthzStore(db),
 		accounts:                  NewAccountsStore(db),
 		partnerCustomer:           NewPartnerCustomerStore(db),
 		outbox:                    NewOutboxStore(db),
+		auditLog:                  NewAuditLogStore(db),
 		metricCache:               NewMetricsCache(assessment),
 		db:                        db,
 	}
@@ -89,6 +110,10 @@ func (s *DataStore) SourceSubsetInventory() SourceSubsetInventory {
 	return s.sourceInventory
 }
 
+func (s *DataStore) SourceVM() SourceVM {
+	return s.sourceVM
+}
+
 func (s *DataStore) Agent() Agent {
 	return s.agent
 }
@@ -113,10 +138,30 @@ func (s *DataStore) AssessmentSubsetInventory() AssessmentSubsetInventory {
 	return s.assessmentSubsetInventory
 }
 
+func (s *DataStore) AssessmentVM() AssessmentVM {
+	return s.assessmentVM
+}
+
 func (s *DataStore) ClusterSizingInput() ClusterSizingInput {
 	return s.cluster
 }
 
+func (s *DataStore) HardwareSKU() HardwareSKU {
+	return s.hardwareSKU
+}
+
+func (s *DataStore) ComplexityTable() ComplexityTable {
+	return s.complexityTable
+}
+
+func (s *DataStore) PolicyBundle() PolicyBundle {
+	return s.policyBundle
+}
+
+func (s *DataStore) ConcernWaiver() ConcernWaiver {
+	return s.concernWaiver
+}
+
 func (s *DataStore) AssessmentEnhancementData() AssessmentEnhancementData {
 	return s.assessmentEnhancementData
 }
@@ -137,6 +182,10 @@ func (s *DataStore) Outbox() Outbox {
 	return s.outbox
 }
 
+func (s *DataStore) AuditLog() AuditLog {
+	return s.auditLog
+}
+
 func (s *DataStore) Statistics(ctx context.Context) (model.InventoryStats, error) {
 	return s.metricCache.GetStats(ctx)
 }
@@ -33,11 +33,20 @@ func mustGetTemplate(name string) string {
 }
 
 // QueryBuilder builds SQL queries from templates.
-type QueryBuilder struct{}
+type QueryBuilder struct {
+	// complexityTable scores the per-VM migration complexity of PopulateComplexityQuery.
+	complexityTable complexity.Table
+}
 
-// NewBuilder creates a new Builder.
+// NewBuilder creates a new Builder scoring complexity with the built-in tables.
 func NewBuilder() *QueryBuilder {
-	return &QueryBuilder{}
+	return &QueryBuilder{complexityTable: complexity.DefaultTable()}
+}
+
+// WithComplexityTable replaces the tables scoring the per-VM migration complexity.
+func (b *QueryBuilder) WithComplexityTable(table complexity.Table) *QueryBuilder {
+	b.complexityTable = table
+	return b
 }
 
 type ingestParams struct {
@@ -49,9 +58,48 @@ func (b *QueryBuilder) CreateSchemaQuery() (string, error) {
 	return b.buildQuery("create_schema", mustGetTemplate("create_schema"), nil)
 }
 
-// IngestRvtoolsQuery returns a query that inserts data from an RVTools Excel file into schema tables.
-func (b *QueryBuilder) IngestRvtoolsQuery(filePath string) (string, error) {
-	return b.buildQuery("ingest_rvtools", mustGetTemplate("ingest_rvtools"), ingestParams{FilePath: filePath})
+// rvtoolsTabs lists the RVTools tabs read by the ingest_rvtools template.
+var rvtoolsTabs = []string{
+	"vInfo", "vCPU", "vMemory", "vDisk", "vDatastore", "vHost", "vHBA", "vNetwork", "dvPort", "dvSwitch", "vCluster",
+}
+
+// RvtoolsSource locates the tabs of an RVTools export. Exactly one of ExcelFile
+// and CSVTabs is set.
+type RvtoolsSource struct {
+	ExcelFile string            // path of the .xlsx workbook
+	CSVTabs   map[string]string // tab name (e.g. "vInfo") → path of the tab's CSV file
+}
+
+type rvtoolsIngestParams struct {
+	Excel   bool
+	Readers map[string]string
+}
+
+// IngestRvtoolsQuery returns a query that inserts data from an RVTools export into schema tables.
+// Tabs missing from a CSV export are read through a failing expression, so their statements
+// fail like a missing Excel sheet does.
+func (b *QueryBuilder) IngestRvtoolsQuery(source RvtoolsSource) (string, error) {
+	params := rvtoolsIngestParams{
+		Excel:   source.ExcelFile != "",
+		Readers: make(map[string]string, len(rvtoolsTabs)),
+	}
+	for _, tab := range rvtoolsTabs {
+		if params.Excel {
+			header := ""
+			if tab == "vInfo" {
+				header = ", header=true"
+			}
+			params.Readers[tab] = fmt.Sprintf("read_xlsx('%s', sheet='%s'%s, all_varchar=true)", escapeSQLString(source.ExcelFile), tab, header)
+			continue
+		}
+		path, ok := source.CSVTabs[tab]
+		if !ok {
+			params.Readers[tab] = fmt.Sprintf("(SELECT error('%s tab not found in RVTools CSV export'))", tab)
+			continue
+		}
+		params.Readers[tab] = fmt.Sprintf("read_csv('%s', header=true, all_varchar=true)", escapeSQLString(path))
+	}
+	return b.buildQuery("ingest_rvtools", mustGetTemplate("ingest_rvtools"), params)
 }
 
 // IngestSqliteQuery returns a query that creates RVTools-shaped tables from a forklift SQLite database.
@@ -59,6 +107,45 @@ func (b *QueryBuilder) IngestSqliteQuery(filePath string) (string, error) {
 	return b.buildQuery("ingest_sqlite", mustGetTemplate("ingest_sqlite"), ingestParams{FilePath: filePath})
 }
 
+// IngestGovcJSONQuery returns a query that inserts data from a govc JSON export into schema tables.
+func (b *QueryBuilder) IngestGovcJSONQuery(filePath string) (string, error) {
+	return b.buildQuery("ingest_govc_json", mustGetTemplate("ingest_govc_json"), ingestParams{FilePath: filePath})
+}
+
+// inventoryTables lists the tables filled by ingestion, parents before the tables referencing them.
+var inventoryTables = []string{
+	"vinfo", "vcpu", "vmemory", "vdisk", "vnetwork", "concerns",
+	"vhost", "vdatastore", "vhba", "dvport", "dvswitch", "vcluster", "about",
+}
+
+type stageParams struct {
+	Tables         []string
+	TablesReversed []string
+	Suffix         string
+	VCenterID      string
+}
+
+// StageSourceQuery returns a query that moves the ingested data into the staged_* tables.
+// suffix is appended to the identifiers clashing with data staged before.
+func (b *QueryBuilder) StageSourceQuery(suffix, vcenterID string) (string, error) {
+	reversed := make([]string, len(inventoryTables))
+	for i, table := range inventoryTables {
+		reversed[len(inventoryTables)-1-i] = table
+	}
+	params := stageParams{
+		Tables:         inventoryTables,
+		TablesReversed: reversed,
+		Suffix:         escapeSQLString(suffix),
+		VCenterID:      escapeSQLString(vcenterID),
+	}
+	return b.buildQuery("stage_source", mustGetTemplate("stage_source"), params)
+}
+
+// MergeStagedSourcesQuery returns a query that moves the staged data back into the inventory tables.
+func (b *QueryBuilder) MergeStagedSourcesQuery() (string, error) {
+	return b.buildQuery("merge_staged_sources", mustGetTemplate("merge_staged_sources"), stageParams{Tables: inventoryTables})
+}
+
 // queryParams holds all template parameters for queries.
 type queryParams struct {
 	NetworkColumns          string
@@ -377,27 +464,48 @@ func (b *QueryBuilder) VMsWithSharedDisksCountQuery(filters Filters) (string, er
 	return b.buildQuery("vms_with_shared_disks_count_query", mustGetTemplate("vms_with_shared_disks_count_query"), params)
 }
 
-// generateOSCaseClauses reads complexity.OSDifficultyScores and generates SQL WHEN clauses.
-func generateOSCaseClauses() string {
+// VMApplicationsQuery builds the query listing the guest applications and labels of the VMs.
+func (b *QueryBuilder) VMApplicationsQuery(filters Filters) (string, error) {
+	params := queryParams{
+		ClusterFilter: escapeSQLString(filters.Cluster),
+		VMListFilter:  buildVMListFilter(filters.VMList, "i"),
+	}
+	return b.buildQuery("vm_applications_query", mustGetTemplate("vm_applications_query"), params)
+}
+
+// generateOSCaseClauses reads the OS scores of the table and generates SQL WHEN clauses.
+// The longest OS names come first, so that the CASE expression matches like Table.ClassifyOS.
+func generateOSCaseClauses(table complexity.Table) string {
 	scoreToLevel := map[int]string{
 		1: "easy",
 		2: "medium",
 		3: "hard",
 		4: "database",
 	}
-	var clauses []string
-	for keyword, score := range complexity.OSDifficultyScores {
-		level := scoreToLevel[score]
+	keywords := make([]string, 0, len(table.OSDifficultyScores))
+	for keyword := range table.OSDifficultyScores {
+		keywords = append(keywords, keyword)
+	}
+	// deterministic output
+	sort.Slice(keywords, func(i, j int) bool {
+		if len(keywords[i]) != len(keywords[j]) {
+			return len(keywords[i]) > len(keywords[j])
+		}
+		return keywords[i] < keywords[j]
+	})
+	clauses := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		level := scoreToLevel[table.OSDifficultyScores[keyword]]
 		clauses = append(clauses, fmt.Sprintf(
-			"            WHEN LOWER(effective_os) LIKE '%%%s%%' THEN '%s'", strings.ToLower(keyword), level))
+			"            WHEN LOWER(effective_os) LIKE '%%%s%%' THEN '%s'", escapeSQLString(strings.ToLower(keyword)), level))
 	}
-	sort.Strings(clauses) // deterministic output
 	return strings.Join(clauses, "\n")
 }
 
 // generateComplexityMatrixCaseClauses reads complexity.ComplexityMatrix and generates
-// SQL WHEN clauses for the combined OS+disk complexity CASE expression.
-func generateComplexityMatrixCaseClauses() string {
+// SQL WHEN clauses for the combined OS+disk complexity CASE expression. The disk levels are
+// scored with the disk tier scores of the table.
+func generateComplexityMatrixCaseClauses(table complexity.Table) string {
 	osScoreToLevel := map[complexity.Score]string{
 		0: "unsupported",
 		1: "easy",
@@ -405,11 +513,12 @@ func generateComplexityMatrixCaseClauses() string {
 		3: "hard",
 		4: "database",
 	}
-	diskScoreToLevel := map[complexity.Score]string{
-		1: "easy",
-		2: "medium",
-		3: "hard",
-		4: "whiteglove",
+	// disk levels of populate_complexity.go.tmpl and their disk complexity tier
+	diskLevels := []struct{ level, tier string }{
+		{"easy", "0-10TiB"},
+		{"medium", "10-20TiB"},
+		{"hard", "20-50TiB"},
+		{"whiteglove", "50+TiB"},
 	}
 
 	// Collect and sort outer (OS) keys for deterministic output.
@@ -424,19 +533,11 @@ func generateComplexityMatrixCaseClauses() string {
 		inner := complexity.ComplexityMatrix[osScore]
 		osLevel := osScoreToLevel[osScore]
 
-		// Collect and sort inner (disk) keys for deterministic output.
-		diskKeys := make([]complexity.Score, 0, len(inner))
-		for diskScore := range inner {
-			diskKeys = append(diskKeys, diskScore)
-		}
-		sort.Ints(diskKeys)
-
-		for _, diskScore := range diskKeys {
-			combined := inner[diskScore]
-			diskLevel := diskScoreToLevel[diskScore]
+		for _, disk := range diskLevels {
+			combined := inner[table.ScoreDiskTierLabel(disk.tier)]
 			clauses = append(clauses, fmt.Sprintf(
 				"            WHEN o.os_level = '%s' AND dl.disk_level = '%s' THEN %d",
-				osLevel, diskLevel, combined))
+				osLevel, disk.level, combined))
 		}
 	}
 	return strings.Join(clauses, "\n")
@@ -445,8 +546,8 @@ func generateComplexityMatrixCaseClauses() string {
 // PopulateComplexityQuery returns a query that computes and stores per-VM migration complexity.
 func (b *QueryBuilder) PopulateComplexityQuery() (string, error) {
 	params := queryParams{
-		OSCaseClauses:           generateOSCaseClauses(),
-		ComplexityMatrixClauses: generateComplexityMatrixCaseClauses(),
+		OSCaseClauses:           generateOSCaseClauses(b.complexityTable),
+		ComplexityMatrixClauses: generateComplexityMatrixCaseClauses(b.complexityTable),
 	}
 	return b.buildQuery("populate_complexity", mustGetTemplate("populate_complexity"), params)
 }
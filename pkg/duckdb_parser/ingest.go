@@ -14,9 +14,10 @@ var stmtRegex = regexp.MustCompile(`(?s)(CREATE|INSERT|UPDATE|DROP|ALTER|WITH|IN
 // criticalStmtPatterns defines patterns for statements that must succeed.
 // If any of these fail, the ingestion should fail immediately.
 var criticalStmtPatterns = []string{
-	"INSTALL ",           // Extension installation must succeed
-	"LOAD ",              // Extension loading must succeed
-	"CREATE TABLE vinfo", // Main VM data table creation must succeed
+	"INSTALL ",                       // Extension installation must succeed
+	"LOAD ",                          // Extension loading must succeed
+	"CREATE TABLE vinfo",             // Main VM data table creation must succeed
+	"CREATE TEMP TABLE govc_objects", // govc JSON export must be readable
 }
 
 // isCriticalStatement checks if a statement matches any critical pattern.
@@ -34,9 +35,16 @@ func isCriticalStatement(stmt string) bool {
 var xlsxErrorMappings = map[string]string{
 	"No xl/workbook.xml found":         "The file is corrupted or not a valid Excel file",
 	"\"vInfo\" not found in xlsx file": "File is not a valid RVTools export (missing required 'vInfo' sheet)",
+	"Malformed JSON":                   "File is not a valid govc JSON export",
+
+	// RVTools CSV exports (zip of per-tab CSV files)
+	"vInfo tab not found in RVTools CSV export": "File is not a valid RVTools CSV export (missing required vInfo CSV file)",
+	"Invalid unicode":            "The RVTools vInfo CSV file is not UTF-8 encoded",
+	"Error when sniffing file":   "The RVTools vInfo CSV file could not be parsed (unrecognized delimiter or quoting)",
+	"Expected Number of Columns": "The RVTools vInfo CSV file has rows with an inconsistent number of columns",
 }
 
-// translateXLSXError converts technical DuckDB xlsx errors to user-friendly messages.
+// translateXLSXError converts technical DuckDB xlsx and csv errors to user-friendly messages.
 func translateXLSXError(err error) error {
 	errStr := err.Error()
 	for pattern, message := range xlsxErrorMappings {
@@ -47,12 +55,19 @@ func translateXLSXError(err error) error {
 	return err
 }
 
-// IngestRvTools ingests data from an RVTools Excel file, runs VM validation if a validator
-// is configured, and validates the schema for required tables/columns.
+// IngestRvTools ingests data from an RVTools Excel file or a zip of RVTools CSV files (tabs are
+// detected by file name), runs VM validation if a validator is configured, and validates the
+// schema for required tables/columns.
 // Returns a ValidationResult with errors (fatal) and warnings (non-fatal).
 // If ValidationResult.HasErrors() is true, the inventory cannot be built.
-func (p *Parser) IngestRvTools(ctx context.Context, excelFile string) (ValidationResult, error) {
-	query, err := p.builder.IngestRvtoolsQuery(excelFile)
+func (p *Parser) IngestRvTools(ctx context.Context, rvtoolsFile string) (ValidationResult, error) {
+	source, cleanup, err := openRvtoolsSource(rvtoolsFile)
+	if err != nil {
+		return ValidationResult{}, fmt.Errorf("opening rvtools file: %w", err)
+	}
+	defer cleanup()
+
+	query, err := p.builder.IngestRvtoolsQuery(source)
 	if err != nil {
 		return ValidationResult{}, fmt.Errorf("building rvtools ingestion query: %w", err)
 	}
@@ -110,6 +125,36 @@ func (p *Parser) IngestSqlite(ctx context.Context, sqliteFile string) (Validatio
 	return result, nil
 }
 
+// IngestGovcJSON ingests data from a govc JSON export (`govc ls -l -json`, optionally merged with
+// `govc about -json`), runs VM validation if a validator is configured, and validates the schema
+// for required tables/columns.
+// Returns a ValidationResult with errors (fatal) and warnings (non-fatal).
+// If ValidationResult.HasErrors() is true, the inventory cannot be built.
+func (p *Parser) IngestGovcJSON(ctx context.Context, jsonFile string) (ValidationResult, error) {
+	query, err := p.builder.IngestGovcJSONQuery(jsonFile)
+	if err != nil {
+		return ValidationResult{}, fmt.Errorf("building govc json ingestion query: %w", err)
+	}
+	if err := p.executeStatements(ctx, query); err != nil {
+		return ValidationResult{}, fmt.Errorf("ingesting govc json data: %w", err)
+	}
+
+	// Validate schema against vinfo (govc JSON inserts directly into vinfo, no vinfo_raw)
+	result := p.ValidateSchema(ctx, "vinfo")
+
+	// Only run post-ingestion steps if schema is valid (we have VMs to process)
+	if result.IsValid() {
+		if err := p.populateComplexity(ctx); err != nil {
+			return result, fmt.Errorf("populating complexity: %w", err)
+		}
+		if err := p.validateVMs(ctx); err != nil {
+			return result, fmt.Errorf("validating VMs: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
 // dropVinfoRaw drops the temporary vinfo_raw table used during RVTools ingestion.
 // This table holds unfiltered data from the Excel file and is only needed for validation.
 func (p *Parser) dropVinfoRaw(ctx context.Context) error {
@@ -0,0 +1,215 @@
+package duckdb_parser
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/list"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// createTestGovcJSON writes what `govc ls -l -json` prints for the inventory folders of a vcsim
+// vCenter, merged with `govc about -json`. The model has one datacenter with two clusters of one
+// host and two VMs each; the first VM of DC0_C0 has known quick stats, disk.EnableUUID and CBT,
+// and the second VM of DC0_C1 is a template.
+func createTestGovcJSON(t *testing.T) (string, types.AboutInfo) {
+	t.Helper()
+
+	model := simulator.VPX()
+	model.Cluster = 2
+	model.ClusterHost = 1
+	model.Host = 0
+	model.Machine = 2
+	require.NoError(t, model.Create())
+	defer model.Remove()
+
+	for _, entity := range model.Map().All("ClusterComputeResource") {
+		cluster := entity.(*simulator.ClusterComputeResource)
+		if cluster.Name != "DC0_C0" {
+			continue
+		}
+		cfg := cluster.ConfigurationEx.(*types.ClusterConfigInfoEx)
+		cfg.DasConfig.Enabled = types.NewBool(true)
+		cfg.DrsConfig.Enabled = types.NewBool(true)
+		cfg.DrsConfig.DefaultVmBehavior = types.DrsBehaviorFullyAutomated
+	}
+	for _, entity := range model.Map().All("VirtualMachine") {
+		vm := entity.(*simulator.VirtualMachine)
+		switch vm.Name {
+		case "DC0_C0_RP0_VM0":
+			vm.Config.Hardware.NumCPU = 4
+			vm.Config.Hardware.NumCoresPerSocket = 2
+			vm.Config.Hardware.MemoryMB = 8192
+			vm.Config.ChangeTrackingEnabled = types.NewBool(true)
+			vm.Config.ExtraConfig = append(vm.Config.ExtraConfig, &types.OptionValue{Key: "disk.EnableUUID", Value: "TRUE"})
+			vm.Runtime.MaxCpuUsage = 10000
+			vm.Summary.QuickStats.OverallCpuUsage = 2500
+			vm.Summary.QuickStats.GuestMemoryUsage = 2048
+			vm.Summary.QuickStats.HostMemoryUsage = 6144
+			vm.Guest.Net[0].IpAddress = []string{"fe80::1", "10.0.0.1"}
+		case "DC0_C1_RP0_VM1":
+			vm.Config.Template = true
+		}
+	}
+
+	var path string
+	var about types.AboutInfo
+	require.NoError(t, model.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c, true)
+		var elements []list.Element
+		for _, folder := range []string{"/*/host", "/*/host/*", "/*/vm", "/*/datastore", "/*/network"} {
+			es, err := finder.ManagedObjectListChildren(ctx, folder)
+			if err != nil {
+				return err
+			}
+			elements = append(elements, es...)
+		}
+		about = c.ServiceContent.About
+
+		data, err := json.Marshal(map[string]any{"about": about, "elements": elements})
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(t.TempDir(), "govc-export.json")
+		return os.WriteFile(path, data, 0o600)
+	}))
+	return path, about
+}
+
+func TestIngestGovcJSON_PopulatesSchema(t *testing.T) {
+	ctx := context.Background()
+	parser, db, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	path, _ := createTestGovcJSON(t)
+	result, err := parser.IngestGovcJSON(ctx, path)
+	require.NoError(t, err)
+	require.True(t, result.IsValid(), "unexpected validation errors: %v", result.Errors)
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	require.Len(t, vms, 3, "templates must be skipped")
+
+	var vmID, vm1Cluster, vm1Datacenter, vm1Host, vm1Network string
+	var vm1CPUs, vm1Memory, vm1DiskMiB int
+	var vm1EnableUUID, vm1CBT bool
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT "VM ID", "Cluster", "Datacenter", "Host", "Network #1", "CPUs", "Memory", "Total disk capacity MiB", "EnableUUID", "CBT" FROM vinfo WHERE "VM" = 'DC0_C0_RP0_VM0'`).
+		Scan(&vmID, &vm1Cluster, &vm1Datacenter, &vm1Host, &vm1Network, &vm1CPUs, &vm1Memory, &vm1DiskMiB, &vm1EnableUUID, &vm1CBT))
+	assert.Equal(t, "DC0_C0", vm1Cluster)
+	assert.Equal(t, "DC0", vm1Datacenter)
+	assert.Equal(t, "DC0_C0_H0", vm1Host)
+	assert.Equal(t, "DC0_DVPG0", vm1Network)
+	assert.Equal(t, 4, vm1CPUs)
+	assert.Equal(t, 8192, vm1Memory)
+	assert.Equal(t, 10240, vm1DiskMiB)
+	assert.True(t, vm1EnableUUID)
+	assert.True(t, vm1CBT)
+
+	var cpuUsage, cpuMax, memoryActive, memoryConsumed int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT c."Overall", c."Max", m."Active", m."Consumed" FROM vcpu c JOIN vmemory m USING ("VM ID") WHERE "VM ID" = ?`, vmID).
+		Scan(&cpuUsage, &cpuMax, &memoryActive, &memoryConsumed))
+	assert.Equal(t, 2500, cpuUsage)
+	assert.Equal(t, 10000, cpuMax)
+	assert.Equal(t, 2048, memoryActive)
+	assert.Equal(t, 6144, memoryConsumed)
+
+	var diskThin bool
+	var diskPath, diskBus string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT "Thin", "Path", "Shared Bus" FROM vdisk WHERE "VM ID" = ?`, vmID).
+		Scan(&diskThin, &diskPath, &diskBus))
+	assert.True(t, diskThin)
+	assert.Equal(t, "[LocalDS_0] DC0_C0_RP0_VM0/disk1.vmdk", diskPath)
+	assert.Equal(t, "noSharing", diskBus)
+
+	var nicCount int
+	var nicSwitch, nicType, ipv4, ipv6 string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) OVER (), "Switch", "Type", "IPv4 Address", "IPv6 Address" FROM vnetwork WHERE "VM ID" = ?`, vmID).
+		Scan(&nicCount, &nicSwitch, &nicType, &ipv4, &ipv6))
+	assert.Equal(t, 1, nicCount, "port groups listed under several folders must be ingested once")
+	assert.Equal(t, "DVS0", nicSwitch)
+	assert.Equal(t, "distributed", nicType)
+	assert.Equal(t, "10.0.0.1", ipv4)
+	assert.Equal(t, "fe80::1", ipv6)
+
+	var hostCount int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM vhost WHERE "# Memory" > 0 AND "# Cores" > 0`).Scan(&hostCount))
+	assert.Equal(t, 2, hostCount)
+
+	var dsHosts string
+	var dsCapacity float64
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT "Hosts", "Capacity MiB" FROM vdatastore WHERE "Name" = 'LocalDS_0'`).Scan(&dsHosts, &dsCapacity))
+	assert.ElementsMatch(t, []string{"DC0_C0_H0", "DC0_C1_H0"}, strings.Split(dsHosts, ","))
+	assert.Positive(t, dsCapacity)
+
+	var objectCount int
+	err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM govc_objects`).Scan(&objectCount)
+	assert.Error(t, err, "staging tables must be dropped after ingestion")
+}
+
+func TestIngestGovcJSON_VClusterMatchesInventoryClusterKeys(t *testing.T) {
+	ctx := context.Background()
+	parser, db, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	path, about := createTestGovcJSON(t)
+	result, err := parser.IngestGovcJSON(ctx, path)
+	require.NoError(t, err)
+	require.True(t, result.IsValid())
+
+	vcenterID, err := parser.VCenterID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, about.InstanceUuid, vcenterID)
+
+	rows, err := db.QueryContext(ctx, `SELECT "Name", "Object ID", "DasEnabled", "DrsEnabled", "DrsDefaultVmBehavior" FROM vcluster ORDER BY "Name"`)
+	require.NoError(t, err)
+	defer func() { _ = rows.Close() }()
+
+	type vcluster struct {
+		objectID string
+		das, drs bool
+		drsMode  string
+	}
+	got := make(map[string]vcluster)
+	for rows.Next() {
+		var name string
+		var c vcluster
+		require.NoError(t, rows.Scan(&name, &c.objectID, &c.das, &c.drs, &c.drsMode))
+		got[name] = c
+	}
+	require.NoError(t, rows.Err())
+	require.Len(t, got, 2)
+
+	assert.Equal(t, generateClusterID("DC0_C0", "DC0", vcenterID), got["DC0_C0"].objectID)
+	assert.True(t, got["DC0_C0"].das)
+	assert.True(t, got["DC0_C0"].drs)
+	assert.Equal(t, "fullyAutomated", got["DC0_C0"].drsMode)
+	assert.False(t, got["DC0_C1"].das)
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+	assert.Contains(t, inv.Clusters, got["DC0_C0"].objectID)
+	assert.Contains(t, inv.Clusters, got["DC0_C1"].objectID)
+	assert.Equal(t, 3, inv.VCenter.VMs.Total)
+}
+
+func TestIngestGovcJSON_InvalidFile(t *testing.T) {
+	ctx := context.Background()
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	path := filepath.Join(t.TempDir(), "broken.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"elements": [`), 0o600))
+
+	_, err := parser.IngestGovcJSON(ctx, path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a valid govc JSON export")
+}
@@ -45,22 +45,10 @@ func (p *Parser) BuildInventory(ctx context.Context, vmList []string) (*inventor
 		return nil, fmt.Errorf("getting clusters: %w", err)
 	}
 
-	// Get cluster name to Object ID mapping from vCluster sheet
-	clusterObjectIDs, err := p.ClusterObjectIDs(ctx)
-	if err != nil {
-		zap.S().Named("duckdb_parser").Warnf("Failed to get cluster object IDs: %v", err)
-		clusterObjectIDs = make(map[string]string)
-	}
-	zap.S().Named("duckdb_parser").Infof("Found %d clusters in vCluster sheet", len(clusterObjectIDs))
+	// Resolve cluster IDs from vCluster or generated
+	clusterIDs := p.resolveClusterIDs(ctx, clusters, vcenterID)
 
-	// Get cluster to datacenter mapping for fallback ID generation
-	clusterDatacenters, err := p.ClusterDatacenters(ctx)
-	if err != nil {
-		zap.S().Named("duckdb_parser").Warnf("Failed to get cluster datacenters: %v", err)
-		clusterDatacenters = make(map[string]string)
-	}
-
-	// Build per-cluster inventories with cluster IDs from vCluster or generated
+	// Build per-cluster inventories keyed by cluster ID
 	clusterInventories := make(map[string]inventory.InventoryData)
 	for _, clusterName := range clusters {
 		clusterFilters := Filters{Cluster: clusterName, VMList: vmList}
@@ -70,9 +58,7 @@ func (p *Parser) BuildInventory(ctx context.Context, vmList []string) (*inventor
 			continue
 		}
 
-		// Use Object ID from vCluster sheet if available, otherwise generate
-		clusterID := resolveClusterID(clusterName, clusterObjectIDs, clusterDatacenters, vcenterID)
-		clusterInventories[clusterID] = *clusterInv
+		clusterInventories[clusterIDs[clusterName]] = *clusterInv
 	}
 
 	now := time.Now().UTC()
@@ -156,6 +142,7 @@ func (p *Parser) buildVMsData(ctx context.Context, filters Filters) (*inventory.
 	vmsData := &inventory.VMsData{
 		PowerStates:              make(map[string]int),
 		OSInfo:                   make(map[string]inventory.OSInfo),
+		Applications:             make(map[string]int),
 		DistributionByCPUTier:    make(map[string]int),
 		DistributionByMemoryTier: make(map[string]int),
 		DistributionByNICCount:   make(map[string]int),
@@ -194,7 +181,7 @@ func (p *Parser) buildVMsData(ctx context.Context, filters Filters) (*inventory.
 			vmsData.OSInfo[os.Name] = inventory.OSInfo{
 				Count:                 os.Count,
 				IsSupported:           os.Supported,
-				SupportTier:           inventory.ClassifyOSTier(os.Name),
+				SupportTier:           p.complexityTable.ClassifyOSTier(os.Name),
 				UpgradeRecommendation: upgradeRec,
 			}
 		}
@@ -202,6 +189,14 @@ func (p *Parser) buildVMsData(ctx context.Context, filters Filters) (*inventory.
 		zap.S().Named("duckdb_parser").Warnf("Failed to get OS summary: %v", err)
 	}
 
+	// Get business applications
+	applications, err := p.ApplicationCounts(ctx, filters)
+	if err == nil {
+		vmsData.Applications = applications
+	} else {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get applications: %v", err)
+	}
+
 	// Get migration counts
 	migratableCount, err := p.MigratableVMCount(ctx, filters)
 	if err == nil {
@@ -472,6 +467,48 @@ func (p *Parser) buildInfraData(ctx context.Context, filters Filters) (*inventor
 }
 
 // resolveClusterID determines the cluster ID from vCluster Object ID or generates one.
+// ClusterIDs returns the mapping of cluster name to the cluster ID used as key
+// of the inventory clusters built by BuildInventory.
+func (p *Parser) ClusterIDs(ctx context.Context) (map[string]string, error) {
+	vcenterID, err := p.VCenterID(ctx)
+	if err != nil {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get vCenter ID: %v", err)
+		vcenterID = ""
+	}
+
+	clusters, err := p.Clusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting clusters: %w", err)
+	}
+
+	return p.resolveClusterIDs(ctx, clusters, vcenterID), nil
+}
+
+// resolveClusterIDs maps each cluster name to its ID, using the Object ID from the
+// vCluster sheet if available and a generated ID otherwise.
+func (p *Parser) resolveClusterIDs(ctx context.Context, clusters []string, vcenterID string) map[string]string {
+	// Get cluster name to Object ID mapping from vCluster sheet
+	clusterObjectIDs, err := p.ClusterObjectIDs(ctx)
+	if err != nil {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get cluster object IDs: %v", err)
+		clusterObjectIDs = make(map[string]string)
+	}
+	zap.S().Named("duckdb_parser").Infof("Found %d clusters in vCluster sheet", len(clusterObjectIDs))
+
+	// Get cluster to datacenter mapping for fallback ID generation
+	clusterDatacenters, err := p.ClusterDatacenters(ctx)
+	if err != nil {
+		zap.S().Named("duckdb_parser").Warnf("Failed to get cluster datacenters: %v", err)
+		clusterDatacenters = make(map[string]string)
+	}
+
+	ids := make(map[string]string, len(clusters))
+	for _, clusterName := range clusters {
+		ids[clusterName] = resolveClusterID(clusterName, clusterObjectIDs, clusterDatacenters, vcenterID)
+	}
+	return ids
+}
+
 func resolveClusterID(clusterName string, objectIDs, datacenters map[string]string, vcenterID string) string {
 	// Use Object ID from vCluster sheet if available
 	if objectID, exists := objectIDs[clusterName]; exists {
@@ -15,6 +15,7 @@ import (
 	"github.com/xuri/excelize/v2"
 
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/inventory"
 )
 
@@ -609,6 +610,38 @@ func TestBuildInventory_MultiCluster(t *testing.T) {
 	assert.Equal(t, 4, clusterTotal)
 }
 
+func TestClusterIDs_MatchInventoryClusterKeys(t *testing.T) {
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	vms := []map[string]string{
+		{"VM": "vm-1", "VM ID": "vm-001", "VI SDK UUID": "uuid-1", "Host": "esxi-host-1", "CPUs": "4", "Memory": "8192", "Powerstate": "poweredOn", "Cluster": "cluster1", "Datacenter": "dc1"},
+		{"VM": "vm-2", "VM ID": "vm-002", "VI SDK UUID": "uuid-2", "Host": "esxi-host-2", "CPUs": "2", "Memory": "4096", "Powerstate": "poweredOn", "Cluster": "cluster2", "Datacenter": "dc1"},
+	}
+	hosts := []map[string]string{
+		{"Datacenter": "dc1", "Cluster": "cluster1", "# Cores": "8", "# CPU": "2", "Object ID": "host-001", "# Memory": "32768", "Model": "ESXi", "Vendor": "VMware", "Host": "esxi-host-1", "Config status": "green"},
+		{"Datacenter": "dc1", "Cluster": "cluster2", "# Cores": "16", "# CPU": "2", "Object ID": "host-002", "# Memory": "65536", "Model": "ESXi", "Vendor": "VMware", "Host": "esxi-host-2", "Config status": "green"},
+	}
+
+	tmpFile := createTestExcel(t, defaultStandardSheets(vms, hosts)...)
+
+	ctx := context.Background()
+	_, err := parser.IngestRvTools(ctx, tmpFile)
+	require.NoError(t, err)
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+
+	ids, err := parser.ClusterIDs(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+
+	for name, id := range ids {
+		_, ok := inv.Clusters[id]
+		assert.True(t, ok, "cluster %s resolved to %s which is not an inventory cluster key", name, id)
+	}
+}
+
 func TestResolveClusterID(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1293,6 +1326,23 @@ func TestPopulateComplexityQuery_IsDeterministic(t *testing.T) {
 	assert.Equal(t, sql1, sql2, "PopulateComplexityQuery output must be deterministic")
 }
 
+// TestPopulateComplexityQuery_ComplexityTable verifies that PopulateComplexityQuery scores
+// with the tables of the builder, the most specific OS names first.
+func TestPopulateComplexityQuery_ComplexityTable(t *testing.T) {
+	table := complexity.DefaultTable()
+	table.OSDifficultyScores["AlmaLinux 9"] = 1
+	table.OSDifficultyScores["Ubuntu Linux 22.04"] = 1
+	table.DiskSizeScores["20-50TiB"] = 2
+
+	sql, err := NewBuilder().WithComplexityTable(table).PopulateComplexityQuery()
+	require.NoError(t, err)
+
+	assert.Contains(t, sql, "LIKE '%almalinux 9%' THEN 'easy'")
+	assert.Contains(t, sql, "o.os_level = 'easy' AND dl.disk_level = 'hard' THEN 1")
+	assert.Less(t, strings.Index(sql, "'%ubuntu linux 22.04%'"), strings.Index(sql, "'%ubuntu linux%'"),
+		"longer OS names must be matched first")
+}
+
 // TestVMs_MigrationExcluded verifies that the migration_excluded field:
 // 1. Defaults to false for RVTools-ingested VMs
 // 2. Can be updated via SQL and is properly returned in the VM model
@@ -0,0 +1,150 @@
+package duckdb_parser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// unsafeSuffixChars matches the characters removed from a source suffix before it is
+// appended to identifiers in SQL.
+var unsafeSuffixChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// StageSource moves the export ingested last into staging tables, so that the export of
+// another vCenter can be ingested into the same parser. Once every export is ingested and
+// staged, MergeStagedSources makes the data of all exports available to the queries.
+//
+// Each VM keeps the UUID of its vCenter. Cluster IDs are resolved against the vCenter of the
+// export before staging; VM IDs, host IDs, cluster IDs and cluster names clashing with an
+// export staged before are made unique.
+func (p *Parser) StageSource(ctx context.Context) error {
+	vcenterID, err := p.VCenterID(ctx)
+	if err != nil {
+		return fmt.Errorf("getting vCenter ID: %w", err)
+	}
+
+	if p.stagedSources > 0 && vcenterID != "" {
+		var count int
+		if err := p.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM staged_about WHERE "InstanceUuid" = ?`, vcenterID).Scan(&count); err != nil {
+			return fmt.Errorf("checking staged vCenters: %w", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("vCenter %s is included in more than one file", vcenterID)
+		}
+	}
+
+	suffix := sourceSuffix(vcenterID, p.stagedSources)
+	if err := p.pinClusterIDs(ctx, vcenterID, suffix); err != nil {
+		return fmt.Errorf("resolving cluster IDs: %w", err)
+	}
+
+	query, err := p.builder.StageSourceQuery(suffix, vcenterID)
+	if err != nil {
+		return fmt.Errorf("building stage query: %w", err)
+	}
+	if err := p.executeAllStatements(ctx, query); err != nil {
+		return fmt.Errorf("staging source: %w", err)
+	}
+
+	p.stagedSources++
+	return nil
+}
+
+// MergeStagedSources moves every export staged by StageSource back into the inventory tables.
+// The inventory then holds the VMs, hosts and clusters of all the staged vCenters; VCenterID
+// reports the vCenter of the first export.
+func (p *Parser) MergeStagedSources(ctx context.Context) error {
+	if p.stagedSources == 0 {
+		return nil
+	}
+
+	query, err := p.builder.MergeStagedSourcesQuery()
+	if err != nil {
+		return fmt.Errorf("building merge query: %w", err)
+	}
+	if err := p.executeAllStatements(ctx, query); err != nil {
+		return fmt.Errorf("merging staged sources: %w", err)
+	}
+
+	p.stagedSources = 0
+	return nil
+}
+
+// pinClusterIDs records the ID of every cluster of the current export in vcluster, so that
+// the ID no longer depends on the vCenter reported by the `about` table once exports are merged.
+// IDs already used by a staged export are regenerated from the export's suffix.
+func (p *Parser) pinClusterIDs(ctx context.Context, vcenterID, suffix string) error {
+	clusters, err := p.Clusters(ctx)
+	if err != nil {
+		return fmt.Errorf("getting clusters: %w", err)
+	}
+	ids := p.resolveClusterIDs(ctx, clusters, vcenterID)
+
+	stagedIDs := make(map[string]bool)
+	if p.stagedSources > 0 {
+		rows, err := p.db.QueryContext(ctx, `SELECT DISTINCT "Object ID" FROM staged_vcluster WHERE "Object ID" IS NOT NULL`)
+		if err != nil {
+			return fmt.Errorf("querying staged cluster IDs: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return fmt.Errorf("scanning staged cluster ID: %w", err)
+			}
+			stagedIDs[id] = true
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterating staged cluster IDs: %w", err)
+		}
+	}
+
+	datacenters, err := p.ClusterDatacenters(ctx)
+	if err != nil {
+		return fmt.Errorf("getting cluster datacenters: %w", err)
+	}
+
+	for _, clusterName := range clusters {
+		id := ids[clusterName]
+		if stagedIDs[id] {
+			id = generateClusterID(clusterName, datacenters[clusterName], suffix)
+		}
+
+		res, err := p.db.ExecContext(ctx, `UPDATE vcluster SET "Object ID" = ? WHERE "Name" = ?`, id, clusterName)
+		if err != nil {
+			return fmt.Errorf("updating cluster %s: %w", clusterName, err)
+		}
+		if n, err := res.RowsAffected(); err == nil && n > 0 {
+			continue
+		}
+		if _, err := p.db.ExecContext(ctx, `INSERT INTO vcluster ("Name", "Object ID") VALUES (?, ?)`, clusterName, id); err != nil {
+			return fmt.Errorf("inserting cluster %s: %w", clusterName, err)
+		}
+	}
+	return nil
+}
+
+// executeAllStatements executes a multi-statement SQL string, failing on the first error.
+func (p *Parser) executeAllStatements(ctx context.Context, query string) error {
+	for _, stmt := range stmtRegex.FindAllString(query, -1) {
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		if _, err := p.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceSuffix identifies a staged export in the identifiers made unique while staging:
+// its vCenter UUID, or its position when the export doesn't report one.
+func sourceSuffix(vcenterID string, index int) string {
+	suffix := unsafeSuffixChars.ReplaceAllString(vcenterID, "")
+	if suffix == "" {
+		suffix = fmt.Sprintf("source-%d", index+1)
+	}
+	return suffix
+}
@@ -0,0 +1,160 @@
+package duckdb_parser
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestVCenterExport writes an RVTools CSV export of one vCenter with two VMs in cluster1,
+// one host and one datastore. VM, host and datastore IDs and names are the same for every
+// vCenter, as the object IDs and default names of separate vCenters are.
+func createTestVCenterExport(t *testing.T, vcenterUUID string) string {
+	t.Helper()
+
+	vinfo := "VM,VM ID,VI SDK UUID,VI SDK API Version,Host,CPUs,Memory,Powerstate,Cluster,Datacenter,OS according to the configuration file,Template\n"
+	vdisk := "VM ID,Disk Key,Unit #,Path,Disk Path,Capacity MiB,Raw,Shared Bus,Disk Mode,Thin,Controller,Label,SCSI Unit #\n"
+	for i := 1; i <= 2; i++ {
+		vinfo += fmt.Sprintf("vm-%d,vm-00%d,%s,8.0.1,esxi-host-1,2,4096,poweredOn,cluster1,dc1,Red Hat Enterprise Linux 9 (64-bit),False\n", i, i, vcenterUUID)
+		vdisk += fmt.Sprintf("vm-00%d,2000,0,[datastore1] vm-%d/vm-%d.vmdk,[datastore1] vm-%d/vm-%d.vmdk,10240,False,,persistent,True,SCSI controller 0,Hard disk 1,0:0\n", i, i, i, i, i)
+	}
+
+	return createTestZip(t, map[string]string{
+		"RVTools_tabvInfo.csv": vinfo,
+		"RVTools_tabvDisk.csv": vdisk,
+		"RVTools_tabvHost.csv": "Datacenter,Cluster,# Cores,# CPU,Object ID,# Memory,Model,Vendor,Host,Config status\n" +
+			"dc1,cluster1,8,2,host-001,32768,PowerEdge,Dell,esxi-host-1,green\n",
+		"RVTools_tabvDatastore.csv": "Hosts,Address,Name,Object ID,Free MiB,MHA,Capacity MiB,Type\n" +
+			"esxi-host-1,,datastore1,datastore-001,51200,True,102400,VMFS\n",
+	})
+}
+
+func TestStageSource_MergesVCenters(t *testing.T) {
+	ctx := context.Background()
+	parser, db, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	for _, vcenter := range []string{"vc-uuid-1", "vc-uuid-2"} {
+		result, err := parser.IngestRvTools(ctx, createTestVCenterExport(t, vcenter))
+		require.NoError(t, err)
+		require.True(t, result.IsValid(), "unexpected validation errors: %v", result.Errors)
+		require.NoError(t, parser.StageSource(ctx))
+	}
+	require.NoError(t, parser.MergeStagedSources(ctx))
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	require.Len(t, vms, 4)
+
+	vcenterByVM := make(map[string]string, len(vms))
+	for _, vm := range vms {
+		vcenterByVM[vm.ID] = vm.VCenterID
+	}
+	assert.Equal(t, map[string]string{
+		"vm-001":           "vc-uuid-1",
+		"vm-002":           "vc-uuid-1",
+		"vm-001@vc-uuid-2": "vc-uuid-2",
+		"vm-002@vc-uuid-2": "vc-uuid-2",
+	}, vcenterByVM)
+
+	var hostCount int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(DISTINCT "Object ID") FROM vhost`).Scan(&hostCount))
+	assert.Equal(t, 2, hostCount)
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "vc-uuid-1", inv.VCenterID)
+	assert.Equal(t, 4, inv.VCenter.VMs.Total)
+	require.Len(t, inv.Clusters, 2)
+
+	// Cluster keys are the IDs each vCenter would get in its own assessment
+	first := generateClusterID("cluster1", "dc1", "vc-uuid-1")
+	second := generateClusterID("cluster1", "dc1", "vc-uuid-2")
+	require.Contains(t, inv.Clusters, first)
+	require.Contains(t, inv.Clusters, second)
+	assert.Equal(t, 2, inv.Clusters[first].VMs.Total)
+	assert.Equal(t, 2, inv.Clusters[second].VMs.Total)
+	assert.Equal(t, 1, inv.Clusters[second].Infra.TotalHosts)
+
+	clusterIDs, err := parser.ClusterIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, first, clusterIDs["cluster1"])
+	assert.Equal(t, second, clusterIDs["cluster1 (vc-uuid-2)"])
+}
+
+func TestStageSource_RenamesClashingDatastoresAndHosts(t *testing.T) {
+	ctx := context.Background()
+	parser, db, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	for _, vcenter := range []string{"vc-uuid-1", "vc-uuid-2"} {
+		_, err := parser.IngestRvTools(ctx, createTestVCenterExport(t, vcenter))
+		require.NoError(t, err)
+		require.NoError(t, parser.StageSource(ctx))
+	}
+	require.NoError(t, parser.MergeStagedSources(ctx))
+
+	rows, err := db.QueryContext(ctx, `SELECT "Name", "Object ID", "Hosts" FROM vdatastore ORDER BY "Name"`)
+	require.NoError(t, err)
+	var datastores [][3]string
+	for rows.Next() {
+		var ds [3]string
+		require.NoError(t, rows.Scan(&ds[0], &ds[1], &ds[2]))
+		datastores = append(datastores, ds)
+	}
+	require.NoError(t, rows.Close())
+	assert.Equal(t, [][3]string{
+		{"datastore1", "datastore-001", "esxi-host-1"},
+		{"datastore1 (vc-uuid-2)", "datastore-001@vc-uuid-2", "esxi-host-1 (vc-uuid-2)"},
+	}, datastores)
+
+	var hostNames []string
+	rows, err = db.QueryContext(ctx, `SELECT DISTINCT "Host" FROM vinfo ORDER BY "Host"`)
+	require.NoError(t, err)
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		hostNames = append(hostNames, name)
+	}
+	require.NoError(t, rows.Close())
+	assert.Equal(t, []string{"esxi-host-1", "esxi-host-1 (vc-uuid-2)"}, hostNames)
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	require.Len(t, vms, 4)
+	for _, vm := range vms {
+		require.Len(t, vm.Disks, 1, "VM %s", vm.ID)
+		if vm.VCenterID == "vc-uuid-2" {
+			assert.Equal(t, "datastore-001@vc-uuid-2", vm.Disks[0].Datastore.ID, "VM %s", vm.ID)
+		} else {
+			assert.Equal(t, "datastore-001", vm.Disks[0].Datastore.ID, "VM %s", vm.ID)
+		}
+	}
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+	var diskCount int
+	for _, dt := range inv.VCenter.VMs.DiskTypes {
+		diskCount += dt.VMCount
+	}
+	assert.Equal(t, 4, diskCount)
+}
+
+func TestStageSource_RejectsDuplicateVCenter(t *testing.T) {
+	ctx := context.Background()
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	_, err := parser.IngestRvTools(ctx, createTestVCenterExport(t, "vc-uuid-1"))
+	require.NoError(t, err)
+	require.NoError(t, parser.StageSource(ctx))
+
+	_, err = parser.IngestRvTools(ctx, createTestVCenterExport(t, "vc-uuid-1"))
+	require.NoError(t, err)
+	err = parser.StageSource(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vCenter vc-uuid-1 is included in more than one file")
+}
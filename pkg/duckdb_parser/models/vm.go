@@ -26,7 +26,11 @@ type VM struct {
 	GuestName                string    `json:"guestName" db:"OS according to the configuration file"`
 	GuestNameFromVmwareTools string    `json:"guestNameFromVmwareTools" db:"OS according to the VMware Tools"`
 	HostName                 string    `json:"hostName" db:"DNS Name"`
-	BalloonedMemory          int32     `json:"balloonedMemory" db:"Ballooned"` // vmemory
+	BalloonedMemory          int32     `json:"balloonedMemory" db:"Ballooned"`           // vmemory
+	CpuUsageMHz              *int32    `json:"cpuUsageMHz,omitempty" db:"Overall"`       // vcpu, nil when not reported
+	CpuMaxMHz                *int32    `json:"cpuMaxMHz,omitempty" db:"Max"`             // vcpu, nil when not reported
+	MemoryActiveMB           *int32    `json:"memoryActiveMB,omitempty" db:"Active"`     // vmemory, nil when not reported
+	MemoryConsumedMB         *int32    `json:"memoryConsumedMB,omitempty" db:"Consumed"` // vmemory, nil when not reported
 	IpAddress                string    `json:"ipAddress" db:"Primary IP Address"`
 	StorageUsed              int64     `json:"storageUsed" db:"In Use MiB"` // SQL returns bytes
 	IsTemplate               bool      `json:"isTemplate" db:"Template"`
@@ -37,6 +41,7 @@ type VM struct {
 	DiskEnableUuid           bool      `json:"diskEnableUuid" db:"EnableUUID"`
 	Datacenter               string    `json:"datacenter" db:"Datacenter"`
 	Cluster                  string    `json:"cluster" db:"Cluster"`
+	VCenterID                string    `json:"vCenterId" db:"VI SDK UUID"`
 	HWVersion                string    `json:"hwVersion" db:"HW version"`
 	TotalDiskCapacityMiB     int32     `json:"totalDiskCapacityMiB" db:"Total disk capacity MiB"`
 	ProvisionedMiB           int32     `json:"provisionedMiB" db:"Provisioned MiB"`
@@ -64,6 +69,39 @@ func (vm VM) EffectiveGuestName() string {
 	return vm.GuestName
 }
 
+// CpuUsagePercent returns the CPU usage of the VM as a percentage of its CPU entitlement, or nil
+// when the source doesn't report it.
+func (vm VM) CpuUsagePercent() *float64 {
+	if vm.CpuUsageMHz == nil || vm.CpuMaxMHz == nil || *vm.CpuMaxMHz <= 0 {
+		return nil
+	}
+	pct := min(float64(*vm.CpuUsageMHz)/float64(*vm.CpuMaxMHz)*100, 100)
+	return &pct
+}
+
+// MemoryUsagePercent returns the memory used by the VM as a percentage of its configured memory,
+// or nil when the source doesn't report it. The used memory is the larger of the consumed memory
+// (host memory backing the guest) and the guest active memory: active memory is sampled over a
+// short window and underestimates the working set of most guests.
+func (vm VM) MemoryUsagePercent() *float64 {
+	if vm.MemoryMB <= 0 {
+		return nil
+	}
+	var usedMB int32
+	switch {
+	case vm.MemoryConsumedMB != nil && vm.MemoryActiveMB != nil:
+		usedMB = max(*vm.MemoryConsumedMB, *vm.MemoryActiveMB)
+	case vm.MemoryConsumedMB != nil:
+		usedMB = *vm.MemoryConsumedMB
+	case vm.MemoryActiveMB != nil:
+		usedMB = *vm.MemoryActiveMB
+	default:
+		return nil
+	}
+	pct := min(float64(usedMB)/float64(vm.MemoryMB)*100, 100)
+	return &pct
+}
+
 // Disk represents a virtual disk from the vdisk table.
 type Disk struct {
 	Key                   string `json:"key" db:"Disk Key"`
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/store"
 )
 
@@ -19,6 +20,12 @@ type Parser struct {
 	db        store.QueryInterceptor
 	builder   *QueryBuilder
 	validator Validator
+
+	// complexityTable scores the per-VM migration complexity and the OS support tiers.
+	complexityTable complexity.Table
+
+	// stagedSources counts the exports moved to the staged_* tables by StageSource.
+	stagedSources int
 }
 
 // New creates a new Parser with optional validator.
@@ -27,9 +34,18 @@ func New(db store.QueryInterceptor, validator Validator) *Parser {
 		db:        db,
 		builder:   NewBuilder(),
 		validator: validator,
+
+		complexityTable: complexity.DefaultTable(),
 	}
 }
 
+// WithComplexityTable scores the ingested VMs with the given tables instead of the built-in ones.
+func (p *Parser) WithComplexityTable(table complexity.Table) *Parser {
+	p.complexityTable = table
+	p.builder.WithComplexityTable(table)
+	return p
+}
+
 // Init creates the database schema.
 func (p *Parser) Init() error {
 	if err := p.createSchema(); err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/georgysavva/scany/v2/sqlscan"
 
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
 	"github.com/kubev2v/migration-planner/pkg/inventory"
 )
 
@@ -152,6 +153,40 @@ func (p *Parser) OsSummary(ctx context.Context, filters Filters) ([]models.Os, e
 	return results, nil
 }
 
+// ApplicationCounts returns the number of VMs running each application of
+// complexity.ApplicationRules, detected from their guest applications and labels.
+func (p *Parser) ApplicationCounts(ctx context.Context, filters Filters) (map[string]int, error) {
+	q, err := p.builder.VMApplicationsQuery(filters)
+	if err != nil {
+		return nil, fmt.Errorf("building vm applications query: %w", err)
+	}
+	rows, err := p.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("querying vm applications: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			vmID   string
+			labels models.Labels
+			apps   models.GuestApps
+		)
+		if err := rows.Scan(&vmID, &labels, &apps); err != nil {
+			return nil, fmt.Errorf("scanning vm applications: %w", err)
+		}
+		guestApps := make([]complexity.GuestApplication, len(apps))
+		for i, app := range apps {
+			guestApps[i] = complexity.GuestApplication{Name: app.Name, Version: app.Version}
+		}
+		for _, id := range complexity.DetectApplications(guestApps, labels) {
+			counts[id]++
+		}
+	}
+	return counts, rows.Err()
+}
+
 // PowerStateCounts returns VM power state distribution.
 func (p *Parser) PowerStateCounts(ctx context.Context, filters Filters) (map[string]int, error) {
 	q, err := p.builder.PowerStateCountsQuery(filters)
@@ -608,6 +643,7 @@ func (p *Parser) readVMs(ctx context.Context, query string) ([]models.VM, error)
 			&vm.DiskEnableUuid,
 			&vm.Datacenter,
 			&vm.Cluster,
+			&vm.VCenterID,
 			&vm.HWVersion,
 			&vm.TotalDiskCapacityMiB,
 			&vm.ProvisionedMiB,
@@ -619,6 +655,10 @@ func (p *Parser) readVMs(ctx context.Context, query string) ([]models.VM, error)
 			&vm.CoresPerSocket,
 			&vm.MemoryHotAddEnabled,
 			&vm.BalloonedMemory,
+			&vm.CpuUsageMHz,
+			&vm.CpuMaxMHz,
+			&vm.MemoryActiveMB,
+			&vm.MemoryConsumedMB,
 			&vm.Disks,
 			&vm.NICs,
 			&vm.Networks,
@@ -93,3 +93,32 @@ func TestVMs_Labels(t *testing.T) {
 	assert.Equal(t, []string{"test"}, []string(vmMap["vm-002"].Labels), "vm-002 should have one label")
 	assert.Empty(t, vmMap["vm-003"].Labels, "vm-003 should still have empty labels")
 }
+
+// TestApplicationCounts verifies that business applications are detected from the guest
+// applications and labels of the VMs, counted once per VM, and that excluded VMs are ignored.
+func TestApplicationCounts(t *testing.T) {
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	ctx := context.Background()
+	_, err := parser.db.ExecContext(ctx, `INSERT INTO vinfo ("VM ID", "VM", "Cluster", "labels", "guest_apps", "migration_excluded") VALUES
+		('vm-001', 'db-1', 'cluster1', '[]', '[{"name": "Microsoft SQL Server 2019", "version": "15.0.2000.5"}, {"name": "SQL Server Agent", "version": "15.0.2000.5"}]', false),
+		('vm-002', 'db-2', 'cluster1', '[]', '[{"name": "Microsoft SQL Server", "version": "10.50.1600.1"}]', false),
+		('vm-003', 'erp-1', 'cluster2', '["app=sap-hana", "production"]', '[{"name": "Oracle Database 19c"}]', false),
+		('vm-004', 'web-1', 'cluster2', '["production"]', '[{"name": "nginx", "version": "1.24"}]', false),
+		('vm-005', 'mail-1', 'cluster2', '[]', '[{"name": "Microsoft Exchange Server 2019"}]', true),
+		('vm-006', 'empty', 'cluster1', '[]', '[]', false)`)
+	require.NoError(t, err)
+
+	counts, err := parser.ApplicationCounts(ctx, Filters{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"mssql": 1, "mssql-eos": 1, "oracle-db": 1, "sap-hana": 1}, counts)
+
+	counts, err = parser.ApplicationCounts(ctx, Filters{Cluster: "cluster2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"oracle-db": 1, "sap-hana": 1}, counts)
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"mssql": 1, "mssql-eos": 1, "oracle-db": 1, "sap-hana": 1}, inv.VCenter.VMs.Applications)
+}
@@ -0,0 +1,136 @@
+package duckdb_parser
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxExtractedCSVBytes caps the total uncompressed size of the CSV files extracted from an
+// RVTools CSV export, protecting the worker's disk against zip bombs.
+const maxExtractedCSVBytes = 4 << 30 // 4 GiB
+
+// xlsxWorkbookEntry is present in every XLSX archive and never in a zip of CSV files.
+const xlsxWorkbookEntry = "xl/workbook.xml"
+
+// rvtoolsTabsBySuffix holds the lower-cased tab names, longest first, so that a file named
+// after "dvSwitch" is never mistaken for a shorter tab name it ends with.
+var rvtoolsTabsBySuffix = func() []string {
+	tabs := make([]string, len(rvtoolsTabs))
+	copy(tabs, rvtoolsTabs)
+	sort.SliceStable(tabs, func(i, j int) bool { return len(tabs[i]) > len(tabs[j]) })
+	return tabs
+}()
+
+// detectRvtoolsTab returns the RVTools tab a CSV file belongs to, based on its name.
+// RVTools names CSV exports after the tab (e.g. "RVTools_tabvInfo.csv" or "vInfo.csv").
+func detectRvtoolsTab(fileName string) (string, bool) {
+	base := path.Base(fileName)
+	if !strings.EqualFold(path.Ext(base), ".csv") {
+		return "", false
+	}
+	stem := strings.ToLower(strings.TrimSuffix(base, path.Ext(base)))
+	for _, tab := range rvtoolsTabsBySuffix {
+		if strings.HasSuffix(stem, strings.ToLower(tab)) {
+			return tab, true
+		}
+	}
+	return "", false
+}
+
+// openRvtoolsSource inspects an uploaded RVTools file and returns where its tabs are read from.
+// Excel workbooks are returned as-is. Zip archives of CSV files are extracted to a temporary
+// directory, one file per detected tab; the returned cleanup function removes it.
+func openRvtoolsSource(filePath string) (RvtoolsSource, func(), error) {
+	excel := RvtoolsSource{ExcelFile: filePath}
+	noop := func() {}
+
+	archive, err := zip.OpenReader(filePath)
+	if err != nil {
+		// Not a zip archive: let the Excel reader report the problem
+		return excel, noop, nil
+	}
+	defer func() { _ = archive.Close() }()
+
+	tabFiles := make(map[string]*zip.File)
+	hasCSV := false
+	for _, f := range archive.File {
+		if f.Name == xlsxWorkbookEntry {
+			return excel, noop, nil
+		}
+		if f.FileInfo().IsDir() || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+		tab, ok := detectRvtoolsTab(f.Name)
+		if strings.EqualFold(path.Ext(f.Name), ".csv") {
+			hasCSV = true
+		}
+		if !ok {
+			continue
+		}
+		if existing, found := tabFiles[tab]; found {
+			zap.S().Warnw("duplicate RVTools CSV tab, keeping first file", "tab", tab, "kept", existing.Name, "ignored", f.Name)
+			continue
+		}
+		tabFiles[tab] = f
+	}
+	if !hasCSV {
+		return excel, noop, nil
+	}
+
+	dir, err := os.MkdirTemp("", "rvtools-csv-*")
+	if err != nil {
+		return RvtoolsSource{}, noop, fmt.Errorf("creating extraction directory: %w", err)
+	}
+	cleanup := func() { _ = os.RemoveAll(dir) }
+
+	source := RvtoolsSource{CSVTabs: make(map[string]string, len(tabFiles))}
+	var remaining int64 = maxExtractedCSVBytes
+	for tab, f := range tabFiles {
+		// Files are written under the tab name, never the archive entry name
+		dest := filepath.Join(dir, tab+".csv")
+		n, err := extractZipFile(f, dest, remaining)
+		if err != nil {
+			cleanup()
+			return RvtoolsSource{}, noop, fmt.Errorf("extracting %s: %w", f.Name, err)
+		}
+		remaining -= n
+		source.CSVTabs[tab] = dest
+	}
+
+	return source, cleanup, nil
+}
+
+// extractZipFile writes a zip entry to dest, failing if it is larger than limit bytes.
+func extractZipFile(f *zip.File, dest string, limit int64) (int64, error) {
+	src, err := f.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = src.Close() }()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return 0, err
+	}
+
+	n, copyErr := io.Copy(out, io.LimitReader(src, limit+1))
+	closeErr := out.Close()
+	if copyErr != nil {
+		return n, copyErr
+	}
+	if closeErr != nil {
+		return n, closeErr
+	}
+	if n > limit {
+		return n, fmt.Errorf("RVTools CSV export exceeds the maximum uncompressed size of %d GiB", maxExtractedCSVBytes>>30)
+	}
+	return n, nil
+}
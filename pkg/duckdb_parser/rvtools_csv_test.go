@@ -0,0 +1,214 @@
+package duckdb_parser
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+// createTestZip writes the given entries (name → content) to a zip archive and returns its path.
+func createTestZip(t *testing.T, entries map[string]string) string {
+	t.Helper()
+
+	zipPath := filepath.Join(t.TempDir(), "rvtools-export.zip")
+	f, err := os.Create(zipPath)
+	require.NoError(t, err)
+
+	w := zip.NewWriter(f)
+	for name, content := range entries {
+		entry, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = entry.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	return zipPath
+}
+
+func TestDetectRvtoolsTab(t *testing.T) {
+	tests := []struct {
+		fileName string
+		tab      string
+		ok       bool
+	}{
+		{"vInfo.csv", "vInfo", true},
+		{"RVTools_tabvInfo.csv", "vInfo", true},
+		{"export/RVTools_tabvHost.CSV", "vHost", true},
+		{"RVTools_tabdvSwitch.csv", "dvSwitch", true},
+		{"RVTools_tabdvPort.csv", "dvPort", true},
+		{"RVTools_tabvSwitch.csv", "", false},
+		{"RVTools_tabvPort.csv", "", false},
+		{"vInfo.xlsx", "", false},
+		{"README.txt", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			tab, ok := detectRvtoolsTab(tt.fileName)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.tab, tab)
+		})
+	}
+}
+
+func TestOpenRvtoolsSource_ExcelWorkbook(t *testing.T) {
+	zipPath := createTestZip(t, map[string]string{
+		"[Content_Types].xml": "<Types/>",
+		xlsxWorkbookEntry:     "<workbook/>",
+	})
+
+	source, cleanup, err := openRvtoolsSource(zipPath)
+	require.NoError(t, err)
+	defer cleanup()
+
+	assert.Equal(t, zipPath, source.ExcelFile)
+	assert.Empty(t, source.CSVTabs)
+}
+
+func TestIngestRvTools_CSVExport(t *testing.T) {
+	ctx := context.Background()
+	parser, db, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	zipPath := createTestZip(t, map[string]string{
+		"RVTools_tabvInfo.csv": "VM,VM ID,VI SDK UUID,Host,CPUs,Memory,Powerstate,Cluster,Datacenter,OS according to the configuration file,Template\n" +
+			"vm-1,vm-001,uuid-1,esxi-host-1,4,8192,poweredOn,cluster1,dc1,Red Hat Enterprise Linux 9 (64-bit),False\n" +
+			"vm-2,vm-002,uuid-1,esxi-host-1,2,4096,poweredOff,cluster1,dc1,Microsoft Windows Server 2019 (64-bit),False\n" +
+			"tpl-1,vm-003,uuid-1,esxi-host-1,2,4096,poweredOff,cluster1,dc1,Red Hat Enterprise Linux 9 (64-bit),True\n",
+		"RVTools_tabvHost.csv": "Datacenter,Cluster,# Cores,# CPU,Object ID,# Memory,Model,Vendor,Host,Config status\n" +
+			"dc1,cluster1,8,2,host-001,32768,PowerEdge,Dell,esxi-host-1,green\n",
+		"RVTools_tabvDisk.csv": "VM ID,Disk Key,Unit #,Path,Disk Path,Capacity MiB,Raw,Shared Bus,Disk Mode,Thin,Controller,Label,SCSI Unit #\n" +
+			"vm-001,2000,0,[ds1] vm-1/vm-1.vmdk,[ds1] vm-1/vm-1.vmdk,102400,False,noSharing,persistent,True,SCSI controller 0,Hard disk 1,0\n",
+		"__MACOSX/RVTools_tabvInfo.csv": "garbage",
+		"README.txt":                    "exported by RVTools",
+	})
+
+	result, err := parser.IngestRvTools(ctx, zipPath)
+	require.NoError(t, err)
+	require.True(t, result.IsValid(), "unexpected validation errors: %v", result.Errors)
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	assert.Len(t, vms, 2, "templates must be skipped")
+
+	var hostCount, diskCount int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM vhost`).Scan(&hostCount))
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM vdisk WHERE "Thin"`).Scan(&diskCount))
+	assert.Equal(t, 1, hostCount)
+	assert.Equal(t, 1, diskCount)
+
+	var complexity int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT "OsDiskComplexity" FROM vinfo WHERE "VM ID" = 'vm-001'`).Scan(&complexity))
+	assert.NotZero(t, complexity, "complexity must be populated for CSV exports")
+
+	// Missing optional tabs are reported as warnings, like missing Excel sheets
+	codes := make([]string, 0, len(result.Warnings))
+	for _, w := range result.Warnings {
+		codes = append(codes, w.Code)
+	}
+	assert.Contains(t, codes, CodeEmptyDatastores)
+	assert.Contains(t, codes, CodeEmptyNICs)
+
+	inv, err := parser.BuildInventory(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, inv.VCenter.VMs.Total)
+}
+
+func TestIngestRvTools_CSVExportWithoutVInfo(t *testing.T) {
+	ctx := context.Background()
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	zipPath := createTestZip(t, map[string]string{
+		"RVTools_tabvHost.csv": "Datacenter,Cluster,Host\ndc1,cluster1,esxi-host-1\n",
+	})
+
+	_, err := parser.IngestRvTools(ctx, zipPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing required vInfo CSV file")
+}
+
+func TestIngestRvTools_CSVExportUtilization(t *testing.T) {
+	ctx := context.Background()
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	zipPath := createTestZip(t, map[string]string{
+		"RVTools_tabvInfo.csv": "VM,VM ID,VI SDK UUID,Host,CPUs,Memory,Powerstate,Cluster,Datacenter\n" +
+			"vm-1,vm-001,uuid-1,esxi-host-1,16,65536,poweredOn,cluster1,dc1\n" +
+			"vm-2,vm-002,uuid-1,esxi-host-1,2,4096,poweredOn,cluster1,dc1\n",
+		"RVTools_tabvHost.csv": "Datacenter,Cluster,# Cores,# CPU,Object ID,# Memory,Model,Vendor,Host,Config status\n" +
+			"dc1,cluster1,8,2,host-001,32768,PowerEdge,Dell,esxi-host-1,green\n",
+		"RVTools_tabvCPU.csv": "VM ID,CPUs,Max,Overall,Hot Add,Hot Remove\n" +
+			"vm-001,16,40000,5000,True,False\n",
+		// older exports have no Active column
+		"RVTools_tabvMemory.csv": "VM ID,Size MiB,Hot Add\n" +
+			"vm-001,65536,True\n",
+	})
+
+	_, err := parser.IngestRvTools(ctx, zipPath)
+	require.NoError(t, err)
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	require.Len(t, vms, 2)
+	byID := make(map[string]models.VM, len(vms))
+	for _, vm := range vms {
+		byID[vm.ID] = vm
+	}
+
+	vm := byID["vm-001"]
+	assert.True(t, vm.CpuHotAddEnabled)
+	assert.True(t, vm.MemoryHotAddEnabled, "vMemory must be ingested without the Active column")
+	require.NotNil(t, vm.CpuUsagePercent())
+	assert.InDelta(t, 12.5, *vm.CpuUsagePercent(), 0.001)
+	assert.Nil(t, vm.MemoryUsagePercent())
+
+	assert.Nil(t, byID["vm-002"].CpuUsagePercent())
+	assert.Nil(t, byID["vm-002"].MemoryUsagePercent())
+}
+
+func TestIngestRvTools_CSVExportMemoryUsage(t *testing.T) {
+	ctx := context.Background()
+	parser, _, cleanup := setupTestParser(t, &testValidator{})
+	defer cleanup()
+
+	zipPath := createTestZip(t, map[string]string{
+		"RVTools_tabvInfo.csv": "VM,VM ID,VI SDK UUID,Host,CPUs,Memory,Powerstate,Cluster,Datacenter\n" +
+			"vm-1,vm-001,uuid-1,esxi-host-1,2,8192,poweredOn,cluster1,dc1\n" +
+			"vm-2,vm-002,uuid-1,esxi-host-1,2,8192,poweredOn,cluster1,dc1\n" +
+			"vm-3,vm-003,uuid-1,esxi-host-1,2,8192,poweredOn,cluster1,dc1\n",
+		"RVTools_tabvHost.csv": "Datacenter,Cluster,# Cores,# CPU,Object ID,# Memory,Model,Vendor,Host,Config status\n" +
+			"dc1,cluster1,8,2,host-001,32768,PowerEdge,Dell,esxi-host-1,green\n",
+		"RVTools_tabvMemory.csv": "VM ID,Size MiB,Hot Add,Active,Consumed\n" +
+			"vm-001,8192,False,819,6144\n" +
+			"vm-002,8192,False,4096,2048\n" +
+			"vm-003,8192,False,1024,\n",
+	})
+
+	_, err := parser.IngestRvTools(ctx, zipPath)
+	require.NoError(t, err)
+
+	vms, err := parser.VMs(ctx, Filters{}, Options{})
+	require.NoError(t, err)
+	require.Len(t, vms, 3)
+	usage := make(map[string]float64, len(vms))
+	for _, vm := range vms {
+		require.NotNil(t, vm.MemoryUsagePercent(), "VM %s", vm.ID)
+		usage[vm.ID] = *vm.MemoryUsagePercent()
+	}
+
+	// The larger of the consumed and the guest active memory
+	assert.InDelta(t, 75, usage["vm-001"], 0.001)
+	assert.InDelta(t, 50, usage["vm-002"], 0.001)
+	assert.InDelta(t, 12.5, usage["vm-003"], 0.001)
+}
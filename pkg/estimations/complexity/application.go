@@ -0,0 +1,253 @@
+package complexity
+
+import (
+	"slices"
+	"strings"
+)
+
+// MigrationApproach is the way a VM running a business application must be migrated.
+type MigrationApproach = string
+
+const (
+	// ApproachCoordinatedCutover migrates the VM as is, during a cutover window coordinated with
+	// the application owners so that the application data is consistent (quiesced or replicated).
+	ApproachCoordinatedCutover MigrationApproach = "coordinated_cutover"
+	// ApproachReplatform upgrades the application to a supported release before or while migrating.
+	ApproachReplatform MigrationApproach = "replatform"
+	// ApproachVendorValidated requires the vendor to validate the target platform (support,
+	// certification, licensing) before migrating.
+	ApproachVendorValidated MigrationApproach = "vendor_validated"
+)
+
+// ApplicationRule maps a business application to its migration complexity score and approach.
+type ApplicationRule struct {
+	ID   string // stable identifier, stored in the inventory (vms.applications)
+	Name string
+	// Keywords are matched as whole words against the guest application names and the VM labels,
+	// case-insensitively and ignoring punctuation, so that "sql server" matches "app=sql-server".
+	Keywords []string
+	// Excludes are matched like Keywords and rule out the names of the drivers, clients and tools
+	// shipped with the application, e.g. "Microsoft ODBC Driver 17 for SQL Server".
+	Excludes []string
+	// Versions restrict the rule to some releases. A release name (e.g. "2012") must be a word of
+	// the application name; a version number ending with or containing a dot (e.g. "10.", "15.0")
+	// must be a prefix of the application version, up to a dot. Labels carry no version and never
+	// match such rules.
+	Versions []string
+	Score    Score
+	Approach MigrationApproach
+}
+
+// ApplicationRules is the built-in application rule set. The first matching rule wins, so that
+// the release-specific rules come before the generic rule of the same application.
+var ApplicationRules = []ApplicationRule{
+	{
+		ID:       "mssql-eos",
+		Name:     "Microsoft SQL Server (end of support)",
+		Keywords: []string{"sql server", "mssql"},
+		Excludes: mssqlExcludes,
+		Versions: []string{"2005", "2008", "2012", "9.", "10.", "11."},
+		Score:    4,
+		Approach: ApproachReplatform,
+	},
+	{
+		ID:       "mssql",
+		Name:     "Microsoft SQL Server",
+		Keywords: []string{"sql server", "mssql"},
+		Excludes: mssqlExcludes,
+		Score:    3,
+		Approach: ApproachCoordinatedCutover,
+	},
+	{
+		ID:       "oracle-db",
+		Name:     "Oracle Database",
+		Keywords: []string{"oracle database", "oracle db", "oracledb", "oracle rdbms"},
+		Score:    4,
+		Approach: ApproachVendorValidated,
+	},
+	{
+		ID:       "sap-hana",
+		Name:     "SAP HANA",
+		Keywords: []string{"sap hana", "hana database"},
+		Excludes: sapExcludes,
+		Score:    4,
+		Approach: ApproachVendorValidated,
+	},
+	{
+		ID:       "sap",
+		Name:     "SAP",
+		Keywords: []string{"sap netweaver", "sap erp", "s 4hana", "sap"},
+		Excludes: sapExcludes,
+		Score:    4,
+		Approach: ApproachVendorValidated,
+	},
+	{
+		ID:       "exchange-eos",
+		Name:     "Microsoft Exchange Server (end of support)",
+		Keywords: []string{"exchange server", "microsoft exchange", "msexchange"},
+		Versions: []string{"2007", "2010", "2013", "8.", "14.", "15.0"},
+		Score:    4,
+		Approach: ApproachReplatform,
+	},
+	{
+		ID:       "exchange",
+		Name:     "Microsoft Exchange Server",
+		Keywords: []string{"exchange server", "microsoft exchange", "msexchange"},
+		Score:    3,
+		Approach: ApproachCoordinatedCutover,
+	},
+}
+
+// mssqlExcludes are the SQL Server drivers, clients and tools installed on the application servers
+// connecting to a database, rather than on the database servers.
+var mssqlExcludes = []string{"odbc", "oledb", "driver", "native client", "client", "management studio", "ssms", "tools", "management objects"}
+
+// sapExcludes are the SAP front ends, clients, runtimes and tools installed on desktops and on the
+// servers of other applications, rather than on the SAP application and database servers.
+var sapExcludes = []string{"gui", "logon", "client", "runtime", "crystal reports", "tools", "studio"}
+
+// GuestApplication is an application reported by the guest of a VM.
+type GuestApplication struct {
+	Name    string
+	Version string
+}
+
+// ClassifyApplication returns the first rule matching a guest application, if any.
+func ClassifyApplication(app GuestApplication) (ApplicationRule, bool) {
+	name := normalizeWords(app.Name)
+	for _, rule := range ApplicationRules {
+		if rule.matchesName(name) && rule.matchesVersion(app) {
+			return rule, true
+		}
+	}
+	return ApplicationRule{}, false
+}
+
+// ClassifyLabel returns the first rule without versions matching a VM label, if any.
+func ClassifyLabel(label string) (ApplicationRule, bool) {
+	normalized := normalizeWords(label)
+	for _, rule := range ApplicationRules {
+		if len(rule.Versions) == 0 && rule.matchesName(normalized) {
+			return rule, true
+		}
+	}
+	return ApplicationRule{}, false
+}
+
+// DetectApplications returns the IDs of the rules matching the guest applications or the labels
+// of a VM, sorted and without duplicates.
+func DetectApplications(apps []GuestApplication, labels []string) []string {
+	var ids []string
+	for _, app := range apps {
+		if rule, ok := ClassifyApplication(app); ok {
+			ids = append(ids, rule.ID)
+		}
+	}
+	for _, label := range labels {
+		if rule, ok := ClassifyLabel(label); ok {
+			ids = append(ids, rule.ID)
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// ApplicationRuleByID returns the rule with the given ID, if any.
+func ApplicationRuleByID(id string) (ApplicationRule, bool) {
+	i := slices.IndexFunc(ApplicationRules, func(rule ApplicationRule) bool { return rule.ID == id })
+	if i < 0 {
+		return ApplicationRule{}, false
+	}
+	return ApplicationRules[i], true
+}
+
+// VMApplicationEntry represents an application detected in the inventory with its VM count.
+type VMApplicationEntry struct {
+	ID    string // rule ID as stored in the inventory (key in vms.applications)
+	Count int    // Number of VMs running the application
+}
+
+// ApplicationEntry is one row in the per-application complexity breakdown.
+type ApplicationEntry struct {
+	ID       string
+	Name     string
+	Score    Score
+	Approach MigrationApproach
+	VMCount  int
+}
+
+// ApplicationBreakdown returns one ApplicationEntry per detected application, sorted by score
+// then name. Applications whose rule no longer exists are reported with score 0 and no approach.
+func ApplicationBreakdown(entries []VMApplicationEntry) []ApplicationEntry {
+	result := make([]ApplicationEntry, len(entries))
+	for i, e := range entries {
+		result[i] = ApplicationEntry{ID: e.ID, Name: e.ID, VMCount: e.Count}
+		if rule, ok := ApplicationRuleByID(e.ID); ok {
+			result[i].Name = rule.Name
+			result[i].Score = rule.Score
+			result[i].Approach = rule.Approach
+		}
+	}
+	slices.SortFunc(result, func(a, b ApplicationEntry) int {
+		if a.Score != b.Score {
+			return a.Score - b.Score
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+func (r ApplicationRule) matchesName(normalized string) bool {
+	return containsWords(normalized, r.Keywords) && !containsWords(normalized, r.Excludes)
+}
+
+func (r ApplicationRule) matchesVersion(app GuestApplication) bool {
+	if len(r.Versions) == 0 {
+		return true
+	}
+	name := normalizeWords(app.Name)
+	version := strings.TrimSpace(app.Version)
+	return slices.ContainsFunc(r.Versions, func(v string) bool {
+		if !strings.Contains(v, ".") {
+			return strings.Contains(name, normalizeWords(v))
+		}
+		if !strings.HasPrefix(version, v) {
+			return false
+		}
+		// "15.0" must not match "15.01"
+		rest := version[len(v):]
+		return strings.HasSuffix(v, ".") || rest == "" || rest[0] == '.'
+	})
+}
+
+// containsWords reports whether one of the keywords is found as whole words in a name normalized
+// with normalizeWords.
+func containsWords(normalized string, keywords []string) bool {
+	return slices.ContainsFunc(keywords, func(keyword string) bool {
+		return strings.Contains(normalized, normalizeWords(keyword))
+	})
+}
+
+// normalizeWords lowercases s, replaces every run of characters other than letters and digits
+// with a single space and pads the result with spaces, so that whole words can be matched with
+// strings.Contains.
+func normalizeWords(s string) string {
+	var b strings.Builder
+	b.WriteByte(' ')
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if ('a' <= r && r <= 'z') || ('0' <= r && r <= '9') {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	if !space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package complexity_test
+
+import (
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClassifyApplication", func() {
+	DescribeTable("matches guest applications by name and version",
+		func(name, version, expectedID string) {
+			rule, ok := complexity.ClassifyApplication(complexity.GuestApplication{Name: name, Version: version})
+			if expectedID == "" {
+				Expect(ok).To(BeFalse())
+				return
+			}
+			Expect(ok).To(BeTrue())
+			Expect(rule.ID).To(Equal(expectedID))
+		},
+		Entry("SQL Server 2019", "Microsoft SQL Server 2019 (64-bit)", "15.0.2000.5", "mssql"),
+		Entry("SQL Server 2012 by name", "Microsoft SQL Server 2012", "", "mssql-eos"),
+		Entry("SQL Server 2008 R2 by version", "Microsoft SQL Server", "10.50.1600.1", "mssql-eos"),
+		Entry("MSSQL on Linux", "mssql-server", "16.0.4135.4", "mssql"),
+		Entry("Oracle Database", "Oracle Database 19c Enterprise Edition", "19.3.0.0", "oracle-db"),
+		Entry("SAP HANA before SAP", "SAP HANA Database", "2.00.059", "sap-hana"),
+		Entry("SAP NetWeaver", "SAP NetWeaver AS ABAP", "7.5", "sap"),
+		Entry("Exchange 2010 by name", "Microsoft Exchange Server 2010", "", "exchange-eos"),
+		Entry("Exchange 2013 by version", "Microsoft Exchange Server", "15.0.1497.2", "exchange-eos"),
+		Entry("Exchange 2019", "Microsoft Exchange Server 2019", "15.2.1118.7", "exchange"),
+		Entry("SQL Server 2019 CU 10 is not a 10.x release", "Microsoft SQL Server 2019 CU 10", "15.0.4123.1", "mssql"),
+		Entry("Exchange 2019 is not a 15.0 release", "Microsoft Exchange Server 2019", "15.01.2507", "exchange"),
+		Entry("versions are not matched inside the name", "Microsoft SQL Server 2019 build 10.50", "15.0.2000.5", "mssql"),
+		Entry("SQL Server ODBC driver", "Microsoft ODBC Driver 17 for SQL Server", "17.10.1.1", ""),
+		Entry("SQL Server Native Client", "Microsoft SQL Server 2012 Native Client", "11.4.7001.0", ""),
+		Entry("SQL Server Management Studio", "SQL Server Management Studio", "19.1.56.0", ""),
+		Entry("SAP GUI", "SAP GUI for Windows 7.70", "7.70", ""),
+		Entry("SAP Crystal Reports runtime", "SAP Crystal Reports runtime engine for .NET Framework (64-bit)", "13.0.32.4286", ""),
+		Entry("SAP HANA client", "SAP HANA Client 2.0", "2.18.24", ""),
+		Entry("unknown application", "Mozilla Firefox", "118.0", ""),
+		Entry("keywords match whole words only", "Sapphire Tools", "", ""),
+		Entry("Oracle Java is not a database", "Oracle Java 8", "", ""),
+	)
+})
+
+var _ = Describe("ClassifyLabel", func() {
+	It("matches labels ignoring case and punctuation", func() {
+		rule, ok := complexity.ClassifyLabel("app=SQL-Server")
+		Expect(ok).To(BeTrue())
+		Expect(rule.ID).To(Equal("mssql"))
+	})
+
+	It("never matches release-specific rules", func() {
+		rule, ok := complexity.ClassifyLabel("exchange-server-2010")
+		Expect(ok).To(BeTrue())
+		Expect(rule.ID).To(Equal("exchange"))
+	})
+
+	It("ignores unrelated labels", func() {
+		_, ok := complexity.ClassifyLabel("production")
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("DetectApplications", func() {
+	It("returns sorted IDs without duplicates", func() {
+		ids := complexity.DetectApplications(
+			[]complexity.GuestApplication{
+				{Name: "Oracle Database 19c"},
+				{Name: "Microsoft SQL Server 2019"},
+				{Name: "Notepad++"},
+			},
+			[]string{"mssql", "critical"},
+		)
+		Expect(ids).To(Equal([]string{"mssql", "oracle-db"}))
+	})
+
+	It("returns nothing for a VM without business applications", func() {
+		Expect(complexity.DetectApplications(nil, []string{"production"})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ApplicationBreakdown", func() {
+	It("scores applications and sorts them by score then name", func() {
+		breakdown := complexity.ApplicationBreakdown([]complexity.VMApplicationEntry{
+			{ID: "oracle-db", Count: 2},
+			{ID: "mssql", Count: 5},
+			{ID: "exchange", Count: 1},
+		})
+		Expect(breakdown).To(Equal([]complexity.ApplicationEntry{
+			{ID: "exchange", Name: "Microsoft Exchange Server", Score: 3, Approach: complexity.ApproachCoordinatedCutover, VMCount: 1},
+			{ID: "mssql", Name: "Microsoft SQL Server", Score: 3, Approach: complexity.ApproachCoordinatedCutover, VMCount: 5},
+			{ID: "oracle-db", Name: "Oracle Database", Score: 4, Approach: complexity.ApproachVendorValidated, VMCount: 2},
+		}))
+	})
+
+	It("reports unknown applications with score 0", func() {
+		breakdown := complexity.ApplicationBreakdown([]complexity.VMApplicationEntry{{ID: "retired", Count: 3}})
+		Expect(breakdown).To(Equal([]complexity.ApplicationEntry{{ID: "retired", Name: "retired", VMCount: 3}}))
+	})
+
+	It("returns an empty breakdown without applications", func() {
+		breakdown := complexity.ApplicationBreakdown(nil)
+		Expect(breakdown).NotTo(BeNil())
+		Expect(breakdown).To(BeEmpty())
+	})
+})
@@ -1,10 +1,5 @@
 package complexity
 
-import (
-	"sort"
-	"strings"
-)
-
 // Score is a numeric complexity level. Lower values indicate simpler migrations.
 // OS scores run 0–4; disk scores run 1–4.
 type Score = int
@@ -18,8 +13,7 @@ var DiskScores = []Score{1, 2, 3, 4}
 // OSDifficultyScores maps OS name substrings to numeric complexity scores.
 // ClassifyOS checks whether each key is a case-insensitive substring of the VM's OS name.
 //
-// When multiple keys match the same OS name, all matching keys carry the same score,
-// so non-deterministic map iteration order does not affect the result.
+// When multiple keys match the same OS name, the longest key wins.
 //
 // Score semantics:
 //
@@ -176,15 +170,9 @@ func CombineComplexity(osScore, diskScore Score) Score {
 
 // ClassifyOS returns the numeric complexity score for the given OS name by
 // checking whether each key in OSDifficultyScores appears as a case-insensitive
-// substring of osName. Returns 0 if no key matches (unknown).
+// substring of osName; the longest matching key wins. Returns 0 if no key matches (unknown).
 func ClassifyOS(osName string) Score {
-	normalized := strings.ToLower(osName)
-	for keyword, score := range OSDifficultyScores {
-		if strings.Contains(normalized, strings.ToLower(keyword)) {
-			return score
-		}
-	}
-	return 0
+	return builtin.ClassifyOS(osName)
 }
 
 // ScoreDiskTierLabel returns the numeric score for an inventory disk tier label.
@@ -243,15 +231,7 @@ type DiskComplexityEntry struct {
 // of OSDifficultyEntry in canonical score order (0–4).
 // All five scores are always present; absent scores carry VMCount == 0.
 func OSBreakdown(osEntries []VMOsEntry) []OSDifficultyEntry {
-	counts := make(map[Score]int)
-	for _, entry := range osEntries {
-		counts[ClassifyOS(entry.Name)] += entry.Count
-	}
-	result := make([]OSDifficultyEntry, len(OSScores))
-	for i, s := range OSScores {
-		result[i] = OSDifficultyEntry{Score: s, VMCount: counts[s]}
-	}
-	return result
+	return builtin.OSBreakdown(osEntries)
 }
 
 // OSNameEntry is one row in the per-OS-name complexity breakdown.
@@ -263,48 +243,19 @@ type OSNameEntry struct {
 
 // OSNameBreakdown returns one OSNameEntry per distinct OS name in osEntries.
 func OSNameBreakdown(osEntries []VMOsEntry) []OSNameEntry {
-	result := make([]OSNameEntry, len(osEntries))
-	for i, e := range osEntries {
-		result[i] = OSNameEntry{
-			Name:    e.Name,
-			Score:   ClassifyOS(e.Name),
-			VMCount: e.Count,
-		}
-	}
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].Score != result[j].Score {
-			return result[i].Score < result[j].Score
-		}
-		return result[i].Name < result[j].Name
-	})
-	return result
+	return builtin.OSNameBreakdown(osEntries)
 }
 
 // OSRatings returns a map from OS name to complexity score for every entry in
 // the given slice. Each name is classified via ClassifyOS. Callers can use this
 // map to show per-OS score annotations alongside the aggregate OSBreakdown result.
 func OSRatings(osEntries []VMOsEntry) map[string]Score {
-	result := make(map[string]Score, len(osEntries))
-	for _, e := range osEntries {
-		result[e.Name] = ClassifyOS(e.Name)
-	}
-	return result
+	return builtin.OSRatings(osEntries)
 }
 
 // DiskBreakdown maps pre-computed inventory tier labels to numeric scores and
 // returns a slice of DiskComplexityEntry in canonical score order (1–4).
 // All four scores are always present; absent scores carry VMCount == 0 and TotalSizeTB == 0.
 func DiskBreakdown(tiers []DiskTierInput) []DiskComplexityEntry {
-	byScore := make(map[Score]DiskTierInput)
-	for _, t := range tiers {
-		if s := ScoreDiskTierLabel(t.Label); s != 0 {
-			byScore[s] = t
-		}
-	}
-	result := make([]DiskComplexityEntry, len(DiskScores))
-	for i, s := range DiskScores {
-		t := byScore[s]
-		result[i] = DiskComplexityEntry{Score: s, VMCount: t.VMCount, TotalSizeTB: t.TotalSizeTB}
-	}
-	return result
+	return builtin.DiskBreakdown(tiers)
 }
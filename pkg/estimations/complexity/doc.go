@@ -11,4 +11,7 @@
 //   - Disk complexity (scores 1–4): derived from the pre-computed disk-size tier
 //     labels stored in the inventory by the agent. Score 1 is the least complex;
 //     score 4 is the most complex.
+//
+// The package-level functions score with the built-in tables. A Table scores with a versioned
+// copy of them, e.g. the tables of an organization that disagrees with some scores.
 package complexity
@@ -0,0 +1,272 @@
+package complexity
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+)
+
+// DefaultTableVersion is the version of the built-in scoring tables. It must be bumped whenever
+// OSDifficultyScores, the disk tier scores or the built-in OS support tiers change, so that
+// assessments scored with the previous tables can be told apart.
+const DefaultTableVersion = "builtin-1"
+
+// Bounds of a scoring table; must match api/v1alpha1/openapi.yaml (ComplexityTableCreate).
+const (
+	TableKeyMaxLength = 100
+	TableMaxEntries   = 500
+)
+
+// legacyDiskTierLabels maps the legacy disk tier labels of old inventory snapshots to the
+// complexity tier labels scored by a Table.
+var legacyDiskTierLabels = map[string]string{
+	"Easy (0-10TB)":       "0-10TiB",
+	"Medium (10-20TB)":    "10-20TiB",
+	"Hard (20-50TB)":      "20-50TiB",
+	"White Glove (>50TB)": "50+TiB",
+}
+
+// supportTiers lists the valid values of Table.OSTiers.
+var supportTiers = []string{
+	inventory.TierCertified,
+	inventory.TierVendorSupported,
+	inventory.TierCommunitySupported,
+	inventory.TierSpecialHandling,
+}
+
+// Table is a versioned set of scoring tables: the OS complexity scores, the disk tier scores
+// and the OS support tiers. The built-in tables are returned by DefaultTable; organizations can
+// replace them with their own version.
+//
+// OS keys are matched as case-insensitive substrings of the OS name. When several keys match,
+// the longest one wins, so that "Ubuntu Linux 22.04" can be scored apart from "Ubuntu Linux".
+type Table struct {
+	Version string `json:"version"`
+	// OSDifficultyScores maps OS name substrings to scores 1–4; unmatched OSes score 0.
+	OSDifficultyScores map[string]Score `json:"osDifficultyScores"`
+	// DiskSizeScores maps every disk complexity tier label to a score 1–4.
+	DiskSizeScores map[string]Score `json:"diskSizeScores"`
+	// OSTiers maps OS name substrings to support tiers; unmatched OSes need special handling.
+	OSTiers map[string]string `json:"osTiers"`
+}
+
+// builtin reads the package tables without copying them, for the package-level functions.
+var builtin = Table{
+	Version:            DefaultTableVersion,
+	OSDifficultyScores: OSDifficultyScores,
+	DiskSizeScores:     DiskSizeRangeRatings(),
+	OSTiers:            inventory.DefaultOSTiers(),
+}
+
+// DefaultTable returns a copy of the built-in scoring tables.
+func DefaultTable() Table {
+	return Table{
+		Version:            DefaultTableVersion,
+		OSDifficultyScores: maps.Clone(OSDifficultyScores),
+		DiskSizeScores:     DiskSizeRangeRatings(),
+		OSTiers:            inventory.DefaultOSTiers(),
+	}
+}
+
+// Validate checks that the OS keys are usable substrings, that every score is between 1 and 4,
+// that every disk complexity tier is scored and that the support tiers are known.
+func (t Table) Validate() error {
+	if len(t.OSDifficultyScores) == 0 {
+		return fmt.Errorf("osDifficultyScores must not be empty")
+	}
+	if err := validateTableKeys("osDifficultyScores", slices.Collect(maps.Keys(t.OSDifficultyScores))); err != nil {
+		return err
+	}
+	for keyword, score := range t.OSDifficultyScores {
+		if score < 1 || score > 4 {
+			return fmt.Errorf("osDifficultyScores: score of %q must be between 1 and 4, got: %d", keyword, score)
+		}
+	}
+
+	for label := range t.DiskSizeScores {
+		if _, ok := builtin.DiskSizeScores[label]; !ok {
+			return fmt.Errorf("diskSizeScores: unknown disk tier %q", label)
+		}
+	}
+	for _, label := range slices.Sorted(maps.Keys(builtin.DiskSizeScores)) {
+		score, ok := t.DiskSizeScores[label]
+		if !ok {
+			return fmt.Errorf("diskSizeScores: disk tier %q is not scored", label)
+		}
+		if score < 1 || score > 4 {
+			return fmt.Errorf("diskSizeScores: score of %q must be between 1 and 4, got: %d", label, score)
+		}
+	}
+
+	if err := validateTableKeys("osTiers", slices.Collect(maps.Keys(t.OSTiers))); err != nil {
+		return err
+	}
+	for keyword, tier := range t.OSTiers {
+		if !slices.Contains(supportTiers, tier) {
+			return fmt.Errorf("osTiers: tier of %q must be one of %s, got: %q", keyword, strings.Join(supportTiers, ", "), tier)
+		}
+	}
+	return nil
+}
+
+// validateTableKeys checks the OS name substrings of a table. The substrings are matched with
+// SQL LIKE during ingestion, so the LIKE wildcards are not allowed.
+func validateTableKeys(table string, keys []string) error {
+	if len(keys) > TableMaxEntries {
+		return fmt.Errorf("%s must have at most %d entries, got: %d", table, TableMaxEntries, len(keys))
+	}
+	seen := make(map[string]string, len(keys))
+	for _, key := range slices.Sorted(slices.Values(keys)) {
+		switch {
+		case strings.TrimSpace(key) == "":
+			return fmt.Errorf("%s: OS name must not be empty", table)
+		case key != strings.TrimSpace(key):
+			return fmt.Errorf("%s: OS name %q must not start or end with spaces", table, key)
+		case len(key) > TableKeyMaxLength:
+			return fmt.Errorf("%s: OS name %q must be at most %d characters", table, key, TableKeyMaxLength)
+		case strings.ContainsAny(key, `%_\`):
+			return fmt.Errorf("%s: OS name %q must not contain %%, _ or \\", table, key)
+		}
+		normalized := strings.ToLower(key)
+		if other, ok := seen[normalized]; ok {
+			return fmt.Errorf("%s: OS names %q and %q only differ by case", table, other, key)
+		}
+		seen[normalized] = key
+	}
+	return nil
+}
+
+// ClassifyOS returns the score of the longest key of OSDifficultyScores that is a
+// case-insensitive substring of osName, or 0 if no key matches (unknown).
+func (t Table) ClassifyOS(osName string) Score {
+	keyword, ok := longestMatch(osName, t.OSDifficultyScores)
+	if !ok {
+		return 0
+	}
+	return t.OSDifficultyScores[keyword]
+}
+
+// ClassifyOSTier returns the support tier of the longest key of OSTiers that is a
+// case-insensitive substring of osName. Falls back to TierSpecialHandling.
+func (t Table) ClassifyOSTier(osName string) string {
+	keyword, ok := longestMatch(osName, t.OSTiers)
+	if !ok {
+		return inventory.TierSpecialHandling
+	}
+	return t.OSTiers[keyword]
+}
+
+// ScoreDiskTierLabel returns the score of a disk complexity tier label. Legacy labels of old
+// inventory snapshots are scored as the matching complexity tier. Returns 0 for unknown labels.
+func (t Table) ScoreDiskTierLabel(label string) Score {
+	if tier, ok := legacyDiskTierLabels[label]; ok {
+		label = tier
+	}
+	return t.DiskSizeScores[label]
+}
+
+// DiskSizeRangeRatings returns a copy of the disk tier scores, suitable for API exposure.
+func (t Table) DiskSizeRangeRatings() map[string]Score {
+	return maps.Clone(t.DiskSizeScores)
+}
+
+// ScoreVM returns the combined OS and disk complexity of a VM from its OS name and the total
+// capacity of its disks, in TiB.
+func (t Table) ScoreVM(osName string, totalDiskTB float64) Score {
+	return CombineComplexity(t.ClassifyOS(osName), t.ScoreDiskTierLabel(DiskTierLabel(totalDiskTB)))
+}
+
+// DiskTierLabel returns the disk complexity tier label of a VM from the total capacity of its
+// disks, in TiB. The thresholds match populate_complexity.go.tmpl in pkg/duckdb_parser.
+func DiskTierLabel(totalDiskTB float64) string {
+	switch {
+	case totalDiskTB < 10:
+		return "0-10TiB"
+	case totalDiskTB < 20:
+		return "10-20TiB"
+	case totalDiskTB < 50:
+		return "20-50TiB"
+	}
+	return "50+TiB"
+}
+
+// OSBreakdown classifies each OS entry with the table. See the package-level OSBreakdown.
+func (t Table) OSBreakdown(osEntries []VMOsEntry) []OSDifficultyEntry {
+	counts := make(map[Score]int)
+	for _, entry := range osEntries {
+		counts[t.ClassifyOS(entry.Name)] += entry.Count
+	}
+	result := make([]OSDifficultyEntry, len(OSScores))
+	for i, s := range OSScores {
+		result[i] = OSDifficultyEntry{Score: s, VMCount: counts[s]}
+	}
+	return result
+}
+
+// OSNameBreakdown classifies each OS name with the table. See the package-level OSNameBreakdown.
+func (t Table) OSNameBreakdown(osEntries []VMOsEntry) []OSNameEntry {
+	result := make([]OSNameEntry, len(osEntries))
+	for i, e := range osEntries {
+		result[i] = OSNameEntry{
+			Name:    e.Name,
+			Score:   t.ClassifyOS(e.Name),
+			VMCount: e.Count,
+		}
+	}
+	slices.SortFunc(result, func(a, b OSNameEntry) int {
+		if a.Score != b.Score {
+			return a.Score - b.Score
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+// OSRatings returns the score of every OS name with the table. See the package-level OSRatings.
+func (t Table) OSRatings(osEntries []VMOsEntry) map[string]Score {
+	result := make(map[string]Score, len(osEntries))
+	for _, e := range osEntries {
+		result[e.Name] = t.ClassifyOS(e.Name)
+	}
+	return result
+}
+
+// DiskBreakdown scores the inventory tier labels with the table. See the package-level DiskBreakdown.
+func (t Table) DiskBreakdown(tiers []DiskTierInput) []DiskComplexityEntry {
+	byScore := make(map[Score]DiskTierInput)
+	for _, tier := range tiers {
+		if s := t.ScoreDiskTierLabel(tier.Label); s != 0 {
+			entry := byScore[s]
+			entry.VMCount += tier.VMCount
+			entry.TotalSizeTB += tier.TotalSizeTB
+			byScore[s] = entry
+		}
+	}
+	result := make([]DiskComplexityEntry, len(DiskScores))
+	for i, s := range DiskScores {
+		tier := byScore[s]
+		result[i] = DiskComplexityEntry{Score: s, VMCount: tier.VMCount, TotalSizeTB: tier.TotalSizeTB}
+	}
+	return result
+}
+
+// longestMatch returns the longest key of table that is a case-insensitive substring of osName.
+// Keys of the same length are tie-broken alphabetically so that the result is deterministic.
+func longestMatch[V any](osName string, table map[string]V) (string, bool) {
+	normalized := strings.ToLower(osName)
+	var best string
+	found := false
+	for keyword := range table {
+		if !strings.Contains(normalized, strings.ToLower(keyword)) {
+			continue
+		}
+		if !found || len(keyword) > len(best) || (len(keyword) == len(best) && keyword < best) {
+			best = keyword
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,78 @@
+package complexity_test
+
+import (
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/complexity"
+	"github.com/kubev2v/migration-planner/pkg/inventory"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Table", func() {
+	var table complexity.Table
+
+	BeforeEach(func() {
+		table = complexity.DefaultTable()
+	})
+
+	It("scores like the package-level functions by default", func() {
+		Expect(table.Version).To(Equal(complexity.DefaultTableVersion))
+		Expect(table.Validate()).To(Succeed())
+		for _, osName := range []string{"AlmaLinux 9 (64-bit)", "Microsoft Windows Server 2019 (64-bit)", "Plan 9"} {
+			Expect(table.ClassifyOS(osName)).To(Equal(complexity.ClassifyOS(osName)), osName)
+			Expect(table.ClassifyOSTier(osName)).To(Equal(inventory.ClassifyOSTier(osName)), osName)
+		}
+		Expect(table.DiskSizeRangeRatings()).To(Equal(complexity.DiskSizeRangeRatings()))
+	})
+
+	It("does not share its maps with the built-in tables", func() {
+		table.OSDifficultyScores["AlmaLinux 9"] = 1
+		Expect(complexity.ClassifyOS("AlmaLinux 9 (64-bit)")).To(Equal(3))
+	})
+
+	It("scores overridden OSes and disk tiers", func() {
+		table.OSDifficultyScores["AlmaLinux 9"] = 1
+		table.DiskSizeScores["10-20TiB"] = 1
+
+		Expect(table.ClassifyOS("AlmaLinux 9 (64-bit)")).To(Equal(1))
+		Expect(table.ScoreDiskTierLabel("10-20TiB")).To(Equal(1))
+		Expect(table.ScoreDiskTierLabel("Medium (10-20TB)")).To(Equal(1))
+		Expect(table.DiskBreakdown([]complexity.DiskTierInput{
+			{Label: "0-10TiB", VMCount: 4, TotalSizeTB: 2},
+			{Label: "10-20TiB", VMCount: 1, TotalSizeTB: 15},
+		})[0]).To(Equal(complexity.DiskComplexityEntry{Score: 1, VMCount: 5, TotalSizeTB: 17}))
+	})
+
+	It("matches the longest OS name", func() {
+		table.OSDifficultyScores["Ubuntu Linux 22.04"] = 1
+
+		Expect(table.ClassifyOS("Ubuntu Linux 22.04 (64-bit)")).To(Equal(1))
+		Expect(table.ClassifyOS("Ubuntu Linux 20.04 (64-bit)")).To(Equal(3))
+	})
+
+	It("scores a VM from its OS and disk capacity", func() {
+		Expect(table.ScoreVM("Red Hat Enterprise Linux 9 (64-bit)", 0.5)).To(Equal(1))
+		Expect(table.ScoreVM("Red Hat Enterprise Linux 9 (64-bit)", 25)).To(Equal(3))
+		Expect(table.ScoreVM("Plan 9", 0.5)).To(Equal(0))
+	})
+
+	DescribeTable("rejects invalid tables",
+		func(mutate func(t *complexity.Table), message string) {
+			mutate(&table)
+			err := table.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("empty OS scores", func(t *complexity.Table) { t.OSDifficultyScores = nil }, "must not be empty"),
+		Entry("OS score out of range", func(t *complexity.Table) { t.OSDifficultyScores["AlmaLinux 9"] = 5 }, "between 1 and 4"),
+		Entry("unknown OS score", func(t *complexity.Table) { t.OSDifficultyScores["AlmaLinux 9"] = 0 }, "between 1 and 4"),
+		Entry("LIKE wildcard", func(t *complexity.Table) { t.OSDifficultyScores["Alma%"] = 2 }, "must not contain"),
+		Entry("padded OS name", func(t *complexity.Table) { t.OSDifficultyScores[" Alma"] = 2 }, "spaces"),
+		Entry("OS name too long", func(t *complexity.Table) { t.OSDifficultyScores[strings.Repeat("a", 101)] = 2 }, "at most 100"),
+		Entry("OS names differing by case", func(t *complexity.Table) { t.OSDifficultyScores["almalinux 9"] = 3 }, "only differ by case"),
+		Entry("missing disk tier", func(t *complexity.Table) { delete(t.DiskSizeScores, "50+TiB") }, `"50+TiB" is not scored`),
+		Entry("unknown disk tier", func(t *complexity.Table) { t.DiskSizeScores["100+TiB"] = 4 }, "unknown disk tier"),
+		Entry("unknown support tier", func(t *complexity.Table) { t.OSTiers["AlmaLinux 9"] = "gold" }, "tier of"),
+	)
+})
@@ -1,10 +1,7 @@
 package engines
 
 import (
-	"fmt"
-
 	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
-	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
 )
 
 // Schema identifies a named set of calculators to run.
@@ -15,35 +12,11 @@ const (
 	SchemaStorageOffload Schema = "storage-offload"
 )
 
-var allSchemas = []Schema{SchemaNetworkBased, SchemaStorageOffload}
-
-var schemaBuilders = map[Schema]func(*estimation.Engine){
-	SchemaNetworkBased: func(e *estimation.Engine) {
-		e.Register(calculators.NewStorageMigration())
-		e.Register(calculators.NewPostMigrationTroubleShooting())
-	},
-	SchemaStorageOffload: func(e *estimation.Engine) {
-		e.Register(calculators.NewStorageOffload())
-		e.Register(calculators.NewPostMigrationTroubleShooting())
-	},
-}
+var defaultRegistry = DefaultRegistry()
 
-// BuildEngines returns one Engine per requested schema.
-// If schemas is nil or empty, all known schemas are used.
+// BuildEngines returns one Engine per requested built-in schema.
+// If schemas is nil or empty, all built-in schemas are used.
 // Returns an error if any schema name is unrecognised.
 func BuildEngines(schemas []Schema) (map[Schema]*estimation.Engine, error) {
-	if len(schemas) == 0 {
-		schemas = allSchemas
-	}
-	result := make(map[Schema]*estimation.Engine, len(schemas))
-	for _, s := range schemas {
-		builder, ok := schemaBuilders[s]
-		if !ok {
-			return nil, fmt.Errorf("unknown estimation schema %q", s)
-		}
-		e := estimation.NewEngine()
-		builder(e)
-		result[s] = e
-	}
-	return result, nil
+	return defaultRegistry.BuildEngines(schemas)
 }
@@ -0,0 +1,300 @@
+package engines
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+// Calculator types usable in a SchemaDefinition.
+const (
+	CalculatorStorageMigration    = "storage-migration"
+	CalculatorStorageOffload      = "storage-offload"
+	CalculatorPostMigrationChecks = "post-migration-checks"
+	CalculatorChangeWindow        = "change-window"
+	CalculatorRemediation         = "remediation"
+)
+
+// SchemaDefinition declares an estimation schema: the calculators it runs, in order.
+type SchemaDefinition struct {
+	Name        Schema                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Calculators []CalculatorDefinition `json:"calculators"`
+}
+
+// CalculatorDefinition declares one calculator of a schema. Params holds the calculator defaults,
+// keyed by estimation param key (e.g. transfer_rate_mbps for WithTransferRateMbps). Values passed
+// with an estimation request still take precedence over them.
+type CalculatorDefinition struct {
+	Type   string             `json:"type"`
+	Params map[string]float64 `json:"params,omitempty"`
+}
+
+// schemaFile is the layout of a schema configuration file.
+type schemaFile struct {
+	Schemas []SchemaDefinition `json:"schemas"`
+}
+
+// calculatorType describes how to build a calculator from its definition.
+type calculatorType struct {
+	// keys are the estimation param keys read by the calculator
+	keys []string
+	// options are the param keys that can be set as calculator defaults
+	options []string
+	build   func(params map[string]float64) estimation.Calculator
+}
+
+var calculatorTypes = map[string]calculatorType{
+	CalculatorStorageMigration: {
+		keys:    []string{calculators.ParamTotalDiskGB, calculators.ParamTransferRateMbps},
+		options: []string{calculators.ParamTransferRateMbps},
+		build: func(params map[string]float64) estimation.Calculator {
+			var opts []calculators.StorageMigrationOption
+			if v, ok := params[calculators.ParamTransferRateMbps]; ok {
+				opts = append(opts, calculators.WithTransferRateMbps(v))
+			}
+			return calculators.NewStorageMigration(opts...)
+		},
+	},
+	CalculatorStorageOffload: {
+		keys: []string{calculators.ParamTotalDiskGB},
+		build: func(map[string]float64) estimation.Calculator {
+			return calculators.NewStorageOffload()
+		},
+	},
+	CalculatorPostMigrationChecks: {
+		keys: []string{
+			calculators.ParamVMCount,
+			calculators.ParamTroubleshootMinsPerVM,
+			calculators.ParamPostMigrationEngineers,
+			calculators.ParamWorkHoursPerDay,
+		},
+		options: []string{
+			calculators.ParamTroubleshootMinsPerVM,
+			calculators.ParamPostMigrationEngineers,
+			calculators.ParamWorkHoursPerDay,
+		},
+		build: func(params map[string]float64) estimation.Calculator {
+			var opts []calculators.PostMigrationTroubleshootingOption
+			if v, ok := params[calculators.ParamTroubleshootMinsPerVM]; ok {
+				opts = append(opts, calculators.WithTroubleshootMinsPerVM(v))
+			}
+			if v, ok := params[calculators.ParamPostMigrationEngineers]; ok {
+				opts = append(opts, calculators.WithEngineerCount(int(v)))
+			}
+			if v, ok := params[calculators.ParamWorkHoursPerDay]; ok {
+				opts = append(opts, calculators.WithWorkHoursPerDay(v))
+			}
+			return calculators.NewPostMigrationTroubleShooting(opts...)
+		},
+	},
+	CalculatorChangeWindow: {
+		keys: []string{
+			calculators.ParamVMCount,
+			calculators.ParamVMsPerChangeWindow,
+			calculators.ParamChangeWindowHours,
+		},
+		options: []string{
+			calculators.ParamVMsPerChangeWindow,
+			calculators.ParamChangeWindowHours,
+		},
+		build: func(params map[string]float64) estimation.Calculator {
+			var opts []calculators.ChangeWindowOption
+			if v, ok := params[calculators.ParamVMsPerChangeWindow]; ok {
+				opts = append(opts, calculators.WithVMsPerChangeWindow(int(v)))
+			}
+			if v, ok := params[calculators.ParamChangeWindowHours]; ok {
+				opts = append(opts, calculators.WithChangeWindowHours(v))
+			}
+			return calculators.NewChangeWindow(opts...)
+		},
+	},
+	CalculatorRemediation: {
+		keys: []string{
+			calculators.ParamRemediationHours,
+			calculators.ParamRemediationEngineers,
+		},
+		options: []string{
+			calculators.ParamRemediationEngineers,
+		},
+		build: func(params map[string]float64) estimation.Calculator {
+			var opts []calculators.RemediationOption
+			if v, ok := params[calculators.ParamRemediationEngineers]; ok {
+				opts = append(opts, calculators.WithRemediationEngineers(int(v)))
+			}
+			return calculators.NewRemediation(opts...)
+		},
+	},
+}
+
+// builtinSchemas are the schemas available without any configuration.
+var builtinSchemas = []SchemaDefinition{
+	{
+		Name:        SchemaNetworkBased,
+		Description: "Disks are copied over the network, followed by post-migration checks",
+		Calculators: []CalculatorDefinition{
+			{Type: CalculatorStorageMigration},
+			{Type: CalculatorPostMigrationChecks},
+		},
+	},
+	{
+		Name:        SchemaStorageOffload,
+		Description: "Disks are copied by the storage array (x-copy), followed by post-migration checks",
+		Calculators: []CalculatorDefinition{
+			{Type: CalculatorStorageOffload},
+			{Type: CalculatorPostMigrationChecks},
+		},
+	},
+}
+
+// Registry holds the estimation schemas known to the service. It is immutable once built
+// and safe for concurrent use.
+type Registry struct {
+	schemas map[Schema]SchemaDefinition
+	order   []Schema
+}
+
+// NewRegistry validates the definitions and returns a registry holding them, in the given order.
+// A definition replaces an earlier one with the same name.
+func NewRegistry(defs ...SchemaDefinition) (*Registry, error) {
+	r := &Registry{schemas: make(map[Schema]SchemaDefinition, len(defs))}
+	for _, def := range defs {
+		if err := def.validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := r.schemas[def.Name]; !exists {
+			r.order = append(r.order, def.Name)
+		}
+		r.schemas[def.Name] = def
+	}
+	return r, nil
+}
+
+// DefaultRegistry returns a registry holding the built-in schemas.
+func DefaultRegistry() *Registry {
+	r, err := NewRegistry(builtinSchemas...)
+	if err != nil {
+		panic(fmt.Sprintf("engines: invalid built-in schema: %v", err))
+	}
+	return r
+}
+
+// LoadRegistry reads schema definitions from a YAML or JSON file of the form
+// `schemas: [{name, description, calculators: [{type, params}]}]` and returns a registry holding
+// the built-in schemas followed by the schemas of the file. A schema of the file named after a
+// built-in schema replaces it.
+func LoadRegistry(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read estimation schemas file: %w", err)
+	}
+
+	var file schemaFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse estimation schemas file: %w", err)
+	}
+	if len(file.Schemas) == 0 {
+		return nil, fmt.Errorf("estimation schemas file %s defines no schemas", path)
+	}
+
+	defs := append(append([]SchemaDefinition{}, builtinSchemas...), file.Schemas...)
+	return NewRegistry(defs...)
+}
+
+// Names returns the names of the registered schemas, in registration order.
+func (r *Registry) Names() []Schema {
+	return append([]Schema(nil), r.order...)
+}
+
+// Schemas returns the registered schema definitions, in registration order.
+func (r *Registry) Schemas() []SchemaDefinition {
+	result := make([]SchemaDefinition, 0, len(r.order))
+	for _, name := range r.order {
+		result = append(result, r.schemas[name])
+	}
+	return result
+}
+
+// BuildEngines returns one Engine per requested schema.
+// If schemas is nil or empty, all registered schemas are used.
+// Returns an error if any schema name is unrecognised.
+func (r *Registry) BuildEngines(schemas []Schema) (map[Schema]*estimation.Engine, error) {
+	if len(schemas) == 0 {
+		schemas = r.order
+	}
+	result := make(map[Schema]*estimation.Engine, len(schemas))
+	for _, s := range schemas {
+		def, ok := r.schemas[s]
+		if !ok {
+			return nil, fmt.Errorf("unknown estimation schema %q", s)
+		}
+		e := estimation.NewEngine()
+		for _, c := range def.Calculators {
+			e.Register(calculatorTypes[c.Type].build(c.Params))
+		}
+		result[s] = e
+	}
+	return result, nil
+}
+
+// ParamKeys returns the sorted estimation param keys read by the calculators of the schema.
+func (d SchemaDefinition) ParamKeys() []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, c := range d.Calculators {
+		for _, k := range calculatorTypes[c.Type].keys {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CalculatorName returns the name under which the calculator reports its estimation.
+func (d CalculatorDefinition) CalculatorName() string {
+	ct, ok := calculatorTypes[d.Type]
+	if !ok {
+		return ""
+	}
+	return ct.build(nil).Name()
+}
+
+func (d SchemaDefinition) validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("estimation schema name is required")
+	}
+	if len(d.Calculators) == 0 {
+		return fmt.Errorf("estimation schema %q has no calculators", d.Name)
+	}
+
+	usedTypes := make(map[string]bool, len(d.Calculators))
+	for _, c := range d.Calculators {
+		ct, ok := calculatorTypes[c.Type]
+		if !ok {
+			return fmt.Errorf("estimation schema %q: unknown calculator type %q", d.Name, c.Type)
+		}
+		if usedTypes[c.Type] {
+			return fmt.Errorf("estimation schema %q: calculator type %q is listed more than once", d.Name, c.Type)
+		}
+		usedTypes[c.Type] = true
+
+		for key, value := range c.Params {
+			if !slices.Contains(ct.options, key) {
+				return fmt.Errorf("estimation schema %q: calculator %q does not accept param %q", d.Name, c.Type, key)
+			}
+			if value <= 0 {
+				return fmt.Errorf("estimation schema %q: param %q of calculator %q must be positive", d.Name, key, c.Type)
+			}
+		}
+	}
+	return nil
+}
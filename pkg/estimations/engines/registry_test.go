@@ -0,0 +1,192 @@
+package engines_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/engines"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation/calculators"
+)
+
+func writeSchemasFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write schemas file: %v", err)
+	}
+	return path
+}
+
+func TestDefaultRegistry_BuiltinSchemas(t *testing.T) {
+	t.Parallel()
+	r := engines.DefaultRegistry()
+
+	names := r.Names()
+	expected := []engines.Schema{engines.SchemaNetworkBased, engines.SchemaStorageOffload}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+
+	keys := r.Schemas()[0].ParamKeys()
+	expectedKeys := []string{
+		calculators.ParamPostMigrationEngineers,
+		calculators.ParamTotalDiskGB,
+		calculators.ParamTransferRateMbps,
+		calculators.ParamTroubleshootMinsPerVM,
+		calculators.ParamVMCount,
+		calculators.ParamWorkHoursPerDay,
+	}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("expected keys %v, got %v", expectedKeys, keys)
+	}
+}
+
+func TestLoadRegistry_YAML(t *testing.T) {
+	t.Parallel()
+	path := writeSchemasFile(t, "schemas.yaml", `
+schemas:
+  - name: slow-network
+    description: Network copy over a WAN link, cutover in change windows
+    calculators:
+      - type: storage-migration
+        params:
+          transfer_rate_mbps: 100
+      - type: change-window
+        params:
+          vms_per_change_window: 10
+          change_window_hours: 2
+`)
+	r, err := engines.LoadRegistry(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	names := r.Names()
+	if len(names) != 3 || names[2] != "slow-network" {
+		t.Fatalf("expected built-in schemas followed by slow-network, got %v", names)
+	}
+
+	result, err := r.BuildEngines([]engines.Schema{"slow-network"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	estimations := result["slow-network"].Run([]estimation.Param{
+		{Key: calculators.ParamTotalDiskGB, Value: 1000.0},
+		{Key: calculators.ParamVMCount, Value: 25},
+	})
+	if len(estimations) != 2 {
+		t.Fatalf("expected 2 estimations, got %d", len(estimations))
+	}
+
+	// 25 VMs / 10 per window = 3 windows * 2h
+	if d := estimations["Change Windows"].Duration; d == nil || *d != 6*time.Hour {
+		t.Errorf("expected 6h of change windows, got %v", d)
+	}
+
+	if reason := estimations["Storage Migration"].Reason; !strings.Contains(reason, "at 100 Mbps") {
+		t.Errorf("expected the transfer rate default of the schema, got %q", reason)
+	}
+}
+
+func TestLoadRegistry_Remediation(t *testing.T) {
+	t.Parallel()
+	path := writeSchemasFile(t, "schemas.yaml", `
+schemas:
+  - name: remediate-first
+    calculators:
+      - type: remediation
+        params:
+          remediation_engineers: 2
+      - type: storage-migration
+`)
+	r, err := engines.LoadRegistry(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := r.BuildEngines([]engines.Schema{"remediate-first"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	estimations := result["remediate-first"].Run([]estimation.Param{
+		{Key: calculators.ParamTotalDiskGB, Value: 1000.0},
+		{Key: calculators.ParamRemediationHours, Value: 12.0},
+	})
+
+	// 12 h / 2 engineers
+	if d := estimations["Issue Remediation"].Duration; d == nil || *d != 6*time.Hour {
+		t.Errorf("expected 6h of remediation, got %v", d)
+	}
+}
+
+func TestLoadRegistry_JSONReplacesBuiltin(t *testing.T) {
+	t.Parallel()
+	path := writeSchemasFile(t, "schemas.json", `{"schemas": [
+		{"name": "network-based", "calculators": [{"type": "storage-migration"}]}
+	]}`)
+	r, err := engines.LoadRegistry(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.Names()) != 2 {
+		t.Errorf("expected the built-in schema to be replaced, got %v", r.Names())
+	}
+	if calcs := r.Schemas()[0].Calculators; len(calcs) != 1 {
+		t.Errorf("expected 1 calculator, got %d", len(calcs))
+	}
+}
+
+func TestLoadRegistry_InvalidDefinitions(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		content string
+		errMsg  string
+	}{
+		"unknown calculator": {
+			content: "schemas: [{name: x, calculators: [{type: bogus}]}]",
+			errMsg:  `unknown calculator type "bogus"`,
+		},
+		"unsupported param": {
+			content: "schemas: [{name: x, calculators: [{type: storage-offload, params: {transfer_rate_mbps: 10}}]}]",
+			errMsg:  `does not accept param "transfer_rate_mbps"`,
+		},
+		"non-positive param": {
+			content: "schemas: [{name: x, calculators: [{type: storage-migration, params: {transfer_rate_mbps: 0}}]}]",
+			errMsg:  "must be positive",
+		},
+		"duplicate calculator": {
+			content: "schemas: [{name: x, calculators: [{type: change-window}, {type: change-window}]}]",
+			errMsg:  "listed more than once",
+		},
+		"no calculators": {
+			content: "schemas: [{name: x, calculators: []}]",
+			errMsg:  "has no calculators",
+		},
+		"missing name": {
+			content: "schemas: [{calculators: [{type: change-window}]}]",
+			errMsg:  "name is required",
+		},
+		"unknown field": {
+			content: "schemas: [{name: x, steps: [], calculators: [{type: change-window}]}]",
+			errMsg:  "failed to parse",
+		},
+		"empty file": {
+			content: "schemas: []",
+			errMsg:  "defines no schemas",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := engines.LoadRegistry(writeSchemasFile(t, "schemas.yaml", tc.content))
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Errorf("expected error containing %q, got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package calculators
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamVMsPerChangeWindow is the estimation.Param key for the number of VMs cut over in one change window.
+	ParamVMsPerChangeWindow = "vms_per_change_window"
+	// ParamChangeWindowHours is the estimation.Param key for the length of one change window in hours.
+	ParamChangeWindowHours = "change_window_hours"
+
+	DefaultVMsPerChangeWindow = 25
+	DefaultChangeWindowHours  = 4.0
+)
+
+// Compile-time assertion that ChangeWindow implements the Calculator interface.
+var _ estimation.Calculator = (*ChangeWindow)(nil)
+
+// ChangeWindow estimates the time spent in change windows to cut the VMs over, when
+// customers only allow cutovers during scheduled maintenance windows.
+type ChangeWindow struct {
+	vmsPerWindow int
+	windowHours  float64
+}
+
+// ChangeWindowOption is a functional option for configuring a ChangeWindow calculator.
+type ChangeWindowOption func(*ChangeWindow)
+
+// WithVMsPerChangeWindow sets the number of VMs cut over in one change window.
+// Non-positive values are ignored and the default is kept.
+func WithVMsPerChangeWindow(count int) ChangeWindowOption {
+	return func(c *ChangeWindow) {
+		if count > 0 {
+			c.vmsPerWindow = count
+		}
+	}
+}
+
+// WithChangeWindowHours sets the length of one change window in hours.
+// Non-positive values are ignored and the default is kept.
+func WithChangeWindowHours(hours float64) ChangeWindowOption {
+	return func(c *ChangeWindow) {
+		if hours > 0 {
+			c.windowHours = hours
+		}
+	}
+}
+
+// NewChangeWindow creates a ChangeWindow calculator with default settings.
+// Optional ChangeWindowOption values can be supplied to override the defaults.
+func NewChangeWindow(opts ...ChangeWindowOption) *ChangeWindow {
+	res := ChangeWindow{
+		vmsPerWindow: DefaultVMsPerChangeWindow,
+		windowHours:  DefaultChangeWindowHours,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *ChangeWindow) Name() string { return "Change Windows" }
+
+// Streamed reports that parallel migration streams run their change windows side by side.
+func (c *ChangeWindow) Streamed() bool { return true }
+
+// Keys returns the list of parameter keys required by this calculator.
+func (c *ChangeWindow) Keys() []string {
+	return []string{ParamVMCount}
+}
+
+// Calculate estimates the time spent in change windows: one window per ParamVMsPerChangeWindow VMs.
+// ParamVMsPerChangeWindow and ParamChangeWindowHours are optional and fall back to the struct defaults.
+func (c *ChangeWindow) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	vmParam, ok := params[ParamVMCount]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamVMCount)
+	}
+	vmCount, err := getInt(vmParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if vmCount < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamVMCount)
+	}
+
+	vmsPerWindow := c.vmsPerWindow
+	if p, exists := params[ParamVMsPerChangeWindow]; exists {
+		v, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if v > 0 {
+			vmsPerWindow = v
+		}
+	}
+
+	windowHours := c.windowHours
+	if p, exists := params[ParamChangeWindowHours]; exists {
+		v, err := getFloat(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if v > 0 {
+			windowHours = v
+		}
+	}
+
+	windows := int(math.Ceil(float64(vmCount) / float64(vmsPerWindow)))
+	duration := time.Duration(float64(windows) * windowHours * float64(time.Hour))
+
+	return estimation.NewPointEstimation(duration, fmt.Sprintf("%d VMs in %d change windows of %g h (%d VMs per window)",
+		vmCount, windows, windowHours, vmsPerWindow)), nil
+}
@@ -0,0 +1,72 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestChangeWindow_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewChangeWindow()
+
+	result, err := calc.Calculate(map[string]estimation.Param{
+		ParamVMCount: {Key: ParamVMCount, Value: 60},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 60 VMs / 25 per window = 3 windows * 4h = 12h
+	if result.Duration == nil || *result.Duration != 12*time.Hour {
+		t.Errorf("expected 12h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "3 change windows") {
+		t.Errorf("unexpected reason: %s", result.Reason)
+	}
+}
+
+func TestChangeWindow_Calculate_OptionsAndParams(t *testing.T) {
+	t.Parallel()
+	calc := NewChangeWindow(WithVMsPerChangeWindow(10), WithChangeWindowHours(6))
+
+	result, err := calc.Calculate(map[string]estimation.Param{
+		ParamVMCount: {Key: ParamVMCount, Value: 25},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// 25 VMs / 10 per window = 3 windows * 6h = 18h
+	if *result.Duration != 18*time.Hour {
+		t.Errorf("expected 18h from options, got %v", *result.Duration)
+	}
+
+	// Params take precedence over options
+	result, err = calc.Calculate(map[string]estimation.Param{
+		ParamVMCount:            {Key: ParamVMCount, Value: 25},
+		ParamVMsPerChangeWindow: {Key: ParamVMsPerChangeWindow, Value: 50.0},
+		ParamChangeWindowHours:  {Key: ParamChangeWindowHours, Value: 2},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if *result.Duration != 2*time.Hour {
+		t.Errorf("expected 2h from params, got %v", *result.Duration)
+	}
+}
+
+func TestChangeWindow_Calculate_Errors(t *testing.T) {
+	t.Parallel()
+	calc := NewChangeWindow()
+
+	if _, err := calc.Calculate(map[string]estimation.Param{}); err == nil {
+		t.Error("expected error for missing vm_count")
+	}
+	if _, err := calc.Calculate(map[string]estimation.Param{
+		ParamVMCount: {Key: ParamVMCount, Value: -1},
+	}); err == nil {
+		t.Error("expected error for negative vm_count")
+	}
+}
@@ -0,0 +1,94 @@
+package calculators
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+const (
+	// ParamRemediationHours is the estimation.Param key for the total effort, in hours, to resolve
+	// the migration issues of the VMs before migrating them (see the remediation catalog of the policies).
+	ParamRemediationHours = "remediation_hours"
+	// ParamRemediationEngineers is the estimation.Param key for the number of engineers resolving the issues.
+	ParamRemediationEngineers = "remediation_engineers"
+
+	DefaultRemediationEngineers = 5
+)
+
+// Compile-time assertion that Remediation implements the Calculator interface.
+var _ estimation.Calculator = (*Remediation)(nil)
+
+// Remediation estimates the time spent resolving the migration issues of the VMs, e.g. enabling
+// CBT or upgrading unsupported operating systems, before they can be migrated.
+type Remediation struct {
+	engineerCount int
+}
+
+// RemediationOption is a functional option for configuring a Remediation calculator.
+type RemediationOption func(*Remediation)
+
+// WithRemediationEngineers sets the number of engineers resolving the issues in parallel.
+// Non-positive values are ignored and the default is kept.
+func WithRemediationEngineers(count int) RemediationOption {
+	return func(r *Remediation) {
+		if count > 0 {
+			r.engineerCount = count
+		}
+	}
+}
+
+// NewRemediation creates a Remediation calculator with default settings.
+// Optional RemediationOption values can be supplied to override the defaults.
+func NewRemediation(opts ...RemediationOption) *Remediation {
+	res := Remediation{
+		engineerCount: DefaultRemediationEngineers,
+	}
+
+	for _, opt := range opts {
+		opt(&res)
+	}
+
+	return &res
+}
+
+// Name returns the human-readable name of this calculator.
+func (c *Remediation) Name() string { return "Issue Remediation" }
+
+// Keys returns the list of parameter keys required by this calculator.
+func (c *Remediation) Keys() []string {
+	return []string{ParamRemediationHours}
+}
+
+// Calculate estimates the remediation duration: the remediation effort shared by the engineers.
+// ParamRemediationEngineers is optional and falls back to the struct default.
+func (c *Remediation) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
+	hoursParam, ok := params[ParamRemediationHours]
+	if !ok {
+		return estimation.Estimation{}, fmt.Errorf("missing %s", ParamRemediationHours)
+	}
+	hours, err := getFloat(hoursParam)
+	if err != nil {
+		return estimation.Estimation{}, err
+	}
+	if hours < 0 {
+		return estimation.Estimation{}, fmt.Errorf("%s must be non-negative", ParamRemediationHours)
+	}
+
+	engineerCount := c.engineerCount
+	if p, exists := params[ParamRemediationEngineers]; exists {
+		v, err := getInt(p)
+		if err != nil {
+			return estimation.Estimation{}, err
+		}
+		if v > 0 {
+			engineerCount = v
+		}
+	}
+
+	duration := time.Duration(hours / float64(engineerCount) * float64(time.Hour))
+
+	return estimation.NewPointEstimation(duration, fmt.Sprintf("%.1f h of issue remediation / %d engineers",
+		hours, engineerCount)), nil
+}
@@ -0,0 +1,70 @@
+package calculators
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func TestRemediation_Calculate_WithDefaults(t *testing.T) {
+	t.Parallel()
+	calc := NewRemediation()
+
+	result, err := calc.Calculate(map[string]estimation.Param{
+		ParamRemediationHours: {Key: ParamRemediationHours, Value: 40.0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// 40 h / 5 engineers = 8h
+	if result.Duration == nil || *result.Duration != 8*time.Hour {
+		t.Errorf("expected 8h, got %v", result.Duration)
+	}
+	if !strings.Contains(result.Reason, "40.0 h") {
+		t.Errorf("unexpected reason: %s", result.Reason)
+	}
+}
+
+func TestRemediation_Calculate_OptionsAndParams(t *testing.T) {
+	t.Parallel()
+	calc := NewRemediation(WithRemediationEngineers(2))
+
+	result, err := calc.Calculate(map[string]estimation.Param{
+		ParamRemediationHours: {Key: ParamRemediationHours, Value: 10},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if *result.Duration != 5*time.Hour {
+		t.Errorf("expected 5h from options, got %v", *result.Duration)
+	}
+
+	// Params take precedence over options
+	result, err = calc.Calculate(map[string]estimation.Param{
+		ParamRemediationHours:     {Key: ParamRemediationHours, Value: 10},
+		ParamRemediationEngineers: {Key: ParamRemediationEngineers, Value: 4.0},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if *result.Duration != 150*time.Minute {
+		t.Errorf("expected 2h30m from params, got %v", *result.Duration)
+	}
+}
+
+func TestRemediation_Calculate_Errors(t *testing.T) {
+	t.Parallel()
+	calc := NewRemediation()
+
+	if _, err := calc.Calculate(map[string]estimation.Param{}); err == nil {
+		t.Error("expected error for missing remediation_hours")
+	}
+	if _, err := calc.Calculate(map[string]estimation.Param{
+		ParamRemediationHours: {Key: ParamRemediationHours, Value: -1},
+	}); err == nil {
+		t.Error("expected error for negative remediation_hours")
+	}
+}
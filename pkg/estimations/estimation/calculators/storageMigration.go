@@ -57,6 +57,9 @@ func (c *StorageMigration) Name() string {
 	return "Storage Migration"
 }
 
+// Streamed reports that each parallel migration stream transfers its share of the disks.
+func (c *StorageMigration) Streamed() bool { return true }
+
 // Keys returns the list of parameter keys required by this calculator.
 // transfer_rate_mbps is optional and falls back to the struct default.
 func (c *StorageMigration) Keys() []string {
@@ -24,6 +24,9 @@ func NewStorageOffload() *StorageOffload { return &StorageOffload{} }
 func (c *StorageOffload) Name() string   { return "Storage Offload" }
 func (c *StorageOffload) Keys() []string { return []string{ParamTotalDiskGB} }
 
+// Streamed reports that each parallel migration stream offloads its share of the disks.
+func (c *StorageOffload) Streamed() bool { return true }
+
 func (c *StorageOffload) Calculate(params map[string]estimation.Param) (estimation.Estimation, error) {
 	diskParam, ok := params[ParamTotalDiskGB]
 	if !ok {
@@ -0,0 +1,100 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+// maxNormalResamples bounds the draws used to get a positive sample of a Normal distribution.
+const maxNormalResamples = 100
+
+// Distribution is a probability distribution of a Param value, sampled by Engine.Simulate.
+// Estimation params are positive quantities, so every distribution only yields positive samples.
+type Distribution interface {
+	// Sample draws one value using the given source of randomness.
+	Sample(r *rand.Rand) float64
+	// Validate reports whether the distribution parameters are consistent.
+	Validate() error
+	// Lower returns the smallest value the distribution can yield.
+	Lower() float64
+}
+
+// Triangular is the triangular distribution between Min and Max, peaking at Mode.
+type Triangular struct {
+	Min, Mode, Max float64
+}
+
+func (d Triangular) Sample(r *rand.Rand) float64 {
+	if d.Max == d.Min {
+		return d.Min
+	}
+	u := r.Float64()
+	split := (d.Mode - d.Min) / (d.Max - d.Min)
+	if u < split {
+		return d.Min + math.Sqrt(u*(d.Max-d.Min)*(d.Mode-d.Min))
+	}
+	return d.Max - math.Sqrt((1-u)*(d.Max-d.Min)*(d.Max-d.Mode))
+}
+
+func (d Triangular) Validate() error {
+	if d.Min <= 0 {
+		return fmt.Errorf("triangular distribution: min must be positive")
+	}
+	if d.Min > d.Mode || d.Mode > d.Max {
+		return fmt.Errorf("triangular distribution: min <= mode <= max required, got %g, %g, %g", d.Min, d.Mode, d.Max)
+	}
+	return nil
+}
+
+func (d Triangular) Lower() float64 { return d.Min }
+
+// Uniform is the uniform distribution between Min and Max.
+type Uniform struct {
+	Min, Max float64
+}
+
+func (d Uniform) Sample(r *rand.Rand) float64 {
+	return d.Min + r.Float64()*(d.Max-d.Min)
+}
+
+func (d Uniform) Validate() error {
+	if d.Min <= 0 {
+		return fmt.Errorf("uniform distribution: min must be positive")
+	}
+	if d.Min > d.Max {
+		return fmt.Errorf("uniform distribution: min <= max required, got %g, %g", d.Min, d.Max)
+	}
+	return nil
+}
+
+func (d Uniform) Lower() float64 { return d.Min }
+
+// Normal is the normal distribution of mean Mean and standard deviation StdDev, truncated to
+// positive values: non-positive draws are discarded.
+type Normal struct {
+	Mean, StdDev float64
+}
+
+func (d Normal) Sample(r *rand.Rand) float64 {
+	for range maxNormalResamples {
+		if v := d.Mean + r.NormFloat64()*d.StdDev; v > 0 {
+			return v
+		}
+	}
+	return d.Mean
+}
+
+func (d Normal) Validate() error {
+	if d.Mean <= 0 {
+		return fmt.Errorf("normal distribution: mean must be positive")
+	}
+	if d.StdDev < 0 {
+		return fmt.Errorf("normal distribution: standard deviation must not be negative")
+	}
+	return nil
+}
+
+// Lower returns the mean minus three standard deviations, floored at zero: the values
+// below are drawn in less than 0.15% of the samples.
+func (d Normal) Lower() float64 { return math.Max(0, d.Mean-3*d.StdDev) }
@@ -0,0 +1,69 @@
+package estimation
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestDistributions_SampleWithinBounds(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		dist     Distribution
+		min, max float64
+	}{
+		"triangular": {dist: Triangular{Min: 100, Mode: 400, Max: 1000}, min: 100, max: 1000},
+		"uniform":    {dist: Uniform{Min: 5, Max: 30}, min: 5, max: 30},
+		"normal":     {dist: Normal{Mean: 1, StdDev: 5}, min: 0, max: 1000},
+		"degenerate": {dist: Triangular{Min: 7, Mode: 7, Max: 7}, min: 7, max: 7},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 1))
+			for range 1000 {
+				v := tc.dist.Sample(r)
+				if v < tc.min || v > tc.max || (tc.min == 0 && v <= 0) {
+					t.Fatalf("sample %g out of [%g, %g]", v, tc.min, tc.max)
+				}
+			}
+		})
+	}
+}
+
+func TestTriangular_SampleMean(t *testing.T) {
+	t.Parallel()
+	d := Triangular{Min: 0.1, Mode: 3, Max: 6}
+	r := rand.New(rand.NewPCG(42, 42))
+	sum := 0.0
+	for range 10000 {
+		sum += d.Sample(r)
+	}
+	// mean of a triangular distribution is (min + mode + max) / 3
+	if mean := sum / 10000; mean < 2.9 || mean > 3.1 {
+		t.Errorf("expected a mean close to 3.03, got %g", mean)
+	}
+}
+
+func TestDistributions_Validate(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		dist   Distribution
+		errMsg string
+	}{
+		"triangular mode out of range": {dist: Triangular{Min: 1, Mode: 5, Max: 4}, errMsg: "min <= mode <= max"},
+		"triangular non-positive min":  {dist: Triangular{Min: 0, Mode: 1, Max: 2}, errMsg: "min must be positive"},
+		"uniform inverted":             {dist: Uniform{Min: 3, Max: 2}, errMsg: "min <= max"},
+		"normal non-positive mean":     {dist: Normal{Mean: 0, StdDev: 1}, errMsg: "mean must be positive"},
+		"normal negative stddev":       {dist: Normal{Mean: 1, StdDev: -1}, errMsg: "standard deviation"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.dist.Validate()
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Errorf("expected error containing %q, got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
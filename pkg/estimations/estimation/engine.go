@@ -27,6 +27,28 @@ func (e *Engine) Register(c Calculator) {
 	e.calculators = append(e.calculators, c)
 }
 
+// Names returns the names of the registered calculators, in registration order.
+// Run results are keyed by these names.
+func (e *Engine) Names() []string {
+	names := make([]string, 0, len(e.calculators))
+	for _, c := range e.calculators {
+		names = append(names, c.Name())
+	}
+	return names
+}
+
+// StreamedNames returns the names of the registered calculators whose work splits across
+// parallel migration streams, in registration order.
+func (e *Engine) StreamedNames() []string {
+	var names []string
+	for _, c := range e.calculators {
+		if s, ok := c.(Streamed); ok && s.Streamed() {
+			names = append(names, c.Name())
+		}
+	}
+	return names
+}
+
 // Run executes all registered calculators against the provided params
 func (e *Engine) Run(inputs []Param) map[string]Estimation {
 	// Convert slice to map for lookups by Calculators
@@ -46,6 +46,34 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestNames_RegistrationOrder(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&mockCalculator{name: "B"})
+	e.Register(&mockCalculator{name: "A"})
+	names := e.Names()
+	if len(names) != 2 || names[0] != "B" || names[1] != "A" {
+		t.Errorf("expected [B A], got %v", names)
+	}
+}
+
+type streamedCalculator struct {
+	mockCalculator
+}
+
+func (c *streamedCalculator) Streamed() bool { return true }
+
+func TestStreamedNames(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&streamedCalculator{mockCalculator{name: "Transfer"}})
+	e.Register(&mockCalculator{name: "Checks"})
+	names := e.StreamedNames()
+	if len(names) != 1 || names[0] != "Transfer" {
+		t.Errorf("expected [Transfer], got %v", names)
+	}
+}
+
 func TestRegister_PanicsOnDuplicate(t *testing.T) {
 	t.Parallel()
 	e := NewEngine()
@@ -0,0 +1,100 @@
+package estimation
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// Percentiles summarizes the durations sampled by a simulation.
+type Percentiles struct {
+	P50 time.Duration
+	P80 time.Duration
+	P95 time.Duration
+}
+
+// SimulationResult is the outcome of Engine.Simulate. Breakdown is keyed by calculator name,
+// like the results of Engine.Run. Percentiles are computed independently for each calculator
+// and for the total, so the percentiles of the breakdown do not add up to the total ones.
+type SimulationResult struct {
+	Runs      int
+	Seed      uint64
+	Total     Percentiles
+	Breakdown map[string]Percentiles
+}
+
+// Simulate runs the registered calculators runs times. On each run, the value of every param
+// listed in distributions is sampled from its distribution, replacing the value of inputs;
+// ranged estimations are sampled uniformly between their bounds. The source of randomness is
+// seeded with seed, so the same inputs and seed always yield the same result.
+func (e *Engine) Simulate(inputs []Param, distributions map[string]Distribution, runs int, seed uint64) (*SimulationResult, error) {
+	if runs <= 0 {
+		return nil, fmt.Errorf("simulation runs must be positive, got %d", runs)
+	}
+	// sample keys in a stable order, so the draws do not depend on map iteration
+	keys := make([]string, 0, len(distributions))
+	for key, d := range distributions {
+		if err := d.Validate(); err != nil {
+			return nil, fmt.Errorf("param %q: %w", key, err)
+		}
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	r := rand.New(rand.NewPCG(seed, seed))
+	params := make(map[string]Param, len(inputs)+len(keys))
+	for _, p := range inputs {
+		params[p.Key] = p
+	}
+
+	totals := make([]time.Duration, runs)
+	samples := make(map[string][]time.Duration, len(e.calculators))
+	for run := range runs {
+		for _, key := range keys {
+			params[key] = Param{Key: key, Value: distributions[key].Sample(r)}
+		}
+		for _, calc := range e.calculators {
+			var d time.Duration
+			// failing calculators count as zero, as in Run
+			if est, err := calc.Calculate(params); err == nil {
+				d = sampleDuration(r, est)
+			}
+			samples[calc.Name()] = append(samples[calc.Name()], d)
+			totals[run] += d
+		}
+	}
+
+	result := &SimulationResult{
+		Runs:      runs,
+		Seed:      seed,
+		Total:     percentiles(totals),
+		Breakdown: make(map[string]Percentiles, len(samples)),
+	}
+	for name, s := range samples {
+		result.Breakdown[name] = percentiles(s)
+	}
+	return result, nil
+}
+
+func sampleDuration(r *rand.Rand, est Estimation) time.Duration {
+	if est.IsRanged() {
+		spread := *est.MaxDuration - *est.MinDuration
+		return *est.MinDuration + time.Duration(r.Float64()*float64(spread))
+	}
+	if est.Duration != nil {
+		return *est.Duration
+	}
+	return 0
+}
+
+// percentiles sorts samples in place and returns their nearest-rank percentiles.
+func percentiles(samples []time.Duration) Percentiles {
+	slices.Sort(samples)
+	rank := func(p float64) time.Duration {
+		idx := int(math.Ceil(p*float64(len(samples)))) - 1
+		return samples[max(idx, 0)]
+	}
+	return Percentiles{P50: rank(0.50), P80: rank(0.80), P95: rank(0.95)}
+}
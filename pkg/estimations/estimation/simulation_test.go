@@ -0,0 +1,99 @@
+package estimation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// paramHoursCalculator estimates as many hours as the value of its param.
+type paramHoursCalculator struct {
+	name, key string
+}
+
+func (c *paramHoursCalculator) Name() string   { return c.name }
+func (c *paramHoursCalculator) Keys() []string { return []string{c.key} }
+func (c *paramHoursCalculator) Calculate(params map[string]Param) (Estimation, error) {
+	v, _ := params[c.key].Value.(float64)
+	return NewPointEstimation(time.Duration(v*float64(time.Hour)), ""), nil
+}
+
+func TestSimulate_Reproducible(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&paramHoursCalculator{name: "A", key: "a"})
+	e.Register(&mockCalculator{name: "R", result: NewRangedEstimation(time.Hour, 3*time.Hour, "")})
+	dists := map[string]Distribution{"a": Uniform{Min: 1, Max: 10}}
+
+	first, err := e.Simulate(nil, dists, 500, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := e.Simulate(nil, dists, 500, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("expected identical results for the same seed, got %+v and %+v", first, second)
+	}
+
+	other, _ := e.Simulate(nil, dists, 500, 8)
+	if reflect.DeepEqual(first.Total, other.Total) {
+		t.Errorf("expected different results for another seed")
+	}
+}
+
+func TestSimulate_Percentiles(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&paramHoursCalculator{name: "A", key: "a"})
+	e.Register(&mockCalculator{name: "Fixed", result: NewPointEstimation(2*time.Hour, "")})
+
+	result, err := e.Simulate(nil, map[string]Distribution{"a": Uniform{Min: 10, Max: 20}}, 2000, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	a := result.Breakdown["A"]
+	if !(a.P50 <= a.P80 && a.P80 <= a.P95) {
+		t.Errorf("expected ordered percentiles, got %+v", a)
+	}
+	// uniform between 10h and 20h
+	near := func(got, want time.Duration) bool { return (got - want).Abs() < 30*time.Minute }
+	if !near(a.P50, 15*time.Hour) || !near(a.P80, 18*time.Hour) || !near(a.P95, 19*time.Hour+30*time.Minute) {
+		t.Errorf("unexpected percentiles %+v", a)
+	}
+	if fixed := result.Breakdown["Fixed"]; fixed.P50 != 2*time.Hour || fixed.P95 != 2*time.Hour {
+		t.Errorf("expected constant percentiles for a point estimation, got %+v", fixed)
+	}
+	if result.Total.P50 != a.P50+2*time.Hour {
+		t.Errorf("expected total P50 %v, got %v", a.P50+2*time.Hour, result.Total.P50)
+	}
+}
+
+func TestSimulate_KeepsFixedInputs(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	e.Register(&paramHoursCalculator{name: "A", key: "a"})
+
+	result, err := e.Simulate([]Param{{Key: "a", Value: 4.0}}, nil, 10, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Total.P95 != 4*time.Hour {
+		t.Errorf("expected 4h, got %v", result.Total.P95)
+	}
+}
+
+func TestSimulate_InvalidInput(t *testing.T) {
+	t.Parallel()
+	e := NewEngine()
+	if _, err := e.Simulate(nil, nil, 0, 1); err == nil || !strings.Contains(err.Error(), "runs must be positive") {
+		t.Errorf("expected a runs error, got %v", err)
+	}
+	_, err := e.Simulate(nil, map[string]Distribution{"a": Uniform{Min: 2, Max: 1}}, 10, 1)
+	if err == nil || !strings.Contains(err.Error(), `param "a"`) {
+		t.Errorf("expected a distribution error, got %v", err)
+	}
+}
@@ -15,6 +15,13 @@ type Calculator interface {
 	Calculate(params map[string]Param) (Estimation, error)
 }
 
+// Streamed is implemented by calculators whose work splits across parallel migration streams,
+// such as data transfers and cutovers. The work of the other calculators is not shortened by
+// streams.
+type Streamed interface {
+	Streamed() bool
+}
+
 // Param represents an input for a Calculator (can be either user supplied or discovered)
 type Param struct {
 	Key   string      // Unique identifier (e.g., "network_bandwidth")
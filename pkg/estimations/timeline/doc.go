@@ -0,0 +1,7 @@
+// Package timeline projects migration estimations onto a work calendar.
+//
+// Estimation durations are hours of work. A Calendar turns them into calendar dates by
+// spending a fixed number of work hours on each working day, skipping non-working weekdays
+// and blackout periods (holidays, change freezes), and by splitting the work of the transfer and
+// cutover phases evenly across parallel migration streams.
+package timeline
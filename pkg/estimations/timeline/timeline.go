@@ -0,0 +1,200 @@
+package timeline
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+// maxProjectionYears bounds the calendar span of a projection, so that a calendar
+// leaving almost no working day can not loop for ever.
+const maxProjectionYears = 20
+
+// DefaultWorkingDays are the working days used when a Calendar does not set any.
+var DefaultWorkingDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// DateRange is an inclusive range of calendar dates.
+type DateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Calendar describes when migration work happens.
+type Calendar struct {
+	Start           time.Time      // date on which work starts; only the date part is used
+	WorkingDays     []time.Weekday // DefaultWorkingDays when empty
+	WorkHoursPerDay float64        // work hours spent on each working day
+	Blackouts       []DateRange    // dates on which no work happens
+	Streams         int            // parallel migration streams, each migrating its share of the VMs; 1 when zero
+}
+
+// Phase is one estimation phase placed on the calendar. End is the date on which the phase
+// finishes with the maximum duration of its estimation, EarliestEnd with the minimum one.
+type Phase struct {
+	Name        string
+	Start       time.Time
+	End         time.Time
+	EarliestEnd time.Time
+}
+
+// Timeline is the projection of an estimation breakdown, phases in execution order.
+type Timeline struct {
+	Start       time.Time
+	End         time.Time
+	EarliestEnd time.Time
+	Phases      []Phase
+}
+
+// Validate checks that the calendar leaves time to work.
+func (c Calendar) Validate() error {
+	if c.Start.IsZero() {
+		return fmt.Errorf("timeline start date is required")
+	}
+	if c.WorkHoursPerDay <= 0 || c.WorkHoursPerDay > 24 {
+		return fmt.Errorf("work hours per day must be between 0 and 24, got %g", c.WorkHoursPerDay)
+	}
+	if c.Streams < 0 {
+		return fmt.Errorf("parallel streams must be positive, got %d", c.Streams)
+	}
+	for _, b := range c.Blackouts {
+		if b.End.Before(b.Start) {
+			return fmt.Errorf("blackout period ends (%s) before it starts (%s)", b.End.Format(time.DateOnly), b.Start.Format(time.DateOnly))
+		}
+	}
+	return nil
+}
+
+// Project places the phases of the breakdown on the calendar, one after the other in the given
+// order. Only the streamed phases are split across the parallel streams. Phases missing from the
+// breakdown are skipped.
+func Project(cal Calendar, order, streamed []string, breakdown map[string]estimation.Estimation) (*Timeline, error) {
+	if err := cal.Validate(); err != nil {
+		return nil, err
+	}
+	if len(cal.WorkingDays) == 0 {
+		cal.WorkingDays = DefaultWorkingDays
+	}
+	if cal.Streams == 0 {
+		cal.Streams = 1
+	}
+
+	latest := newCursor(cal)
+	earliest := newCursor(cal)
+	tl := &Timeline{}
+	for _, name := range order {
+		est, ok := breakdown[name]
+		if !ok {
+			continue
+		}
+		minDuration, maxDuration := durations(est)
+		if slices.Contains(streamed, name) {
+			minDuration /= time.Duration(cal.Streams)
+			maxDuration /= time.Duration(cal.Streams)
+		}
+
+		start, end, err := latest.advance(maxDuration)
+		if err != nil {
+			return nil, fmt.Errorf("phase %q: %w", name, err)
+		}
+		_, earliestEnd, err := earliest.advance(minDuration)
+		if err != nil {
+			return nil, fmt.Errorf("phase %q: %w", name, err)
+		}
+
+		if len(tl.Phases) == 0 {
+			tl.Start = start
+		}
+		tl.Phases = append(tl.Phases, Phase{Name: name, Start: start, End: end, EarliestEnd: earliestEnd})
+		tl.End = end
+		tl.EarliestEnd = earliestEnd
+	}
+
+	if len(tl.Phases) == 0 {
+		start, _, err := latest.advance(0)
+		if err != nil {
+			return nil, err
+		}
+		tl.Start, tl.End, tl.EarliestEnd = start, start, start
+	}
+	return tl, nil
+}
+
+func durations(est estimation.Estimation) (time.Duration, time.Duration) {
+	if est.IsRanged() {
+		return *est.MinDuration, *est.MaxDuration
+	}
+	if est.Duration != nil {
+		return *est.Duration, *est.Duration
+	}
+	return 0, 0
+}
+
+// cursor walks the calendar, tracking the work hours already spent on the current day.
+type cursor struct {
+	cal     Calendar
+	day     time.Time
+	limit   time.Time
+	used    time.Duration
+	workDay time.Duration
+}
+
+func newCursor(cal Calendar) *cursor {
+	day := dateOf(cal.Start)
+	return &cursor{
+		cal:     cal,
+		day:     day,
+		limit:   day.AddDate(maxProjectionYears, 0, 0),
+		workDay: time.Duration(cal.WorkHoursPerDay * float64(time.Hour)),
+	}
+}
+
+// advance spends work on the calendar and returns the dates on which the work starts and ends.
+func (c *cursor) advance(work time.Duration) (time.Time, time.Time, error) {
+	if err := c.skipToWork(); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start := c.day
+	for {
+		available := c.workDay - c.used
+		if work <= available {
+			c.used += work
+			return start, c.day, nil
+		}
+		work -= available
+		c.used = c.workDay
+		if err := c.skipToWork(); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+}
+
+// skipToWork moves the cursor to the next day with work hours left, the current day included.
+func (c *cursor) skipToWork() error {
+	for !c.day.After(c.limit) {
+		if c.used < c.workDay && c.isWorkingDay(c.day) {
+			return nil
+		}
+		c.day = c.day.AddDate(0, 0, 1)
+		c.used = 0
+	}
+	return fmt.Errorf("timeline exceeds %d years", maxProjectionYears)
+}
+
+func (c *cursor) isWorkingDay(day time.Time) bool {
+	if !slices.Contains(c.cal.WorkingDays, day.Weekday()) {
+		return false
+	}
+	for _, b := range c.cal.Blackouts {
+		if !day.Before(dateOf(b.Start)) && !day.After(dateOf(b.End)) {
+			return false
+		}
+	}
+	return true
+}
+
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,169 @@
+package timeline
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubev2v/migration-planner/pkg/estimations/estimation"
+)
+
+func date(s string) time.Time {
+	d, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func assertDate(t *testing.T, what string, got time.Time, want string) {
+	t.Helper()
+	if got.Format(time.DateOnly) != want {
+		t.Errorf("%s: expected %s, got %s", what, want, got.Format(time.DateOnly))
+	}
+}
+
+func TestProject_SequentialPhasesOnWorkingDays(t *testing.T) {
+	t.Parallel()
+	// 2026-01-02 is a Friday
+	cal := Calendar{Start: date("2026-01-02"), WorkHoursPerDay: 8}
+	breakdown := map[string]estimation.Estimation{
+		"Transfer": estimation.NewPointEstimation(12*time.Hour, ""),
+		"Checks":   estimation.NewPointEstimation(8*time.Hour, ""),
+	}
+
+	tl, err := Project(cal, []string{"Transfer", "Checks"}, nil, breakdown)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tl.Phases) != 2 {
+		t.Fatalf("expected 2 phases, got %d", len(tl.Phases))
+	}
+
+	// 8h on Friday, 4h on Monday
+	assertDate(t, "transfer start", tl.Phases[0].Start, "2026-01-02")
+	assertDate(t, "transfer end", tl.Phases[0].End, "2026-01-05")
+	// 4h left on Monday, 4h on Tuesday
+	assertDate(t, "checks start", tl.Phases[1].Start, "2026-01-05")
+	assertDate(t, "checks end", tl.Phases[1].End, "2026-01-06")
+	assertDate(t, "timeline start", tl.Start, "2026-01-02")
+	assertDate(t, "timeline end", tl.End, "2026-01-06")
+}
+
+func TestProject_StartsOnNextWorkingDay(t *testing.T) {
+	t.Parallel()
+	// 2026-01-03 is a Saturday
+	cal := Calendar{Start: date("2026-01-03"), WorkHoursPerDay: 8}
+	tl, err := Project(cal, []string{"A"}, nil, map[string]estimation.Estimation{
+		"A": estimation.NewPointEstimation(8*time.Hour, ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertDate(t, "start", tl.Start, "2026-01-05")
+	assertDate(t, "end", tl.End, "2026-01-05")
+}
+
+func TestProject_SkipsBlackouts(t *testing.T) {
+	t.Parallel()
+	cal := Calendar{
+		Start:           date("2026-12-21"), // Monday
+		WorkHoursPerDay: 8,
+		Blackouts:       []DateRange{{Start: date("2026-12-23"), End: date("2027-01-01")}},
+	}
+	tl, err := Project(cal, []string{"A"}, nil, map[string]estimation.Estimation{
+		"A": estimation.NewPointEstimation(24*time.Hour, ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Mon 21, Tue 22, then the freeze, then Mon 4 Jan
+	assertDate(t, "end", tl.End, "2027-01-04")
+}
+
+func TestProject_ParallelStreamsAndRanges(t *testing.T) {
+	t.Parallel()
+	cal := Calendar{
+		Start:           date("2026-01-05"), // Monday
+		WorkHoursPerDay: 6,
+		WorkingDays:     []time.Weekday{time.Monday, time.Wednesday},
+		Streams:         2,
+	}
+	tl, err := Project(cal, []string{"Offload"}, []string{"Offload"}, map[string]estimation.Estimation{
+		"Offload": estimation.NewRangedEstimation(12*time.Hour, 36*time.Hour, ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// min: 6h per stream, done Monday; max: 18h per stream, Mon + Wed + Mon
+	assertDate(t, "earliest end", tl.EarliestEnd, "2026-01-05")
+	assertDate(t, "end", tl.End, "2026-01-12")
+}
+
+func TestProject_StreamsOnlySplitStreamedPhases(t *testing.T) {
+	t.Parallel()
+	cal := Calendar{Start: date("2026-01-05"), WorkHoursPerDay: 8, Streams: 2} // Monday
+	tl, err := Project(cal, []string{"Transfer", "Checks"}, []string{"Transfer"}, map[string]estimation.Estimation{
+		"Transfer": estimation.NewPointEstimation(16*time.Hour, ""),
+		"Checks":   estimation.NewPointEstimation(16*time.Hour, ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 8h of transfer per stream on Monday, then 16h of checks on Tuesday and Wednesday
+	assertDate(t, "transfer end", tl.Phases[0].End, "2026-01-05")
+	assertDate(t, "checks start", tl.Phases[1].Start, "2026-01-06")
+	assertDate(t, "checks end", tl.Phases[1].End, "2026-01-07")
+}
+
+func TestProject_SkipsMissingPhases(t *testing.T) {
+	t.Parallel()
+	cal := Calendar{Start: date("2026-01-05"), WorkHoursPerDay: 8}
+	tl, err := Project(cal, []string{"Missing", "A"}, nil, map[string]estimation.Estimation{
+		"A": estimation.NewPointEstimation(time.Hour, ""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tl.Phases) != 1 || tl.Phases[0].Name != "A" {
+		t.Errorf("expected only phase A, got %+v", tl.Phases)
+	}
+}
+
+func TestProject_InvalidCalendar(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		cal    Calendar
+		errMsg string
+	}{
+		"missing start": {
+			cal:    Calendar{WorkHoursPerDay: 8},
+			errMsg: "start date is required",
+		},
+		"no work hours": {
+			cal:    Calendar{Start: date("2026-01-05")},
+			errMsg: "work hours per day",
+		},
+		"inverted blackout": {
+			cal: Calendar{Start: date("2026-01-05"), WorkHoursPerDay: 8,
+				Blackouts: []DateRange{{Start: date("2026-02-01"), End: date("2026-01-01")}}},
+			errMsg: "before it starts",
+		},
+		"endless blackout": {
+			cal: Calendar{Start: date("2026-01-05"), WorkHoursPerDay: 8,
+				Blackouts: []DateRange{{Start: date("2026-01-01"), End: date("2099-01-01")}}},
+			errMsg: "timeline exceeds",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := Project(tc.cal, []string{"A"}, nil, map[string]estimation.Estimation{
+				"A": estimation.NewPointEstimation(time.Hour, ""),
+			})
+			if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+				t.Errorf("expected error containing %q, got %v", tc.errMsg, err)
+			}
+		})
+	}
+}
@@ -13,12 +13,15 @@ type UserActionData struct {
 }
 
 type ShareAssessmentActionData struct {
-	AssessmentID string `json:"assessment_id"`
-	PartnerID    string `json:"partner_id"`
+	AssessmentID string   `json:"assessment_id"`
+	PartnerID    string   `json:"partner_id,omitempty"`
+	Subjects     []string `json:"subjects,omitempty"`
+	Relation     string   `json:"relation,omitempty"`
 }
 
 type UnshareAssessmentActionData struct {
-	AssessmentID string `json:"assessment_id"`
+	AssessmentID string   `json:"assessment_id"`
+	Subjects     []string `json:"subjects,omitempty"`
 }
 
 type SizingActionData struct {
@@ -54,6 +57,37 @@ func NewShareAssessmentPayload(username, assessmentID, partnerID string) UserAct
 	}
 }
 
+// NewShareAssessmentWithPayload is the payload of an assessment shared with users and groups,
+// the subjects are formatted as <type>:<id>.
+func NewShareAssessmentWithPayload(username, assessmentID string, subjects []string, relation string) UserActionEventPayload {
+	return UserActionEventPayload{
+		UserAction: UserActionData{
+			Username:  username,
+			Timestamp: time.Now().UTC(),
+			Data: ShareAssessmentActionData{
+				AssessmentID: assessmentID,
+				Subjects:     subjects,
+				Relation:     relation,
+			},
+		},
+	}
+}
+
+// NewUnshareAssessmentWithPayload is the payload of an assessment unshared from users and groups,
+// the subjects are formatted as <type>:<id>.
+func NewUnshareAssessmentWithPayload(username, assessmentID string, subjects []string) UserActionEventPayload {
+	return UserActionEventPayload{
+		UserAction: UserActionData{
+			Username:  username,
+			Timestamp: time.Now().UTC(),
+			Data: UnshareAssessmentActionData{
+				AssessmentID: assessmentID,
+				Subjects:     subjects,
+			},
+		},
+	}
+}
+
 func NewUnshareAssessmentPayload(username, assessmentID string) UserActionEventPayload {
 	return UserActionEventPayload{
 		UserAction: UserActionData{
@@ -94,6 +94,11 @@ func toAPIVMs(v *inventory.VMsData) api.VMs {
 		osInfo[name] = info
 	}
 
+	var applications *map[string]int
+	if len(v.Applications) > 0 {
+		applications = &v.Applications
+	}
+
 	diskSizeTiers := make(map[string]api.DiskSizeTierSummary)
 	for tier, summary := range v.DiskSizeTiers {
 		diskSizeTiers[tier] = api.DiskSizeTierSummary{
@@ -169,6 +174,7 @@ func toAPIVMs(v *inventory.VMsData) api.VMs {
 		TotalWithSharedDisks:        &totalWithSharedDisks,
 		PowerStates:                 v.PowerStates,
 		OsInfo:                      &osInfo,
+		Applications:                applications,
 		CpuCores: api.VMResourceBreakdown{
 			Total:                          v.CPUCores.Total,
 			TotalForMigratable:             v.CPUCores.TotalForMigratable,
@@ -48,6 +48,7 @@ type VMsData struct {
 	TotalWithSharedDisks        int
 	PowerStates                 map[string]int
 	OSInfo                      map[string]OSInfo
+	Applications                map[string]int // VM count per complexity.ApplicationRules ID
 	CPUCores                    ResourceBreakdown
 	RamGB                       ResourceBreakdown
 	DiskCount                   ResourceBreakdown
@@ -1,6 +1,9 @@
 package inventory
 
-import "strings"
+import (
+	"maps"
+	"strings"
+)
 
 const (
 	TierCertified          = "certified"
@@ -60,3 +63,8 @@ func ClassifyOSTier(osName string) string {
 	}
 	return TierSpecialHandling
 }
+
+// DefaultOSTiers returns a copy of the built-in support tiers, keyed by OS name substring.
+func DefaultOSTiers() map[string]string {
+	return maps.Clone(osTierMap)
+}
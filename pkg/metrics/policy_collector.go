@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/kubev2v/migration-planner/pkg/opa"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// policyCollector reports the OPA policies currently evaluated by a validator.
+type policyCollector struct {
+	validator      *opa.Validator
+	info           *prometheus.Desc
+	loadedAt       *prometheus.Desc
+	reloadFailures *prometheus.Desc
+}
+
+func newPolicyCollector(v *opa.Validator) prometheus.Collector {
+	fqName := func(name string) string {
+		return fmt.Sprintf("%s_opa_policy_%s", assistedMigration, name)
+	}
+
+	return &policyCollector{
+		validator: v,
+		info: prometheus.NewDesc(
+			fqName("info"),
+			"Revision of the active OPA policies. Always 1.",
+			[]string{"revision"},
+			prometheus.Labels{},
+		),
+		loadedAt: prometheus.NewDesc(
+			fqName("loaded_timestamp_seconds"),
+			"Time when the active OPA policies were loaded.",
+			nil,
+			prometheus.Labels{},
+		),
+		reloadFailures: prometheus.NewDesc(
+			fqName("reload_failures_total"),
+			"Total number of OPA policy reloads that failed, keeping the active policies.",
+			nil,
+			prometheus.Labels{},
+		),
+	}
+}
+
+func (c *policyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.info
+	ch <- c.loadedAt
+	ch <- c.reloadFailures
+}
+
+// Collect implements Collector.
+func (c *policyCollector) Collect(ch chan<- prometheus.Metric) {
+	status := c.validator.Status()
+	ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1, status.Revision)
+	ch <- prometheus.MustNewConstMetric(c.loadedAt, prometheus.GaugeValue, float64(status.LoadedAt.Unix()))
+	ch <- prometheus.MustNewConstMetric(c.reloadFailures, prometheus.CounterValue, float64(status.ReloadFailures))
+}
+
+// RegisterPolicyMetrics registers the metrics of the OPA policies evaluated by v.
+func RegisterPolicyMetrics(v *opa.Validator) {
+	prometheus.MustRegister(newPolicyCollector(v))
+}
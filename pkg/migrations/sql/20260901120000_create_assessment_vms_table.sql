@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS assessment_vms (
+    snapshot_id INTEGER NOT NULL REFERENCES snapshots(id) ON DELETE CASCADE,
+    vm_id TEXT NOT NULL,
+    assessment_id VARCHAR(255) NOT NULL REFERENCES assessments(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    cluster_id TEXT,
+    cluster_name TEXT,
+    datacenter TEXT,
+    host TEXT,
+    os TEXT,
+    power_state TEXT,
+    cpu_count INTEGER NOT NULL DEFAULT 0,
+    memory_mb INTEGER NOT NULL DEFAULT 0,
+    disk_gb INTEGER NOT NULL DEFAULT 0,
+    is_template BOOLEAN NOT NULL DEFAULT false,
+    migration_excluded BOOLEAN NOT NULL DEFAULT false,
+    concerns jsonb NOT NULL DEFAULT '[]'::jsonb,
+    PRIMARY KEY (snapshot_id, vm_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_assessment_vms_assessment_id ON assessment_vms(assessment_id);
+
+-- GIN index backs the concern id / category containment filters
+CREATE INDEX IF NOT EXISTS idx_assessment_vms_concerns ON assessment_vms USING GIN (concerns jsonb_path_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_assessment_vms_concerns;
+DROP INDEX IF EXISTS idx_assessment_vms_assessment_id;
+DROP TABLE IF EXISTS assessment_vms;
+-- +goose StatementEnd
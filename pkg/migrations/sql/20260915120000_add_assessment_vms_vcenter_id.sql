@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE assessment_vms ADD COLUMN IF NOT EXISTS vcenter_id TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE assessment_vms DROP COLUMN IF EXISTS vcenter_id;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS hardware_skus (
+    name VARCHAR(100) PRIMARY KEY,
+    cores INTEGER NOT NULL,
+    threads INTEGER,
+    memory_gb INTEGER NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    rack_units INTEGER NOT NULL,
+    power_watts INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS hardware_skus;
+-- +goose StatementEnd
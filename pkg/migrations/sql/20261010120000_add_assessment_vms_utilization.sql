@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE assessment_vms ADD COLUMN IF NOT EXISTS cpu_usage_percent DOUBLE PRECISION;
+ALTER TABLE assessment_vms ADD COLUMN IF NOT EXISTS memory_usage_percent DOUBLE PRECISION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE assessment_vms DROP COLUMN IF EXISTS memory_usage_percent;
+ALTER TABLE assessment_vms DROP COLUMN IF EXISTS cpu_usage_percent;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS complexity_tables (
+    org_id VARCHAR(255) NOT NULL,
+    revision INTEGER NOT NULL,
+    version VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT false,
+    tables JSONB NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (org_id, revision),
+    UNIQUE (org_id, version)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS complexity_tables_active_idx ON complexity_tables (org_id) WHERE active;
+
+-- Existing assessments were scored with the built-in tables
+ALTER TABLE assessments ADD COLUMN IF NOT EXISTS complexity_table_version VARCHAR(100) NOT NULL DEFAULT 'builtin-1';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE assessments DROP COLUMN IF EXISTS complexity_table_version;
+DROP TABLE IF EXISTS complexity_tables;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS policy_bundles (
+    org_id VARCHAR(255) NOT NULL,
+    revision INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    modules JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT false,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (org_id, revision)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS policy_bundles_active_idx ON policy_bundles (org_id) WHERE active;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS policy_bundles;
+-- +goose StatementEnd
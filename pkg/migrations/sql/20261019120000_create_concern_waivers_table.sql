@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS concern_waivers (
+    id VARCHAR(255) PRIMARY KEY,
+    assessment_id VARCHAR(255) NOT NULL REFERENCES assessments(id) ON DELETE CASCADE,
+    concern_id TEXT NOT NULL,
+    vm_ids TEXT[] NOT NULL DEFAULT '{}',
+    justification TEXT NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_concern_waivers_assessment_id ON concern_waivers(assessment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS concern_waivers;
+-- +goose StatementEnd
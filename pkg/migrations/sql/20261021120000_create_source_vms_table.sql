@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS source_vms (
+    source_id TEXT NOT NULL REFERENCES sources(id) ON DELETE CASCADE,
+    vm_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    cluster_id TEXT,
+    cluster_name TEXT,
+    vcenter_id TEXT NOT NULL DEFAULT '',
+    datacenter TEXT,
+    host TEXT,
+    os TEXT,
+    power_state TEXT,
+    cpu_count INTEGER NOT NULL DEFAULT 0,
+    memory_mb INTEGER NOT NULL DEFAULT 0,
+    disk_gb INTEGER NOT NULL DEFAULT 0,
+    is_template BOOLEAN NOT NULL DEFAULT false,
+    migration_excluded BOOLEAN NOT NULL DEFAULT false,
+    concerns jsonb NOT NULL DEFAULT '[]'::jsonb,
+    cpu_usage_percent DOUBLE PRECISION,
+    memory_usage_percent DOUBLE PRECISION,
+    PRIMARY KEY (source_id, vm_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS source_vms;
+-- +goose StatementEnd
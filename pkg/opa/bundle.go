@@ -0,0 +1,343 @@
+package opa
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxBundleSize bounds the size of a bundle, compressed and uncompressed.
+	maxBundleSize = 16 << 20
+
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+)
+
+var defaultBundleClient = &http.Client{Timeout: 30 * time.Second}
+
+// ReadBundle reads the policies of an OPA bundle, a gzipped tarball. The .rego files and the
+// RemediationCatalogFile are keyed by their base name, like the files of a policy directory;
+// tests and other files are ignored.
+func ReadBundle(r io.Reader) (map[string]string, error) {
+	gz, err := gzip.NewReader(io.LimitReader(r, maxBundleSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	policies := make(map[string]string)
+	regoFiles := 0
+	tr := tar.NewReader(io.LimitReader(gz, maxBundleSize))
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+
+		name := path.Base(header.Name)
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if name != RemediationCatalogFile {
+			if !strings.HasSuffix(name, ".rego") || strings.HasSuffix(name, "_test.rego") {
+				continue
+			}
+			regoFiles++
+		}
+		if _, exists := policies[name]; exists {
+			return nil, fmt.Errorf("bundle contains several policies named %s", name)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy %s from bundle: %w", header.Name, err)
+		}
+		policies[name] = string(content)
+	}
+
+	if regoFiles == 0 {
+		return nil, fmt.Errorf("no .rego policy files found in bundle")
+	}
+	return policies, nil
+}
+
+// HTTPBundleSource downloads a bundle from a URL. The bundle is downloaded again only when its
+// ETag changes.
+type HTTPBundleSource struct {
+	url    string
+	client *http.Client
+
+	mu       sync.Mutex
+	etag     string
+	policies map[string]string
+}
+
+// NewHTTPBundleSource returns the source of the bundle at url. A nil client uses a default
+// client with a 30 seconds timeout.
+func NewHTTPBundleSource(url string, client *http.Client) *HTTPBundleSource {
+	if client == nil {
+		client = defaultBundleClient
+	}
+	return &HTTPBundleSource{url: url, client: client}
+}
+
+func (s *HTTPBundleSource) Fetch(ctx context.Context) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return s.policies, nil
+	case http.StatusOK:
+	default:
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	policies, err := ReadBundle(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.etag = resp.Header.Get("ETag")
+	s.policies = policies
+	return policies, nil
+}
+
+func (s *HTTPBundleSource) String() string {
+	return s.url
+}
+
+// OCIBundleSource pulls a bundle from an OCI registry: the first layer of the image manifest
+// is the bundle, as pushed by "oras push" or "opa build" tools. Public repositories are pulled
+// anonymously, with the token of the registry if it requires one. The layer is downloaded again
+// only when its digest changes.
+type OCIBundleSource struct {
+	registry   string
+	repository string
+	reference  string
+	scheme     string
+	client     *http.Client
+
+	mu       sync.Mutex
+	token    string
+	digest   string
+	policies map[string]string
+}
+
+// NewOCIBundleSource returns the source of the bundle at ref, e.g.
+// "quay.io/org/policies:latest" or "quay.io/org/policies@sha256:...". A nil client uses a
+// default client with a 30 seconds timeout.
+func NewOCIBundleSource(ref string, client *http.Client) (*OCIBundleSource, error) {
+	registry, repository, found := strings.Cut(ref, "/")
+	if !found || registry == "" || repository == "" {
+		return nil, fmt.Errorf("invalid OCI reference %q: expected registry/repository[:tag|@digest]", ref)
+	}
+
+	reference := "latest"
+	if name, digest, found := strings.Cut(repository, "@"); found {
+		repository, reference = name, digest
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, reference = repository[:i], repository[i+1:]
+	}
+	if repository == "" || reference == "" {
+		return nil, fmt.Errorf("invalid OCI reference %q: expected registry/repository[:tag|@digest]", ref)
+	}
+
+	if client == nil {
+		client = defaultBundleClient
+	}
+	return &OCIBundleSource{
+		registry:   registry,
+		repository: repository,
+		reference:  reference,
+		scheme:     "https",
+		client:     client,
+	}, nil
+}
+
+func (s *OCIBundleSource) Fetch(ctx context.Context) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var manifest struct {
+		Layers []struct {
+			Digest string `json:"digest"`
+		} `json:"layers"`
+	}
+	body, err := s.get(ctx, "manifests/"+s.reference, ociManifestMediaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest: %w", err)
+	}
+	err = json.NewDecoder(io.LimitReader(body, maxBundleSize)).Decode(&manifest)
+	_ = body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if len(manifest.Layers) == 0 {
+		return nil, fmt.Errorf("manifest has no layers")
+	}
+
+	digest := manifest.Layers[0].Digest
+	if digest == s.digest {
+		return s.policies, nil
+	}
+	if !strings.HasPrefix(digest, "sha256:") {
+		return nil, fmt.Errorf("unsupported layer digest %q", digest)
+	}
+
+	body, err = s.get(ctx, "blobs/"+digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get layer %s: %w", digest, err)
+	}
+	defer func() { _ = body.Close() }()
+
+	blob, err := io.ReadAll(io.LimitReader(body, maxBundleSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get layer %s: %w", digest, err)
+	}
+	if len(blob) > maxBundleSize {
+		return nil, fmt.Errorf("layer %s exceeds %d bytes", digest, maxBundleSize)
+	}
+	if sum := sha256.Sum256(blob); "sha256:"+hex.EncodeToString(sum[:]) != digest {
+		return nil, fmt.Errorf("layer %s does not match its digest", digest)
+	}
+
+	policies, err := ReadBundle(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	s.digest = digest
+	s.policies = policies
+	return policies, nil
+}
+
+func (s *OCIBundleSource) String() string {
+	separator := ":"
+	if strings.Contains(s.reference, ":") {
+		separator = "@"
+	}
+	return "oci://" + s.registry + "/" + s.repository + separator + s.reference
+}
+
+// get requests a manifest or blob of the repository, getting a token first if the registry
+// requires one.
+func (s *OCIBundleSource) get(ctx context.Context, resource, accept string) (io.ReadCloser, error) {
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("%s://%s/v2/%s/%s", s.scheme, s.registry, s.repository, resource), nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if s.token != "" {
+			req.Header.Set("Authorization", "Bearer "+s.token)
+		}
+		return s.client.Do(req)
+	}
+
+	resp, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if s.token, err = s.fetchToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = do(); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// fetchToken gets an anonymous token from the realm of a Bearer challenge.
+func (s *OCIBundleSource) fetchToken(ctx context.Context, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry authentication %q", challenge)
+	}
+
+	values := map[string]string{}
+	for _, param := range strings.Split(params, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		values[key] = strings.Trim(value, `"`)
+	}
+	realm, err := url.Parse(values["realm"])
+	if err != nil || values["realm"] == "" {
+		return "", fmt.Errorf("invalid registry authentication realm in %q", challenge)
+	}
+	query := realm.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = "repository:" + s.repository + ":pull"
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get registry token: unexpected status %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode registry token: %w", err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	if token.AccessToken != "" {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("registry returned an empty token")
+}
@@ -0,0 +1,176 @@
+package opa
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func buildBundle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("Failed to write bundle: %v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("Failed to write bundle: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Failed to write bundle: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("Failed to write bundle: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadBundle(t *testing.T) {
+	bundle := buildBundle(t, map[string]string{
+		"policies/vmware/test.rego":      testPolicy,
+		"policies/vmware/test_test.rego": "package test",
+		"data.json":                      "{}",
+		".manifest":                      "{}",
+	})
+
+	policies, err := ReadBundle(bytes.NewReader(bundle))
+	if err != nil {
+		t.Fatalf("ReadBundle() failed: %v", err)
+	}
+	if len(policies) != 1 || policies["test.rego"] != testPolicy {
+		t.Errorf("Expected only test.rego, got %v", policies)
+	}
+
+	bundle = buildBundle(t, map[string]string{
+		"policies/test.rego":                 testPolicy,
+		"policies/" + RemediationCatalogFile: testRemediations,
+	})
+	if policies, err = ReadBundle(bytes.NewReader(bundle)); err != nil {
+		t.Fatalf("ReadBundle() failed: %v", err)
+	}
+	if len(policies) != 2 || policies[RemediationCatalogFile] != testRemediations {
+		t.Errorf("Expected test.rego and the remediation catalog, got %v", policies)
+	}
+
+	if _, err := ReadBundle(bytes.NewReader(buildBundle(t, map[string]string{RemediationCatalogFile: "{}"}))); err == nil {
+		t.Error("ReadBundle() expected an error for a bundle with a remediation catalog only")
+	}
+	if _, err := ReadBundle(bytes.NewReader(buildBundle(t, map[string]string{"data.json": "{}"}))); err == nil {
+		t.Error("ReadBundle() expected an error for a bundle without policies")
+	}
+	if _, err := ReadBundle(bytes.NewReader(buildBundle(t, map[string]string{"a/x.rego": "a", "b/x.rego": "b"}))); err == nil {
+		t.Error("ReadBundle() expected an error for duplicate policy names")
+	}
+	if _, err := ReadBundle(strings.NewReader("not a tarball")); err == nil {
+		t.Error("ReadBundle() expected an error for an invalid bundle")
+	}
+}
+
+func TestHTTPBundleSource(t *testing.T) {
+	bundle := buildBundle(t, map[string]string{"test.rego": testPolicy})
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		downloads++
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write(bundle)
+	}))
+	defer server.Close()
+
+	source := NewHTTPBundleSource(server.URL+"/bundle.tar.gz", server.Client())
+	for range 2 {
+		policies, err := source.Fetch(context.Background())
+		if err != nil {
+			t.Fatalf("Fetch() failed: %v", err)
+		}
+		if policies["test.rego"] != testPolicy {
+			t.Errorf("Expected test.rego, got %v", policies)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("Expected the bundle to be downloaded once, got %d", downloads)
+	}
+
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	if _, err := NewHTTPBundleSource(notFound.URL, notFound.Client()).Fetch(context.Background()); err == nil {
+		t.Error("Fetch() expected an error for a missing bundle")
+	}
+}
+
+func TestOCIBundleSource(t *testing.T) {
+	bundle := buildBundle(t, map[string]string{"test.rego": testPolicy})
+	sum := sha256.Sum256(bundle)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	blobDownloads := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:org/policies:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token": "secret"}`))
+	})
+	mux.HandleFunc("/v2/org/policies/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="registry",scope="repository:org/policies:pull"`, r.Host))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/org/policies/manifests/v1":
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			_, _ = fmt.Fprintf(w, `{"schemaVersion": 2, "layers": [{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": %q}]}`, digest)
+		case "/v2/org/policies/blobs/" + digest:
+			blobDownloads++
+			_, _ = w.Write(bundle)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source, err := NewOCIBundleSource(strings.TrimPrefix(server.URL, "http://")+"/org/policies:v1", server.Client())
+	if err != nil {
+		t.Fatalf("NewOCIBundleSource() failed: %v", err)
+	}
+	source.scheme = "http"
+
+	for range 2 {
+		policies, err := source.Fetch(context.Background())
+		if err != nil {
+			t.Fatalf("Fetch() failed: %v", err)
+		}
+		if policies["test.rego"] != testPolicy {
+			t.Errorf("Expected test.rego, got %v", policies)
+		}
+	}
+	if blobDownloads != 1 {
+		t.Errorf("Expected the layer to be downloaded once, got %d", blobDownloads)
+	}
+
+	missing, err := NewOCIBundleSource(strings.TrimPrefix(server.URL, "http://")+"/org/policies:v2", server.Client())
+	if err != nil {
+		t.Fatalf("NewOCIBundleSource() failed: %v", err)
+	}
+	missing.scheme = "http"
+	if _, err := missing.Fetch(context.Background()); err == nil {
+		t.Error("Fetch() expected an error for a missing tag")
+	}
+}
@@ -16,10 +16,12 @@ func NewPolicyReader() *PolicyReader {
 	return &PolicyReader{}
 }
 
-// ReadPolicies Read all .rego policy files from the specified directory
+// ReadPolicies Read all .rego policy files from the specified directory, and its
+// RemediationCatalogFile if any
 func (pr *PolicyReader) ReadPolicies(policiesDir string) (map[string]string, error) {
 
 	policies := make(map[string]string)
+	regoFiles := 0
 
 	entries, err := os.ReadDir(policiesDir)
 	if err != nil {
@@ -27,9 +29,14 @@ func (pr *PolicyReader) ReadPolicies(policiesDir string) (map[string]string, err
 	}
 
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".rego") ||
-			strings.HasSuffix(entry.Name(), "_test.rego") {
-			continue // Skip test files
+		if entry.IsDir() {
+			continue
+		}
+		if entry.Name() != RemediationCatalogFile {
+			if !strings.HasSuffix(entry.Name(), ".rego") || strings.HasSuffix(entry.Name(), "_test.rego") {
+				continue // Skip test files
+			}
+			regoFiles++
 		}
 
 		path := filepath.Join(policiesDir, entry.Name())
@@ -42,7 +49,7 @@ func (pr *PolicyReader) ReadPolicies(policiesDir string) (map[string]string, err
 		zap.S().Named("opa").Debugf("Read policy: %s", entry.Name())
 	}
 
-	if len(policies) == 0 {
+	if regoFiles == 0 {
 		return nil, fmt.Errorf("no .rego policy files found in directory: %s", policiesDir)
 	}
 
@@ -0,0 +1,150 @@
+package opa
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+// RemediationCatalogFile is the file of a policy directory or bundle holding its remediation
+// catalog. Its entries are added to the built-in ones, replacing those with the same concern ID.
+const RemediationCatalogFile = "remediations.json"
+
+// OSUpgradeConcernID is the concern raised for the guest operating systems that have a
+// recommended upgrade in the remediation catalog.
+const OSUpgradeConcernID = "vmware.os.upgrade.recommendation"
+
+//go:embed remediations.json
+var builtinRemediations []byte
+
+// Remediation is the guidance to resolve a concern.
+type Remediation struct {
+	ConcernID        string   `json:"concernId"`
+	Steps            []string `json:"steps"`
+	EffortHoursPerVM float64  `json:"effortHoursPerVM"` // estimated hands-on effort to resolve the concern on one VM
+	DocLinks         []string `json:"docLinks,omitempty"`
+	AutomationHint   string   `json:"automationHint,omitempty"` // how to resolve the concern in bulk, if possible
+}
+
+// remediationFile is the layout of RemediationCatalogFile.
+type remediationFile struct {
+	Remediations []Remediation `json:"remediations"`
+	// OSUpgrades maps the lowercase names of unsupported guest operating systems, or their
+	// prefixes, to the recommended upgrade target.
+	OSUpgrades map[string]string `json:"osUpgrades"`
+}
+
+// RemediationCatalog is the remediation guidance of a set of policies, keyed by concern ID.
+// It is immutable.
+type RemediationCatalog struct {
+	revision     string
+	remediations map[string]Remediation
+	osUpgrades   map[string]string
+}
+
+// newRemediationCatalog returns the built-in catalog plus the catalog file of the policies, if any.
+func newRemediationCatalog(policies map[string]string, revision string) (*RemediationCatalog, error) {
+	catalog := &RemediationCatalog{
+		revision:     revision,
+		remediations: make(map[string]Remediation),
+		osUpgrades:   make(map[string]string),
+	}
+	if err := catalog.add(builtinRemediations); err != nil {
+		return nil, fmt.Errorf("invalid built-in remediation catalog: %w", err)
+	}
+	if content, found := policies[RemediationCatalogFile]; found {
+		if err := catalog.add([]byte(content)); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", RemediationCatalogFile, err)
+		}
+	}
+	return catalog, nil
+}
+
+func (c *RemediationCatalog) add(content []byte) error {
+	var file remediationFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(file.Remediations))
+	for _, r := range file.Remediations {
+		switch {
+		case r.ConcernID == "":
+			return fmt.Errorf("remediation without concernId")
+		case seen[r.ConcernID]:
+			return fmt.Errorf("several remediations for concern %s", r.ConcernID)
+		case len(r.Steps) == 0:
+			return fmt.Errorf("remediation of concern %s has no steps", r.ConcernID)
+		case r.EffortHoursPerVM < 0:
+			return fmt.Errorf("remediation of concern %s has a negative effort", r.ConcernID)
+		}
+		seen[r.ConcernID] = true
+		c.remediations[r.ConcernID] = r
+	}
+	for name, target := range file.OSUpgrades {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || target == "" {
+			return fmt.Errorf("osUpgrades entries must have a name and a target")
+		}
+		c.osUpgrades[name] = target
+	}
+	return nil
+}
+
+// with returns a copy of c plus the entries of a catalog file. c is left unchanged.
+func (c *RemediationCatalog) with(content []byte) (*RemediationCatalog, error) {
+	catalog := &RemediationCatalog{
+		revision:     c.revision,
+		remediations: maps.Clone(c.remediations),
+		osUpgrades:   maps.Clone(c.osUpgrades),
+	}
+	if err := catalog.add(content); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// Revision returns the revision of the policies the catalog belongs to, see PolicyRevision.
+func (c *RemediationCatalog) Revision() string {
+	return c.revision
+}
+
+// Lookup returns the remediation of a concern, if any.
+func (c *RemediationCatalog) Lookup(concernID string) (Remediation, bool) {
+	r, found := c.remediations[concernID]
+	return r, found
+}
+
+// OSUpgradeConcern returns an OS upgrade recommendation concern if the catalog recommends an
+// upgrade for the guest operating system, nil otherwise. The name is matched exactly, then by
+// the longest prefix, case-insensitively.
+func (c *RemediationCatalog) OSUpgradeConcern(osName string) *models.Concern {
+	osNameLower := strings.ToLower(strings.TrimSpace(osName))
+	if osNameLower == "" {
+		return nil
+	}
+	upgradeTarget, found := c.osUpgrades[osNameLower]
+	if !found {
+		matched := ""
+		for name, target := range c.osUpgrades {
+			if strings.HasPrefix(osNameLower, name) && len(name) > len(matched) {
+				matched, upgradeTarget = name, target
+			}
+		}
+	}
+
+	if upgradeTarget == "" {
+		return nil
+	}
+
+	return &models.Concern{
+		Id:         OSUpgradeConcernID,
+		Category:   "Information",
+		Label:      "OS Upgrade Recommendation",
+		Assessment: fmt.Sprintf("The guest operating system: %s is not currently supported. The operating system can be upgraded to %s", osName, upgradeTarget),
+	}
+}
@@ -0,0 +1,207 @@
+package opa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+const testRemediations = `{
+  "remediations": [
+    {"concernId": "test.simple.concern", "steps": ["Rename the VM."], "effortHoursPerVM": 0.5, "automationHint": "govc vm.change -name"},
+    {"concernId": "vmware.disk.rdm.detected", "steps": ["Convert the RDM disks."], "effortHoursPerVM": 2}
+  ],
+  "osUpgrades": {"Fedora 30": "Red Hat Enterprise Linux 9"}
+}`
+
+func TestValidator_Remediations_BuiltIn(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+
+	catalog := validator.Remediations()
+	if catalog.Revision() != validator.Revision() {
+		t.Errorf("Expected catalog revision %s, got %s", validator.Revision(), catalog.Revision())
+	}
+	r, found := catalog.Lookup(OSUpgradeConcernID)
+	if !found || r.EffortHoursPerVM <= 0 || len(r.Steps) == 0 {
+		t.Errorf("Expected a built-in remediation for %s, got %+v", OSUpgradeConcernID, r)
+	}
+	if _, found := catalog.Lookup("test.simple.concern"); found {
+		t.Error("Expected no remediation for test.simple.concern")
+	}
+}
+
+func TestValidator_Remediations_FromPolicies(t *testing.T) {
+	validator, err := NewValidator(map[string]string{
+		"test.rego":            testPolicy,
+		RemediationCatalogFile: testRemediations,
+	})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+
+	catalog := validator.Remediations()
+	if r, found := catalog.Lookup("test.simple.concern"); !found || r.EffortHoursPerVM != 0.5 || r.AutomationHint != "govc vm.change -name" {
+		t.Errorf("Expected the remediation of the catalog file, got %+v", r)
+	}
+	// The entries of the file replace the built-in ones
+	if r, _ := catalog.Lookup("vmware.disk.rdm.detected"); r.EffortHoursPerVM != 2 {
+		t.Errorf("Expected the effort of the catalog file, got %v", r.EffortHoursPerVM)
+	}
+	// The other built-in entries are kept
+	if _, found := catalog.Lookup(OSUpgradeConcernID); !found {
+		t.Errorf("Expected the built-in remediation for %s", OSUpgradeConcernID)
+	}
+
+	// The catalog file is versioned with the policies
+	other, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+	if other.Revision() == validator.Revision() {
+		t.Error("Expected the catalog file to change the policy revision")
+	}
+}
+
+func TestValidator_Remediations_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not json":         `{`,
+		"no concern ID":    `{"remediations": [{"steps": ["a"]}]}`,
+		"no steps":         `{"remediations": [{"concernId": "a"}]}`,
+		"negative effort":  `{"remediations": [{"concernId": "a", "steps": ["a"], "effortHoursPerVM": -1}]}`,
+		"duplicate":        `{"remediations": [{"concernId": "a", "steps": ["a"]}, {"concernId": "a", "steps": ["b"]}]}`,
+		"empty OS upgrade": `{"osUpgrades": {"centos 7": ""}}`,
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewValidator(map[string]string{"test.rego": testPolicy, RemediationCatalogFile: content})
+			if err == nil || !strings.Contains(err.Error(), RemediationCatalogFile) {
+				t.Errorf("Expected an error about %s, got %v", RemediationCatalogFile, err)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_OSUpgradeConcern(t *testing.T) {
+	validator, err := NewValidator(map[string]string{
+		"test.rego":            testPolicy,
+		RemediationCatalogFile: testRemediations,
+	})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+
+	tests := []struct {
+		guestName string
+		target    string
+	}{
+		{guestName: "CentOS 7 (64-bit)", target: "Red Hat Enterprise Linux 7"},
+		{guestName: "Red Hat Enterprise Linux 6 (64-bit)", target: "Red Hat Enterprise Linux 7"},
+		{guestName: "Fedora 30", target: "Red Hat Enterprise Linux 9"},
+		{guestName: "Red Hat Enterprise Linux 9 (64-bit)"},
+		{guestName: ""},
+	}
+	for _, tt := range tests {
+		concerns, err := validator.Validate(context.Background(), models.VM{Name: "vm", GuestName: tt.guestName})
+		if err != nil {
+			t.Fatalf("Validate() failed: %v", err)
+		}
+		var upgrade *models.Concern
+		for i := range concerns {
+			if concerns[i].Id == OSUpgradeConcernID {
+				upgrade = &concerns[i]
+			}
+		}
+		switch {
+		case tt.target == "" && upgrade != nil:
+			t.Errorf("%q: expected no OS upgrade concern, got %+v", tt.guestName, upgrade)
+		case tt.target != "" && (upgrade == nil || !strings.HasSuffix(upgrade.Assessment, tt.target)):
+			t.Errorf("%q: expected an upgrade to %s, got %+v", tt.guestName, tt.target, upgrade)
+		}
+	}
+}
+
+func TestPolicyReader_ReadPolicies_RemediationCatalog(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"test.rego":            testPolicy,
+		RemediationCatalogFile: testRemediations,
+		"README.json":          "{}",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	policies, err := NewPolicyReader().ReadPolicies(dir)
+	if err != nil {
+		t.Fatalf("ReadPolicies() failed: %v", err)
+	}
+	if len(policies) != 2 || policies[RemediationCatalogFile] != testRemediations {
+		t.Errorf("Expected the policy and the remediation catalog, got %v", policies)
+	}
+
+	// A catalog alone is not a policy directory
+	if err := os.Remove(filepath.Join(dir, "test.rego")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewPolicyReader().ReadPolicies(dir); err == nil {
+		t.Error("Expected an error for a directory without policies")
+	}
+}
+
+func TestValidator_WithPolicies_RejectsOtherFiles(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+	if _, err := validator.WithPolicies(map[string]string{"notes.json": "{}"}); err == nil {
+		t.Error("Expected organization policies to be .rego files or a remediation catalog only")
+	}
+	if _, err := validator.WithPolicies(map[string]string{RemediationCatalogFile: `{"remediations": [{"concernId": "x"}]}`}); err == nil {
+		t.Error("Expected an invalid remediation catalog to be rejected")
+	}
+}
+
+func TestValidator_WithPolicies_ExtendsRemediations(t *testing.T) {
+	validator, err := NewValidator(map[string]string{
+		"test.rego":            testPolicy,
+		RemediationCatalogFile: `{"remediations": [{"concernId": "global.concern", "steps": ["Fix it."]}]}`,
+	})
+	if err != nil {
+		t.Fatalf("NewValidator() failed: %v", err)
+	}
+
+	org, err := validator.WithPolicies(map[string]string{"org/" + RemediationCatalogFile: testRemediations})
+	if err != nil {
+		t.Fatalf("WithPolicies() failed: %v", err)
+	}
+	catalog := org.Remediations()
+	for _, concernID := range []string{"test.simple.concern", "global.concern", OSUpgradeConcernID} {
+		if _, found := catalog.Lookup(concernID); !found {
+			t.Errorf("Expected a remediation for %s", concernID)
+		}
+	}
+	if _, found := validator.Remediations().Lookup("test.simple.concern"); found {
+		t.Error("Expected the catalog of the validator to be left unchanged")
+	}
+
+	// The OS upgrades of the organization are evaluated with its policies
+	concerns, err := org.Validate(context.Background(), models.VM{Name: "vm", GuestName: "Fedora 30"})
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	found := false
+	for _, c := range concerns {
+		found = found || c.Id == OSUpgradeConcernID
+	}
+	if !found {
+		t.Errorf("Expected an OS upgrade concern, got %+v", concerns)
+	}
+}
@@ -2,8 +2,16 @@ package opa
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
 
@@ -12,9 +20,31 @@ import (
 	"go.uber.org/zap"
 )
 
-// Validator handles policy compilation and validation
+// ConcernsPackage is the package of the policies; its concerns rule is evaluated for every VM.
+const ConcernsPackage = "io.konveyor.forklift.vmware"
+
+// Validator handles policy compilation and validation. The compiled policies can be replaced
+// while the validator is in use (see Reload): every evaluation uses the set active when it starts.
 type Validator struct {
+	current        atomic.Pointer[policySet]
+	reloadFailures atomic.Int64
+}
+
+// policySet is an immutable set of compiled policies.
+type policySet struct {
+	policies      map[string]string
 	preparedQuery rego.PreparedEvalQuery
+	revision      string
+	loadedAt      time.Time
+	remediations  *RemediationCatalog
+}
+
+// PolicyStatus describes the policies evaluated by a validator.
+type PolicyStatus struct {
+	Revision       string    // content hash of the policies, see PolicyRevision
+	Files          int       // number of policy files
+	LoadedAt       time.Time // when the policies were compiled
+	ReloadFailures int64     // number of reloads rejected since the validator was created
 }
 
 func NewValidatorFromDir(policiesDir string) (*Validator, error) {
@@ -33,52 +63,200 @@ func NewValidator(policies map[string]string) (*Validator, error) {
 		return nil, fmt.Errorf("no policies provided for validation")
 	}
 
+	modules, err := parsePolicies(policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile policies: %w", err)
+	}
+	set, err := compilePolicies(policies, modules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile policies: %w", err)
+	}
+
 	validator := &Validator{}
+	validator.current.Store(set)
 
-	if err := validator.compilePolicies(policies); err != nil {
-		return nil, fmt.Errorf("failed to compile policies: %w", err)
+	zap.S().Named("opa").Infof("OPA validator initialized with %d policies (revision %s)", len(policies), set.revision)
+	return validator, nil
+}
+
+// Reload compiles the given policies and, if they compile, makes them the policies of v.
+// Evaluations in progress complete with the previous policies. If the policies do not compile,
+// v keeps the previous ones and the error is returned.
+func (v *Validator) Reload(policies map[string]string) error {
+	if len(policies) == 0 {
+		v.reloadFailures.Add(1)
+		return fmt.Errorf("no policies provided for validation")
 	}
 
-	zap.S().Named("opa").Infof("OPA validator initialized with %d policies", len(policies))
+	modules, err := parsePolicies(policies)
+	if err == nil {
+		var set *policySet
+		if set, err = compilePolicies(policies, modules); err == nil {
+			previous := v.current.Swap(set)
+			zap.S().Named("opa").Infof("OPA policies reloaded: revision %s -> %s (%d policies)", previous.revision, set.revision, len(policies))
+			return nil
+		}
+	}
+	v.reloadFailures.Add(1)
+	return fmt.Errorf("failed to compile policies: %w", err)
+}
+
+// Revision returns the revision of the policies currently evaluated by v.
+func (v *Validator) Revision() string {
+	return v.current.Load().revision
+}
+
+// Status returns the revision and load time of the policies currently evaluated by v.
+func (v *Validator) Status() PolicyStatus {
+	set := v.current.Load()
+	return PolicyStatus{
+		Revision:       set.revision,
+		Files:          len(set.policies),
+		LoadedAt:       set.loadedAt,
+		ReloadFailures: v.reloadFailures.Load(),
+	}
+}
+
+// Remediations returns the remediation catalog of the policies currently evaluated by v.
+func (v *Validator) Remediations() *RemediationCatalog {
+	return v.current.Load().remediations
+}
+
+// Snapshot returns a validator evaluating the policies currently evaluated by v, unaffected by
+// later reloads of v, so that all the VMs of an inventory are evaluated with the same policies.
+func (v *Validator) Snapshot() *Validator {
+	snapshot := &Validator{}
+	snapshot.current.Store(v.current.Load())
+	return snapshot
+}
+
+// PolicyRevision returns the revision of a set of policies: the first 12 hexadecimal digits of
+// the SHA-256 of the file names and contents, sorted by file name.
+func PolicyRevision(policies map[string]string) string {
+	h := sha256.New()
+	for _, filename := range slices.Sorted(maps.Keys(policies)) {
+		// The lengths delimit the names and contents, so that moving bytes between them changes the hash
+		_, _ = fmt.Fprintf(h, "%d:%s%d:%s", len(filename), filename, len(policies[filename]), policies[filename])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// WithPolicies returns a validator evaluating the policies of v plus the given ones, e.g. the
+// policies of an organization. The added policies must be in ConcernsPackage: they can add
+// concerns but not change the rules of v, which the compiler rejects. A RemediationCatalogFile
+// among them, in any directory, is added to the remediation catalog of v. v is left unchanged.
+func (v *Validator) WithPolicies(policies map[string]string) (*Validator, error) {
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("no policies provided for validation")
+	}
+
+	current := v.current.Load()
+
+	var catalogFile string
+	for filename := range policies {
+		switch {
+		case path.Base(filename) == RemediationCatalogFile:
+			catalogFile = filename
+		case !strings.HasSuffix(filename, ".rego"):
+			return nil, fmt.Errorf("policy %s is not a .rego file nor %s", filename, RemediationCatalogFile)
+		}
+	}
+	added, err := parsePolicies(policies)
+	if err != nil {
+		return nil, err
+	}
+	for filename, module := range added {
+		if _, exists := current.policies[filename]; exists {
+			return nil, fmt.Errorf("policy %s already exists", filename)
+		}
+		if module.Package.Path.String() != "data."+ConcernsPackage {
+			return nil, fmt.Errorf("policy %s must be in package %s, not %s", filename, ConcernsPackage,
+				strings.TrimPrefix(module.Package.Path.String(), "data."))
+		}
+	}
+
+	modules, err := parsePolicies(current.policies)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(modules, added)
+
+	// The catalog of the added policies extends the one of v rather than replacing its file
+	all := maps.Clone(current.policies)
+	for filename, content := range policies {
+		if filename != catalogFile {
+			all[filename] = content
+		}
+	}
+	set, err := compilePolicies(all, modules)
+	if err != nil {
+		return nil, err
+	}
+	if catalogFile != "" {
+		if set.remediations, err = set.remediations.with([]byte(policies[catalogFile])); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", RemediationCatalogFile, err)
+		}
+	}
+
+	validator := &Validator{}
+	validator.current.Store(set)
 	return validator, nil
 }
 
-// compilePolicies Compile the provided policy content and prepares the query
-func (v *Validator) compilePolicies(policies map[string]string) error {
-	compiler := ast.NewCompiler()
+// parsePolicies parses the provided policy content with Rego v1; policies must be v1-compatible.
+// Files other than .rego files, e.g. RemediationCatalogFile, are not policies and are skipped.
+func parsePolicies(policies map[string]string) (map[string]*ast.Module, error) {
 	modules := make(map[string]*ast.Module)
-
 	for filename, content := range policies {
-		// Parse with Rego v1; ensure policies are v1-compatible
+		if !strings.HasSuffix(filename, ".rego") {
+			continue
+		}
 		module, err := ast.ParseModuleWithOpts(filename, content, ast.ParserOptions{
 			RegoVersion: ast.RegoV1,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to parse policy %s: %w", filename, err)
+			return nil, fmt.Errorf("failed to parse policy %s: %w", filename, err)
 		}
 		modules[filename] = module
 	}
+	return modules, nil
+}
+
+// compilePolicies Compile the provided policy modules and prepares the query
+func compilePolicies(policies map[string]string, modules map[string]*ast.Module) (*policySet, error) {
+	compiler := ast.NewCompiler()
 
 	compiler.Compile(modules)
 	if compiler.Failed() {
-		return fmt.Errorf("policy compilation failed: %v", compiler.Errors)
+		return nil, fmt.Errorf("policy compilation failed: %v", compiler.Errors)
 	}
 
 	// Use v1 runtime for future-proofing and better performance
 	r := rego.New(
-		rego.Query("data.io.konveyor.forklift.vmware.concerns"),
+		rego.Query("data."+ConcernsPackage+".concerns"),
 		rego.Compiler(compiler),
 		rego.SetRegoVersion(ast.RegoV1),
 	)
 
 	preparedQuery, err := r.PrepareForEval(context.Background())
 	if err != nil {
-		return fmt.Errorf("failed to prepare rego query: %w", err)
+		return nil, fmt.Errorf("failed to prepare rego query: %w", err)
+	}
+
+	revision := PolicyRevision(policies)
+	remediations, err := newRemediationCatalog(policies, revision)
+	if err != nil {
+		return nil, err
 	}
 
-	v.preparedQuery = preparedQuery
-	zap.S().Named("opa").Infof("Successfully compiled %d policy files", len(policies))
-	return nil
+	zap.S().Named("opa").Infof("Successfully compiled %d policy files", len(modules))
+	return &policySet{
+		policies:      maps.Clone(policies),
+		preparedQuery: preparedQuery,
+		revision:      revision,
+		loadedAt:      time.Now(),
+		remediations:  remediations,
+	}, nil
 }
 
 // Validate implements duckdb_parser.Validator for models.VM.
@@ -86,7 +264,8 @@ func (v *Validator) compilePolicies(policies map[string]string) error {
 func (v *Validator) Validate(ctx context.Context, vm models.VM) ([]models.Concern, error) {
 	// models.VM is already JSON-compatible with the OPA input format,
 	// so we can pass it directly for evaluation
-	resultSet, err := v.preparedQuery.Eval(ctx, rego.EvalInput(vm))
+	set := v.current.Load()
+	resultSet, err := set.preparedQuery.Eval(ctx, rego.EvalInput(vm))
 	if err != nil {
 		return nil, fmt.Errorf("policy evaluation failed for VM %q: %w", vm.Name, err)
 	}
@@ -120,7 +299,7 @@ func (v *Validator) Validate(ctx context.Context, vm models.VM) ([]models.Concer
 	}
 
 	// Add OS upgrade recommendation for upgradable OSes
-	if upgradeConcern := GetOSUpgradeConcern(vm.EffectiveGuestName()); upgradeConcern != nil {
+	if upgradeConcern := set.remediations.OSUpgradeConcern(vm.EffectiveGuestName()); upgradeConcern != nil {
 		concerns = append(concerns, *upgradeConcern)
 	}
 
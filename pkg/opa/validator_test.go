@@ -204,3 +204,187 @@ func TestNewValidatorFromDir(t *testing.T) {
 		t.Error("NewValidatorFromDir() returned nil validator")
 	}
 }
+
+const testOrgPolicy = `package io.konveyor.forklift.vmware
+
+import rego.v1
+
+concerns contains flag if {
+	"pci" in input.labels
+	flag := {
+		"id": "org.pci.concern",
+		"category": "Critical",
+		"label": "PCI VM",
+		"assessment": "PCI VMs are not migrated in the first wave.",
+	}
+}
+`
+
+func TestValidator_WithPolicies(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+
+	extended, err := validator.WithPolicies(map[string]string{"org/pci.rego": testOrgPolicy})
+	if err != nil {
+		t.Fatalf("WithPolicies() failed: %v", err)
+	}
+
+	concerns, err := extended.Validate(context.Background(), models.VM{
+		Name:   "test-vm-with-concern",
+		Labels: models.Labels{"pci"},
+	})
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 2 {
+		t.Errorf("Expected the global and the added concerns, got %v", concerns)
+	}
+
+	// The original validator is unchanged
+	concerns, err = validator.Validate(context.Background(), models.VM{Name: "clean-vm", Labels: models.Labels{"pci"}})
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 0 {
+		t.Errorf("Expected 0 concerns from the original validator, got %v", concerns)
+	}
+}
+
+func TestValidator_WithPolicies_Rejected(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		policies map[string]string
+		errMsg   string
+	}{
+		{
+			name:     "no policies",
+			policies: map[string]string{},
+			errMsg:   "no policies provided",
+		},
+		{
+			name:     "existing file name",
+			policies: map[string]string{"test.rego": testOrgPolicy},
+			errMsg:   "already exists",
+		},
+		{
+			name:     "other package",
+			policies: map[string]string{"org/other.rego": "package acme.rules\n\nallow := true\n"},
+			errMsg:   "must be in package io.konveyor.forklift.vmware",
+		},
+		{
+			name:     "syntax error",
+			policies: map[string]string{"org/broken.rego": "package io.konveyor.forklift.vmware\n\nconcerns contains flag if {\n"},
+			errMsg:   "failed to parse policy org/broken.rego",
+		},
+		{
+			name:     "conflicting rule",
+			policies: map[string]string{"org/conflict.rego": "package io.konveyor.forklift.vmware\n\nconcerns := []\n"},
+			errMsg:   "policy compilation failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.WithPolicies(tt.policies)
+			if err == nil {
+				t.Fatal("WithPolicies() expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("WithPolicies() error should contain %q, got: %v", tt.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestValidator_Reload(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+	revision := validator.Revision()
+	snapshot := validator.Snapshot()
+
+	if err := validator.Reload(map[string]string{"pci.rego": testOrgPolicy}); err != nil {
+		t.Fatalf("Reload() failed: %v", err)
+	}
+	if validator.Revision() == revision {
+		t.Errorf("Expected a new revision after reload, got %s", revision)
+	}
+
+	vm := models.VM{Name: "test-vm-with-concern", Labels: models.Labels{"pci"}}
+	concerns, err := validator.Validate(context.Background(), vm)
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 1 || concerns[0].Id != "org.pci.concern" {
+		t.Errorf("Expected only the concern of the reloaded policies, got %v", concerns)
+	}
+
+	// The snapshot keeps the policies it was taken with
+	if snapshot.Revision() != revision {
+		t.Errorf("Expected snapshot revision %s, got %s", revision, snapshot.Revision())
+	}
+	concerns, err = snapshot.Validate(context.Background(), vm)
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 1 || concerns[0].Id != "test.simple.concern" {
+		t.Errorf("Expected only the concern of the original policies, got %v", concerns)
+	}
+}
+
+func TestValidator_Reload_KeepsPoliciesOnFailure(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+	revision := validator.Revision()
+
+	for name, policies := range map[string]map[string]string{
+		"empty":         {},
+		"syntax error":  {"broken.rego": "package io.konveyor.forklift.vmware\n\nconcerns contains"},
+		"compile error": {"broken.rego": "package io.konveyor.forklift.vmware\n\nimport rego.v1\n\nconcerns contains x if { x := undefined_ref }\n"},
+	} {
+		if err := validator.Reload(policies); err == nil {
+			t.Errorf("%s: Reload() expected an error", name)
+		}
+	}
+
+	status := validator.Status()
+	if status.Revision != revision || status.Files != 1 {
+		t.Errorf("Expected the original policies to be kept, got %+v", status)
+	}
+	if status.ReloadFailures != 3 {
+		t.Errorf("Expected 3 reload failures, got %d", status.ReloadFailures)
+	}
+
+	concerns, err := validator.Validate(context.Background(), models.VM{Name: "test-vm-with-concern"})
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 1 {
+		t.Errorf("Expected 1 concern from the original policies, got %v", concerns)
+	}
+}
+
+func TestPolicyRevision(t *testing.T) {
+	revision := PolicyRevision(map[string]string{"a.rego": "x", "b.rego": "y"})
+	if len(revision) != 12 {
+		t.Errorf("Expected a 12 characters revision, got %q", revision)
+	}
+	if PolicyRevision(map[string]string{"b.rego": "y", "a.rego": "x"}) != revision {
+		t.Error("Expected the revision not to depend on the map order")
+	}
+	if PolicyRevision(map[string]string{"a.rego": "xb.rego", "": "y"}) == revision {
+		t.Error("Expected different policies to have different revisions")
+	}
+	if PolicyRevision(map[string]string{"a.rego": "x", "b.rego": "z"}) == revision {
+		t.Error("Expected a content change to change the revision")
+	}
+}
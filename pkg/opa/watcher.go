@@ -0,0 +1,103 @@
+package opa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PolicySource provides the policies to evaluate, e.g. the files of a directory or a bundle.
+type PolicySource interface {
+	// Fetch returns the current policies, keyed by file name.
+	Fetch(ctx context.Context) (map[string]string, error)
+	// String describes the source in logs.
+	String() string
+}
+
+// NewPolicySource returns the source at location: an http(s):// or oci:// bundle reference,
+// or else a policy directory.
+func NewPolicySource(location string) (PolicySource, error) {
+	switch {
+	case strings.HasPrefix(location, "http://"), strings.HasPrefix(location, "https://"):
+		return NewHTTPBundleSource(location, nil), nil
+	case strings.HasPrefix(location, "oci://"):
+		return NewOCIBundleSource(strings.TrimPrefix(location, "oci://"), nil)
+	case location == "":
+		return nil, fmt.Errorf("no policy source provided")
+	default:
+		return NewDirSource(location), nil
+	}
+}
+
+// DirSource reads the policies from a directory, see PolicyReader.
+type DirSource struct {
+	dir    string
+	reader *PolicyReader
+}
+
+func NewDirSource(dir string) *DirSource {
+	return &DirSource{dir: dir, reader: NewPolicyReader()}
+}
+
+func (s *DirSource) Fetch(_ context.Context) (map[string]string, error) {
+	return s.reader.ReadPolicies(s.dir)
+}
+
+func (s *DirSource) String() string {
+	return s.dir
+}
+
+// Watcher reloads the policies of a validator when the policies of its source change.
+// The source is polled: file system events are not reliable for the symlinked directories
+// of mounted ConfigMaps, and bundles have to be polled anyway.
+type Watcher struct {
+	validator *Validator
+	source    PolicySource
+	interval  time.Duration
+}
+
+func NewWatcher(validator *Validator, source PolicySource, interval time.Duration) *Watcher {
+	return &Watcher{validator: validator, source: source, interval: interval}
+}
+
+// Run polls the source every interval until ctx is done.
+func (w *Watcher) Run(ctx context.Context) {
+	logger := zap.S().Named("opa_watcher")
+	logger.Infow("watching OPA policies", "source", w.source.String(), "interval", w.interval, "revision", w.validator.Revision())
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.Check(ctx); err != nil {
+				logger.Errorw("failed to reload OPA policies, keeping the current ones",
+					"source", w.source.String(), "revision", w.validator.Revision(), "error", err)
+			}
+		}
+	}
+}
+
+// Check fetches the policies of the source and reloads the validator if their revision differs
+// from the current one. It returns whether the policies were reloaded. On error, the validator
+// keeps its current policies.
+func (w *Watcher) Check(ctx context.Context) (bool, error) {
+	policies, err := w.source.Fetch(ctx)
+	if err != nil {
+		w.validator.reloadFailures.Add(1)
+		return false, fmt.Errorf("fetching policies from %s: %w", w.source, err)
+	}
+	if PolicyRevision(policies) == w.validator.Revision() {
+		return false, nil
+	}
+	if err := w.validator.Reload(policies); err != nil {
+		return false, err
+	}
+	return true, nil
+}
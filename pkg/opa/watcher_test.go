@@ -0,0 +1,117 @@
+package opa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubev2v/migration-planner/pkg/duckdb_parser/models"
+)
+
+func TestWatcher_Check_Directory(t *testing.T) {
+	dir := t.TempDir()
+	policyFile := filepath.Join(dir, "test.rego")
+	if err := os.WriteFile(policyFile, []byte(testPolicy), 0644); err != nil {
+		t.Fatalf("Failed to write test policy: %v", err)
+	}
+
+	validator, err := NewValidatorFromDir(dir)
+	if err != nil {
+		t.Fatalf("NewValidatorFromDir() failed: %v", err)
+	}
+	watcher := NewWatcher(validator, NewDirSource(dir), 0)
+
+	// Unchanged directory
+	reloaded, err := watcher.Check(context.Background())
+	if err != nil || reloaded {
+		t.Fatalf("Check() = %v, %v; expected no reload", reloaded, err)
+	}
+
+	// New policy file
+	if err := os.WriteFile(filepath.Join(dir, "pci.rego"), []byte(testOrgPolicy), 0644); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+	reloaded, err = watcher.Check(context.Background())
+	if err != nil || !reloaded {
+		t.Fatalf("Check() = %v, %v; expected a reload", reloaded, err)
+	}
+	concerns, err := validator.Validate(context.Background(), models.VM{Name: "clean-vm", Labels: models.Labels{"pci"}})
+	if err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	if len(concerns) != 1 {
+		t.Errorf("Expected the concern of the new policy, got %v", concerns)
+	}
+	revision := validator.Revision()
+
+	// Broken policy file: the current policies are kept
+	if err := os.WriteFile(filepath.Join(dir, "broken.rego"), []byte("package broken\n\nconcerns contains"), 0644); err != nil {
+		t.Fatalf("Failed to write policy: %v", err)
+	}
+	if _, err := watcher.Check(context.Background()); err == nil {
+		t.Fatal("Check() expected an error for a broken policy")
+	}
+	if validator.Revision() != revision {
+		t.Errorf("Expected revision %s to be kept, got %s", revision, validator.Revision())
+	}
+
+	// Directory removed: the current policies are kept
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	if _, err := watcher.Check(context.Background()); err == nil {
+		t.Fatal("Check() expected an error for a missing directory")
+	}
+	if validator.Revision() != revision {
+		t.Errorf("Expected revision %s to be kept, got %s", revision, validator.Revision())
+	}
+	if failures := validator.Status().ReloadFailures; failures != 2 {
+		t.Errorf("Expected 2 reload failures, got %d", failures)
+	}
+}
+
+func TestWatcher_Run_StopsWithContext(t *testing.T) {
+	validator, err := NewValidator(map[string]string{"test.rego": testPolicy})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewWatcher(validator, NewDirSource(t.TempDir()), 1).Run(ctx)
+		close(done)
+	}()
+	cancel()
+	<-done
+}
+
+func TestNewPolicySource(t *testing.T) {
+	tests := []struct {
+		location string
+		expected string
+	}{
+		{location: "/app/policies", expected: "/app/policies"},
+		{location: "https://example.com/bundle.tar.gz", expected: "https://example.com/bundle.tar.gz"},
+		{location: "oci://quay.io/org/policies", expected: "oci://quay.io/org/policies:latest"},
+		{location: "oci://localhost:5000/org/policies:v2", expected: "oci://localhost:5000/org/policies:v2"},
+		{location: "oci://quay.io/org/policies@sha256:abc", expected: "oci://quay.io/org/policies@sha256:abc"},
+	}
+	for _, tt := range tests {
+		source, err := NewPolicySource(tt.location)
+		if err != nil {
+			t.Errorf("NewPolicySource(%q) failed: %v", tt.location, err)
+			continue
+		}
+		if source.String() != tt.expected {
+			t.Errorf("NewPolicySource(%q) = %s, expected %s", tt.location, source, tt.expected)
+		}
+	}
+
+	for _, location := range []string{"", "oci://quay.io", "oci://quay.io/"} {
+		if _, err := NewPolicySource(location); err == nil {
+			t.Errorf("NewPolicySource(%q) expected an error", location)
+		}
+	}
+}
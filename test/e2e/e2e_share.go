@@ -296,6 +296,65 @@ var _ = Describe("e2e-share", func() {
 		})
 	})
 
+	Context("Share with users and groups", func() {
+		It("owner shares assessment with a colleague and a group, then unshares", func() {
+			zap.S().Infof("============Running test: %s============", CurrentSpecReport().LeafNodeText)
+
+			colleagueSvc, err := NewPlannerService(UserAuth("sharecolleague", "custorg", config.Cfg.Test.DefaultEmailDomain))
+			Expect(err).To(BeNil())
+
+			// Customer shares the assessment with a colleague and with the partner group by name
+			statusCode, err := customerSvc.ShareAssessmentWith(assessment.Id, []v1alpha1.ShareSubject{
+				{Type: v1alpha1.ShareSubjectTypeUser, Id: "sharecolleague"},
+				{Type: v1alpha1.ShareSubjectTypeGroup, Id: group.Id.String()},
+			})
+			Expect(err).To(BeNil())
+			Expect(statusCode).To(Equal(http.StatusOK))
+
+			// Both the colleague and the group members can read the assessment
+			colleagueAssessment, err := colleagueSvc.GetAssessment(assessment.Id)
+			Expect(err).To(BeNil())
+			Expect(*colleagueAssessment.Permissions).To(ConsistOf(v1alpha1.Read))
+			partnerAssessments, err := partnerSvc.GetAssessments()
+			Expect(err).To(BeNil())
+			Expect(findAssessment(partnerAssessments, assessment.Id)).To(BeTrue())
+
+			// Owner sees the grants
+			customerAssessment, err := customerSvc.GetAssessment(assessment.Id)
+			Expect(err).To(BeNil())
+			Expect(customerAssessment.Sharing.SharedWith).To(HaveLen(2))
+			for _, s := range customerAssessment.Sharing.SharedWith {
+				Expect(*s.Relation).To(Equal("viewer"))
+			}
+
+			// Customer unshares the assessment from the colleague only
+			statusCode, err = customerSvc.UnshareAssessmentWith(assessment.Id, []v1alpha1.ShareSubject{
+				{Type: v1alpha1.ShareSubjectTypeUser, Id: "sharecolleague"},
+			})
+			Expect(err).To(BeNil())
+			Expect(statusCode).To(Equal(http.StatusOK))
+
+			_, err = colleagueSvc.GetAssessment(assessment.Id)
+			Expect(err).ToNot(BeNil())
+			partnerAssessments, err = partnerSvc.GetAssessments()
+			Expect(err).To(BeNil())
+			Expect(findAssessment(partnerAssessments, assessment.Id)).To(BeTrue())
+
+			zap.S().Infof("============Successfully Passed: %s=====", CurrentSpecReport().LeafNodeText)
+		})
+
+		It("share with an unknown group — returns 400", func() {
+			zap.S().Infof("============Running test: %s============", CurrentSpecReport().LeafNodeText)
+
+			statusCode, _ := customerSvc.ShareAssessmentWith(assessment.Id, []v1alpha1.ShareSubject{
+				{Type: v1alpha1.ShareSubjectTypeGroup, Id: uuid.New().String()},
+			})
+			Expect(statusCode).To(Equal(http.StatusBadRequest))
+
+			zap.S().Infof("============Successfully Passed: %s=====", CurrentSpecReport().LeafNodeText)
+		})
+	})
+
 	Context("Share authorization", func() {
 		It("non-customer cannot share — returns 400", func() {
 			zap.S().Infof("============Running test: %s============", CurrentSpecReport().LeafNodeText)
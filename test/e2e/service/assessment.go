@@ -232,9 +232,20 @@ func (s *plannerService) UpdateAssessment(id uuid.UUID, name string) (*v1alpha1.
 
 // ShareAssessment shares an assessment with the user's partner organization
 func (s *plannerService) ShareAssessment(id uuid.UUID) (int, error) {
+	return s.ShareAssessmentWith(id, nil)
+}
+
+// ShareAssessmentWith shares an assessment with users and groups, or with the user's partner
+// organization when no subjects are given
+func (s *plannerService) ShareAssessmentWith(id uuid.UUID, subjects []v1alpha1.ShareSubject) (int, error) {
 	zap.S().Infof("[PlannerService] Share assessment %s [user: %s, organization: %s]", id, s.credentials.Username, s.credentials.Organization)
 
-	res, err := s.api.PostRequest(path.Join(apiV1AssessmentsPath, id.String(), "share"), nil)
+	reqBody, err := shareRequestBody(subjects)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := s.api.PostRequest(path.Join(apiV1AssessmentsPath, id.String(), "share"), reqBody)
 	if err != nil {
 		return 0, err
 	}
@@ -249,9 +260,20 @@ func (s *plannerService) ShareAssessment(id uuid.UUID) (int, error) {
 
 // UnshareAssessment unshares an assessment from the user's partner organization
 func (s *plannerService) UnshareAssessment(id uuid.UUID) (int, error) {
+	return s.UnshareAssessmentWith(id, nil)
+}
+
+// UnshareAssessmentWith unshares an assessment from users and groups, or from the user's partner
+// organization when no subjects are given
+func (s *plannerService) UnshareAssessmentWith(id uuid.UUID, subjects []v1alpha1.ShareSubject) (int, error) {
 	zap.S().Infof("[PlannerService] Unshare assessment %s [user: %s, organization: %s]", id, s.credentials.Username, s.credentials.Organization)
 
-	res, err := s.api.DeleteRequest(path.Join(apiV1AssessmentsPath, id.String(), "share"))
+	reqBody, err := shareRequestBody(subjects)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := s.api.DeleteRequestWithBody(path.Join(apiV1AssessmentsPath, id.String(), "share"), reqBody)
 	if err != nil {
 		return 0, err
 	}
@@ -264,6 +286,14 @@ func (s *plannerService) UnshareAssessment(id uuid.UUID) (int, error) {
 	return res.StatusCode, nil
 }
 
+func shareRequestBody(subjects []v1alpha1.ShareSubject) ([]byte, error) {
+	req := v1alpha1.AssessmentShareRequest{}
+	if len(subjects) > 0 {
+		req.Subjects = &subjects
+	}
+	return json.Marshal(req)
+}
+
 // RemoveAssessment deletes a specific assessment by ID
 func (s *plannerService) RemoveAssessment(id uuid.UUID) error {
 	zap.S().Infof("[PlannerService] Delete assessment [user: %s, organization: %s]", s.credentials.Username, s.credentials.Organization)
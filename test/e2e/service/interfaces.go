@@ -38,6 +38,8 @@ type assessmentApi interface {
 	RemoveAssessment(uuid.UUID) error
 	ShareAssessment(uuid.UUID) (int, error)
 	UnshareAssessment(uuid.UUID) (int, error)
+	ShareAssessmentWith(uuid.UUID, []v1alpha1.ShareSubject) (int, error)
+	UnshareAssessmentWith(uuid.UUID, []v1alpha1.ShareSubject) (int, error)
 }
 
 type jobApi interface {
@@ -60,6 +60,12 @@ func (api *ServiceApi) DeleteRequest(path string) (*http.Response, error) {
 	return api.request(http.MethodDelete, path, nil)
 }
 
+// DeleteRequestWithBody makes an HTTP DELETE request to the specified path with the provided body
+// and authorization token, returning the HTTP response.
+func (api *ServiceApi) DeleteRequestWithBody(path string, body []byte) (*http.Response, error) {
+	return api.request(http.MethodDelete, path, body)
+}
+
 func (api *ServiceApi) prepareRequest(method string, path string, body []byte) (*http.Request, error) {
 	var req *http.Request
 	var err error
@@ -75,7 +81,7 @@ func (api *ServiceApi) prepareRequest(method string, path string, body []byte) (
 	case http.MethodPut:
 		req, err = http.NewRequest(http.MethodPut, queryPath, bytes.NewReader(body))
 	case http.MethodDelete:
-		req, err = http.NewRequest(http.MethodDelete, queryPath, nil)
+		req, err = http.NewRequest(http.MethodDelete, queryPath, bytes.NewReader(body))
 	default:
 		return nil, fmt.Errorf("unsupported method: %s", method)
 	}